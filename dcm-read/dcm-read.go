@@ -1,38 +1,25 @@
 // Package main is a script that reads a filesystem full of dcm files and
 // generates a json report.
-// It uses the dcm2xml tool from the dcm4chee 3 toolkit.
-// It will use the dcm2xml tool to generate an xml with the dicom attributes
-// and then parse through them.
+// Files are recognized by their content and read with the dicom package up
+// to the Pixel Data, the tags in TagFlatList are reported grouped by
+// patient, study, series and instance.
 package main
 
 import (
-	"encoding/xml"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
 	"reflect"
+	"runtime"
+	"time"
 
+	"github.com/davidgamba/go-dicom/dicom"
 	"github.com/davidgamba/go-getoptions"
 )
 
 var debug bool
 
-// <NativeDicomModel><DicomAttribute keyword="SeriesDescription" tag="0008103E" vr="LO"><Value number="1">COR T2 HASTE</Value></DicomAttribute></NativeDicomModel>
-
-// NativeDicomModel -
-type NativeDicomModel struct {
-	DicomAttributes []DicomAttribute `xml:"DicomAttribute"`
-}
-
-// DicomAttribute -
-type DicomAttribute struct {
-	Keyword     string `xml:"keyword,attr"`
-	Tag         string `xml:"tag,attr"`
-	VR          string `xml:"vr,attr"`
-	PatientName string `xml:"PersonName>Alphabetic>FamilyName"`
-	Value       []string
-}
-
 type dcmKeyType interface{}
 
 // TagFlatList -
@@ -96,88 +83,132 @@ func GetStructFields(s interface{}) []reflect.StructField {
 	return values
 }
 
-func readDCMFile(bin, dcmFilepath string) (NativeDicomModel, error) {
-	var ndm NativeDicomModel
-	command := []string{}
-	command = append(command, bin+string(os.PathSeparator)+"bin"+string(os.PathSeparator)+"dcm2xml")
-	command = append(command, dcmFilepath)
-	debugln(command)
-	out, err := exec.Command(command[0], command[1:]...).Output()
+// readDCMFile reads the elements of the file with the given tags, stopping
+// before the Pixel Data.
+// Files without elements past the File Meta Information are not DICOM,
+// the few bytes of some other files look like a data element.
+func readDCMFile(dcmFilepath string, tags []dicom.Tag) (*dicom.Dataset, error) {
+	f, err := os.Open(dcmFilepath)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "[ERROR] command: %s\n", err)
-		return ndm, err
+		return nil, err
 	}
-	debugf("%s\n", out)
-	err = xml.Unmarshal(out, &ndm)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "[ERROR] unmarshaling XML: %s\n", err)
-		return ndm, err
+	defer f.Close()
+	// Large values, like embedded overlays or private blobs, are never
+	// reported.
+	d := dicom.NewDecoder(f, dicom.Options{StopAtTag: dicom.PixelDataTag, LargeValueLength: 1 << 12, Lenient: true})
+	ds := &dicom.Dataset{}
+	body, instance := false, false
+	for {
+		e, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		body = body || e.Tag.Group != 0x0002
+		instance = instance || e.Tag == dicom.SOPInstanceUIDTag
+		for _, t := range tags {
+			if e.Tag == t {
+				ds.Elements = append(ds.Elements, e)
+			}
+		}
+	}
+	for _, w := range d.Warnings() {
+		debugf("%s: %s\n", dcmFilepath, w)
+	}
+	if !body {
+		return nil, &dicom.Error{Kind: dicom.ErrNotDICOM, Msg: "no data element found"}
 	}
-	return ndm, nil
+	if !instance {
+		return nil, fmt.Errorf("missing SOP Instance UID %s", dicom.SOPInstanceUIDTag)
+	}
+	return ds, nil
+}
+
+// isNotDICOM reports whether err comes from a file that is not DICOM.
+func isNotDICOM(err error) bool {
+	e, ok := err.(*dicom.Error)
+	return ok && e.Kind == dicom.ErrNotDICOM
 }
 
 func debugf(format string, a ...interface{}) (n int, err error) {
 	if debug {
-		return fmt.Printf(format, a)
+		return fmt.Fprintf(os.Stderr, format, a...)
 	}
 	return 0, nil
 }
 func debugln(a ...interface{}) (n int, err error) {
 	if debug {
-		return fmt.Println(a)
+		return fmt.Fprintln(os.Stderr, a...)
 	}
 	return 0, nil
 }
 
 func synopsis() {
-	synopsis := `dcm-read <dcm_file_path>
-  --lib <dcm4chee-location>
-  [--debug]
-`
-	fmt.Fprintln(os.Stderr, synopsis)
-}
+	synopsis := `dcm-read <path>... [--jobs <n>] [--progress] [--debug]
 
-type stringSlice []string
+    Walks the given files and directories and prints a JSON inventory of the
+    DICOM files found, grouped by patient, study, series and instance.
 
-func (s stringSlice) contains(a string) bool {
-	for _, b := range s {
-		if a == b {
-			return true
-		}
-	}
-	return false
+    --jobs      Number of files read in parallel. Default: number of CPUs.
+
+    --progress  Print the number of files scanned to stderr every few
+                seconds.
+`
+	fmt.Fprintln(os.Stderr, synopsis)
 }
 
 func main() {
-	var lib string
 	opt := getoptions.New()
-	opt.StringVar(&lib, "lib", "")
+	opt.Bool("help", false)
+	opt.BoolVar(&debug, "debug", false)
+	var jobs int
+	opt.IntVar(&jobs, "jobs", runtime.NumCPU())
+	progress := opt.Bool("progress", false)
 	remaining, err := opt.Parse(os.Args[1:])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
 		os.Exit(1)
 	}
-	if !opt.Called("lib") {
-		fmt.Fprintf(os.Stderr, "[ERROR] missing dcm4chee --lib option\n")
+	if opt.Called("help") {
 		synopsis()
 		os.Exit(1)
 	}
+	if len(remaining) < 1 {
+		fmt.Fprintf(os.Stderr, "ERROR: Missing path\n")
+		synopsis()
+		os.Exit(1)
+	}
+	if jobs < 1 {
+		fmt.Fprintf(os.Stderr, "ERROR: --jobs must be at least 1\n")
+		os.Exit(1)
+	}
 	fields := GetStructFields(TagFlatList{})
-	tags := []string{}
+	tags := []dicom.Tag{}
 	for _, f := range fields {
-		tags = append(tags, string(f.Tag.Get("dcm")))
-	}
-
-	ndm, _ := readDCMFile(lib, remaining[0])
-	for _, da := range ndm.DicomAttributes {
-		if stringSlice(tags).contains(da.Tag) {
-			if len(da.Value) > 0 {
-				fmt.Printf("%s -> %s\n", da.Keyword, da.Value[0])
-			} else if da.PatientName != "" {
-				fmt.Printf("%s -> %v\n", da.Keyword, da.PatientName)
-			} else {
-				fmt.Printf("%s\n", da.Keyword)
-			}
+		t, err := dicom.ParseTag(f.Tag.Get("dcm"))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: %s: %s\n", f.Name, err)
+			os.Exit(1)
 		}
+		tags = append(tags, t)
+	}
+
+	inv := NewInventory()
+	opts := scanOptions{jobs: jobs, interval: 5 * time.Second}
+	if *progress {
+		opts.progress = os.Stderr
+	}
+	scan(inv, remaining, tags, opts)
+	inv.Finish()
+	out, err := json.MarshalIndent(inv, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(out))
+	for _, e := range inv.Errors {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", e)
 	}
 }
@@ -0,0 +1,185 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom"
+)
+
+// Inventory - JSON report of the scanned files grouped by patient, study,
+// series and instance, sorted by their IDs and UIDs.
+type Inventory struct {
+	NumberOfFiles int
+	Patients      []*Patient
+	// Errors lists the DICOM files, paths and directories that couldn't be
+	// read.
+	Errors []string `json:",omitempty"`
+
+	patients  map[string]*Patient
+	studies   map[string]*Study
+	series    map[string]*Series
+	instances map[string]*Instance
+}
+
+// Patient - Patient level entry, the NumberOfRelated fields count the
+// entries below it.
+type Patient struct {
+	PatientTags
+	Studies []*Study
+}
+
+// Study - Study level entry.
+type Study struct {
+	StudyTags
+	Series []*Series
+}
+
+// Series - Series level entry.
+type Series struct {
+	SeriesTags
+	Instances []*Instance
+}
+
+// Instance - Instance level entry, with the files holding it.
+type Instance struct {
+	InstanceTags
+	Files []string
+}
+
+// NewInventory returns an empty Inventory.
+func NewInventory() *Inventory {
+	return &Inventory{
+		Patients:  []*Patient{},
+		patients:  map[string]*Patient{},
+		studies:   map[string]*Study{},
+		series:    map[string]*Series{},
+		instances: map[string]*Instance{},
+	}
+}
+
+// Add records the file with its dataset.
+// A study, series or instance UID repeated under a different parent gets
+// its own entry.
+func (inv *Inventory) Add(file string, ds *dicom.Dataset) {
+	inv.NumberOfFiles++
+	tags := TagFlatList{}
+	fillTags(&tags.PatientTags, ds)
+	fillTags(&tags.StudyTags, ds)
+	fillTags(&tags.SeriesTags, ds)
+	fillTags(&tags.InstanceTags, ds)
+	pKey := key(tags.PatientID)
+	p, ok := inv.patients[pKey]
+	if !ok {
+		p = &Patient{PatientTags: tags.PatientTags}
+		inv.patients[pKey] = p
+		inv.Patients = append(inv.Patients, p)
+	}
+	stKey := pKey + "\\" + key(tags.StudyInstanceUID)
+	st, ok := inv.studies[stKey]
+	if !ok {
+		st = &Study{StudyTags: tags.StudyTags}
+		inv.studies[stKey] = st
+		p.Studies = append(p.Studies, st)
+	}
+	seKey := stKey + "\\" + key(tags.SeriesInstanceUID)
+	se, ok := inv.series[seKey]
+	if !ok {
+		se = &Series{SeriesTags: tags.SeriesTags}
+		inv.series[seKey] = se
+		st.Series = append(st.Series, se)
+	}
+	iKey := seKey + "\\" + key(tags.SOPInstanceUID)
+	i, ok := inv.instances[iKey]
+	if !ok {
+		i = &Instance{InstanceTags: tags.InstanceTags}
+		inv.instances[iKey] = i
+		se.Instances = append(se.Instances, i)
+	}
+	i.Files = append(i.Files, file)
+}
+
+// Finish sorts the entries and fills their counts and the Modalities in
+// Study.
+func (inv *Inventory) Finish() {
+	sort.Strings(inv.Errors)
+	sort.Slice(inv.Patients, func(i, j int) bool { return less(inv.Patients[i].PatientID, inv.Patients[j].PatientID) })
+	for _, p := range inv.Patients {
+		sort.Slice(p.Studies, func(i, j int) bool { return less(p.Studies[i].StudyInstanceUID, p.Studies[j].StudyInstanceUID) })
+		pSeries, pInstances := 0, 0
+		for _, st := range p.Studies {
+			sort.Slice(st.Series, func(i, j int) bool { return less(st.Series[i].SeriesInstanceUID, st.Series[j].SeriesInstanceUID) })
+			stInstances := 0
+			modalities := []string{}
+			for _, se := range st.Series {
+				if m := key(se.Modality); m != "" && !stringSlice(modalities).contains(m) {
+					modalities = append(modalities, m)
+				}
+				sort.Slice(se.Instances, func(i, j int) bool { return less(se.Instances[i].SOPInstanceUID, se.Instances[j].SOPInstanceUID) })
+				for _, i := range se.Instances {
+					sort.Strings(i.Files)
+				}
+				se.NumberOfRelatedInstances = len(se.Instances)
+				stInstances += len(se.Instances)
+			}
+			// Files don't carry the study level Modalities in Study.
+			if st.ModalitiesInStudy == nil && len(modalities) > 0 {
+				sort.Strings(modalities)
+				st.ModalitiesInStudy = strings.Join(modalities, "\\")
+			}
+			st.NumberOfRelatedSeries = len(st.Series)
+			st.NumberOfRelatedInstances = stInstances
+			pSeries += len(st.Series)
+			pInstances += stInstances
+		}
+		p.NumberOfRelatedStudies = len(p.Studies)
+		p.NumberOfRelatedSeries = pSeries
+		p.NumberOfRelatedInstances = pInstances
+	}
+}
+
+type stringSlice []string
+
+func (s stringSlice) contains(a string) bool {
+	for _, b := range s {
+		if a == b {
+			return true
+		}
+	}
+	return false
+}
+
+// key returns the string value of a field, "" when it is missing.
+func key(v dcmKeyType) string {
+	s, _ := v.(string)
+	return s
+}
+
+// less orders the string values of two fields, missing ones first.
+func less(a, b dcmKeyType) bool {
+	return key(a) < key(b)
+}
+
+// fillTags sets the fields of the struct pointed to by dst to the values of
+// the elements named by their dcm tags.
+func fillTags(dst interface{}, ds *dicom.Dataset) {
+	v := reflect.ValueOf(dst).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		t, err := dicom.ParseTag(v.Type().Field(i).Tag.Get("dcm"))
+		if err != nil {
+			continue
+		}
+		if e, ok := ds.FindElement(t); ok {
+			v.Field(i).Set(reflect.ValueOf(e.ValueString()))
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/davidgamba/go-dicom/dicom"
+)
+
+// scanOptions - Controls how scan reads the files.
+type scanOptions struct {
+	// jobs is the number of files read at the same time.
+	jobs int
+	// progress receives a line with the counts every interval, nil disables
+	// it.
+	progress io.Writer
+	interval time.Duration
+}
+
+// readAhead - Files per job the walk may get ahead of the next file added
+// to the inventory, so a stalled read doesn't make pending grow without
+// bound.
+const readAhead = 4
+
+// file - File found by the walk, numbered in walk order.
+type file struct {
+	index int
+	path  string
+	ds    *dicom.Dataset
+	err   error
+}
+
+// scan adds the DICOM files under each of the paths to the inventory,
+// other files are skipped.
+// Files are read by a pool of workers and added to the inventory in walk
+// order, so the report doesn't depend on which read finishes first. The walk
+// stays at most readAhead files per job ahead of the next file added.
+// Paths and directories that can't be read are recorded in the inventory
+// Errors, the walk goes on with the rest.
+func scan(inv *Inventory, paths []string, tags []dicom.Tag, opts scanOptions) {
+	if opts.jobs < 1 {
+		opts.jobs = 1
+	}
+	files := make(chan file, opts.jobs)
+	// The walk takes a slot before handing out a file and the slot is freed
+	// when the file is added in walk order. Slots are taken in walk order, so
+	// the next file always holds one and is read.
+	window := make(chan struct{}, readAhead*opts.jobs)
+	go func() {
+		defer close(files)
+		n := 0
+		for _, root := range paths {
+			filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+				switch {
+				case err != nil:
+					window <- struct{}{}
+					files <- file{index: n, path: path, err: err}
+					n++
+					if entry != nil && entry.IsDir() {
+						return fs.SkipDir
+					}
+				case entry.Type().IsRegular():
+					window <- struct{}{}
+					files <- file{index: n, path: path}
+					n++
+				}
+				return nil
+			})
+		}
+	}()
+
+	read := make(chan file, opts.jobs)
+	var wg sync.WaitGroup
+	for i := 0; i < opts.jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range files {
+				if f.err == nil {
+					f.ds, f.err = readDCMFile(f.path, tags)
+				}
+				read <- f
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(read)
+	}()
+
+	// Files read ahead of the next one in walk order wait in pending.
+	pending := map[int]file{}
+	next, scanned := 0, 0
+	start := time.Now()
+	report := func() {
+		if opts.progress != nil {
+			elapsed := time.Since(start)
+			fmt.Fprintf(opts.progress, "scanned %d files, %d DICOM, %d errors in %s (%.0f files/s)\n",
+				scanned, inv.NumberOfFiles, len(inv.Errors), elapsed.Round(time.Second), float64(scanned)/elapsed.Seconds())
+		}
+	}
+	var tick <-chan time.Time
+	if opts.progress != nil {
+		ticker := time.NewTicker(opts.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case f, ok := <-read:
+			if !ok {
+				report()
+				return
+			}
+			scanned++
+			pending[f.index] = f
+			for {
+				f, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				<-window
+				switch {
+				case isNotDICOM(f.err):
+					debugf("skipping %s: %s\n", f.path, f.err)
+				case f.err != nil:
+					inv.Errors = append(inv.Errors, fmt.Sprintf("%s: %s", f.path, f.err))
+				default:
+					inv.Add(f.path, f.ds)
+				}
+			}
+		case <-tick:
+			report()
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/davidgamba/go-dicom/dicom"
+)
+
+// writeDCM writes a DICOM file with the dataset given in the DICOM JSON
+// Model, without File Meta Information when raw is set.
+func writeDCM(t *testing.T, path, js string, raw bool) {
+	ds, err := dicom.DecodeJSON(strings.NewReader(js))
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	defer f.Close()
+	if raw {
+		err = dicom.WriteDataset(f, ds, dicom.ExplicitVRLittleEndian)
+	} else {
+		err = dicom.Write(f, ds, dicom.WriteOptions{TransferSyntax: dicom.ExplicitVRLittleEndian})
+	}
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+}
+
+// instanceJSON returns a CT instance of patient p in the DICOM JSON Model.
+func instanceJSON(p, sop string) string {
+	return fmt.Sprintf(`{
+		"00080016": {"vr": "UI", "Value": ["1.2.840.10008.5.1.4.1.1.2"]},
+		"00080018": {"vr": "UI", "Value": ["%s"]},
+		"00080060": {"vr": "CS", "Value": ["CT"]},
+		"00100020": {"vr": "LO", "Value": ["%s"]},
+		"0020000D": {"vr": "UI", "Value": ["1.2.3"]},
+		"0020000E": {"vr": "UI", "Value": ["1.2.3.1"]}
+	}`, sop, p)
+}
+
+func writeFile(t *testing.T, path, s string) {
+	if err := os.WriteFile(path, []byte(s), 0644); err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+}
+
+func scanSOPInstanceUIDs(inv *Inventory) []string {
+	uids := []string{}
+	for _, p := range inv.Patients {
+		for _, st := range p.Studies {
+			for _, se := range st.Series {
+				for _, i := range se.Instances {
+					uids = append(uids, key(i.SOPInstanceUID))
+				}
+			}
+		}
+	}
+	return uids
+}
+
+func TestScanSkipsNonDICOM(t *testing.T) {
+	dir := t.TempDir()
+	writeDCM(t, filepath.Join(dir, "a", "1.dcm"), instanceJSON("P1", "1.2.3.1.1"), false)
+	writeDCM(t, filepath.Join(dir, "a", "2.dcm"), instanceJSON("P1", "1.2.3.1.2"), false)
+	writeDCM(t, filepath.Join(dir, "b", "3"), instanceJSON("P2", "1.2.3.1.3"), true)
+	writeDCM(t, filepath.Join(dir, "b", "no-sop.dcm"), `{"00100020": {"vr": "LO", "Value": ["P3"]}}`, true)
+	writeFile(t, filepath.Join(dir, "main.go"), "// Package main is a script\npackage main\n")
+	writeFile(t, filepath.Join(dir, "a", "notes.txt"), "hello world\n")
+	writeFile(t, filepath.Join(dir, "a", "empty"), "")
+	writeFile(t, filepath.Join(dir, "b", "report.json"), "{\"00100010\": {\"vr\": \"PN\"}}\n")
+	// File Meta Information alone.
+	writeFile(t, filepath.Join(dir, "b", "meta"), strings.Repeat("\x00", 128)+"DICM\x02\x00\x10\x00UI\x14\x001.2.840.10008.1.2.1\x00")
+
+	inv := NewInventory()
+	scan(inv, []string{dir}, []dicom.Tag{dicom.SOPInstanceUIDTag, {Group: 0x0010, Element: 0x0020}}, scanOptions{jobs: 2})
+	inv.Finish()
+	if inv.NumberOfFiles != 3 {
+		t.Errorf("Fail: %d files", inv.NumberOfFiles)
+	}
+	if uids := scanSOPInstanceUIDs(inv); !reflect.DeepEqual(uids, []string{"1.2.3.1.1", "1.2.3.1.2", "1.2.3.1.3"}) {
+		t.Errorf("Fail: %v", uids)
+	}
+	if len(inv.Errors) != 1 || !strings.HasPrefix(inv.Errors[0], filepath.Join(dir, "b", "no-sop.dcm")+": missing SOP Instance UID") {
+		t.Errorf("Fail: %v", inv.Errors)
+	}
+}
+
+func TestScanMissingPath(t *testing.T) {
+	dir := t.TempDir()
+	writeDCM(t, filepath.Join(dir, "1.dcm"), instanceJSON("P1", "1.2.3.1.1"), false)
+	missing := filepath.Join(dir, "missing")
+
+	inv := NewInventory()
+	scan(inv, []string{missing, dir}, []dicom.Tag{dicom.SOPInstanceUIDTag}, scanOptions{jobs: 2})
+	inv.Finish()
+	if uids := scanSOPInstanceUIDs(inv); !reflect.DeepEqual(uids, []string{"1.2.3.1.1"}) {
+		t.Errorf("Fail: %v", uids)
+	}
+	if len(inv.Errors) != 1 || !strings.HasPrefix(inv.Errors[0], missing+": ") {
+		t.Errorf("Fail: %v", inv.Errors)
+	}
+}
+
+func TestScanUnreadableDir(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("directory permissions don't apply to root")
+	}
+	dir := t.TempDir()
+	writeDCM(t, filepath.Join(dir, "a", "1.dcm"), instanceJSON("P1", "1.2.3.1.1"), false)
+	writeDCM(t, filepath.Join(dir, "b", "2.dcm"), instanceJSON("P1", "1.2.3.1.2"), false)
+	writeDCM(t, filepath.Join(dir, "c", "3.dcm"), instanceJSON("P1", "1.2.3.1.3"), false)
+	locked := filepath.Join(dir, "b")
+	if err := os.Chmod(locked, 0); err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	defer os.Chmod(locked, 0755)
+
+	inv := NewInventory()
+	scan(inv, []string{dir}, []dicom.Tag{dicom.SOPInstanceUIDTag}, scanOptions{jobs: 2})
+	inv.Finish()
+	if uids := scanSOPInstanceUIDs(inv); !reflect.DeepEqual(uids, []string{"1.2.3.1.1", "1.2.3.1.3"}) {
+		t.Errorf("Fail: %v", uids)
+	}
+	if len(inv.Errors) != 1 || !strings.HasPrefix(inv.Errors[0], locked+": ") {
+		t.Errorf("Fail: %v", inv.Errors)
+	}
+}
@@ -0,0 +1,141 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package main is a script that converts DICOM files between the
+// uncompressed transfer syntaxes.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/davidgamba/go-dicom/dicom"
+	"github.com/davidgamba/go-getoptions"
+)
+
+var debug bool
+
+func debugf(format string, a ...interface{}) (n int, err error) {
+	if debug {
+		return fmt.Printf(format, a...)
+	}
+	return 0, nil
+}
+
+// syntaxes - Transfer syntaxes dcmconv converts between, by option name.
+var syntaxes = map[string]dicom.TransferSyntax{
+	"implicit": dicom.ImplicitVRLittleEndian,
+	"explicit": dicom.ExplicitVRLittleEndian,
+	"big":      dicom.ExplicitVRBigEndian,
+	"deflated": dicom.DeflatedExplicitVRLittleEndian,
+}
+
+// lookupSyntax returns the transfer syntax for its option name or UID.
+func lookupSyntax(s string) (dicom.TransferSyntax, error) {
+	if ts, ok := syntaxes[s]; ok {
+		return ts, nil
+	}
+	for _, ts := range syntaxes {
+		if ts.UID == s {
+			return ts, nil
+		}
+	}
+	return dicom.TransferSyntax{}, fmt.Errorf("unsupported transfer syntax '%s'", s)
+}
+
+func convert(in, out string, ts dicom.TransferSyntax) error {
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	d := dicom.NewDecoder(f, dicom.Options{})
+	ds, err := d.Decode()
+	if err != nil {
+		return err
+	}
+	if _, err := lookupSyntax(d.TransferSyntax().UID); err != nil {
+		return fmt.Errorf("%s: %s", in, err)
+	}
+	debugf("%s: %s -> %s\n", in, d.TransferSyntax().UID, ts.UID)
+	// Write next to the output and rename, so converting a file in place
+	// doesn't truncate it before it is read.
+	tmp, err := ioutil.TempFile(filepath.Dir(out), filepath.Base(out)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	// The temporary file is created 0600, give the output the mode of the
+	// input.
+	err = tmp.Chmod(fi.Mode().Perm())
+	if err == nil {
+		err = dicom.Write(tmp, ds, dicom.WriteOptions{TransferSyntax: ts})
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), out)
+}
+
+func synopsis() {
+	synopsis := `dcmconv <dcm_file> <out_file> --ts <transfer_syntax> [--debug]
+
+    --ts    Transfer syntax of the output file, either its UID or one of:
+            implicit  Implicit VR Little Endian
+            explicit  Explicit VR Little Endian
+            big       Explicit VR Big Endian
+            deflated  Deflated Explicit VR Little Endian
+`
+	fmt.Fprintln(os.Stderr, synopsis)
+}
+
+func main() {
+	opt := getoptions.New()
+	opt.Bool("help", false)
+	opt.BoolVar(&debug, "debug", false)
+	var tsName string
+	opt.StringVar(&tsName, "ts", "")
+	remaining, err := opt.Parse(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	if opt.Called("help") {
+		synopsis()
+		os.Exit(1)
+	}
+	if len(remaining) < 2 {
+		fmt.Fprintf(os.Stderr, "ERROR: Missing input or output file\n")
+		synopsis()
+		os.Exit(1)
+	}
+	if !opt.Called("ts") {
+		fmt.Fprintf(os.Stderr, "ERROR: Missing --ts option\n")
+		synopsis()
+		os.Exit(1)
+	}
+	ts, err := lookupSyntax(tsName)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	err = convert(remaining[0], remaining[1], ts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -1,101 +1,71 @@
-// Package main is a script that reads a filesystem full of dcm files and
-// generates a json report.
+// Package main is a script that dumps the elements of a dcm file as text, in
+// the DICOM JSON Model or in the Native DICOM Model XML.
 package main
 
 import (
-	"encoding/binary"
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
-	"os"
-	// "strconv"
 	"log"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
-	"github.com/davidgamba/go-dicom/dcmdump/tag"
-	"github.com/davidgamba/go-dicom/dcmdump/ts"
-	vri "github.com/davidgamba/go-dicom/dcmdump/vr"
+	"github.com/davidgamba/go-dicom/dicom"
+	"github.com/davidgamba/go-dicom/dicom/tag"
+	"github.com/davidgamba/go-dicom/dicom/uid"
 	"github.com/davidgamba/go-getoptions"
 )
 
 var debug bool
 
-func debugf(format string, a ...interface{}) (n int, err error) {
-	if debug {
-		return fmt.Printf(format, a...)
-	}
-	return 0, nil
-}
-func debugln(a ...interface{}) (n int, err error) {
-	if debug {
-		return fmt.Println(a...)
-	}
-	return 0, nil
-}
-
-type stringSlice []string
-
-func (s stringSlice) contains(a string) bool {
-	for _, b := range s {
-		if a == b {
-			return true
+func elementString(e *dicom.Element, level int) string {
+	tn := "MISSING"
+	if e.Tag.IsPrivate() {
+		switch {
+		case e.Tag.Element == 0x0000:
+			tn = "PrivateGroupLength"
+		case e.Tag.Element >= 0x0010 && e.Tag.Element <= 0x00FF:
+			tn = "PrivateCreator"
+		case e.Creator != "":
+			tn = e.Creator + ":" + tag.PrivateKey(e.Tag.Group, e.Tag.Element)
+			if entry, ok := tag.LookupPrivate(e.Creator, e.Tag.Group, e.Tag.Element); ok {
+				tn = e.Creator + ":" + entry.Name
+			}
 		}
+	} else if entry, _, ok := tag.Lookup(e.Tag.Group, e.Tag.Element); ok {
+		tn = entry.Keyword
 	}
-	return false
-}
-
-type dicomqr struct {
-	Empty [128]byte
-	DICM  [4]byte
-	Rest  []byte
-}
-
-// DataElement -
-type DataElement struct {
-	N        int
-	TagGroup []byte // [2]byte
-	TagElem  []byte // [2]byte
-	TagStr   string
-	VR       []byte // [2]byte
-	VRStr    string
-	VRLen    int
-	Len      uint32
-	Data     []byte
-	PartOfSQ bool
+	padding := strings.Repeat("    ", level)
+	if e.Len < 128 {
+		return fmt.Sprintf("%s%04d %s %s %d %s %s", padding, e.Offset, e.Tag, e.VR, e.Len, tn, stringData(e))
+	}
+	return fmt.Sprintf("%s%04d %s %s %d %s %s", padding, e.Offset, e.Tag, e.VR, e.Len, tn, "...")
 }
 
-// String -
-func (de *DataElement) String() string {
-	tn := tag.Tag[de.TagStr]["name"]
-	if _, ok := tag.Tag[de.TagStr]; !ok {
-		tn = "MISSING"
+func printElement(e *dicom.Element, level int) {
+	fmt.Println(elementString(e, level))
+	printBytes(e.Value)
+	for i, item := range e.Items {
+		fmt.Printf("%s%s Item #%d\n", strings.Repeat("    ", level+1), dicom.ItemTag, i+1)
+		printDataset(item, level+1)
 	}
-	padding := ""
-	if de.PartOfSQ {
-		padding = "    "
-	}
-	if de.Len < 128 {
-		return fmt.Sprintf("%s%04d (%s) %s %d %d %s %s", padding, de.N, de.TagStr, de.VRStr, de.VRLen, de.Len, tn, de.stringData())
+	if e.Fragments != nil {
+		padding := strings.Repeat("    ", level+1)
+		fmt.Printf("%s%s Basic Offset Table %v\n", padding, dicom.ItemTag, e.OffsetTable)
+		for i, f := range e.Fragments {
+			fmt.Printf("%s%s Fragment #%d %d\n", padding, dicom.ItemTag, i+1, len(f))
+		}
 	}
-	return fmt.Sprintf("%s%04d (%s) %s %d %d %s %s", padding, de.N, de.TagStr, de.VRStr, de.VRLen, de.Len, tn, "...")
 }
 
-type fh os.File
-
-func readNBytes(f *os.File, size int) ([]byte, error) {
-	data := make([]byte, size)
-	for {
-		data = data[:cap(data)]
-		n, err := f.Read(data)
-		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			return nil, err
-		}
-		data = data[:n]
+func printDataset(ds *dicom.Dataset, level int) {
+	for _, e := range ds.Elements {
+		printElement(e, level)
 	}
-	return data, nil
 }
 
 // http://rosettacode.org/wiki/Strip_control_codes_and_extended_characters_from_a_string#Go
@@ -109,11 +79,6 @@ func stripCtlFromUTF8(str string) string {
 	}, str)
 }
 
-func tagString(b []byte) string {
-	tag := strings.ToUpper(fmt.Sprintf("%02x%02x%02x%02x", b[1], b[0], b[3], b[2]))
-	return tag
-}
-
 func printBytes(b []byte) {
 	if !debug {
 		return
@@ -146,243 +111,96 @@ func printBytes(b []byte) {
 	fmt.Printf("\n")
 }
 
-func (de *DataElement) stringData() string {
-	if de.TagStr == "00020010" {
-		dataStr := string(de.Data)
-		l := len(de.Data)
-		if de.Data[l-1] == 0x0 {
-			dataStr = string(de.Data[:l-1])
-		}
-		if tsStr, ok := ts.TS[dataStr]; ok {
-			return dataStr + " " + tsStr["name"].(string)
+func stringData(e *dicom.Element) string {
+	if len(e.Value) == 0 {
+		return ""
+	}
+	if e.VR == "UI" {
+		uids, _ := e.Strings()
+		if entry, ok := uid.UID[uids[0]]; ok && len(uids) == 1 {
+			return uids[0] + " " + entry.Name
 		}
 	}
-	if _, ok := vri.VR[de.VRStr]["fixed"]; ok && vri.VR[de.VRStr]["fixed"].(bool) {
-		s := ""
-		l := len(de.Data)
-		n := 0
-		vrl := vri.VR[de.VRStr]["len"].(int)
-		switch vrl {
-		case 1:
-			for n+1 <= l {
-				s += fmt.Sprintf("%d ", de.Data[n])
-				n++
-			}
-			return s
-		case 2:
-			for n+2 <= l {
-				e := binary.LittleEndian.Uint16(de.Data[n : n+2])
-				s += fmt.Sprintf("%d ", e)
-				n += 2
-			}
-			return s
-		case 4:
-			for n+4 <= l {
-				e := binary.LittleEndian.Uint32(de.Data[n : n+4])
-				s += fmt.Sprintf("%d ", e)
-				n += 4
-			}
-			return s
-		default:
-			return string(de.Data)
+	switch e.VR {
+	case "DA", "TM", "DT":
+		// Values without a UTC offset are shown as they are.
+		if dts, err := e.DateTimes(time.UTC); err == nil && len(dts) == 1 {
+			return e.ValueString() + " [" + dts[0].ISO8601() + "]"
 		}
-	} else {
-		if _, ok := vri.VR[de.VRStr]["padded"]; ok && vri.VR[de.VRStr]["padded"].(bool) {
-			l := len(de.Data)
-			if de.Data[l-1] == 0x0 {
-				return string(de.Data[:l-1])
-			}
-			return string(de.Data)
+	case "AS":
+		if ages, err := e.Ages(); err == nil && len(ages) == 1 {
+			units := map[byte]string{'D': "days", 'W': "weeks", 'M': "months", 'Y': "years"}
+			return fmt.Sprintf("%s [%d %s]", e.ValueString(), ages[0].Value, units[ages[0].Unit])
 		}
-		return string(de.Data)
 	}
+	return e.ValueString()
 }
 
-func parseDataElement(bytes []byte, n int, explicit bool, limit int) {
-	l := len(bytes)
-	log.Printf("parseDataElement of size: %d, start possition: %d, limit %d", l, n, limit)
-	// Data element
-	m := n
-	for n <= l && m+4 <= l && n <= limit && m+4 <= limit {
-		undefinedLen := false
-		de := DataElement{N: n}
-		m += 4
-		t := bytes[n:m]
-		de.TagGroup = bytes[n : n+2]
-		de.TagElem = bytes[n+2 : n+4]
-		de.TagStr = tagString(t)
-		// TODO: Clean up tagString
-		tagStr := tagString(t)
-		log.Printf("n: %d, Tag: %X -> %s\n", n, t, tagStr)
-		printBytes(bytes[n:m])
-		n = m
-		if tagStr == "" {
-			log.Printf("%d Empty Tag: %s\n", n, tagStr)
-		} else if _, ok := tag.Tag[tagStr]; !ok {
-			fmt.Fprintf(os.Stderr, "INFO: %d Missing tag '%s'\n", n, tagStr)
-		} else {
-			log.Printf("Tag Name: %s\n", tag.Tag[tagStr]["name"])
-		}
-		var len uint32
-		var vr string
-		if explicit {
-			debugf("%d VR\n", n)
-			m += 2
-			printBytes(bytes[n:m])
-			de.VR = bytes[n:m]
-			de.VRStr = string(bytes[n:m])
-			vr = string(bytes[n:m])
-			if _, ok := vri.VR[vr]; !ok {
-				if bytes[n] == 0x0 && bytes[n+1] == 0x0 {
-					fmt.Fprintf(os.Stderr, "INFO: Blank VR\n")
-					vr = "00"
-					de.VRStr = "00"
-				} else {
-					fmt.Fprintf(os.Stderr, "ERROR: %d Missing VR '%s'\n", n, vr)
-					printBytes(bytes[n:limit])
-					return
-				}
-			}
-			n = m
-			if vr == "OB" ||
-				vr == "OD" ||
-				vr == "OF" ||
-				vr == "OL" ||
-				vr == "OW" ||
-				vr == "SQ" ||
-				vr == "UC" ||
-				vr == "UR" ||
-				vr == "UT" ||
-				vr == "UN" {
-				debugln("Reserved")
-				m += 2
-				printBytes(bytes[n:m])
-				n = m
-				debugln("Lenght")
-				m += 4
-				printBytes(bytes[n:m])
-				len = binary.LittleEndian.Uint32(bytes[n:m])
-				n = m
-			} else {
-				debugln("Lenght")
-				m += 2
-				printBytes(bytes[n:m])
-				len16 := binary.LittleEndian.Uint16(bytes[n:m])
-				len = uint32(len16)
-				n = m
-			}
-		} else {
-			debugln("Lenght")
-			m += 4
-			printBytes(bytes[n:m])
-			len = binary.LittleEndian.Uint32(bytes[n:m])
-			n = m
-		}
-		if len == 0xFFFFFFFF {
-			undefinedLen = true
-			for {
-				endTag := bytes[m : m+4]
-				endTagStr := tagString(endTag)
-				if de.TagStr == "FFFEE000" && endTagStr == "FFFEE00D" {
-					// FFFEE000 item
-					// find FFFEE00D: ItemDelimitationItem
-					log.Printf("found ItemDelimitationItem at %d", m)
-					len = uint32(m - n)
-					m = n
-					break
-				} else if endTagStr == "FFFEE0DD" {
-					// Find FFFEE0DD: SequenceDelimitationItem
-					log.Printf("found SequenceDelimitationItem at %d", m)
-					len = uint32(m - n)
-					m = n
-					break
-				} else {
-					m++
-					if m >= l {
-						fmt.Fprintf(os.Stderr, "ERROR: Couldn't find SequenceDelimitationItem\n")
-						printBytes(bytes[n:l])
-						return
-					}
-				}
-			}
-		}
-		de.Len = len
-		debugf("Lenght: %d\n", len)
-		m += int(len)
-		printBytes(bytes[n:m])
-		if de.TagStr == "FFFEE000" {
-			de.Data = []byte{}
-			fmt.Println(de.String())
-			log.Printf("parseDataElement Item %d %d", n, m)
-			printBytes(bytes[n:m])
-			parseDataElement(bytes, n, true, m)
-			log.Printf("parseDataElement Item Complete")
-		} else if vr == "SQ" {
-			de.Data = []byte{}
-			fmt.Println(de.String())
-			log.Printf("parseDataElement SQ %d %d", n, m)
-			printBytes(bytes[n:m])
-			parseDataElement(bytes, n, false, m)
-			log.Printf("parseDataElement SQ Complete")
-		} else {
-			de.Data = bytes[n:m]
-			fmt.Println(de.String())
-		}
-		if undefinedLen {
-			m += 8
+// bulkDataURI points to the values left in the file, so the JSON and XML
+// reports don't carry the pixel data.
+// The URI of encapsulated pixel data spans its items up to the Sequence
+// Delimitation Item.
+func bulkDataURI(file string) func(e *dicom.Element) string {
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		abs = file
+	}
+	return func(e *dicom.Element) string {
+		if !e.Lazy {
+			return ""
 		}
-		n = m
+		return fmt.Sprintf("file://%s?offset=%d&length=%d", filepath.ToSlash(abs), e.ValueOffset, e.ValueLength())
 	}
-	log.Printf("parseDataElement Complete")
 }
 
-func parseSQDataElements(bytes []byte, n int, explicit bool) int {
-	log.Printf("parseSQDataElements")
-	l := len(bytes)
-	m := n
-	for n <= l && m+4 <= l {
-		de := DataElement{N: n}
-		m := n + 4
-		printBytes(bytes[n:m])
-		t := bytes[n:m]
-		tagStr := tagString(t)
-		de.TagGroup = bytes[n : n+2]
-		de.TagElem = bytes[n+2 : n+4]
-		de.TagStr = tagString(t)
-		log.Printf("n: %d, Tag: %X -> %s\n", n, t, tagStr)
-		if _, ok := tag.Tag[tagStr]; !ok {
-			fmt.Fprintf(os.Stderr, "ERROR: %d Missing tag '%s'\n", n, tagStr)
-		}
-		// if _, ok := tag.Tag[tagStr]; ok && tag.Tag[tagStr]["name"] == "ItemDelimitationItem" {
-		// 	sequenceDelimitationItem = true
-		// }
-		for m <= l {
-			// Find FFFEE00D: ItemDelimitationItem
-			endTag := bytes[m : m+4]
-			endTagStr := tagString(endTag)
-			if endTagStr == "FFFEE00D" {
-				debugln("Item Delim found")
-				de.Data = bytes[n:m]
-				printBytes(bytes[n:m])
-				log.Printf("Tag: %X -> %s\n", endTag, endTagStr)
-				m += 4
-				n = m
-				// m += 4
-				// printBytes(bytes[n:m])
-				// n = m
-				break
-			} else {
-				m++
-			}
-		}
-		fmt.Println(de.String())
+func printJSON(file string, d *dicom.Decoder) error {
+	ds, err := d.Decode()
+	if err != nil {
+		return err
+	}
+	var buf, out bytes.Buffer
+	err = dicom.EncodeJSON(&buf, ds, dicom.JSONOptions{BulkDataURI: bulkDataURI(file)})
+	if err != nil {
+		return err
+	}
+	json.Indent(&out, buf.Bytes(), "", "  ")
+	_, err = out.WriteTo(os.Stdout)
+	return err
+}
+
+func printXML(file string, d *dicom.Decoder) error {
+	ds, err := d.Decode()
+	if err != nil {
+		return err
+	}
+	return dicom.EncodeXML(os.Stdout, ds, dicom.XMLOptions{BulkDataURI: bulkDataURI(file)})
+}
+
+func loadPrivateDict(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
 	}
-	log.Printf("parseSQDataElement Complete")
-	return n
+	defer f.Close()
+	return tag.LoadPrivate(f)
 }
 
 func synopsis() {
-	synopsis := `dcmdump <dcm_file> [--debug]
+	synopsis := `dcmdump <dcm_file> [--format text|json|xml] [--private-dict <dict_file>] [--stop-at <tag>] [--strict] [--debug]
+
+    --format        Output format, text lines, the DICOM JSON Model
+                    (PS3.18 Annex F) or the Native DICOM Model XML
+                    (PS3.19 Section A.1), like dcm2xml. Default: text.
+                    Large binary values are referenced by a file:// BulkDataURI.
+
+    --private-dict  DCMTK style private dictionary to load, can be repeated.
+
+    --stop-at       Stop before the first top level element with a tag
+                    greater or equal to the given one, e.g. 7FE00010 or
+                    PixelData.
+
+    --strict        Stop at the first malformed element instead of showing
+                    it as a warning.
 `
 	fmt.Fprintln(os.Stderr, synopsis)
 }
@@ -393,6 +211,12 @@ func main() {
 	opt := getoptions.New()
 	opt.Bool("help", false)
 	opt.BoolVar(&debug, "debug", false)
+	privateDicts := opt.StringSlice("private-dict", 1, 1)
+	var stopAt string
+	opt.StringVar(&stopAt, "stop-at", "")
+	strict := opt.Bool("strict", false)
+	var format string
+	opt.StringVar(&format, "format", "text")
 	remaining, err := opt.Parse(os.Args[1:])
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
@@ -408,24 +232,62 @@ func main() {
 		os.Exit(1)
 	}
 	file = remaining[0]
+	if format != "text" && format != "json" && format != "xml" {
+		fmt.Fprintf(os.Stderr, "ERROR: unknown format '%s'\n", format)
+		synopsis()
+		os.Exit(1)
+	}
+	for _, d := range *privateDicts {
+		err := loadPrivateDict(d)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: failed to load private dictionary '%s': %s\n", d, err)
+			os.Exit(1)
+		}
+	}
+	// Values are only shown when they are short, large ones are left in
+	// the file.
+	opts := dicom.Options{LargeValueLength: 1 << 16, Lenient: !*strict}
+	if stopAt != "" {
+		opts.StopAtTag, err = dicom.ParseTag(stopAt)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+			os.Exit(1)
+		}
+	}
 	if !debug {
 		log.SetOutput(ioutil.Discard)
+	} else {
+		opts.LargeValueLength = 0
 	}
-	bytes, err := ioutil.ReadFile(file)
+	f, err := os.Open(file)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "ERROR: failed to read file: '%s'\n", err)
 		os.Exit(1)
 	}
-
-	// Intro
-	n := 128
-	printBytes(bytes[0:n])
-	// DICM
-	m := n + 4
-	printBytes(bytes[n:m])
-	n = m
-
-	explicit := true
-
-	parseDataElement(bytes, n, explicit, len(bytes))
+	defer f.Close()
+	d := dicom.NewDecoder(f, opts)
+	for format == "text" {
+		e, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+			os.Exit(1)
+		}
+		printElement(e, 0)
+	}
+	switch format {
+	case "json":
+		err = printJSON(file, d)
+	case "xml":
+		err = printXML(file, d)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	for _, w := range d.Warnings() {
+		fmt.Fprintf(os.Stderr, "WARNING: %s\n", w)
+	}
 }
@@ -1,3164 +0,0 @@
-package tag
-
-// Tag -
-// http://dicom.nema.org/medical/dicom/current/output/html/part06.html#chapter_6
-// Table 6-1. Registry of DICOM Data Elements
-// http://www.sno.phy.queensu.ca/~phil/exiftool/TagNames/DICOM.html
-var Tag = map[string]map[string]string{
-	"00020000": {"name": "FileMetaInfoGroupLength"},
-	"00020001": {"name": "FileMetaInfoVersion"},
-	"00020002": {"name": "MediaStorageSOPClassUID"},
-	"00020003": {"name": "MediaStorageSOPInstanceUID"},
-	"00020010": {"name": "TransferSyntaxUID"},
-	"00020012": {"name": "ImplementationClassUID"},
-	"00020013": {"name": "ImplementationVersionName"},
-	"00020016": {"name": "SourceApplicationEntityTitle"},
-	"00020100": {"name": "PrivateInformationCreatorUID"},
-	"00020102": {"name": "PrivateInformation"},
-	"00041130": {"name": "FileSetID"},
-	"00041141": {"name": "FileSetDescriptorFileID"},
-	"00041142": {"name": "SpecificCharacterSetOfFile"},
-	"00041200": {"name": "FirstDirectoryRecordOffset"},
-	"00041202": {"name": "LastDirectoryRecordOffset"},
-	"00041212": {"name": "FileSetConsistencyFlag"},
-	"00041220": {"name": "DirectoryRecordSequence"},
-	"00041400": {"name": "OffsetOfNextDirectoryRecord"},
-	"00041410": {"name": "RecordInUseFlag"},
-	"00041420": {"name": "LowerLevelDirectoryEntityOffset"},
-	"00041430": {"name": "DirectoryRecordType"},
-	"00041432": {"name": "PrivateRecordUID"},
-	"00041500": {"name": "ReferencedFileID"},
-	"00041504": {"name": "MRDRDirectoryRecordOffset"},
-	"00041510": {"name": "ReferencedSOPClassUIDInFile"},
-	"00041511": {"name": "ReferencedSOPInstanceUIDInFile"},
-	"00041512": {"name": "ReferencedTransferSyntaxUIDInFile"},
-	"0004151A": {"name": "ReferencedRelatedSOPClassUIDInFile"},
-	"00041600": {"name": "NumberOfReferences"},
-	"00080000": {"name": "IdentifyingGroupLength"},
-	"00080001": {"name": "LengthToEnd"},
-	"00080005": {"name": "SpecificCharacterSet"},
-	"00080006": {"name": "LanguageCodeSequence"},
-	"00080008": {"name": "ImageType"},
-	"00080010": {"name": "RecognitionCode"},
-	"00080012": {"name": "InstanceCreationDate"},
-	"00080013": {"name": "InstanceCreationTime"},
-	"00080014": {"name": "InstanceCreatorUID"},
-	"00080016": {"name": "SOPClassUID"},
-	"00080018": {"name": "SOPInstanceUID"},
-	"0008001A": {"name": "RelatedGeneralSOPClassUID"},
-	"0008001B": {"name": "OriginalSpecializedSOPClassUID"},
-	"00080020": {"name": "StudyDate"},
-	"00080021": {"name": "SeriesDate"},
-	"00080022": {"name": "AcquisitionDate"},
-	"00080023": {"name": "ContentDate"},
-	"00080024": {"name": "OverlayDate"},
-	"00080025": {"name": "CurveDate"},
-	"0008002A": {"name": "AcquisitionDateTime"},
-	"00080030": {"name": "StudyTime"},
-	"00080031": {"name": "SeriesTime"},
-	"00080032": {"name": "AcquisitionTime"},
-	"00080033": {"name": "ContentTime"},
-	"00080034": {"name": "OverlayTime"},
-	"00080035": {"name": "CurveTime"},
-	"00080040": {"name": "DataSetType"},
-	"00080041": {"name": "DataSetSubtype"},
-	"00080042": {"name": "NuclearMedicineSeriesType"},
-	"00080050": {"name": "AccessionNumber"},
-	"00080052": {"name": "QueryRetrieveLevel"},
-	"00080054": {"name": "RetrieveAETitle"},
-	"00080056": {"name": "InstanceAvailability"},
-	"00080058": {"name": "FailedSOPInstanceUIDList"},
-	"00080060": {"name": "Modality"},
-	"00080061": {"name": "ModalitiesInStudy"},
-	"00080062": {"name": "SOPClassesInStudy"},
-	"00080064": {"name": "ConversionType"},
-	"00080068": {"name": "PresentationIntentType"},
-	"00080070": {"name": "Manufacturer"},
-	"00080080": {"name": "InstitutionName"},
-	"00080081": {"name": "InstitutionAddress"},
-	"00080082": {"name": "InstitutionCodeSequence"},
-	"00080090": {"name": "ReferringPhysicianName"},
-	"00080092": {"name": "ReferringPhysicianAddress"},
-	"00080094": {"name": "ReferringPhysicianTelephoneNumber"},
-	"00080096": {"name": "ReferringPhysicianIDSequence"},
-	"00080100": {"name": "CodeValue"},
-	"00080102": {"name": "CodingSchemeDesignator"},
-	"00080103": {"name": "CodingSchemeVersion"},
-	"00080104": {"name": "CodeMeaning"},
-	"00080105": {"name": "MappingResource"},
-	"00080106": {"name": "ContextGroupVersion"},
-	"00080107": {"name": "ContextGroupLocalVersion"},
-	"0008010B": {"name": "ContextGroupExtensionFlag"},
-	"0008010C": {"name": "CodingSchemeUID"},
-	"0008010D": {"name": "ContextGroupExtensionCreatorUID"},
-	"0008010F": {"name": "ContextIdentifier"},
-	"00080110": {"name": "CodingSchemeIDSequence"},
-	"00080112": {"name": "CodingSchemeRegistry"},
-	"00080114": {"name": "CodingSchemeExternalID"},
-	"00080115": {"name": "CodingSchemeName"},
-	"00080116": {"name": "ResponsibleOrganization"},
-	"00080117": {"name": "ContextUID"},
-	"00080201": {"name": "TimezoneOffsetFromUTC"},
-	"00081000": {"name": "NetworkID"},
-	"00081010": {"name": "StationName"},
-	"00081030": {"name": "StudyDescription"},
-	"00081032": {"name": "ProcedureCodeSequence"},
-	"0008103E": {"name": "SeriesDescription"},
-	"00081040": {"name": "InstitutionalDepartmentName"},
-	"00081048": {"name": "PhysiciansOfRecord"},
-	"00081049": {"name": "PhysiciansOfRecordIDSequence"},
-	"00081050": {"name": "PerformingPhysicianName"},
-	"00081052": {"name": "PerformingPhysicianIDSequence"},
-	"00081060": {"name": "NameOfPhysicianReadingStudy"},
-	"00081062": {"name": "PhysicianReadingStudyIDSequence"},
-	"00081070": {"name": "OperatorsName"},
-	"00081072": {"name": "OperatorIDSequence"},
-	"00081080": {"name": "AdmittingDiagnosesDescription"},
-	"00081084": {"name": "AdmittingDiagnosesCodeSequence"},
-	"00081090": {"name": "ManufacturersModelName"},
-	"00081100": {"name": "ReferencedResultsSequence"},
-	"00081110": {"name": "ReferencedStudySequence"},
-	"00081111": {"name": "ReferencedProcedureStepSequence"},
-	"00081115": {"name": "ReferencedSeriesSequence"},
-	"00081120": {"name": "ReferencedPatientSequence"},
-	"00081125": {"name": "ReferencedVisitSequence"},
-	"00081130": {"name": "ReferencedOverlaySequence"},
-	"0008113A": {"name": "ReferencedWaveformSequence"},
-	"00081140": {"name": "ReferencedImageSequence"},
-	"00081145": {"name": "ReferencedCurveSequence"},
-	"0008114A": {"name": "ReferencedInstanceSequence"},
-	"00081150": {"name": "ReferencedSOPClassUID"},
-	"00081155": {"name": "ReferencedSOPInstanceUID"},
-	"0008115A": {"name": "SOPClassesSupported"},
-	"00081160": {"name": "ReferencedFrameNumber"},
-	"00081161": {"name": "SimpleFrameList"},
-	"00081162": {"name": "CalculatedFrameList"},
-	"00081163": {"name": "TimeRange"},
-	"00081164": {"name": "FrameExtractionSequence"},
-	"00081195": {"name": "TransactionUID"},
-	"00081197": {"name": "FailureReason"},
-	"00081198": {"name": "FailedSOPSequence"},
-	"00081199": {"name": "ReferencedSOPSequence"},
-	"00081200": {"name": "OtherReferencedStudiesSequence"},
-	"00081250": {"name": "RelatedSeriesSequence"},
-	"00082110": {"name": "LossyImageCompression"},
-	"00082111": {"name": "DerivationDescription"},
-	"00082112": {"name": "SourceImageSequence"},
-	"00082120": {"name": "StageName"},
-	"00082122": {"name": "StageNumber"},
-	"00082124": {"name": "NumberOfStages"},
-	"00082127": {"name": "ViewName"},
-	"00082128": {"name": "ViewNumber"},
-	"00082129": {"name": "NumberOfEventTimers"},
-	"0008212A": {"name": "NumberOfViewsInStage"},
-	"00082130": {"name": "EventElapsedTimes"},
-	"00082132": {"name": "EventTimerNames"},
-	"00082133": {"name": "EventTimerSequence"},
-	"00082134": {"name": "EventTimeOffset"},
-	"00082135": {"name": "EventCodeSequence"},
-	"00082142": {"name": "StartTrim"},
-	"00082143": {"name": "StopTrim"},
-	"00082144": {"name": "RecommendedDisplayFrameRate"},
-	"00082200": {"name": "TransducerPosition"},
-	"00082204": {"name": "TransducerOrientation"},
-	"00082208": {"name": "AnatomicStructure"},
-	"00082218": {"name": "AnatomicRegionSequence"},
-	"00082220": {"name": "AnatomicRegionModifierSequence"},
-	"00082228": {"name": "PrimaryAnatomicStructureSequence"},
-	"00082229": {"name": "AnatomicStructureOrRegionSequence"},
-	"00082230": {"name": "AnatomicStructureModifierSequence"},
-	"00082240": {"name": "TransducerPositionSequence"},
-	"00082242": {"name": "TransducerPositionModifierSequence"},
-	"00082244": {"name": "TransducerOrientationSequence"},
-	"00082246": {"name": "TransducerOrientationModifierSeq"},
-	"00082253": {"name": "AnatomicEntrancePortalCodeSeqTrial"},
-	"00082255": {"name": "AnatomicApproachDirCodeSeqTrial"},
-	"00082256": {"name": "AnatomicPerspectiveDescrTrial"},
-	"00082257": {"name": "AnatomicPerspectiveCodeSeqTrial"},
-	"00083001": {"name": "AlternateRepresentationSequence"},
-	"00083010": {"name": "IrradiationEventUID"},
-	"00084000": {"name": "IdentifyingComments"},
-	"00089007": {"name": "FrameType"},
-	"00089092": {"name": "ReferencedImageEvidenceSequence"},
-	"00089121": {"name": "ReferencedRawDataSequence"},
-	"00089123": {"name": "CreatorVersionUID"},
-	"00089124": {"name": "DerivationImageSequence"},
-	"00089154": {"name": "SourceImageEvidenceSequence"},
-	"00089205": {"name": "PixelPresentation"},
-	"00089206": {"name": "VolumetricProperties"},
-	"00089207": {"name": "VolumeBasedCalculationTechnique"},
-	"00089208": {"name": "ComplexImageComponent"},
-	"00089209": {"name": "AcquisitionContrast"},
-	"00089215": {"name": "DerivationCodeSequence"},
-	"00089237": {"name": "GrayscalePresentationStateSequence"},
-	"00089410": {"name": "ReferencedOtherPlaneSequence"},
-	"00089458": {"name": "FrameDisplaySequence"},
-	"00089459": {"name": "RecommendedDisplayFrameRateInFloat"},
-	"00089460": {"name": "SkipFrameRangeFlag"},
-	"00091001": {"name": "FullFidelity"},
-	"00091002": {"name": "SuiteID"},
-	"00091004": {"name": "ProductID"},
-	"00091027": {"name": "ImageActualDate"},
-	"00091030": {"name": "ServiceID"},
-	"00091031": {"name": "MobileLocationNumber"},
-	"000910E3": {"name": "EquipmentUID"},
-	"000910E6": {"name": "GenesisVersionNow"},
-	"000910E7": {"name": "ExamRecordChecksum"},
-	"000910E9": {"name": "ActualSeriesDataTimeStamp"},
-	"00100000": {"name": "PatientGroupLength"},
-	"00100010": {"name": "PatientName"},
-	"00100020": {"name": "PatientID"},
-	"00100021": {"name": "IssuerOfPatientID"},
-	"00100022": {"name": "TypeOfPatientID"},
-	"00100030": {"name": "PatientBirthDate"},
-	"00100032": {"name": "PatientBirthTime"},
-	"00100040": {"name": "PatientSex"},
-	"00100050": {"name": "PatientInsurancePlanCodeSequence"},
-	"00100101": {"name": "PatientPrimaryLanguageCodeSeq"},
-	"00100102": {"name": "PatientPrimaryLanguageCodeModSeq"},
-	"00101000": {"name": "OtherPatientIDs"},
-	"00101001": {"name": "OtherPatientNames"},
-	"00101002": {"name": "OtherPatientIDsSequence"},
-	"00101005": {"name": "PatientBirthName"},
-	"00101010": {"name": "PatientAge"},
-	"00101020": {"name": "PatientSize"},
-	"00101030": {"name": "PatientWeight"},
-	"00101040": {"name": "PatientAddress"},
-	"00101050": {"name": "InsurancePlanIdentification"},
-	"00101060": {"name": "PatientMotherBirthName"},
-	"00101080": {"name": "MilitaryRank"},
-	"00101081": {"name": "BranchOfService"},
-	"00101090": {"name": "MedicalRecordLocator"},
-	"00102000": {"name": "MedicalAlerts"},
-	"00102110": {"name": "Allergies"},
-	"00102150": {"name": "CountryOfResidence"},
-	"00102152": {"name": "RegionOfResidence"},
-	"00102154": {"name": "PatientTelephoneNumbers"},
-	"00102160": {"name": "EthnicGroup"},
-	"00102180": {"name": "Occupation"},
-	"001021A0": {"name": "SmokingStatus"},
-	"001021B0": {"name": "AdditionalPatientHistory"},
-	"001021C0": {"name": "PregnancyStatus"},
-	"001021D0": {"name": "LastMenstrualDate"},
-	"001021F0": {"name": "PatientReligiousPreference"},
-	"00102201": {"name": "PatientSpeciesDescription"},
-	"00102202": {"name": "PatientSpeciesCodeSequence"},
-	"00102203": {"name": "PatientSexNeutered"},
-	"00102210": {"name": "AnatomicalOrientationType"},
-	"00102292": {"name": "PatientBreedDescription"},
-	"00102293": {"name": "PatientBreedCodeSequence"},
-	"00102294": {"name": "BreedRegistrationSequence"},
-	"00102295": {"name": "BreedRegistrationNumber"},
-	"00102296": {"name": "BreedRegistryCodeSequence"},
-	"00102297": {"name": "ResponsiblePerson"},
-	"00102298": {"name": "ResponsiblePersonRole"},
-	"00102299": {"name": "ResponsibleOrganization"},
-	"00104000": {"name": "PatientComments"},
-	"00109431": {"name": "ExaminedBodyThickness"},
-	"00111010": {"name": "PatientStatus"},
-	"00120010": {"name": "ClinicalTrialSponsorName"},
-	"00120020": {"name": "ClinicalTrialProtocolID"},
-	"00120021": {"name": "ClinicalTrialProtocolName"},
-	"00120030": {"name": "ClinicalTrialSiteID"},
-	"00120031": {"name": "ClinicalTrialSiteName"},
-	"00120040": {"name": "ClinicalTrialSubjectID"},
-	"00120042": {"name": "ClinicalTrialSubjectReadingID"},
-	"00120050": {"name": "ClinicalTrialTimePointID"},
-	"00120051": {"name": "ClinicalTrialTimePointDescription"},
-	"00120060": {"name": "ClinicalTrialCoordinatingCenter"},
-	"00120062": {"name": "PatientIdentityRemoved"},
-	"00120063": {"name": "DeidentificationMethod"},
-	"00120064": {"name": "DeidentificationMethodCodeSequence"},
-	"00120071": {"name": "ClinicalTrialSeriesID"},
-	"00120072": {"name": "ClinicalTrialSeriesDescription"},
-	"00120084": {"name": "DistributionType"},
-	"00120085": {"name": "ConsentForDistributionFlag"},
-	"00180000": {"name": "AcquisitionGroupLength"},
-	"00180010": {"name": "ContrastBolusAgent"},
-	"00180012": {"name": "ContrastBolusAgentSequence"},
-	"00180014": {"name": "ContrastBolusAdministrationRoute"},
-	"00180015": {"name": "BodyPartExamined"},
-	"00180020": {"name": "ScanningSequence"},
-	"00180021": {"name": "SequenceVariant"},
-	"00180022": {"name": "ScanOptions"},
-	"00180023": {"name": "MRAcquisitionType"},
-	"00180024": {"name": "SequenceName"},
-	"00180025": {"name": "AngioFlag"},
-	"00180026": {"name": "InterventionDrugInformationSeq"},
-	"00180027": {"name": "InterventionDrugStopTime"},
-	"00180028": {"name": "InterventionDrugDose"},
-	"00180029": {"name": "InterventionDrugSequence"},
-	"0018002A": {"name": "AdditionalDrugSequence"},
-	"00180030": {"name": "Radionuclide"},
-	"00180031": {"name": "Radiopharmaceutical"},
-	"00180032": {"name": "EnergyWindowCenterline"},
-	"00180033": {"name": "EnergyWindowTotalWidth"},
-	"00180034": {"name": "InterventionDrugName"},
-	"00180035": {"name": "InterventionDrugStartTime"},
-	"00180036": {"name": "InterventionSequence"},
-	"00180037": {"name": "TherapyType"},
-	"00180038": {"name": "InterventionStatus"},
-	"00180039": {"name": "TherapyDescription"},
-	"0018003A": {"name": "InterventionDescription"},
-	"00180040": {"name": "CineRate"},
-	"00180042": {"name": "InitialCineRunState"},
-	"00180050": {"name": "SliceThickness"},
-	"00180060": {"name": "KVP"},
-	"00180070": {"name": "CountsAccumulated"},
-	"00180071": {"name": "AcquisitionTerminationCondition"},
-	"00180072": {"name": "EffectiveDuration"},
-	"00180073": {"name": "AcquisitionStartCondition"},
-	"00180074": {"name": "AcquisitionStartConditionData"},
-	"00180075": {"name": "AcquisitionEndConditionData"},
-	"00180080": {"name": "RepetitionTime"},
-	"00180081": {"name": "EchoTime"},
-	"00180082": {"name": "InversionTime"},
-	"00180083": {"name": "NumberOfAverages"},
-	"00180084": {"name": "ImagingFrequency"},
-	"00180085": {"name": "ImagedNucleus"},
-	"00180086": {"name": "EchoNumber"},
-	"00180087": {"name": "MagneticFieldStrength"},
-	"00180088": {"name": "SpacingBetweenSlices"},
-	"00180089": {"name": "NumberOfPhaseEncodingSteps"},
-	"00180090": {"name": "DataCollectionDiameter"},
-	"00180091": {"name": "EchoTrainLength"},
-	"00180093": {"name": "PercentSampling"},
-	"00180094": {"name": "PercentPhaseFieldOfView"},
-	"00180095": {"name": "PixelBandwidth"},
-	"00181000": {"name": "DeviceSerialNumber"},
-	"00181002": {"name": "DeviceUID"},
-	"00181003": {"name": "DeviceID"},
-	"00181004": {"name": "PlateID"},
-	"00181005": {"name": "GeneratorID"},
-	"00181006": {"name": "GridID"},
-	"00181007": {"name": "CassetteID"},
-	"00181008": {"name": "GantryID"},
-	"00181010": {"name": "SecondaryCaptureDeviceID"},
-	"00181011": {"name": "HardcopyCreationDeviceID"},
-	"00181012": {"name": "DateOfSecondaryCapture"},
-	"00181014": {"name": "TimeOfSecondaryCapture"},
-	"00181016": {"name": "SecondaryCaptureDeviceManufacturer"},
-	"00181017": {"name": "HardcopyDeviceManufacturer"},
-	"00181018": {"name": "SecondaryCaptureDeviceModelName"},
-	"00181019": {"name": "SecondaryCaptureDeviceSoftwareVers"},
-	"0018101A": {"name": "HardcopyDeviceSoftwareVersion"},
-	"0018101B": {"name": "HardcopyDeviceModelName"},
-	"00181020": {"name": "SoftwareVersion"},
-	"00181022": {"name": "VideoImageFormatAcquired"},
-	"00181023": {"name": "DigitalImageFormatAcquired"},
-	"00181030": {"name": "ProtocolName"},
-	"00181040": {"name": "ContrastBolusRoute"},
-	"00181041": {"name": "ContrastBolusVolume"},
-	"00181042": {"name": "ContrastBolusStartTime"},
-	"00181043": {"name": "ContrastBolusStopTime"},
-	"00181044": {"name": "ContrastBolusTotalDose"},
-	"00181045": {"name": "SyringeCounts"},
-	"00181046": {"name": "ContrastFlowRate"},
-	"00181047": {"name": "ContrastFlowDuration"},
-	"00181048": {"name": "ContrastBolusIngredient"},
-	"00181049": {"name": "ContrastBolusConcentration"},
-	"00181050": {"name": "SpatialResolution"},
-	"00181060": {"name": "TriggerTime"},
-	"00181061": {"name": "TriggerSourceOrType"},
-	"00181062": {"name": "NominalInterval"},
-	"00181063": {"name": "FrameTime"},
-	"00181064": {"name": "CardiacFramingType"},
-	"00181065": {"name": "FrameTimeVector"},
-	"00181066": {"name": "FrameDelay"},
-	"00181067": {"name": "ImageTriggerDelay"},
-	"00181068": {"name": "MultiplexGroupTimeOffset"},
-	"00181069": {"name": "TriggerTimeOffset"},
-	"0018106A": {"name": "SynchronizationTrigger"},
-	"0018106C": {"name": "SynchronizationChannel"},
-	"0018106E": {"name": "TriggerSamplePosition"},
-	"00181070": {"name": "RadiopharmaceuticalRoute"},
-	"00181071": {"name": "RadiopharmaceuticalVolume"},
-	"00181072": {"name": "RadiopharmaceuticalStartTime"},
-	"00181073": {"name": "RadiopharmaceuticalStopTime"},
-	"00181074": {"name": "RadionuclideTotalDose"},
-	"00181075": {"name": "RadionuclideHalfLife"},
-	"00181076": {"name": "RadionuclidePositronFraction"},
-	"00181077": {"name": "RadiopharmaceuticalSpecActivity"},
-	"00181078": {"name": "RadiopharmaceuticalStartDateTime"},
-	"00181079": {"name": "RadiopharmaceuticalStopDateTime"},
-	"00181080": {"name": "BeatRejectionFlag"},
-	"00181081": {"name": "LowRRValue"},
-	"00181082": {"name": "HighRRValue"},
-	"00181083": {"name": "IntervalsAcquired"},
-	"00181084": {"name": "IntervalsRejected"},
-	"00181085": {"name": "PVCRejection"},
-	"00181086": {"name": "SkipBeats"},
-	"00181088": {"name": "HeartRate"},
-	"00181090": {"name": "CardiacNumberOfImages"},
-	"00181094": {"name": "TriggerWindow"},
-	"00181100": {"name": "ReconstructionDiameter"},
-	"00181110": {"name": "DistanceSourceToDetector"},
-	"00181111": {"name": "DistanceSourceToPatient"},
-	"00181114": {"name": "EstimatedRadiographicMagnification"},
-	"00181120": {"name": "GantryDetectorTilt"},
-	"00181121": {"name": "GantryDetectorSlew"},
-	"00181130": {"name": "TableHeight"},
-	"00181131": {"name": "TableTraverse"},
-	"00181134": {"name": "TableMotion"},
-	"00181135": {"name": "TableVerticalIncrement"},
-	"00181136": {"name": "TableLateralIncrement"},
-	"00181137": {"name": "TableLongitudinalIncrement"},
-	"00181138": {"name": "TableAngle"},
-	"0018113A": {"name": "TableType"},
-	"00181140": {"name": "RotationDirection"},
-	"00181141": {"name": "AngularPosition"},
-	"00181142": {"name": "RadialPosition"},
-	"00181143": {"name": "ScanArc"},
-	"00181144": {"name": "AngularStep"},
-	"00181145": {"name": "CenterOfRotationOffset"},
-	"00181146": {"name": "RotationOffset"},
-	"00181147": {"name": "FieldOfViewShape"},
-	"00181149": {"name": "FieldOfViewDimensions"},
-	"00181150": {"name": "ExposureTime"},
-	"00181151": {"name": "XRayTubeCurrent"},
-	"00181152": {"name": "Exposure"},
-	"00181153": {"name": "ExposureInMicroAmpSec"},
-	"00181154": {"name": "AveragePulseWidth"},
-	"00181155": {"name": "RadiationSetting"},
-	"00181156": {"name": "RectificationType"},
-	"0018115A": {"name": "RadiationMode"},
-	"0018115E": {"name": "ImageAreaDoseProduct"},
-	"00181160": {"name": "FilterType"},
-	"00181161": {"name": "TypeOfFilters"},
-	"00181162": {"name": "IntensifierSize"},
-	"00181164": {"name": "ImagerPixelSpacing"},
-	"00181166": {"name": "Grid"},
-	"00181170": {"name": "GeneratorPower"},
-	"00181180": {"name": "CollimatorGridName"},
-	"00181181": {"name": "CollimatorType"},
-	"00181182": {"name": "FocalDistance"},
-	"00181183": {"name": "XFocusCenter"},
-	"00181184": {"name": "YFocusCenter"},
-	"00181190": {"name": "FocalSpots"},
-	"00181191": {"name": "AnodeTargetMaterial"},
-	"001811A0": {"name": "BodyPartThickness"},
-	"001811A2": {"name": "CompressionForce"},
-	"00181200": {"name": "DateOfLastCalibration"},
-	"00181201": {"name": "TimeOfLastCalibration"},
-	"00181210": {"name": "ConvolutionKernel"},
-	"00181240": {"name": "UpperLowerPixelValues"},
-	"00181242": {"name": "ActualFrameDuration"},
-	"00181243": {"name": "CountRate"},
-	"00181244": {"name": "PreferredPlaybackSequencing"},
-	"00181250": {"name": "ReceiveCoilName"},
-	"00181251": {"name": "TransmitCoilName"},
-	"00181260": {"name": "PlateType"},
-	"00181261": {"name": "PhosphorType"},
-	"00181300": {"name": "ScanVelocity"},
-	"00181301": {"name": "WholeBodyTechnique"},
-	"00181302": {"name": "ScanLength"},
-	"00181310": {"name": "AcquisitionMatrix"},
-	"00181312": {"name": "InPlanePhaseEncodingDirection"},
-	"00181314": {"name": "FlipAngle"},
-	"00181315": {"name": "VariableFlipAngleFlag"},
-	"00181316": {"name": "SAR"},
-	"00181318": {"name": "DB-Dt"},
-	"00181400": {"name": "AcquisitionDeviceProcessingDescr"},
-	"00181401": {"name": "AcquisitionDeviceProcessingCode"},
-	"00181402": {"name": "CassetteOrientation"},
-	"00181403": {"name": "CassetteSize"},
-	"00181404": {"name": "ExposuresOnPlate"},
-	"00181405": {"name": "RelativeXRayExposure"},
-	"00181450": {"name": "ColumnAngulation"},
-	"00181460": {"name": "TomoLayerHeight"},
-	"00181470": {"name": "TomoAngle"},
-	"00181480": {"name": "TomoTime"},
-	"00181490": {"name": "TomoType"},
-	"00181491": {"name": "TomoClass"},
-	"00181495": {"name": "NumberOfTomosynthesisSourceImages"},
-	"00181500": {"name": "PositionerMotion"},
-	"00181508": {"name": "PositionerType"},
-	"00181510": {"name": "PositionerPrimaryAngle"},
-	"00181511": {"name": "PositionerSecondaryAngle"},
-	"00181520": {"name": "PositionerPrimaryAngleIncrement"},
-	"00181521": {"name": "PositionerSecondaryAngleIncrement"},
-	"00181530": {"name": "DetectorPrimaryAngle"},
-	"00181531": {"name": "DetectorSecondaryAngle"},
-	"00181600": {"name": "ShutterShape"},
-	"00181602": {"name": "ShutterLeftVerticalEdge"},
-	"00181604": {"name": "ShutterRightVerticalEdge"},
-	"00181606": {"name": "ShutterUpperHorizontalEdge"},
-	"00181608": {"name": "ShutterLowerHorizontalEdge"},
-	"00181610": {"name": "CenterOfCircularShutter"},
-	"00181612": {"name": "RadiusOfCircularShutter"},
-	"00181620": {"name": "VerticesOfPolygonalShutter"},
-	"00181622": {"name": "ShutterPresentationValue"},
-	"00181623": {"name": "ShutterOverlayGroup"},
-	"00181624": {"name": "ShutterPresentationColorCIELabVal"},
-	"00181700": {"name": "CollimatorShape"},
-	"00181702": {"name": "CollimatorLeftVerticalEdge"},
-	"00181704": {"name": "CollimatorRightVerticalEdge"},
-	"00181706": {"name": "CollimatorUpperHorizontalEdge"},
-	"00181708": {"name": "CollimatorLowerHorizontalEdge"},
-	"00181710": {"name": "CenterOfCircularCollimator"},
-	"00181712": {"name": "RadiusOfCircularCollimator"},
-	"00181720": {"name": "VerticesOfPolygonalCollimator"},
-	"00181800": {"name": "AcquisitionTimeSynchronized"},
-	"00181801": {"name": "TimeSource"},
-	"00181802": {"name": "TimeDistributionProtocol"},
-	"00181803": {"name": "NTPSourceAddress"},
-	"00182001": {"name": "PageNumberVector"},
-	"00182002": {"name": "FrameLabelVector"},
-	"00182003": {"name": "FramePrimaryAngleVector"},
-	"00182004": {"name": "FrameSecondaryAngleVector"},
-	"00182005": {"name": "SliceLocationVector"},
-	"00182006": {"name": "DisplayWindowLabelVector"},
-	"00182010": {"name": "NominalScannedPixelSpacing"},
-	"00182020": {"name": "DigitizingDeviceTransportDirection"},
-	"00182030": {"name": "RotationOfScannedFilm"},
-	"00183100": {"name": "IVUSAcquisition"},
-	"00183101": {"name": "IVUSPullbackRate"},
-	"00183102": {"name": "IVUSGatedRate"},
-	"00183103": {"name": "IVUSPullbackStartFrameNumber"},
-	"00183104": {"name": "IVUSPullbackStopFrameNumber"},
-	"00183105": {"name": "LesionNumber"},
-	"00184000": {"name": "AcquisitionComments"},
-	"00185000": {"name": "OutputPower"},
-	"00185010": {"name": "TransducerData"},
-	"00185012": {"name": "FocusDepth"},
-	"00185020": {"name": "ProcessingFunction"},
-	"00185021": {"name": "PostprocessingFunction"},
-	"00185022": {"name": "MechanicalIndex"},
-	"00185024": {"name": "BoneThermalIndex"},
-	"00185026": {"name": "CranialThermalIndex"},
-	"00185027": {"name": "SoftTissueThermalIndex"},
-	"00185028": {"name": "SoftTissueFocusThermalIndex"},
-	"00185029": {"name": "SoftTissueSurfaceThermalIndex"},
-	"00185030": {"name": "DynamicRange"},
-	"00185040": {"name": "TotalGain"},
-	"00185050": {"name": "DepthOfScanField"},
-	"00185100": {"name": "PatientPosition"},
-	"00185101": {"name": "ViewPosition"},
-	"00185104": {"name": "ProjectionEponymousNameCodeSeq"},
-	"00185210": {"name": "ImageTransformationMatrix"},
-	"00185212": {"name": "ImageTranslationVector"},
-	"00186000": {"name": "Sensitivity"},
-	"00186011": {"name": "SequenceOfUltrasoundRegions"},
-	"00186012": {"name": "RegionSpatialFormat"},
-	"00186014": {"name": "RegionDataType"},
-	"00186016": {"name": "RegionFlags"},
-	"00186018": {"name": "RegionLocationMinX0"},
-	"0018601A": {"name": "RegionLocationMinY0"},
-	"0018601C": {"name": "RegionLocationMaxX1"},
-	"0018601E": {"name": "RegionLocationMaxY1"},
-	"00186020": {"name": "ReferencePixelX0"},
-	"00186022": {"name": "ReferencePixelY0"},
-	"00186024": {"name": "PhysicalUnitsXDirection"},
-	"00186026": {"name": "PhysicalUnitsYDirection"},
-	"00186028": {"name": "ReferencePixelPhysicalValueX"},
-	"0018602A": {"name": "ReferencePixelPhysicalValueY"},
-	"0018602C": {"name": "PhysicalDeltaX"},
-	"0018602E": {"name": "PhysicalDeltaY"},
-	"00186030": {"name": "TransducerFrequency"},
-	"00186031": {"name": "TransducerType"},
-	"00186032": {"name": "PulseRepetitionFrequency"},
-	"00186034": {"name": "DopplerCorrectionAngle"},
-	"00186036": {"name": "SteeringAngle"},
-	"00186038": {"name": "DopplerSampleVolumeXPosRetired"},
-	"00186039": {"name": "DopplerSampleVolumeXPosition"},
-	"0018603A": {"name": "DopplerSampleVolumeYPosRetired"},
-	"0018603B": {"name": "DopplerSampleVolumeYPosition"},
-	"0018603C": {"name": "TMLinePositionX0Retired"},
-	"0018603D": {"name": "TMLinePositionX0"},
-	"0018603E": {"name": "TMLinePositionY0Retired"},
-	"0018603F": {"name": "TMLinePositionY0"},
-	"00186040": {"name": "TMLinePositionX1Retired"},
-	"00186041": {"name": "TMLinePositionX1"},
-	"00186042": {"name": "TMLinePositionY1Retired"},
-	"00186043": {"name": "TMLinePositionY1"},
-	"00186044": {"name": "PixelComponentOrganization"},
-	"00186046": {"name": "PixelComponentMask"},
-	"00186048": {"name": "PixelComponentRangeStart"},
-	"0018604A": {"name": "PixelComponentRangeStop"},
-	"0018604C": {"name": "PixelComponentPhysicalUnits"},
-	"0018604E": {"name": "PixelComponentDataType"},
-	"00186050": {"name": "NumberOfTableBreakPoints"},
-	"00186052": {"name": "TableOfXBreakPoints"},
-	"00186054": {"name": "TableOfYBreakPoints"},
-	"00186056": {"name": "NumberOfTableEntries"},
-	"00186058": {"name": "TableOfPixelValues"},
-	"0018605A": {"name": "TableOfParameterValues"},
-	"00186060": {"name": "RWaveTimeVector"},
-	"00187000": {"name": "DetectorConditionsNominalFlag"},
-	"00187001": {"name": "DetectorTemperature"},
-	"00187004": {"name": "DetectorType"},
-	"00187005": {"name": "DetectorConfiguration"},
-	"00187006": {"name": "DetectorDescription"},
-	"00187008": {"name": "DetectorMode"},
-	"0018700A": {"name": "DetectorID"},
-	"0018700C": {"name": "DateOfLastDetectorCalibration"},
-	"0018700E": {"name": "TimeOfLastDetectorCalibration"},
-	"00187010": {"name": "DetectorExposuresSinceCalibration"},
-	"00187011": {"name": "DetectorExposuresSinceManufactured"},
-	"00187012": {"name": "DetectorTimeSinceLastExposure"},
-	"00187014": {"name": "DetectorActiveTime"},
-	"00187016": {"name": "DetectorActiveOffsetFromExposure"},
-	"0018701A": {"name": "DetectorBinning"},
-	"00187020": {"name": "DetectorElementPhysicalSize"},
-	"00187022": {"name": "DetectorElementSpacing"},
-	"00187024": {"name": "DetectorActiveShape"},
-	"00187026": {"name": "DetectorActiveDimensions"},
-	"00187028": {"name": "DetectorActiveOrigin"},
-	"0018702A": {"name": "DetectorManufacturerName"},
-	"0018702B": {"name": "DetectorManufacturersModelName"},
-	"00187030": {"name": "FieldOfViewOrigin"},
-	"00187032": {"name": "FieldOfViewRotation"},
-	"00187034": {"name": "FieldOfViewHorizontalFlip"},
-	"00187040": {"name": "GridAbsorbingMaterial"},
-	"00187041": {"name": "GridSpacingMaterial"},
-	"00187042": {"name": "GridThickness"},
-	"00187044": {"name": "GridPitch"},
-	"00187046": {"name": "GridAspectRatio"},
-	"00187048": {"name": "GridPeriod"},
-	"0018704C": {"name": "GridFocalDistance"},
-	"00187050": {"name": "FilterMaterial"},
-	"00187052": {"name": "FilterThicknessMinimum"},
-	"00187054": {"name": "FilterThicknessMaximum"},
-	"00187060": {"name": "ExposureControlMode"},
-	"00187062": {"name": "ExposureControlModeDescription"},
-	"00187064": {"name": "ExposureStatus"},
-	"00187065": {"name": "PhototimerSetting"},
-	"00188150": {"name": "ExposureTimeInMicroSec"},
-	"00188151": {"name": "XRayTubeCurrentInMicroAmps"},
-	"00189004": {"name": "ContentQualification"},
-	"00189005": {"name": "PulseSequenceName"},
-	"00189006": {"name": "MRImagingModifierSequence"},
-	"00189008": {"name": "EchoPulseSequence"},
-	"00189009": {"name": "InversionRecovery"},
-	"00189010": {"name": "FlowCompensation"},
-	"00189011": {"name": "MultipleSpinEcho"},
-	"00189012": {"name": "MultiPlanarExcitation"},
-	"00189014": {"name": "PhaseContrast"},
-	"00189015": {"name": "TimeOfFlightContrast"},
-	"00189016": {"name": "Spoiling"},
-	"00189017": {"name": "SteadyStatePulseSequence"},
-	"00189018": {"name": "EchoPlanarPulseSequence"},
-	"00189019": {"name": "TagAngleFirstAxis"},
-	"00189020": {"name": "MagnetizationTransfer"},
-	"00189021": {"name": "T2Preparation"},
-	"00189022": {"name": "BloodSignalNulling"},
-	"00189024": {"name": "SaturationRecovery"},
-	"00189025": {"name": "SpectrallySelectedSuppression"},
-	"00189026": {"name": "SpectrallySelectedExcitation"},
-	"00189027": {"name": "SpatialPresaturation"},
-	"00189028": {"name": "Tagging"},
-	"00189029": {"name": "OversamplingPhase"},
-	"00189030": {"name": "TagSpacingFirstDimension"},
-	"00189032": {"name": "GeometryOfKSpaceTraversal"},
-	"00189033": {"name": "SegmentedKSpaceTraversal"},
-	"00189034": {"name": "RectilinearPhaseEncodeReordering"},
-	"00189035": {"name": "TagThickness"},
-	"00189036": {"name": "PartialFourierDirection"},
-	"00189037": {"name": "CardiacSynchronizationTechnique"},
-	"00189041": {"name": "ReceiveCoilManufacturerName"},
-	"00189042": {"name": "MRReceiveCoilSequence"},
-	"00189043": {"name": "ReceiveCoilType"},
-	"00189044": {"name": "QuadratureReceiveCoil"},
-	"00189045": {"name": "MultiCoilDefinitionSequence"},
-	"00189046": {"name": "MultiCoilConfiguration"},
-	"00189047": {"name": "MultiCoilElementName"},
-	"00189048": {"name": "MultiCoilElementUsed"},
-	"00189049": {"name": "MRTransmitCoilSequence"},
-	"00189050": {"name": "TransmitCoilManufacturerName"},
-	"00189051": {"name": "TransmitCoilType"},
-	"00189052": {"name": "SpectralWidth"},
-	"00189053": {"name": "ChemicalShiftReference"},
-	"00189054": {"name": "VolumeLocalizationTechnique"},
-	"00189058": {"name": "MRAcquisitionFrequencyEncodeSteps"},
-	"00189059": {"name": "Decoupling"},
-	"00189060": {"name": "DecoupledNucleus"},
-	"00189061": {"name": "DecouplingFrequency"},
-	"00189062": {"name": "DecouplingMethod"},
-	"00189063": {"name": "DecouplingChemicalShiftReference"},
-	"00189064": {"name": "KSpaceFiltering"},
-	"00189065": {"name": "TimeDomainFiltering"},
-	"00189066": {"name": "NumberOfZeroFills"},
-	"00189067": {"name": "BaselineCorrection"},
-	"00189069": {"name": "ParallelReductionFactorInPlane"},
-	"00189070": {"name": "CardiacRRIntervalSpecified"},
-	"00189073": {"name": "AcquisitionDuration"},
-	"00189074": {"name": "FrameAcquisitionDateTime"},
-	"00189075": {"name": "DiffusionDirectionality"},
-	"00189076": {"name": "DiffusionGradientDirectionSequence"},
-	"00189077": {"name": "ParallelAcquisition"},
-	"00189078": {"name": "ParallelAcquisitionTechnique"},
-	"00189079": {"name": "InversionTimes"},
-	"00189080": {"name": "MetaboliteMapDescription"},
-	"00189081": {"name": "PartialFourier"},
-	"00189082": {"name": "EffectiveEchoTime"},
-	"00189083": {"name": "MetaboliteMapCodeSequence"},
-	"00189084": {"name": "ChemicalShiftSequence"},
-	"00189085": {"name": "CardiacSignalSource"},
-	"00189087": {"name": "DiffusionBValue"},
-	"00189089": {"name": "DiffusionGradientOrientation"},
-	"00189090": {"name": "VelocityEncodingDirection"},
-	"00189091": {"name": "VelocityEncodingMinimumValue"},
-	"00189093": {"name": "NumberOfKSpaceTrajectories"},
-	"00189094": {"name": "CoverageOfKSpace"},
-	"00189095": {"name": "SpectroscopyAcquisitionPhaseRows"},
-	"00189096": {"name": "ParallelReductFactorInPlaneRetired"},
-	"00189098": {"name": "TransmitterFrequency"},
-	"00189100": {"name": "ResonantNucleus"},
-	"00189101": {"name": "FrequencyCorrection"},
-	"00189103": {"name": "MRSpectroscopyFOV-GeometrySequence"},
-	"00189104": {"name": "SlabThickness"},
-	"00189105": {"name": "SlabOrientation"},
-	"00189106": {"name": "MidSlabPosition"},
-	"00189107": {"name": "MRSpatialSaturationSequence"},
-	"00189112": {"name": "MRTimingAndRelatedParametersSeq"},
-	"00189114": {"name": "MREchoSequence"},
-	"00189115": {"name": "MRModifierSequence"},
-	"00189117": {"name": "MRDiffusionSequence"},
-	"00189118": {"name": "CardiacTriggerSequence"},
-	"00189119": {"name": "MRAveragesSequence"},
-	"00189125": {"name": "MRFOV-GeometrySequence"},
-	"00189126": {"name": "VolumeLocalizationSequence"},
-	"00189127": {"name": "SpectroscopyAcquisitionDataColumns"},
-	"00189147": {"name": "DiffusionAnisotropyType"},
-	"00189151": {"name": "FrameReferenceDateTime"},
-	"00189152": {"name": "MRMetaboliteMapSequence"},
-	"00189155": {"name": "ParallelReductionFactorOutOfPlane"},
-	"00189159": {"name": "SpectroscopyOutOfPlanePhaseSteps"},
-	"00189166": {"name": "BulkMotionStatus"},
-	"00189168": {"name": "ParallelReductionFactSecondInPlane"},
-	"00189169": {"name": "CardiacBeatRejectionTechnique"},
-	"00189170": {"name": "RespiratoryMotionCompTechnique"},
-	"00189171": {"name": "RespiratorySignalSource"},
-	"00189172": {"name": "BulkMotionCompensationTechnique"},
-	"00189173": {"name": "BulkMotionSignalSource"},
-	"00189174": {"name": "ApplicableSafetyStandardAgency"},
-	"00189175": {"name": "ApplicableSafetyStandardDescr"},
-	"00189176": {"name": "OperatingModeSequence"},
-	"00189177": {"name": "OperatingModeType"},
-	"00189178": {"name": "OperatingMode"},
-	"00189179": {"name": "SpecificAbsorptionRateDefinition"},
-	"00189180": {"name": "GradientOutputType"},
-	"00189181": {"name": "SpecificAbsorptionRateValue"},
-	"00189182": {"name": "GradientOutput"},
-	"00189183": {"name": "FlowCompensationDirection"},
-	"00189184": {"name": "TaggingDelay"},
-	"00189185": {"name": "RespiratoryMotionCompTechDescr"},
-	"00189186": {"name": "RespiratorySignalSourceID"},
-	"00189195": {"name": "ChemicalShiftsMinIntegrateLimitHz"},
-	"00189196": {"name": "ChemicalShiftsMaxIntegrateLimitHz"},
-	"00189197": {"name": "MRVelocityEncodingSequence"},
-	"00189198": {"name": "FirstOrderPhaseCorrection"},
-	"00189199": {"name": "WaterReferencedPhaseCorrection"},
-	"00189200": {"name": "MRSpectroscopyAcquisitionType"},
-	"00189214": {"name": "RespiratoryCyclePosition"},
-	"00189217": {"name": "VelocityEncodingMaximumValue"},
-	"00189218": {"name": "TagSpacingSecondDimension"},
-	"00189219": {"name": "TagAngleSecondAxis"},
-	"00189220": {"name": "FrameAcquisitionDuration"},
-	"00189226": {"name": "MRImageFrameTypeSequence"},
-	"00189227": {"name": "MRSpectroscopyFrameTypeSequence"},
-	"00189231": {"name": "MRAcqPhaseEncodingStepsInPlane"},
-	"00189232": {"name": "MRAcqPhaseEncodingStepsOutOfPlane"},
-	"00189234": {"name": "SpectroscopyAcqPhaseColumns"},
-	"00189236": {"name": "CardiacCyclePosition"},
-	"00189239": {"name": "SpecificAbsorptionRateSequence"},
-	"00189240": {"name": "RFEchoTrainLength"},
-	"00189241": {"name": "GradientEchoTrainLength"},
-	"00189295": {"name": "ChemicalShiftsMinIntegrateLimitPPM"},
-	"00189296": {"name": "ChemicalShiftsMaxIntegrateLimitPPM"},
-	"00189301": {"name": "CTAcquisitionTypeSequence"},
-	"00189302": {"name": "AcquisitionType"},
-	"00189303": {"name": "TubeAngle"},
-	"00189304": {"name": "CTAcquisitionDetailsSequence"},
-	"00189305": {"name": "RevolutionTime"},
-	"00189306": {"name": "SingleCollimationWidth"},
-	"00189307": {"name": "TotalCollimationWidth"},
-	"00189308": {"name": "CTTableDynamicsSequence"},
-	"00189309": {"name": "TableSpeed"},
-	"00189310": {"name": "TableFeedPerRotation"},
-	"00189311": {"name": "SpiralPitchFactor"},
-	"00189312": {"name": "CTGeometrySequence"},
-	"00189313": {"name": "DataCollectionCenterPatient"},
-	"00189314": {"name": "CTReconstructionSequence"},
-	"00189315": {"name": "ReconstructionAlgorithm"},
-	"00189316": {"name": "ConvolutionKernelGroup"},
-	"00189317": {"name": "ReconstructionFieldOfView"},
-	"00189318": {"name": "ReconstructionTargetCenterPatient"},
-	"00189319": {"name": "ReconstructionAngle"},
-	"00189320": {"name": "ImageFilter"},
-	"00189321": {"name": "CTExposureSequence"},
-	"00189322": {"name": "ReconstructionPixelSpacing"},
-	"00189323": {"name": "ExposureModulationType"},
-	"00189324": {"name": "EstimatedDoseSaving"},
-	"00189325": {"name": "CTXRayDetailsSequence"},
-	"00189326": {"name": "CTPositionSequence"},
-	"00189327": {"name": "TablePosition"},
-	"00189328": {"name": "ExposureTimeInMilliSec"},
-	"00189329": {"name": "CTImageFrameTypeSequence"},
-	"00189330": {"name": "XRayTubeCurrentInMilliAmps"},
-	"00189332": {"name": "ExposureInMilliAmpSec"},
-	"00189333": {"name": "ConstantVolumeFlag"},
-	"00189334": {"name": "FluoroscopyFlag"},
-	"00189335": {"name": "SourceToDataCollectionCenterDist"},
-	"00189337": {"name": "ContrastBolusAgentNumber"},
-	"00189338": {"name": "ContrastBolusIngredientCodeSeq"},
-	"00189340": {"name": "ContrastAdministrationProfileSeq"},
-	"00189341": {"name": "ContrastBolusUsageSequence"},
-	"00189342": {"name": "ContrastBolusAgentAdministered"},
-	"00189343": {"name": "ContrastBolusAgentDetected"},
-	"00189344": {"name": "ContrastBolusAgentPhase"},
-	"00189345": {"name": "CTDIvol"},
-	"00189346": {"name": "CTDIPhantomTypeCodeSequence"},
-	"00189351": {"name": "CalciumScoringMassFactorPatient"},
-	"00189352": {"name": "CalciumScoringMassFactorDevice"},
-	"00189353": {"name": "EnergyWeightingFactor"},
-	"00189360": {"name": "CTAdditionalXRaySourceSequence"},
-	"00189401": {"name": "ProjectionPixelCalibrationSequence"},
-	"00189402": {"name": "DistanceSourceToIsocenter"},
-	"00189403": {"name": "DistanceObjectToTableTop"},
-	"00189404": {"name": "ObjectPixelSpacingInCenterOfBeam"},
-	"00189405": {"name": "PositionerPositionSequence"},
-	"00189406": {"name": "TablePositionSequence"},
-	"00189407": {"name": "CollimatorShapeSequence"},
-	"00189412": {"name": "XA-XRFFrameCharacteristicsSequence"},
-	"00189417": {"name": "FrameAcquisitionSequence"},
-	"00189420": {"name": "XRayReceptorType"},
-	"00189423": {"name": "AcquisitionProtocolName"},
-	"00189424": {"name": "AcquisitionProtocolDescription"},
-	"00189425": {"name": "ContrastBolusIngredientOpaque"},
-	"00189426": {"name": "DistanceReceptorPlaneToDetHousing"},
-	"00189427": {"name": "IntensifierActiveShape"},
-	"00189428": {"name": "IntensifierActiveDimensions"},
-	"00189429": {"name": "PhysicalDetectorSize"},
-	"00189430": {"name": "PositionOfIsocenterProjection"},
-	"00189432": {"name": "FieldOfViewSequence"},
-	"00189433": {"name": "FieldOfViewDescription"},
-	"00189434": {"name": "ExposureControlSensingRegionsSeq"},
-	"00189435": {"name": "ExposureControlSensingRegionShape"},
-	"00189436": {"name": "ExposureControlSensRegionLeftEdge"},
-	"00189437": {"name": "ExposureControlSensRegionRightEdge"},
-	"00189440": {"name": "CenterOfCircExposControlSensRegion"},
-	"00189441": {"name": "RadiusOfCircExposControlSensRegion"},
-	"00189447": {"name": "ColumnAngulationPatient"},
-	"00189449": {"name": "BeamAngle"},
-	"00189451": {"name": "FrameDetectorParametersSequence"},
-	"00189452": {"name": "CalculatedAnatomyThickness"},
-	"00189455": {"name": "CalibrationSequence"},
-	"00189456": {"name": "ObjectThicknessSequence"},
-	"00189457": {"name": "PlaneIdentification"},
-	"00189461": {"name": "FieldOfViewDimensionsInFloat"},
-	"00189462": {"name": "IsocenterReferenceSystemSequence"},
-	"00189463": {"name": "PositionerIsocenterPrimaryAngle"},
-	"00189464": {"name": "PositionerIsocenterSecondaryAngle"},
-	"00189465": {"name": "PositionerIsocenterDetRotAngle"},
-	"00189466": {"name": "TableXPositionToIsocenter"},
-	"00189467": {"name": "TableYPositionToIsocenter"},
-	"00189468": {"name": "TableZPositionToIsocenter"},
-	"00189469": {"name": "TableHorizontalRotationAngle"},
-	"00189470": {"name": "TableHeadTiltAngle"},
-	"00189471": {"name": "TableCradleTiltAngle"},
-	"00189472": {"name": "FrameDisplayShutterSequence"},
-	"00189473": {"name": "AcquiredImageAreaDoseProduct"},
-	"00189474": {"name": "CArmPositionerTabletopRelationship"},
-	"00189476": {"name": "XRayGeometrySequence"},
-	"00189477": {"name": "IrradiationEventIDSequence"},
-	"00189504": {"name": "XRay3DFrameTypeSequence"},
-	"00189506": {"name": "ContributingSourcesSequence"},
-	"00189507": {"name": "XRay3DAcquisitionSequence"},
-	"00189508": {"name": "PrimaryPositionerScanArc"},
-	"00189509": {"name": "SecondaryPositionerScanArc"},
-	"00189510": {"name": "PrimaryPositionerScanStartAngle"},
-	"00189511": {"name": "SecondaryPositionerScanStartAngle"},
-	"00189514": {"name": "PrimaryPositionerIncrement"},
-	"00189515": {"name": "SecondaryPositionerIncrement"},
-	"00189516": {"name": "StartAcquisitionDateTime"},
-	"00189517": {"name": "EndAcquisitionDateTime"},
-	"00189524": {"name": "ApplicationName"},
-	"00189525": {"name": "ApplicationVersion"},
-	"00189526": {"name": "ApplicationManufacturer"},
-	"00189527": {"name": "AlgorithmType"},
-	"00189528": {"name": "AlgorithmDescription"},
-	"00189530": {"name": "XRay3DReconstructionSequence"},
-	"00189531": {"name": "ReconstructionDescription"},
-	"00189538": {"name": "PerProjectionAcquisitionSequence"},
-	"00189601": {"name": "DiffusionBMatrixSequence"},
-	"00189602": {"name": "DiffusionBValueXX"},
-	"00189603": {"name": "DiffusionBValueXY"},
-	"00189604": {"name": "DiffusionBValueXZ"},
-	"00189605": {"name": "DiffusionBValueYY"},
-	"00189606": {"name": "DiffusionBValueYZ"},
-	"00189607": {"name": "DiffusionBValueZZ"},
-	"00189701": {"name": "DecayCorrectionDateTime"},
-	"00189715": {"name": "StartDensityThreshold"},
-	"00189722": {"name": "TerminationTimeThreshold"},
-	"00189725": {"name": "DetectorGeometry"},
-	"00189727": {"name": "AxialDetectorDimension"},
-	"00189735": {"name": "PETPositionSequence"},
-	"00189739": {"name": "NumberOfIterations"},
-	"00189740": {"name": "NumberOfSubsets"},
-	"00189751": {"name": "PETFrameTypeSequence"},
-	"00189756": {"name": "ReconstructionType"},
-	"00189758": {"name": "DecayCorrected"},
-	"00189759": {"name": "AttenuationCorrected"},
-	"00189760": {"name": "ScatterCorrected"},
-	"00189761": {"name": "DeadTimeCorrected"},
-	"00189762": {"name": "GantryMotionCorrected"},
-	"00189763": {"name": "PatientMotionCorrected"},
-	"00189765": {"name": "RandomsCorrected"},
-	"00189767": {"name": "SensitivityCalibrated"},
-	"00189801": {"name": "DepthsOfFocus"},
-	"00189804": {"name": "ExclusionStartDatetime"},
-	"00189805": {"name": "ExclusionDuration"},
-	"00189807": {"name": "ImageDataTypeSequence"},
-	"00189808": {"name": "DataType"},
-	"0018980B": {"name": "AliasedDataType"},
-	"0018A001": {"name": "ContributingEquipmentSequence"},
-	"0018A002": {"name": "ContributionDateTime"},
-	"0018A003": {"name": "ContributionDescription"},
-	"00191002": {"name": "NumberOfCellsIInDetector"},
-	"00191003": {"name": "CellNumberAtTheta"},
-	"00191004": {"name": "CellSpacing"},
-	"0019100F": {"name": "HorizFrameOfRef"},
-	"00191011": {"name": "SeriesContrast"},
-	"00191012": {"name": "LastPseq"},
-	"00191013": {"name": "StartNumberForBaseline"},
-	"00191014": {"name": "EndNumberForBaseline"},
-	"00191015": {"name": "StartNumberForEnhancedScans"},
-	"00191016": {"name": "EndNumberForEnhancedScans"},
-	"00191017": {"name": "SeriesPlane"},
-	"00191018": {"name": "FirstScanRas"},
-	"00191019": {"name": "FirstScanLocation"},
-	"0019101A": {"name": "LastScanRas"},
-	"0019101B": {"name": "LastScanLoc"},
-	"0019101E": {"name": "DisplayFieldOfView"},
-	"00191023": {"name": "TableSpeed"},
-	"00191024": {"name": "MidScanTime"},
-	"00191025": {"name": "MidScanFlag"},
-	"00191026": {"name": "DegreesOfAzimuth"},
-	"00191027": {"name": "GantryPeriod"},
-	"0019102A": {"name": "XRayOnPosition"},
-	"0019102B": {"name": "XRayOffPosition"},
-	"0019102C": {"name": "NumberOfTriggers"},
-	"0019102E": {"name": "AngleOfFirstView"},
-	"0019102F": {"name": "TriggerFrequency"},
-	"00191039": {"name": "ScanFOVType"},
-	"00191040": {"name": "StatReconFlag"},
-	"00191041": {"name": "ComputeType"},
-	"00191042": {"name": "SegmentNumber"},
-	"00191043": {"name": "TotalSegmentsRequested"},
-	"00191044": {"name": "InterscanDelay"},
-	"00191047": {"name": "ViewCompressionFactor"},
-	"0019104A": {"name": "TotalNoOfRefChannels"},
-	"0019104B": {"name": "DataSizeForScanData"},
-	"00191052": {"name": "ReconPostProcflag"},
-	"00191057": {"name": "CTWaterNumber"},
-	"00191058": {"name": "CTBoneNumber"},
-	"0019105A": {"name": "AcquisitionDuration"},
-	"0019105E": {"name": "NumberOfChannels"},
-	"0019105F": {"name": "IncrementBetweenChannels"},
-	"00191060": {"name": "StartingView"},
-	"00191061": {"name": "NumberOfViews"},
-	"00191062": {"name": "IncrementBetweenViews"},
-	"0019106A": {"name": "DependantOnNoViewsProcessed"},
-	"0019106B": {"name": "FieldOfViewInDetectorCells"},
-	"00191070": {"name": "ValueOfBackProjectionButton"},
-	"00191071": {"name": "SetIfFatqEstimatesWereUsed"},
-	"00191072": {"name": "ZChanAvgOverViews"},
-	"00191073": {"name": "AvgOfLeftRefChansOverViews"},
-	"00191074": {"name": "MaxLeftChanOverViews"},
-	"00191075": {"name": "AvgOfRightRefChansOverViews"},
-	"00191076": {"name": "MaxRightChanOverViews"},
-	"0019107D": {"name": "SecondEcho"},
-	"0019107E": {"name": "NumberOfEchoes"},
-	"0019107F": {"name": "TableDelta"},
-	"00191081": {"name": "Contiguous"},
-	"00191084": {"name": "PeakSAR"},
-	"00191085": {"name": "MonitorSAR"},
-	"00191087": {"name": "CardiacRepetitionTime"},
-	"00191088": {"name": "ImagesPerCardiacCycle"},
-	"0019108A": {"name": "ActualReceiveGainAnalog"},
-	"0019108B": {"name": "ActualReceiveGainDigital"},
-	"0019108D": {"name": "DelayAfterTrigger"},
-	"0019108F": {"name": "Swappf"},
-	"00191090": {"name": "PauseInterval"},
-	"00191091": {"name": "PulseTime"},
-	"00191092": {"name": "SliceOffsetOnFreqAxis"},
-	"00191093": {"name": "CenterFrequency"},
-	"00191094": {"name": "TransmitGain"},
-	"00191095": {"name": "AnalogReceiverGain"},
-	"00191096": {"name": "DigitalReceiverGain"},
-	"00191097": {"name": "BitmapDefiningCVs"},
-	"00191098": {"name": "CenterFreqMethod"},
-	"0019109B": {"name": "PulseSeqMode"},
-	"0019109C": {"name": "PulseSeqName"},
-	"0019109D": {"name": "PulseSeqDate"},
-	"0019109E": {"name": "InternalPulseSeqName"},
-	"0019109F": {"name": "TransmittingCoil"},
-	"001910A0": {"name": "SurfaceCoilType"},
-	"001910A1": {"name": "ExtremityCoilFlag"},
-	"001910A2": {"name": "RawDataRunNumber"},
-	"001910A3": {"name": "CalibratedFieldStrength"},
-	"001910A4": {"name": "SATFatWaterBone"},
-	"001910A5": {"name": "ReceiveBandwidth"},
-	"001910A7": {"name": "UserData01"},
-	"001910A8": {"name": "UserData02"},
-	"001910A9": {"name": "UserData03"},
-	"001910AA": {"name": "UserData04"},
-	"001910AB": {"name": "UserData05"},
-	"001910AC": {"name": "UserData06"},
-	"001910AD": {"name": "UserData07"},
-	"001910AE": {"name": "UserData08"},
-	"001910AF": {"name": "UserData09"},
-	"001910B0": {"name": "UserData10"},
-	"001910B1": {"name": "UserData11"},
-	"001910B2": {"name": "UserData12"},
-	"001910B3": {"name": "UserData13"},
-	"001910B4": {"name": "UserData14"},
-	"001910B5": {"name": "UserData15"},
-	"001910B6": {"name": "UserData16"},
-	"001910B7": {"name": "UserData17"},
-	"001910B8": {"name": "UserData18"},
-	"001910B9": {"name": "UserData19"},
-	"001910BA": {"name": "UserData20"},
-	"001910BB": {"name": "UserData21"},
-	"001910BC": {"name": "UserData22"},
-	"001910BD": {"name": "UserData23"},
-	"001910BE": {"name": "ProjectionAngle"},
-	"001910C0": {"name": "SaturationPlanes"},
-	"001910C1": {"name": "SurfaceCoilIntensity"},
-	"001910C2": {"name": "SATLocationR"},
-	"001910C3": {"name": "SATLocationL"},
-	"001910C4": {"name": "SATLocationA"},
-	"001910C5": {"name": "SATLocationP"},
-	"001910C6": {"name": "SATLocationH"},
-	"001910C7": {"name": "SATLocationF"},
-	"001910C8": {"name": "SATThicknessR-L"},
-	"001910C9": {"name": "SATThicknessA-P"},
-	"001910CA": {"name": "SATThicknessH-F"},
-	"001910CB": {"name": "PrescribedFlowAxis"},
-	"001910CC": {"name": "VelocityEncoding"},
-	"001910CD": {"name": "ThicknessDisclaimer"},
-	"001910CE": {"name": "PrescanType"},
-	"001910CF": {"name": "PrescanStatus"},
-	"001910D0": {"name": "RawDataType"},
-	"001910D2": {"name": "ProjectionAlgorithm"},
-	"001910D3": {"name": "ProjectionAlgorithm"},
-	"001910D5": {"name": "FractionalEcho"},
-	"001910D6": {"name": "PrepPulse"},
-	"001910D7": {"name": "CardiacPhases"},
-	"001910D8": {"name": "VariableEchoflag"},
-	"001910D9": {"name": "ConcatenatedSAT"},
-	"001910DA": {"name": "ReferenceChannelUsed"},
-	"001910DB": {"name": "BackProjectorCoefficient"},
-	"001910DC": {"name": "PrimarySpeedCorrectionUsed"},
-	"001910DD": {"name": "OverrangeCorrectionUsed"},
-	"001910DE": {"name": "DynamicZAlphaValue"},
-	"001910DF": {"name": "UserData"},
-	"001910E0": {"name": "UserData"},
-	"001910E2": {"name": "VelocityEncodeScale"},
-	"001910F2": {"name": "FastPhases"},
-	"001910F9": {"name": "TransmissionGain"},
-	"00200000": {"name": "RelationshipGroupLength"},
-	"0020000D": {"name": "StudyInstanceUID"},
-	"0020000E": {"name": "SeriesInstanceUID"},
-	"00200010": {"name": "StudyID"},
-	"00200011": {"name": "SeriesNumber"},
-	"00200012": {"name": "AcquisitionNumber"},
-	"00200013": {"name": "InstanceNumber"},
-	"00200014": {"name": "IsotopeNumber"},
-	"00200015": {"name": "PhaseNumber"},
-	"00200016": {"name": "IntervalNumber"},
-	"00200017": {"name": "TimeSlotNumber"},
-	"00200018": {"name": "AngleNumber"},
-	"00200019": {"name": "ItemNumber"},
-	"00200020": {"name": "PatientOrientation"},
-	"00200022": {"name": "OverlayNumber"},
-	"00200024": {"name": "CurveNumber"},
-	"00200026": {"name": "LookupTableNumber"},
-	"00200030": {"name": "ImagePosition"},
-	"00200032": {"name": "ImagePositionPatient"},
-	"00200035": {"name": "ImageOrientation"},
-	"00200037": {"name": "ImageOrientationPatient"},
-	"00200050": {"name": "Location"},
-	"00200052": {"name": "FrameOfReferenceUID"},
-	"00200060": {"name": "Laterality"},
-	"00200062": {"name": "ImageLaterality"},
-	"00200070": {"name": "ImageGeometryType"},
-	"00200080": {"name": "MaskingImage"},
-	"00200100": {"name": "TemporalPositionIdentifier"},
-	"00200105": {"name": "NumberOfTemporalPositions"},
-	"00200110": {"name": "TemporalResolution"},
-	"00200200": {"name": "SynchronizationFrameOfReferenceUID"},
-	"00201000": {"name": "SeriesInStudy"},
-	"00201001": {"name": "AcquisitionsInSeries"},
-	"00201002": {"name": "ImagesInAcquisition"},
-	"00201003": {"name": "ImagesInSeries"},
-	"00201004": {"name": "AcquisitionsInStudy"},
-	"00201005": {"name": "ImagesInStudy"},
-	"00201020": {"name": "Reference"},
-	"00201040": {"name": "PositionReferenceIndicator"},
-	"00201041": {"name": "SliceLocation"},
-	"00201070": {"name": "OtherStudyNumbers"},
-	"00201200": {"name": "NumberOfPatientRelatedStudies"},
-	"00201202": {"name": "NumberOfPatientRelatedSeries"},
-	"00201204": {"name": "NumberOfPatientRelatedInstances"},
-	"00201206": {"name": "NumberOfStudyRelatedSeries"},
-	"00201208": {"name": "NumberOfStudyRelatedInstances"},
-	"00201209": {"name": "NumberOfSeriesRelatedInstances"},
-	"00203401": {"name": "ModifyingDeviceID"},
-	"00203402": {"name": "ModifiedImageID"},
-	"00203403": {"name": "ModifiedImageDate"},
-	"00203404": {"name": "ModifyingDeviceManufacturer"},
-	"00203405": {"name": "ModifiedImageTime"},
-	"00203406": {"name": "ModifiedImageDescription"},
-	"00204000": {"name": "ImageComments"},
-	"00205000": {"name": "OriginalImageIdentification"},
-	"00205002": {"name": "OriginalImageIdentNomenclature"},
-	"00209056": {"name": "StackID"},
-	"00209057": {"name": "InStackPositionNumber"},
-	"00209071": {"name": "FrameAnatomySequence"},
-	"00209072": {"name": "FrameLaterality"},
-	"00209111": {"name": "FrameContentSequence"},
-	"00209113": {"name": "PlanePositionSequence"},
-	"00209116": {"name": "PlaneOrientationSequence"},
-	"00209128": {"name": "TemporalPositionIndex"},
-	"00209153": {"name": "TriggerDelayTime"},
-	"00209156": {"name": "FrameAcquisitionNumber"},
-	"00209157": {"name": "DimensionIndexValues"},
-	"00209158": {"name": "FrameComments"},
-	"00209161": {"name": "ConcatenationUID"},
-	"00209162": {"name": "InConcatenationNumber"},
-	"00209163": {"name": "InConcatenationTotalNumber"},
-	"00209164": {"name": "DimensionOrganizationUID"},
-	"00209165": {"name": "DimensionIndexPointer"},
-	"00209167": {"name": "FunctionalGroupPointer"},
-	"00209213": {"name": "DimensionIndexPrivateCreator"},
-	"00209221": {"name": "DimensionOrganizationSequence"},
-	"00209222": {"name": "DimensionIndexSequence"},
-	"00209228": {"name": "ConcatenationFrameOffsetNumber"},
-	"00209238": {"name": "FunctionalGroupPrivateCreator"},
-	"00209241": {"name": "NominalPercentageOfCardiacPhase"},
-	"00209245": {"name": "NominalPercentOfRespiratoryPhase"},
-	"00209246": {"name": "StartingRespiratoryAmplitude"},
-	"00209247": {"name": "StartingRespiratoryPhase"},
-	"00209248": {"name": "EndingRespiratoryAmplitude"},
-	"00209249": {"name": "EndingRespiratoryPhase"},
-	"00209250": {"name": "RespiratoryTriggerType"},
-	"00209251": {"name": "RRIntervalTimeNominal"},
-	"00209252": {"name": "ActualCardiacTriggerDelayTime"},
-	"00209253": {"name": "RespiratorySynchronizationSequence"},
-	"00209254": {"name": "RespiratoryIntervalTime"},
-	"00209255": {"name": "NominalRespiratoryTriggerDelayTime"},
-	"00209256": {"name": "RespiratoryTriggerDelayThreshold"},
-	"00209257": {"name": "ActualRespiratoryTriggerDelayTime"},
-	"00209301": {"name": "ImagePositionVolume"},
-	"00209302": {"name": "ImageOrientationVolume"},
-	"00209308": {"name": "ApexPosition"},
-	"00209421": {"name": "DimensionDescriptionLabel"},
-	"00209450": {"name": "PatientOrientationInFrameSequence"},
-	"00209453": {"name": "FrameLabel"},
-	"00209518": {"name": "AcquisitionIndex"},
-	"00209529": {"name": "ContributingSOPInstancesRefSeq"},
-	"00209536": {"name": "ReconstructionIndex"},
-	"00211003": {"name": "SeriesFromWhichPrescribed"},
-	"00211005": {"name": "GenesisVersionNow"},
-	"00211007": {"name": "SeriesRecordChecksum"},
-	"00211018": {"name": "GenesisVersionNow"},
-	"00211019": {"name": "AcqreconRecordChecksum"},
-	"00211020": {"name": "TableStartLocation"},
-	"00211035": {"name": "SeriesFromWhichPrescribed"},
-	"00211036": {"name": "ImageFromWhichPrescribed"},
-	"00211037": {"name": "ScreenFormat"},
-	"0021104A": {"name": "AnatomicalReferenceForScout"},
-	"0021104F": {"name": "LocationsInAcquisition"},
-	"00211050": {"name": "GraphicallyPrescribed"},
-	"00211051": {"name": "RotationFromSourceXRot"},
-	"00211052": {"name": "RotationFromSourceYRot"},
-	"00211053": {"name": "RotationFromSourceZRot"},
-	"00211054": {"name": "ImagePosition"},
-	"00211055": {"name": "ImageOrientation"},
-	"00211056": {"name": "IntegerSlop"},
-	"00211057": {"name": "IntegerSlop"},
-	"00211058": {"name": "IntegerSlop"},
-	"00211059": {"name": "IntegerSlop"},
-	"0021105A": {"name": "IntegerSlop"},
-	"0021105B": {"name": "FloatSlop"},
-	"0021105C": {"name": "FloatSlop"},
-	"0021105D": {"name": "FloatSlop"},
-	"0021105E": {"name": "FloatSlop"},
-	"0021105F": {"name": "FloatSlop"},
-	"00211081": {"name": "AutoWindowLevelAlpha"},
-	"00211082": {"name": "AutoWindowLevelBeta"},
-	"00211083": {"name": "AutoWindowLevelWindow"},
-	"00211084": {"name": "ToWindowLevelLevel"},
-	"00211090": {"name": "TubeFocalSpotPosition"},
-	"00211091": {"name": "BiopsyPosition"},
-	"00211092": {"name": "BiopsyTLocation"},
-	"00211093": {"name": "BiopsyRefLocation"},
-	"00220001": {"name": "LightPathFilterPassThroughWavelen"},
-	"00220002": {"name": "LightPathFilterPassBand"},
-	"00220003": {"name": "ImagePathFilterPassThroughWavelen"},
-	"00220004": {"name": "ImagePathFilterPassBand"},
-	"00220005": {"name": "PatientEyeMovementCommanded"},
-	"00220006": {"name": "PatientEyeMovementCommandCodeSeq"},
-	"00220007": {"name": "SphericalLensPower"},
-	"00220008": {"name": "CylinderLensPower"},
-	"00220009": {"name": "CylinderAxis"},
-	"0022000A": {"name": "EmmetropicMagnification"},
-	"0022000B": {"name": "IntraOcularPressure"},
-	"0022000C": {"name": "HorizontalFieldOfView"},
-	"0022000D": {"name": "PupilDilated"},
-	"0022000E": {"name": "DegreeOfDilation"},
-	"00220010": {"name": "StereoBaselineAngle"},
-	"00220011": {"name": "StereoBaselineDisplacement"},
-	"00220012": {"name": "StereoHorizontalPixelOffset"},
-	"00220013": {"name": "StereoVerticalPixelOffset"},
-	"00220014": {"name": "StereoRotation"},
-	"00220015": {"name": "AcquisitionDeviceTypeCodeSequence"},
-	"00220016": {"name": "IlluminationTypeCodeSequence"},
-	"00220017": {"name": "LightPathFilterTypeStackCodeSeq"},
-	"00220018": {"name": "ImagePathFilterTypeStackCodeSeq"},
-	"00220019": {"name": "LensesCodeSequence"},
-	"0022001A": {"name": "ChannelDescriptionCodeSequence"},
-	"0022001B": {"name": "RefractiveStateSequence"},
-	"0022001C": {"name": "MydriaticAgentCodeSequence"},
-	"0022001D": {"name": "RelativeImagePositionCodeSequence"},
-	"00220020": {"name": "StereoPairsSequence"},
-	"00220021": {"name": "LeftImageSequence"},
-	"00220022": {"name": "RightImageSequence"},
-	"00220030": {"name": "AxialLengthOfTheEye"},
-	"00220031": {"name": "OphthalmicFrameLocationSequence"},
-	"00220032": {"name": "ReferenceCoordinates"},
-	"00220035": {"name": "DepthSpatialResolution"},
-	"00220036": {"name": "MaximumDepthDistortion"},
-	"00220037": {"name": "AlongScanSpatialResolution"},
-	"00220038": {"name": "MaximumAlongScanDistortion"},
-	"00220039": {"name": "OphthalmicImageOrientation"},
-	"00220041": {"name": "DepthOfTransverseImage"},
-	"00220042": {"name": "MydriaticAgentConcUnitsSeq"},
-	"00220048": {"name": "AcrossScanSpatialResolution"},
-	"00220049": {"name": "MaximumAcrossScanDistortion"},
-	"0022004E": {"name": "MydriaticAgentConcentration"},
-	"00220055": {"name": "IlluminationWaveLength"},
-	"00220056": {"name": "IlluminationPower"},
-	"00220057": {"name": "IlluminationBandwidth"},
-	"00220058": {"name": "MydriaticAgentSequence"},
-	"00231001": {"name": "NumberOfSeriesInStudy"},
-	"00231002": {"name": "NumberOfUnarchivedSeries"},
-	"00231010": {"name": "ReferenceImageField"},
-	"00231050": {"name": "SummaryImage"},
-	"00231070": {"name": "StartTimeSecsInFirstAxial"},
-	"00231074": {"name": "NoofUpdatesToHeader"},
-	"0023107D": {"name": "IndicatesIfTheStudyHasCompleteInfo"},
-	"00251006": {"name": "LastPulseSequenceUsed"},
-	"00251007": {"name": "ImagesInSeries"},
-	"00251010": {"name": "LandmarkCounter"},
-	"00251011": {"name": "NumberOfAcquisitions"},
-	"00251014": {"name": "IndicatesNoofUpdatesToHeader"},
-	"00251017": {"name": "SeriesCompleteFlag"},
-	"00251018": {"name": "NumberOfImagesArchived"},
-	"00251019": {"name": "LastImageNumberUsed"},
-	"0025101A": {"name": "PrimaryReceiverSuiteAndHost"},
-	"00271006": {"name": "ImageArchiveFlag"},
-	"00271010": {"name": "ScoutType"},
-	"0027101C": {"name": "VmaMamp"},
-	"0027101D": {"name": "VmaPhase"},
-	"0027101E": {"name": "VmaMod"},
-	"0027101F": {"name": "VmaClip"},
-	"00271020": {"name": "SmartScanOnOffFlag"},
-	"00271030": {"name": "ForeignImageRevision"},
-	"00271031": {"name": "ImagingMode"},
-	"00271032": {"name": "PulseSequence"},
-	"00271033": {"name": "ImagingOptions"},
-	"00271035": {"name": "PlaneType"},
-	"00271036": {"name": "ObliquePlane"},
-	"00271040": {"name": "RASLetterOfImageLocation"},
-	"00271041": {"name": "ImageLocation"},
-	"00271042": {"name": "CenterRCoordOfPlaneImage"},
-	"00271043": {"name": "CenterACoordOfPlaneImage"},
-	"00271044": {"name": "CenterSCoordOfPlaneImage"},
-	"00271045": {"name": "NormalRCoord"},
-	"00271046": {"name": "NormalACoord"},
-	"00271047": {"name": "NormalSCoord"},
-	"00271048": {"name": "RCoordOfTopRightCorner"},
-	"00271049": {"name": "ACoordOfTopRightCorner"},
-	"0027104A": {"name": "SCoordOfTopRightCorner"},
-	"0027104B": {"name": "RCoordOfBottomRightCorner"},
-	"0027104C": {"name": "ACoordOfBottomRightCorner"},
-	"0027104D": {"name": "SCoordOfBottomRightCorner"},
-	"00271050": {"name": "TableStartLocation"},
-	"00271051": {"name": "TableEndLocation"},
-	"00271052": {"name": "RASLetterForSideOfImage"},
-	"00271053": {"name": "RASLetterForAnteriorPosterior"},
-	"00271054": {"name": "RASLetterForScoutStartLoc"},
-	"00271055": {"name": "RASLetterForScoutEndLoc"},
-	"00271060": {"name": "ImageDimensionX"},
-	"00271061": {"name": "ImageDimensionY"},
-	"00271062": {"name": "NumberOfExcitations"},
-	"00280000": {"name": "ImagePresentationGroupLength"},
-	"00280002": {"name": "SamplesPerPixel"},
-	"00280003": {"name": "SamplesPerPixelUsed"},
-	"00280004": {"name": "PhotometricInterpretation"},
-	"00280005": {"name": "ImageDimensions"},
-	"00280006": {"name": "PlanarConfiguration"},
-	"00280008": {"name": "NumberOfFrames"},
-	"00280009": {"name": "FrameIncrementPointer"},
-	"0028000A": {"name": "FrameDimensionPointer"},
-	"00280010": {"name": "Rows"},
-	"00280011": {"name": "Columns"},
-	"00280012": {"name": "Planes"},
-	"00280014": {"name": "UltrasoundColorDataPresent"},
-	"00280030": {"name": "PixelSpacing"},
-	"00280031": {"name": "ZoomFactor"},
-	"00280032": {"name": "ZoomCenter"},
-	"00280034": {"name": "PixelAspectRatio"},
-	"00280040": {"name": "ImageFormat"},
-	"00280050": {"name": "ManipulatedImage"},
-	"00280051": {"name": "CorrectedImage"},
-	"0028005F": {"name": "CompressionRecognitionCode"},
-	"00280060": {"name": "CompressionCode"},
-	"00280061": {"name": "CompressionOriginator"},
-	"00280062": {"name": "CompressionLabel"},
-	"00280063": {"name": "CompressionDescription"},
-	"00280065": {"name": "CompressionSequence"},
-	"00280066": {"name": "CompressionStepPointers"},
-	"00280068": {"name": "RepeatInterval"},
-	"00280069": {"name": "BitsGrouped"},
-	"00280070": {"name": "PerimeterTable"},
-	"00280071": {"name": "PerimeterValue"},
-	"00280080": {"name": "PredictorRows"},
-	"00280081": {"name": "PredictorColumns"},
-	"00280082": {"name": "PredictorConstants"},
-	"00280090": {"name": "BlockedPixels"},
-	"00280091": {"name": "BlockRows"},
-	"00280092": {"name": "BlockColumns"},
-	"00280093": {"name": "RowOverlap"},
-	"00280094": {"name": "ColumnOverlap"},
-	"00280100": {"name": "BitsAllocated"},
-	"00280101": {"name": "BitsStored"},
-	"00280102": {"name": "HighBit"},
-	"00280103": {"name": "PixelRepresentation"},
-	"00280104": {"name": "SmallestValidPixelValue"},
-	"00280105": {"name": "LargestValidPixelValue"},
-	"00280106": {"name": "SmallestImagePixelValue"},
-	"00280107": {"name": "LargestImagePixelValue"},
-	"00280108": {"name": "SmallestPixelValueInSeries"},
-	"00280109": {"name": "LargestPixelValueInSeries"},
-	"00280110": {"name": "SmallestImagePixelValueInPlane"},
-	"00280111": {"name": "LargestImagePixelValueInPlane"},
-	"00280120": {"name": "PixelPaddingValue"},
-	"00280121": {"name": "PixelPaddingRangeLimit"},
-	"00280200": {"name": "ImageLocation"},
-	"00280300": {"name": "QualityControlImage"},
-	"00280301": {"name": "BurnedInAnnotation"},
-	"00280400": {"name": "TransformLabel"},
-	"00280401": {"name": "TransformVersionNumber"},
-	"00280402": {"name": "NumberOfTransformSteps"},
-	"00280403": {"name": "SequenceOfCompressedData"},
-	"00280404": {"name": "DetailsOfCoefficients"},
-	"00280700": {"name": "DCTLabel"},
-	"00280701": {"name": "DataBlockDescription"},
-	"00280702": {"name": "DataBlock"},
-	"00280710": {"name": "NormalizationFactorFormat"},
-	"00280720": {"name": "ZonalMapNumberFormat"},
-	"00280721": {"name": "ZonalMapLocation"},
-	"00280722": {"name": "ZonalMapFormat"},
-	"00280730": {"name": "AdaptiveMapFormat"},
-	"00280740": {"name": "CodeNumberFormat"},
-	"00280A02": {"name": "PixelSpacingCalibrationType"},
-	"00280A04": {"name": "PixelSpacingCalibrationDescription"},
-	"00281040": {"name": "PixelIntensityRelationship"},
-	"00281041": {"name": "PixelIntensityRelationshipSign"},
-	"00281050": {"name": "WindowCenter"},
-	"00281051": {"name": "WindowWidth"},
-	"00281052": {"name": "RescaleIntercept"},
-	"00281053": {"name": "RescaleSlope"},
-	"00281054": {"name": "RescaleType"},
-	"00281055": {"name": "WindowCenterAndWidthExplanation"},
-	"00281056": {"name": "VOI_LUTFunction"},
-	"00281080": {"name": "GrayScale"},
-	"00281090": {"name": "RecommendedViewingMode"},
-	"00281100": {"name": "GrayLookupTableDescriptor"},
-	"00281101": {"name": "RedPaletteColorTableDescriptor"},
-	"00281102": {"name": "GreenPaletteColorTableDescriptor"},
-	"00281103": {"name": "BluePaletteColorTableDescriptor"},
-	"00281111": {"name": "LargeRedPaletteColorTableDescr"},
-	"00281112": {"name": "LargeGreenPaletteColorTableDescr"},
-	"00281113": {"name": "LargeBluePaletteColorTableDescr"},
-	"00281199": {"name": "PaletteColorTableUID"},
-	"00281200": {"name": "GrayLookupTableData"},
-	"00281201": {"name": "RedPaletteColorTableData"},
-	"00281202": {"name": "GreenPaletteColorTableData"},
-	"00281203": {"name": "BluePaletteColorTableData"},
-	"00281211": {"name": "LargeRedPaletteColorTableData"},
-	"00281212": {"name": "LargeGreenPaletteColorTableData"},
-	"00281213": {"name": "LargeBluePaletteColorTableData"},
-	"00281214": {"name": "LargePaletteColorLookupTableUID"},
-	"00281221": {"name": "SegmentedRedColorTableData"},
-	"00281222": {"name": "SegmentedGreenColorTableData"},
-	"00281223": {"name": "SegmentedBlueColorTableData"},
-	"00281300": {"name": "BreastImplantPresent"},
-	"00281350": {"name": "PartialView"},
-	"00281351": {"name": "PartialViewDescription"},
-	"00281352": {"name": "PartialViewCodeSequence"},
-	"0028135A": {"name": "SpatialLocationsPreserved"},
-	"00281402": {"name": "DataPathAssignment"},
-	"00281404": {"name": "BlendingLUT1Sequence"},
-	"00281406": {"name": "BlendingWeightConstant"},
-	"00281408": {"name": "BlendingLookupTableData"},
-	"0028140C": {"name": "BlendingLUT2Sequence"},
-	"0028140E": {"name": "DataPathID"},
-	"0028140F": {"name": "RGBLUTTransferFunction"},
-	"00281410": {"name": "AlphaLUTTransferFunction"},
-	"00282000": {"name": "ICCProfile"},
-	"00282110": {"name": "LossyImageCompression"},
-	"00282112": {"name": "LossyImageCompressionRatio"},
-	"00282114": {"name": "LossyImageCompressionMethod"},
-	"00283000": {"name": "ModalityLUTSequence"},
-	"00283002": {"name": "LUTDescriptor"},
-	"00283003": {"name": "LUTExplanation"},
-	"00283004": {"name": "ModalityLUTType"},
-	"00283006": {"name": "LUTData"},
-	"00283010": {"name": "VOILUTSequence"},
-	"00283110": {"name": "SoftcopyVOILUTSequence"},
-	"00284000": {"name": "ImagePresentationComments"},
-	"00285000": {"name": "BiPlaneAcquisitionSequence"},
-	"00286010": {"name": "RepresentativeFrameNumber"},
-	"00286020": {"name": "FrameNumbersOfInterest"},
-	"00286022": {"name": "FrameOfInterestDescription"},
-	"00286023": {"name": "FrameOfInterestType"},
-	"00286030": {"name": "MaskPointers"},
-	"00286040": {"name": "RWavePointer"},
-	"00286100": {"name": "MaskSubtractionSequence"},
-	"00286101": {"name": "MaskOperation"},
-	"00286102": {"name": "ApplicableFrameRange"},
-	"00286110": {"name": "MaskFrameNumbers"},
-	"00286112": {"name": "ContrastFrameAveraging"},
-	"00286114": {"name": "MaskSubPixelShift"},
-	"00286120": {"name": "TIDOffset"},
-	"00286190": {"name": "MaskOperationExplanation"},
-	"00287FE0": {"name": "PixelDataProviderURL"},
-	"00289001": {"name": "DataPointRows"},
-	"00289002": {"name": "DataPointColumns"},
-	"00289003": {"name": "SignalDomainColumns"},
-	"00289099": {"name": "LargestMonochromePixelValue"},
-	"00289108": {"name": "DataRepresentation"},
-	"00289110": {"name": "PixelMeasuresSequence"},
-	"00289132": {"name": "FrameVOILUTSequence"},
-	"00289145": {"name": "PixelValueTransformationSequence"},
-	"00289235": {"name": "SignalDomainRows"},
-	"00289411": {"name": "DisplayFilterPercentage"},
-	"00289415": {"name": "FramePixelShiftSequence"},
-	"00289416": {"name": "SubtractionItemID"},
-	"00289422": {"name": "PixelIntensityRelationshipLUTSeq"},
-	"00289443": {"name": "FramePixelDataPropertiesSequence"},
-	"00289444": {"name": "GeometricalProperties"},
-	"00289445": {"name": "GeometricMaximumDistortion"},
-	"00289446": {"name": "ImageProcessingApplied"},
-	"00289454": {"name": "MaskSelectionMode"},
-	"00289474": {"name": "LUTFunction"},
-	"00289478": {"name": "MaskVisibilityPercentage"},
-	"00289501": {"name": "PixelShiftSequence"},
-	"00289502": {"name": "RegionPixelShiftSequence"},
-	"00289503": {"name": "VerticesOfTheRegion"},
-	"00289506": {"name": "PixelShiftFrameRange"},
-	"00289507": {"name": "LUTFrameRange"},
-	"00289520": {"name": "ImageToEquipmentMappingMatrix"},
-	"00289537": {"name": "EquipmentCoordinateSystemID"},
-	"00291004": {"name": "LowerRangeOfPixels1a"},
-	"00291005": {"name": "LowerRangeOfPixels1b"},
-	"00291006": {"name": "LowerRangeOfPixels1c"},
-	"00291007": {"name": "LowerRangeOfPixels1d"},
-	"00291008": {"name": "LowerRangeOfPixels1e"},
-	"00291009": {"name": "LowerRangeOfPixels1f"},
-	"0029100A": {"name": "LowerRangeOfPixels1g"},
-	"00291015": {"name": "LowerRangeOfPixels1h"},
-	"00291016": {"name": "LowerRangeOfPixels1i"},
-	"00291017": {"name": "LowerRangeOfPixels2"},
-	"00291018": {"name": "UpperRangeOfPixels2"},
-	"0029101A": {"name": "LenOfTotHdrInBytes"},
-	"00291026": {"name": "VersionOfTheHdrStruct"},
-	"00291034": {"name": "AdvantageCompOverflow"},
-	"00291035": {"name": "AdvantageCompUnderflow"},
-	"00320000": {"name": "StudyGroupLength"},
-	"0032000A": {"name": "StudyStatusID"},
-	"0032000C": {"name": "StudyPriorityID"},
-	"00320012": {"name": "StudyIDIssuer"},
-	"00320032": {"name": "StudyVerifiedDate"},
-	"00320033": {"name": "StudyVerifiedTime"},
-	"00320034": {"name": "StudyReadDate"},
-	"00320035": {"name": "StudyReadTime"},
-	"00321000": {"name": "ScheduledStudyStartDate"},
-	"00321001": {"name": "ScheduledStudyStartTime"},
-	"00321010": {"name": "ScheduledStudyStopDate"},
-	"00321011": {"name": "ScheduledStudyStopTime"},
-	"00321020": {"name": "ScheduledStudyLocation"},
-	"00321021": {"name": "ScheduledStudyLocationAETitle"},
-	"00321030": {"name": "ReasonForStudy"},
-	"00321031": {"name": "RequestingPhysicianIDSequence"},
-	"00321032": {"name": "RequestingPhysician"},
-	"00321033": {"name": "RequestingService"},
-	"00321040": {"name": "StudyArrivalDate"},
-	"00321041": {"name": "StudyArrivalTime"},
-	"00321050": {"name": "StudyCompletionDate"},
-	"00321051": {"name": "StudyCompletionTime"},
-	"00321055": {"name": "StudyComponentStatusID"},
-	"00321060": {"name": "RequestedProcedureDescription"},
-	"00321064": {"name": "RequestedProcedureCodeSequence"},
-	"00321070": {"name": "RequestedContrastAgent"},
-	"00324000": {"name": "StudyComments"},
-	"00380004": {"name": "ReferencedPatientAliasSequence"},
-	"00380008": {"name": "VisitStatusID"},
-	"00380010": {"name": "AdmissionID"},
-	"00380011": {"name": "IssuerOfAdmissionID"},
-	"00380016": {"name": "RouteOfAdmissions"},
-	"0038001A": {"name": "ScheduledAdmissionDate"},
-	"0038001B": {"name": "ScheduledAdmissionTime"},
-	"0038001C": {"name": "ScheduledDischargeDate"},
-	"0038001D": {"name": "ScheduledDischargeTime"},
-	"0038001E": {"name": "ScheduledPatientInstitResidence"},
-	"00380020": {"name": "AdmittingDate"},
-	"00380021": {"name": "AdmittingTime"},
-	"00380030": {"name": "DischargeDate"},
-	"00380032": {"name": "DischargeTime"},
-	"00380040": {"name": "DischargeDiagnosisDescription"},
-	"00380044": {"name": "DischargeDiagnosisCodeSequence"},
-	"00380050": {"name": "SpecialNeeds"},
-	"00380060": {"name": "ServiceEpisodeID"},
-	"00380061": {"name": "IssuerOfServiceEpisodeID"},
-	"00380062": {"name": "ServiceEpisodeDescription"},
-	"00380100": {"name": "PertinentDocumentsSequence"},
-	"00380300": {"name": "CurrentPatientLocation"},
-	"00380400": {"name": "PatientInstitutionResidence"},
-	"00380500": {"name": "PatientState"},
-	"00380502": {"name": "PatientClinicalTrialParticipSeq"},
-	"00384000": {"name": "VisitComments"},
-	"003A0004": {"name": "WaveformOriginality"},
-	"003A0005": {"name": "NumberOfWaveformChannels"},
-	"003A0010": {"name": "NumberOfWaveformSamples"},
-	"003A001A": {"name": "SamplingFrequency"},
-	"003A0020": {"name": "MultiplexGroupLabel"},
-	"003A0200": {"name": "ChannelDefinitionSequence"},
-	"003A0202": {"name": "WaveformChannelNumber"},
-	"003A0203": {"name": "ChannelLabel"},
-	"003A0205": {"name": "ChannelStatus"},
-	"003A0208": {"name": "ChannelSourceSequence"},
-	"003A0209": {"name": "ChannelSourceModifiersSequence"},
-	"003A020A": {"name": "SourceWaveformSequence"},
-	"003A020C": {"name": "ChannelDerivationDescription"},
-	"003A0210": {"name": "ChannelSensitivity"},
-	"003A0211": {"name": "ChannelSensitivityUnitsSequence"},
-	"003A0212": {"name": "ChannelSensitivityCorrectionFactor"},
-	"003A0213": {"name": "ChannelBaseline"},
-	"003A0214": {"name": "ChannelTimeSkew"},
-	"003A0215": {"name": "ChannelSampleSkew"},
-	"003A0218": {"name": "ChannelOffset"},
-	"003A021A": {"name": "WaveformBitsStored"},
-	"003A0220": {"name": "FilterLowFrequency"},
-	"003A0221": {"name": "FilterHighFrequency"},
-	"003A0222": {"name": "NotchFilterFrequency"},
-	"003A0223": {"name": "NotchFilterBandwidth"},
-	"003A0230": {"name": "WaveformDataDisplayScale"},
-	"003A0231": {"name": "WaveformDisplayBkgCIELabValue"},
-	"003A0240": {"name": "WaveformPresentationGroupSequence"},
-	"003A0241": {"name": "PresentationGroupNumber"},
-	"003A0242": {"name": "ChannelDisplaySequence"},
-	"003A0244": {"name": "ChannelRecommendDisplayCIELabValue"},
-	"003A0245": {"name": "ChannelPosition"},
-	"003A0246": {"name": "DisplayShadingFlag"},
-	"003A0247": {"name": "FractionalChannelDisplayScale"},
-	"003A0248": {"name": "AbsoluteChannelDisplayScale"},
-	"003A0300": {"name": "MultiplexAudioChannelsDescrCodeSeq"},
-	"003A0301": {"name": "ChannelIdentificationCode"},
-	"003A0302": {"name": "ChannelMode"},
-	"00400001": {"name": "ScheduledStationAETitle"},
-	"00400002": {"name": "ScheduledProcedureStepStartDate"},
-	"00400003": {"name": "ScheduledProcedureStepStartTime"},
-	"00400004": {"name": "ScheduledProcedureStepEndDate"},
-	"00400005": {"name": "ScheduledProcedureStepEndTime"},
-	"00400006": {"name": "ScheduledPerformingPhysiciansName"},
-	"00400007": {"name": "ScheduledProcedureStepDescription"},
-	"00400008": {"name": "ScheduledProtocolCodeSequence"},
-	"00400009": {"name": "ScheduledProcedureStepID"},
-	"0040000A": {"name": "StageCodeSequence"},
-	"0040000B": {"name": "ScheduledPerformingPhysicianIDSeq"},
-	"00400010": {"name": "ScheduledStationName"},
-	"00400011": {"name": "ScheduledProcedureStepLocation"},
-	"00400012": {"name": "PreMedication"},
-	"00400020": {"name": "ScheduledProcedureStepStatus"},
-	"00400031": {"name": "LocalNamespaceEntityID"},
-	"00400032": {"name": "UniversalEntityID"},
-	"00400033": {"name": "UniversalEntityIDType"},
-	"00400035": {"name": "IdentifierTypeCode"},
-	"00400036": {"name": "AssigningFacilitySequence"},
-	"00400100": {"name": "ScheduledProcedureStepSequence"},
-	"00400220": {"name": "ReferencedNonImageCompositeSOPSeq"},
-	"00400241": {"name": "PerformedStationAETitle"},
-	"00400242": {"name": "PerformedStationName"},
-	"00400243": {"name": "PerformedLocation"},
-	"00400244": {"name": "PerformedProcedureStepStartDate"},
-	"00400245": {"name": "PerformedProcedureStepStartTime"},
-	"00400250": {"name": "PerformedProcedureStepEndDate"},
-	"00400251": {"name": "PerformedProcedureStepEndTime"},
-	"00400252": {"name": "PerformedProcedureStepStatus"},
-	"00400253": {"name": "PerformedProcedureStepID"},
-	"00400254": {"name": "PerformedProcedureStepDescription"},
-	"00400255": {"name": "PerformedProcedureTypeDescription"},
-	"00400260": {"name": "PerformedProtocolCodeSequence"},
-	"00400261": {"name": "PerformedProtocolType"},
-	"00400270": {"name": "ScheduledStepAttributesSequence"},
-	"00400275": {"name": "RequestAttributesSequence"},
-	"00400280": {"name": "CommentsOnPerformedProcedureStep"},
-	"00400281": {"name": "ProcStepDiscontinueReasonCodeSeq"},
-	"00400293": {"name": "QuantitySequence"},
-	"00400294": {"name": "Quantity"},
-	"00400295": {"name": "MeasuringUnitsSequence"},
-	"00400296": {"name": "BillingItemSequence"},
-	"00400300": {"name": "TotalTimeOfFluoroscopy"},
-	"00400301": {"name": "TotalNumberOfExposures"},
-	"00400302": {"name": "EntranceDose"},
-	"00400303": {"name": "ExposedArea"},
-	"00400306": {"name": "DistanceSourceToEntrance"},
-	"00400307": {"name": "DistanceSourceToSupport"},
-	"0040030E": {"name": "ExposureDoseSequence"},
-	"00400310": {"name": "CommentsOnRadiationDose"},
-	"00400312": {"name": "XRayOutput"},
-	"00400314": {"name": "HalfValueLayer"},
-	"00400316": {"name": "OrganDose"},
-	"00400318": {"name": "OrganExposed"},
-	"00400320": {"name": "BillingProcedureStepSequence"},
-	"00400321": {"name": "FilmConsumptionSequence"},
-	"00400324": {"name": "BillingSuppliesAndDevicesSequence"},
-	"00400330": {"name": "ReferencedProcedureStepSequence"},
-	"00400340": {"name": "PerformedSeriesSequence"},
-	"00400400": {"name": "CommentsOnScheduledProcedureStep"},
-	"00400440": {"name": "ProtocolContextSequence"},
-	"00400441": {"name": "ContentItemModifierSequence"},
-	"0040050A": {"name": "SpecimenAccessionNumber"},
-	"00400512": {"name": "ContainerIdentifier"},
-	"0040051A": {"name": "ContainerDescription"},
-	"00400550": {"name": "SpecimenSequence"},
-	"00400551": {"name": "SpecimenIdentifier"},
-	"00400552": {"name": "SpecimenDescriptionSequenceTrial"},
-	"00400553": {"name": "SpecimenDescriptionTrial"},
-	"00400554": {"name": "SpecimenUID"},
-	"00400555": {"name": "AcquisitionContextSequence"},
-	"00400556": {"name": "AcquisitionContextDescription"},
-	"0040059A": {"name": "SpecimenTypeCodeSequence"},
-	"00400600": {"name": "SpecimenShortDescription"},
-	"004006FA": {"name": "SlideIdentifier"},
-	"0040071A": {"name": "ImageCenterPointCoordinatesSeq"},
-	"0040072A": {"name": "XOffsetInSlideCoordinateSystem"},
-	"0040073A": {"name": "YOffsetInSlideCoordinateSystem"},
-	"0040074A": {"name": "ZOffsetInSlideCoordinateSystem"},
-	"004008D8": {"name": "PixelSpacingSequence"},
-	"004008DA": {"name": "CoordinateSystemAxisCodeSequence"},
-	"004008EA": {"name": "MeasurementUnitsCodeSequence"},
-	"004009F8": {"name": "VitalStainCodeSequenceTrial"},
-	"00401001": {"name": "RequestedProcedureID"},
-	"00401002": {"name": "ReasonForRequestedProcedure"},
-	"00401003": {"name": "RequestedProcedurePriority"},
-	"00401004": {"name": "PatientTransportArrangements"},
-	"00401005": {"name": "RequestedProcedureLocation"},
-	"00401006": {"name": "PlacerOrderNumber-Procedure"},
-	"00401007": {"name": "FillerOrderNumber-Procedure"},
-	"00401008": {"name": "ConfidentialityCode"},
-	"00401009": {"name": "ReportingPriority"},
-	"0040100A": {"name": "ReasonForRequestedProcedureCodeSeq"},
-	"00401010": {"name": "NamesOfIntendedRecipientsOfResults"},
-	"00401011": {"name": "IntendedRecipientsOfResultsIDSeq"},
-	"00401101": {"name": "PersonIdentificationCodeSequence"},
-	"00401102": {"name": "PersonAddress"},
-	"00401103": {"name": "PersonTelephoneNumbers"},
-	"00401400": {"name": "RequestedProcedureComments"},
-	"00402001": {"name": "ReasonForImagingServiceRequest"},
-	"00402004": {"name": "IssueDateOfImagingServiceRequest"},
-	"00402005": {"name": "IssueTimeOfImagingServiceRequest"},
-	"00402006": {"name": "PlacerOrderNum-ImagingServiceReq"},
-	"00402007": {"name": "FillerOrderNum-ImagingServiceReq"},
-	"00402008": {"name": "OrderEnteredBy"},
-	"00402009": {"name": "OrderEntererLocation"},
-	"00402010": {"name": "OrderCallbackPhoneNumber"},
-	"00402016": {"name": "PlacerOrderNum-ImagingServiceReq"},
-	"00402017": {"name": "FillerOrderNum-ImagingServiceReq"},
-	"00402400": {"name": "ImagingServiceRequestComments"},
-	"00403001": {"name": "ConfidentialityOnPatientDataDescr"},
-	"00404001": {"name": "GenPurposeScheduledProcStepStatus"},
-	"00404002": {"name": "GenPurposePerformedProcStepStatus"},
-	"00404003": {"name": "GenPurposeSchedProcStepPriority"},
-	"00404004": {"name": "SchedProcessingApplicationsCodeSeq"},
-	"00404005": {"name": "SchedProcedureStepStartDateAndTime"},
-	"00404006": {"name": "MultipleCopiesFlag"},
-	"00404007": {"name": "PerformedProcessingAppsCodeSeq"},
-	"00404009": {"name": "HumanPerformerCodeSequence"},
-	"00404010": {"name": "SchedProcStepModificationDateTime"},
-	"00404011": {"name": "ExpectedCompletionDateAndTime"},
-	"00404015": {"name": "ResultingGenPurposePerfProcStepSeq"},
-	"00404016": {"name": "RefGenPurposeSchedProcStepSeq"},
-	"00404018": {"name": "ScheduledWorkitemCodeSequence"},
-	"00404019": {"name": "PerformedWorkitemCodeSequence"},
-	"00404020": {"name": "InputAvailabilityFlag"},
-	"00404021": {"name": "InputInformationSequence"},
-	"00404022": {"name": "RelevantInformationSequence"},
-	"00404023": {"name": "RefGenPurSchedProcStepTransUID"},
-	"00404025": {"name": "ScheduledStationNameCodeSequence"},
-	"00404026": {"name": "ScheduledStationClassCodeSequence"},
-	"00404027": {"name": "SchedStationGeographicLocCodeSeq"},
-	"00404028": {"name": "PerformedStationNameCodeSequence"},
-	"00404029": {"name": "PerformedStationClassCodeSequence"},
-	"00404030": {"name": "PerformedStationGeogLocCodeSeq"},
-	"00404031": {"name": "RequestedSubsequentWorkItemCodeSeq"},
-	"00404032": {"name": "NonDICOMOutputCodeSequence"},
-	"00404033": {"name": "OutputInformationSequence"},
-	"00404034": {"name": "ScheduledHumanPerformersSequence"},
-	"00404035": {"name": "ActualHumanPerformersSequence"},
-	"00404036": {"name": "HumanPerformersOrganization"},
-	"00404037": {"name": "HumanPerformerName"},
-	"00404040": {"name": "RawDataHandling"},
-	"00408302": {"name": "EntranceDoseInMilliGy"},
-	"00409094": {"name": "RefImageRealWorldValueMappingSeq"},
-	"00409096": {"name": "RealWorldValueMappingSequence"},
-	"00409098": {"name": "PixelValueMappingCodeSequence"},
-	"00409210": {"name": "LUTLabel"},
-	"00409211": {"name": "RealWorldValueLastValueMapped"},
-	"00409212": {"name": "RealWorldValueLUTData"},
-	"00409216": {"name": "RealWorldValueFirstValueMapped"},
-	"00409224": {"name": "RealWorldValueIntercept"},
-	"00409225": {"name": "RealWorldValueSlope"},
-	"0040A010": {"name": "RelationshipType"},
-	"0040A027": {"name": "VerifyingOrganization"},
-	"0040A030": {"name": "VerificationDateTime"},
-	"0040A032": {"name": "ObservationDateTime"},
-	"0040A040": {"name": "ValueType"},
-	"0040A043": {"name": "ConceptNameCodeSequence"},
-	"0040A050": {"name": "ContinuityOfContent"},
-	"0040A073": {"name": "VerifyingObserverSequence"},
-	"0040A075": {"name": "VerifyingObserverName"},
-	"0040A078": {"name": "AuthorObserverSequence"},
-	"0040A07A": {"name": "ParticipantSequence"},
-	"0040A07C": {"name": "CustodialOrganizationSequence"},
-	"0040A080": {"name": "ParticipationType"},
-	"0040A082": {"name": "ParticipationDateTime"},
-	"0040A084": {"name": "ObserverType"},
-	"0040A088": {"name": "VerifyingObserverIdentCodeSequence"},
-	"0040A090": {"name": "EquivalentCDADocumentSequence"},
-	"0040A0B0": {"name": "ReferencedWaveformChannels"},
-	"0040A120": {"name": "DateTime"},
-	"0040A121": {"name": "Date"},
-	"0040A122": {"name": "Time"},
-	"0040A123": {"name": "PersonName"},
-	"0040A124": {"name": "UID"},
-	"0040A130": {"name": "TemporalRangeType"},
-	"0040A132": {"name": "ReferencedSamplePositions"},
-	"0040A136": {"name": "ReferencedFrameNumbers"},
-	"0040A138": {"name": "ReferencedTimeOffsets"},
-	"0040A13A": {"name": "ReferencedDateTime"},
-	"0040A160": {"name": "TextValue"},
-	"0040A168": {"name": "ConceptCodeSequence"},
-	"0040A170": {"name": "PurposeOfReferenceCodeSequence"},
-	"0040A180": {"name": "AnnotationGroupNumber"},
-	"0040A195": {"name": "ModifierCodeSequence"},
-	"0040A300": {"name": "MeasuredValueSequence"},
-	"0040A301": {"name": "NumericValueQualifierCodeSequence"},
-	"0040A30A": {"name": "NumericValue"},
-	"0040A353": {"name": "AddressTrial"},
-	"0040A354": {"name": "TelephoneNumberTrial"},
-	"0040A360": {"name": "PredecessorDocumentsSequence"},
-	"0040A370": {"name": "ReferencedRequestSequence"},
-	"0040A372": {"name": "PerformedProcedureCodeSequence"},
-	"0040A375": {"name": "CurrentRequestedProcEvidenceSeq"},
-	"0040A385": {"name": "PertinentOtherEvidenceSequence"},
-	"0040A390": {"name": "HL7StructuredDocumentRefSeq"},
-	"0040A491": {"name": "CompletionFlag"},
-	"0040A492": {"name": "CompletionFlagDescription"},
-	"0040A493": {"name": "VerificationFlag"},
-	"0040A494": {"name": "ArchiveRequested"},
-	"0040A496": {"name": "PreliminaryFlag"},
-	"0040A504": {"name": "ContentTemplateSequence"},
-	"0040A525": {"name": "IdenticalDocumentsSequence"},
-	"0040A730": {"name": "ContentSequence"},
-	"0040B020": {"name": "AnnotationSequence"},
-	"0040DB00": {"name": "TemplateIdentifier"},
-	"0040DB06": {"name": "TemplateVersion"},
-	"0040DB07": {"name": "TemplateLocalVersion"},
-	"0040DB0B": {"name": "TemplateExtensionFlag"},
-	"0040DB0C": {"name": "TemplateExtensionOrganizationUID"},
-	"0040DB0D": {"name": "TemplateExtensionCreatorUID"},
-	"0040DB73": {"name": "ReferencedContentItemIdentifier"},
-	"0040E001": {"name": "HL7InstanceIdentifier"},
-	"0040E004": {"name": "HL7DocumentEffectiveTime"},
-	"0040E006": {"name": "HL7DocumentTypeCodeSequence"},
-	"0040E010": {"name": "RetrieveURI"},
-	"0040E011": {"name": "RetrieveLocationUID"},
-	"00420010": {"name": "DocumentTitle"},
-	"00420011": {"name": "EncapsulatedDocument"},
-	"00420012": {"name": "MIMETypeOfEncapsulatedDocument"},
-	"00420013": {"name": "SourceInstanceSequence"},
-	"00420014": {"name": "ListOfMIMETypes"},
-	"00431001": {"name": "BitmapOfPrescanOptions"},
-	"00431002": {"name": "GradientOffsetInX"},
-	"00431003": {"name": "GradientOffsetInY"},
-	"00431004": {"name": "GradientOffsetInZ"},
-	"00431005": {"name": "ImgIsOriginalOrUnoriginal"},
-	"00431006": {"name": "NumberOfEPIShots"},
-	"00431007": {"name": "ViewsPerSegment"},
-	"00431008": {"name": "RespiratoryRateBpm"},
-	"00431009": {"name": "RespiratoryTriggerPoint"},
-	"0043100A": {"name": "TypeOfReceiverUsed"},
-	"0043100B": {"name": "PeakRateOfChangeOfGradientField"},
-	"0043100C": {"name": "LimitsInUnitsOfPercent"},
-	"0043100D": {"name": "PSDEstimatedLimit"},
-	"0043100E": {"name": "PSDEstimatedLimitInTeslaPerSecond"},
-	"0043100F": {"name": "Saravghead"},
-	"00431010": {"name": "WindowValue"},
-	"00431011": {"name": "TotalInputViews"},
-	"00431012": {"name": "X-RayChain"},
-	"00431013": {"name": "DeconKernelParameters"},
-	"00431014": {"name": "CalibrationParameters"},
-	"00431015": {"name": "TotalOutputViews"},
-	"00431016": {"name": "NumberOfOverranges"},
-	"00431017": {"name": "IBHImageScaleFactors"},
-	"00431018": {"name": "BBHCoefficients"},
-	"00431019": {"name": "NumberOfBBHChainsToBlend"},
-	"0043101A": {"name": "StartingChannelNumber"},
-	"0043101B": {"name": "PpscanParameters"},
-	"0043101C": {"name": "GEImageIntegrity"},
-	"0043101D": {"name": "LevelValue"},
-	"0043101E": {"name": "DeltaStartTime"},
-	"0043101F": {"name": "MaxOverrangesInAView"},
-	"00431020": {"name": "AvgOverrangesAllViews"},
-	"00431021": {"name": "CorrectedAfterGlowTerms"},
-	"00431025": {"name": "ReferenceChannels"},
-	"00431026": {"name": "NoViewsRefChansBlocked"},
-	"00431027": {"name": "ScanPitchRatio"},
-	"00431028": {"name": "UniqueImageIden"},
-	"00431029": {"name": "HistogramTables"},
-	"0043102A": {"name": "UserDefinedData"},
-	"0043102B": {"name": "PrivateScanOptions"},
-	"0043102C": {"name": "EffectiveEchoSpacing"},
-	"0043102D": {"name": "StringSlopField1"},
-	"0043102E": {"name": "StringSlopField2"},
-	"0043102F": {"name": "RawDataType"},
-	"00431030": {"name": "RawDataType"},
-	"00431031": {"name": "RACordOfTargetReconCenter"},
-	"00431032": {"name": "RawDataType"},
-	"00431033": {"name": "NegScanspacing"},
-	"00431034": {"name": "OffsetFrequency"},
-	"00431035": {"name": "UserUsageTag"},
-	"00431036": {"name": "UserFillMapMSW"},
-	"00431037": {"name": "UserFillMapLSW"},
-	"00431038": {"name": "User25-48"},
-	"00431039": {"name": "SlopInt6-9"},
-	"00431040": {"name": "TriggerOnPosition"},
-	"00431041": {"name": "DegreeOfRotation"},
-	"00431042": {"name": "DASTriggerSource"},
-	"00431043": {"name": "DASFpaGain"},
-	"00431044": {"name": "DASOutputSource"},
-	"00431045": {"name": "DASAdInput"},
-	"00431046": {"name": "DASCalMode"},
-	"00431047": {"name": "DASCalFrequency"},
-	"00431048": {"name": "DASRegXm"},
-	"00431049": {"name": "DASAutoZero"},
-	"0043104A": {"name": "StartingChannelOfView"},
-	"0043104B": {"name": "DASXmPattern"},
-	"0043104C": {"name": "TGGCTriggerMode"},
-	"0043104D": {"name": "StartScanToXrayOnDelay"},
-	"0043104E": {"name": "DurationOfXrayOn"},
-	"00431060": {"name": "SlopInt10-17"},
-	"00431061": {"name": "ScannerStudyEntityUID"},
-	"00431062": {"name": "ScannerStudyID"},
-	"0043106F": {"name": "ScannerTableEntry"},
-	"00440001": {"name": "ProductPackageIdentifier"},
-	"00440002": {"name": "SubstanceAdministrationApproval"},
-	"00440003": {"name": "ApprovalStatusFurtherDescription"},
-	"00440004": {"name": "ApprovalStatusDateTime"},
-	"00440007": {"name": "ProductTypeCodeSequence"},
-	"00440008": {"name": "ProductName"},
-	"00440009": {"name": "ProductDescription"},
-	"0044000A": {"name": "ProductLotIdentifier"},
-	"0044000B": {"name": "ProductExpirationDateTime"},
-	"00440010": {"name": "SubstanceAdministrationDateTime"},
-	"00440011": {"name": "SubstanceAdministrationNotes"},
-	"00440012": {"name": "SubstanceAdministrationDeviceID"},
-	"00440013": {"name": "ProductParameterSequence"},
-	"00440019": {"name": "SubstanceAdminParameterSeq"},
-	"00451001": {"name": "NumberOfMacroRowsInDetector"},
-	"00451002": {"name": "MacroWidthAtISOCenter"},
-	"00451003": {"name": "DASType"},
-	"00451004": {"name": "DASGain"},
-	"00451005": {"name": "DASTemperature"},
-	"00451006": {"name": "TableDirectionInOrOut"},
-	"00451007": {"name": "ZSmoothingFactor"},
-	"00451008": {"name": "ViewWeightingMode"},
-	"00451009": {"name": "SigmaRowNumberWhichRowsWereUsed"},
-	"0045100A": {"name": "MinimumDasValueFoundInTheScanData"},
-	"0045100B": {"name": "MaximumOffsetShiftValueUsed"},
-	"0045100C": {"name": "NumberOfViewsShifted"},
-	"0045100D": {"name": "ZTrackingFlag"},
-	"0045100E": {"name": "MeanZError"},
-	"0045100F": {"name": "ZTrackingMaximumError"},
-	"00451010": {"name": "StartingViewForRow2a"},
-	"00451011": {"name": "NumberOfViewsInRow2a"},
-	"00451012": {"name": "StartingViewForRow1a"},
-	"00451013": {"name": "SigmaMode"},
-	"00451014": {"name": "NumberOfViewsInRow1a"},
-	"00451015": {"name": "StartingViewForRow2b"},
-	"00451016": {"name": "NumberOfViewsInRow2b"},
-	"00451017": {"name": "StartingViewForRow1b"},
-	"00451018": {"name": "NumberOfViewsInRow1b"},
-	"00451019": {"name": "AirFilterCalibrationDate"},
-	"0045101A": {"name": "AirFilterCalibrationTime"},
-	"0045101B": {"name": "PhantomCalibrationDate"},
-	"0045101C": {"name": "PhantomCalibrationTime"},
-	"0045101D": {"name": "ZSlopeCalibrationDate"},
-	"0045101E": {"name": "ZSlopeCalibrationTime"},
-	"0045101F": {"name": "CrosstalkCalibrationDate"},
-	"00451020": {"name": "CrosstalkCalibrationTime"},
-	"00451021": {"name": "IterboneOptionFlag"},
-	"00451022": {"name": "PeristalticFlagOption"},
-	"00460012": {"name": "LensDescription"},
-	"00460014": {"name": "RightLensSequence"},
-	"00460015": {"name": "LeftLensSequence"},
-	"00460018": {"name": "CylinderSequence"},
-	"00460028": {"name": "PrismSequence"},
-	"00460030": {"name": "HorizontalPrismPower"},
-	"00460032": {"name": "HorizontalPrismBase"},
-	"00460034": {"name": "VerticalPrismPower"},
-	"00460036": {"name": "VerticalPrismBase"},
-	"00460038": {"name": "LensSegmentType"},
-	"00460040": {"name": "OpticalTransmittance"},
-	"00460042": {"name": "ChannelWidth"},
-	"00460044": {"name": "PupilSize"},
-	"00460046": {"name": "CornealSize"},
-	"00460060": {"name": "DistancePupillaryDistance"},
-	"00460062": {"name": "NearPupillaryDistance"},
-	"00460064": {"name": "OtherPupillaryDistance"},
-	"00460075": {"name": "RadiusOfCurvature"},
-	"00460076": {"name": "KeratometricPower"},
-	"00460077": {"name": "KeratometricAxis"},
-	"00460092": {"name": "BackgroundColor"},
-	"00460094": {"name": "Optotype"},
-	"00460095": {"name": "OptotypePresentation"},
-	"00460100": {"name": "AddNearSequence"},
-	"00460101": {"name": "AddIntermediateSequence"},
-	"00460102": {"name": "AddOtherSequence"},
-	"00460104": {"name": "AddPower"},
-	"00460106": {"name": "ViewingDistance"},
-	"00460125": {"name": "ViewingDistanceType"},
-	"00460135": {"name": "VisualAcuityModifiers"},
-	"00460137": {"name": "DecimalVisualAcuity"},
-	"00460139": {"name": "OptotypeDetailedDefinition"},
-	"00460146": {"name": "SpherePower"},
-	"00460147": {"name": "CylinderPower"},
-	"00500004": {"name": "CalibrationImage"},
-	"00500010": {"name": "DeviceSequence"},
-	"00500014": {"name": "DeviceLength"},
-	"00500015": {"name": "ContainerComponentWidth"},
-	"00500016": {"name": "DeviceDiameter"},
-	"00500017": {"name": "DeviceDiameterUnits"},
-	"00500018": {"name": "DeviceVolume"},
-	"00500019": {"name": "InterMarkerDistance"},
-	"0050001B": {"name": "ContainerComponentID"},
-	"00500020": {"name": "DeviceDescription"},
-	"00540010": {"name": "EnergyWindowVector"},
-	"00540011": {"name": "NumberOfEnergyWindows"},
-	"00540012": {"name": "EnergyWindowInformationSequence"},
-	"00540013": {"name": "EnergyWindowRangeSequence"},
-	"00540014": {"name": "EnergyWindowLowerLimit"},
-	"00540015": {"name": "EnergyWindowUpperLimit"},
-	"00540016": {"name": "RadiopharmaceuticalInformationSeq"},
-	"00540017": {"name": "ResidualSyringeCounts"},
-	"00540018": {"name": "EnergyWindowName"},
-	"00540020": {"name": "DetectorVector"},
-	"00540021": {"name": "NumberOfDetectors"},
-	"00540022": {"name": "DetectorInformationSequence"},
-	"00540030": {"name": "PhaseVector"},
-	"00540031": {"name": "NumberOfPhases"},
-	"00540032": {"name": "PhaseInformationSequence"},
-	"00540033": {"name": "NumberOfFramesInPhase"},
-	"00540036": {"name": "PhaseDelay"},
-	"00540038": {"name": "PauseBetweenFrames"},
-	"00540039": {"name": "PhaseDescription"},
-	"00540050": {"name": "RotationVector"},
-	"00540051": {"name": "NumberOfRotations"},
-	"00540052": {"name": "RotationInformationSequence"},
-	"00540053": {"name": "NumberOfFramesInRotation"},
-	"00540060": {"name": "RRIntervalVector"},
-	"00540061": {"name": "NumberOfRRIntervals"},
-	"00540062": {"name": "GatedInformationSequence"},
-	"00540063": {"name": "DataInformationSequence"},
-	"00540070": {"name": "TimeSlotVector"},
-	"00540071": {"name": "NumberOfTimeSlots"},
-	"00540072": {"name": "TimeSlotInformationSequence"},
-	"00540073": {"name": "TimeSlotTime"},
-	"00540080": {"name": "SliceVector"},
-	"00540081": {"name": "NumberOfSlices"},
-	"00540090": {"name": "AngularViewVector"},
-	"00540100": {"name": "TimeSliceVector"},
-	"00540101": {"name": "NumberOfTimeSlices"},
-	"00540200": {"name": "StartAngle"},
-	"00540202": {"name": "TypeOfDetectorMotion"},
-	"00540210": {"name": "TriggerVector"},
-	"00540211": {"name": "NumberOfTriggersInPhase"},
-	"00540220": {"name": "ViewCodeSequence"},
-	"00540222": {"name": "ViewModifierCodeSequence"},
-	"00540300": {"name": "RadionuclideCodeSequence"},
-	"00540302": {"name": "AdministrationRouteCodeSequence"},
-	"00540304": {"name": "RadiopharmaceuticalCodeSequence"},
-	"00540306": {"name": "CalibrationDataSequence"},
-	"00540308": {"name": "EnergyWindowNumber"},
-	"00540400": {"name": "ImageID"},
-	"00540410": {"name": "PatientOrientationCodeSequence"},
-	"00540412": {"name": "PatientOrientationModifierCodeSeq"},
-	"00540414": {"name": "PatientGantryRelationshipCodeSeq"},
-	"00540500": {"name": "SliceProgressionDirection"},
-	"00541000": {"name": "SeriesType"},
-	"00541001": {"name": "Units"},
-	"00541002": {"name": "CountsSource"},
-	"00541004": {"name": "ReprojectionMethod"},
-	"00541100": {"name": "RandomsCorrectionMethod"},
-	"00541101": {"name": "AttenuationCorrectionMethod"},
-	"00541102": {"name": "DecayCorrection"},
-	"00541103": {"name": "ReconstructionMethod"},
-	"00541104": {"name": "DetectorLinesOfResponseUsed"},
-	"00541105": {"name": "ScatterCorrectionMethod"},
-	"00541200": {"name": "AxialAcceptance"},
-	"00541201": {"name": "AxialMash"},
-	"00541202": {"name": "TransverseMash"},
-	"00541203": {"name": "DetectorElementSize"},
-	"00541210": {"name": "CoincidenceWindowWidth"},
-	"00541220": {"name": "SecondaryCountsType"},
-	"00541300": {"name": "FrameReferenceTime"},
-	"00541310": {"name": "PrimaryCountsAccumulated"},
-	"00541311": {"name": "SecondaryCountsAccumulated"},
-	"00541320": {"name": "SliceSensitivityFactor"},
-	"00541321": {"name": "DecayFactor"},
-	"00541322": {"name": "DoseCalibrationFactor"},
-	"00541323": {"name": "ScatterFractionFactor"},
-	"00541324": {"name": "DeadTimeFactor"},
-	"00541330": {"name": "ImageIndex"},
-	"00541400": {"name": "CountsIncluded"},
-	"00541401": {"name": "DeadTimeCorrectionFlag"},
-	"00603000": {"name": "HistogramSequence"},
-	"00603002": {"name": "HistogramNumberOfBins"},
-	"00603004": {"name": "HistogramFirstBinValue"},
-	"00603006": {"name": "HistogramLastBinValue"},
-	"00603008": {"name": "HistogramBinWidth"},
-	"00603010": {"name": "HistogramExplanation"},
-	"00603020": {"name": "HistogramData"},
-	"00620001": {"name": "SegmentationType"},
-	"00620002": {"name": "SegmentSequence"},
-	"00620003": {"name": "SegmentedPropertyCategoryCodeSeq"},
-	"00620004": {"name": "SegmentNumber"},
-	"00620005": {"name": "SegmentLabel"},
-	"00620006": {"name": "SegmentDescription"},
-	"00620008": {"name": "SegmentAlgorithmType"},
-	"00620009": {"name": "SegmentAlgorithmName"},
-	"0062000A": {"name": "SegmentIdentificationSequence"},
-	"0062000B": {"name": "ReferencedSegmentNumber"},
-	"0062000C": {"name": "RecommendedDisplayGrayscaleValue"},
-	"0062000D": {"name": "RecommendedDisplayCIELabValue"},
-	"0062000E": {"name": "MaximumFractionalValue"},
-	"0062000F": {"name": "SegmentedPropertyTypeCodeSequence"},
-	"00620010": {"name": "SegmentationFractionalType"},
-	"00640002": {"name": "DeformableRegistrationSequence"},
-	"00640003": {"name": "SourceFrameOfReferenceUID"},
-	"00640005": {"name": "DeformableRegistrationGridSequence"},
-	"00640007": {"name": "GridDimensions"},
-	"00640008": {"name": "GridResolution"},
-	"00640009": {"name": "VectorGridData"},
-	"0064000F": {"name": "PreDeformationMatrixRegistSeq"},
-	"00640010": {"name": "PostDeformationMatrixRegistSeq"},
-	"00660001": {"name": "NumberOfSurfaces"},
-	"00660002": {"name": "SurfaceSequence"},
-	"00660003": {"name": "SurfaceNumber"},
-	"00660004": {"name": "SurfaceComments"},
-	"00660009": {"name": "SurfaceProcessing"},
-	"0066000A": {"name": "SurfaceProcessingRatio"},
-	"0066000E": {"name": "FiniteVolume"},
-	"00660010": {"name": "Manifold"},
-	"00660011": {"name": "SurfacePointsSequence"},
-	"00660015": {"name": "NumberOfSurfacePoints"},
-	"00660016": {"name": "PointCoordinatesData"},
-	"00660017": {"name": "PointPositionAccuracy"},
-	"00660018": {"name": "MeanPointDistance"},
-	"00660019": {"name": "MaximumPointDistance"},
-	"0066001B": {"name": "AxisOfRotation"},
-	"0066001C": {"name": "CenterOfRotation"},
-	"0066001E": {"name": "NumberOfVectors"},
-	"0066001F": {"name": "VectorDimensionality"},
-	"00660020": {"name": "VectorAccuracy"},
-	"00660021": {"name": "VectorCoordinateData"},
-	"00660023": {"name": "TrianglePointIndexList"},
-	"00660024": {"name": "EdgePointIndexList"},
-	"00660025": {"name": "VertexPointIndexList"},
-	"00660026": {"name": "TriangleStripSequence"},
-	"00660027": {"name": "TriangleFanSequence"},
-	"00660028": {"name": "LineSequence"},
-	"00660029": {"name": "PrimitivePointIndexList"},
-	"0066002A": {"name": "SurfaceCount"},
-	"0066002F": {"name": "AlgorithmFamilyCodeSequ"},
-	"00660031": {"name": "AlgorithmVersion"},
-	"00660032": {"name": "AlgorithmParameters"},
-	"00660034": {"name": "FacetSequence"},
-	"00660036": {"name": "AlgorithmName"},
-	"00700001": {"name": "GraphicAnnotationSequence"},
-	"00700002": {"name": "GraphicLayer"},
-	"00700003": {"name": "BoundingBoxAnnotationUnits"},
-	"00700004": {"name": "AnchorPointAnnotationUnits"},
-	"00700005": {"name": "GraphicAnnotationUnits"},
-	"00700006": {"name": "UnformattedTextValue"},
-	"00700008": {"name": "TextObjectSequence"},
-	"00700009": {"name": "GraphicObjectSequence"},
-	"00700010": {"name": "BoundingBoxTopLeftHandCorner"},
-	"00700011": {"name": "BoundingBoxBottomRightHandCorner"},
-	"00700012": {"name": "BoundingBoxTextHorizJustification"},
-	"00700014": {"name": "AnchorPoint"},
-	"00700015": {"name": "AnchorPointVisibility"},
-	"00700020": {"name": "GraphicDimensions"},
-	"00700021": {"name": "NumberOfGraphicPoints"},
-	"00700022": {"name": "GraphicData"},
-	"00700023": {"name": "GraphicType"},
-	"00700024": {"name": "GraphicFilled"},
-	"00700040": {"name": "ImageRotationRetired"},
-	"00700041": {"name": "ImageHorizontalFlip"},
-	"00700042": {"name": "ImageRotation"},
-	"00700050": {"name": "DisplayedAreaTopLeftTrial"},
-	"00700051": {"name": "DisplayedAreaBottomRightTrial"},
-	"00700052": {"name": "DisplayedAreaTopLeft"},
-	"00700053": {"name": "DisplayedAreaBottomRight"},
-	"0070005A": {"name": "DisplayedAreaSelectionSequence"},
-	"00700060": {"name": "GraphicLayerSequence"},
-	"00700062": {"name": "GraphicLayerOrder"},
-	"00700066": {"name": "GraphicLayerRecDisplayGraysclValue"},
-	"00700067": {"name": "GraphicLayerRecDisplayRGBValue"},
-	"00700068": {"name": "GraphicLayerDescription"},
-	"00700080": {"name": "ContentLabel"},
-	"00700081": {"name": "ContentDescription"},
-	"00700082": {"name": "PresentationCreationDate"},
-	"00700083": {"name": "PresentationCreationTime"},
-	"00700084": {"name": "ContentCreatorName"},
-	"00700086": {"name": "ContentCreatorIDCodeSequence"},
-	"00700100": {"name": "PresentationSizeMode"},
-	"00700101": {"name": "PresentationPixelSpacing"},
-	"00700102": {"name": "PresentationPixelAspectRatio"},
-	"00700103": {"name": "PresentationPixelMagRatio"},
-	"00700306": {"name": "ShapeType"},
-	"00700308": {"name": "RegistrationSequence"},
-	"00700309": {"name": "MatrixRegistrationSequence"},
-	"0070030A": {"name": "MatrixSequence"},
-	"0070030C": {"name": "FrameOfRefTransformationMatrixType"},
-	"0070030D": {"name": "RegistrationTypeCodeSequence"},
-	"0070030F": {"name": "FiducialDescription"},
-	"00700310": {"name": "FiducialIdentifier"},
-	"00700311": {"name": "FiducialIdentifierCodeSequence"},
-	"00700312": {"name": "ContourUncertaintyRadius"},
-	"00700314": {"name": "UsedFiducialsSequence"},
-	"00700318": {"name": "GraphicCoordinatesDataSequence"},
-	"0070031A": {"name": "FiducialUID"},
-	"0070031C": {"name": "FiducialSetSequence"},
-	"0070031E": {"name": "FiducialSequence"},
-	"00700401": {"name": "GraphicLayerRecomDisplayCIELabVal"},
-	"00700402": {"name": "BlendingSequence"},
-	"00700403": {"name": "RelativeOpacity"},
-	"00700404": {"name": "ReferencedSpatialRegistrationSeq"},
-	"00700405": {"name": "BlendingPosition"},
-	"00720002": {"name": "HangingProtocolName"},
-	"00720004": {"name": "HangingProtocolDescription"},
-	"00720006": {"name": "HangingProtocolLevel"},
-	"00720008": {"name": "HangingProtocolCreator"},
-	"0072000A": {"name": "HangingProtocolCreationDateTime"},
-	"0072000C": {"name": "HangingProtocolDefinitionSequence"},
-	"0072000E": {"name": "HangingProtocolUserIDCodeSequence"},
-	"00720010": {"name": "HangingProtocolUserGroupName"},
-	"00720012": {"name": "SourceHangingProtocolSequence"},
-	"00720014": {"name": "NumberOfPriorsReferenced"},
-	"00720020": {"name": "ImageSetsSequence"},
-	"00720022": {"name": "ImageSetSelectorSequence"},
-	"00720024": {"name": "ImageSetSelectorUsageFlag"},
-	"00720026": {"name": "SelectorAttribute"},
-	"00720028": {"name": "SelectorValueNumber"},
-	"00720030": {"name": "TimeBasedImageSetsSequence"},
-	"00720032": {"name": "ImageSetNumber"},
-	"00720034": {"name": "ImageSetSelectorCategory"},
-	"00720038": {"name": "RelativeTime"},
-	"0072003A": {"name": "RelativeTimeUnits"},
-	"0072003C": {"name": "AbstractPriorValue"},
-	"0072003E": {"name": "AbstractPriorCodeSequence"},
-	"00720040": {"name": "ImageSetLabel"},
-	"00720050": {"name": "SelectorAttributeVR"},
-	"00720052": {"name": "SelectorSequencePointer"},
-	"00720054": {"name": "SelectorSeqPointerPrivateCreator"},
-	"00720056": {"name": "SelectorAttributePrivateCreator"},
-	"00720060": {"name": "SelectorATValue"},
-	"00720062": {"name": "SelectorCSValue"},
-	"00720064": {"name": "SelectorISValue"},
-	"00720066": {"name": "SelectorLOValue"},
-	"00720068": {"name": "SelectorLTValue"},
-	"0072006A": {"name": "SelectorPNValue"},
-	"0072006C": {"name": "SelectorSHValue"},
-	"0072006E": {"name": "SelectorSTValue"},
-	"00720070": {"name": "SelectorUTValue"},
-	"00720072": {"name": "SelectorDSValue"},
-	"00720074": {"name": "SelectorFDValue"},
-	"00720076": {"name": "SelectorFLValue"},
-	"00720078": {"name": "SelectorULValue"},
-	"0072007A": {"name": "SelectorUSValue"},
-	"0072007C": {"name": "SelectorSLValue"},
-	"0072007E": {"name": "SelectorSSValue"},
-	"00720080": {"name": "SelectorCodeSequenceValue"},
-	"00720100": {"name": "NumberOfScreens"},
-	"00720102": {"name": "NominalScreenDefinitionSequence"},
-	"00720104": {"name": "NumberOfVerticalPixels"},
-	"00720106": {"name": "NumberOfHorizontalPixels"},
-	"00720108": {"name": "DisplayEnvironmentSpatialPosition"},
-	"0072010A": {"name": "ScreenMinimumGrayscaleBitDepth"},
-	"0072010C": {"name": "ScreenMinimumColorBitDepth"},
-	"0072010E": {"name": "ApplicationMaximumRepaintTime"},
-	"00720200": {"name": "DisplaySetsSequence"},
-	"00720202": {"name": "DisplaySetNumber"},
-	"00720203": {"name": "DisplaySetLabel"},
-	"00720204": {"name": "DisplaySetPresentationGroup"},
-	"00720206": {"name": "DisplaySetPresentationGroupDescr"},
-	"00720208": {"name": "PartialDataDisplayHandling"},
-	"00720210": {"name": "SynchronizedScrollingSequence"},
-	"00720212": {"name": "DisplaySetScrollingGroup"},
-	"00720214": {"name": "NavigationIndicatorSequence"},
-	"00720216": {"name": "NavigationDisplaySet"},
-	"00720218": {"name": "ReferenceDisplaySets"},
-	"00720300": {"name": "ImageBoxesSequence"},
-	"00720302": {"name": "ImageBoxNumber"},
-	"00720304": {"name": "ImageBoxLayoutType"},
-	"00720306": {"name": "ImageBoxTileHorizontalDimension"},
-	"00720308": {"name": "ImageBoxTileVerticalDimension"},
-	"00720310": {"name": "ImageBoxScrollDirection"},
-	"00720312": {"name": "ImageBoxSmallScrollType"},
-	"00720314": {"name": "ImageBoxSmallScrollAmount"},
-	"00720316": {"name": "ImageBoxLargeScrollType"},
-	"00720318": {"name": "ImageBoxLargeScrollAmount"},
-	"00720320": {"name": "ImageBoxOverlapPriority"},
-	"00720330": {"name": "CineRelativeToRealTime"},
-	"00720400": {"name": "FilterOperationsSequence"},
-	"00720402": {"name": "FilterByCategory"},
-	"00720404": {"name": "FilterByAttributePresence"},
-	"00720406": {"name": "FilterByOperator"},
-	"00720432": {"name": "SynchronizedImageBoxList"},
-	"00720434": {"name": "TypeOfSynchronization"},
-	"00720500": {"name": "BlendingOperationType"},
-	"00720510": {"name": "ReformattingOperationType"},
-	"00720512": {"name": "ReformattingThickness"},
-	"00720514": {"name": "ReformattingInterval"},
-	"00720516": {"name": "ReformattingOpInitialViewDir"},
-	"00720520": {"name": "RenderingType3D"},
-	"00720600": {"name": "SortingOperationsSequence"},
-	"00720602": {"name": "SortByCategory"},
-	"00720604": {"name": "SortingDirection"},
-	"00720700": {"name": "DisplaySetPatientOrientation"},
-	"00720702": {"name": "VOIType"},
-	"00720704": {"name": "PseudoColorType"},
-	"00720706": {"name": "ShowGrayscaleInverted"},
-	"00720710": {"name": "ShowImageTrueSizeFlag"},
-	"00720712": {"name": "ShowGraphicAnnotationFlag"},
-	"00720714": {"name": "ShowPatientDemographicsFlag"},
-	"00720716": {"name": "ShowAcquisitionTechniquesFlag"},
-	"00720717": {"name": "DisplaySetHorizontalJustification"},
-	"00720718": {"name": "DisplaySetVerticalJustification"},
-	"00741000": {"name": "UnifiedProcedureStepState"},
-	"00741002": {"name": "UPSProgressInformationSequence"},
-	"00741004": {"name": "UnifiedProcedureStepProgress"},
-	"00741006": {"name": "UnifiedProcedureStepProgressDescr"},
-	"00741008": {"name": "UnifiedProcedureStepComURISeq"},
-	"0074100A": {"name": "ContactURI"},
-	"0074100C": {"name": "ContactDisplayName"},
-	"00741020": {"name": "BeamTaskSequence"},
-	"00741022": {"name": "BeamTaskType"},
-	"00741024": {"name": "BeamOrderIndex"},
-	"00741030": {"name": "DeliveryVerificationImageSequence"},
-	"00741032": {"name": "VerificationImageTiming"},
-	"00741034": {"name": "DoubleExposureFlag"},
-	"00741036": {"name": "DoubleExposureOrdering"},
-	"00741038": {"name": "DoubleExposureMeterset"},
-	"0074103A": {"name": "DoubleExposureFieldDelta"},
-	"00741040": {"name": "RelatedReferenceRTImageSequence"},
-	"00741042": {"name": "GeneralMachineVerificationSequence"},
-	"00741044": {"name": "ConventionalMachineVerificationSeq"},
-	"00741046": {"name": "IonMachineVerificationSequence"},
-	"00741048": {"name": "FailedAttributesSequence"},
-	"0074104A": {"name": "OverriddenAttributesSequence"},
-	"0074104C": {"name": "ConventionalControlPointVerifySeq"},
-	"0074104E": {"name": "IonControlPointVerificationSeq"},
-	"00741050": {"name": "AttributeOccurrenceSequence"},
-	"00741052": {"name": "AttributeOccurrencePointer"},
-	"00741054": {"name": "AttributeItemSelector"},
-	"00741056": {"name": "AttributeOccurrencePrivateCreator"},
-	"00741200": {"name": "ScheduledProcedureStepPriority"},
-	"00741202": {"name": "WorklistLabel"},
-	"00741204": {"name": "ProcedureStepLabel"},
-	"00741210": {"name": "ScheduledProcessingParametersSeq"},
-	"00741212": {"name": "PerformedProcessingParametersSeq"},
-	"00741216": {"name": "UPSPerformedProcedureSequence"},
-	"00741220": {"name": "RelatedProcedureStepSequence"},
-	"00741222": {"name": "ProcedureStepRelationshipType"},
-	"00741230": {"name": "DeletionLock"},
-	"00741234": {"name": "ReceivingAE"},
-	"00741236": {"name": "RequestingAE"},
-	"00741238": {"name": "ReasonForCancellation"},
-	"00741242": {"name": "SCPStatus"},
-	"00741244": {"name": "SubscriptionListStatus"},
-	"00741246": {"name": "UPSListStatus"},
-	"00880130": {"name": "StorageMediaFileSetID"},
-	"00880140": {"name": "StorageMediaFileSetUID"},
-	"00880200": {"name": "IconImageSequence"},
-	"00880904": {"name": "TopicTitle"},
-	"00880906": {"name": "TopicSubject"},
-	"00880910": {"name": "TopicAuthor"},
-	"00880912": {"name": "TopicKeywords"},
-	"01000410": {"name": "SOPInstanceStatus"},
-	"01000420": {"name": "SOPAuthorizationDateAndTime"},
-	"01000424": {"name": "SOPAuthorizationComment"},
-	"01000426": {"name": "AuthorizationEquipmentCertNumber"},
-	"04000005": {"name": "MACIDNumber"},
-	"04000010": {"name": "MACCalculationTransferSyntaxUID"},
-	"04000015": {"name": "MACAlgorithm"},
-	"04000020": {"name": "DataElementsSigned"},
-	"04000100": {"name": "DigitalSignatureUID"},
-	"04000105": {"name": "DigitalSignatureDateTime"},
-	"04000110": {"name": "CertificateType"},
-	"04000115": {"name": "CertificateOfSigner"},
-	"04000120": {"name": "Signature"},
-	"04000305": {"name": "CertifiedTimestampType"},
-	"04000310": {"name": "CertifiedTimestamp"},
-	"04000401": {"name": "DigitalSignaturePurposeCodeSeq"},
-	"04000402": {"name": "ReferencedDigitalSignatureSeq"},
-	"04000403": {"name": "ReferencedSOPInstanceMACSeq"},
-	"04000404": {"name": "MAC"},
-	"04000500": {"name": "EncryptedAttributesSequence"},
-	"04000510": {"name": "EncryptedContentTransferSyntaxUID"},
-	"04000520": {"name": "EncryptedContent"},
-	"04000550": {"name": "ModifiedAttributesSequence"},
-	"04000561": {"name": "OriginalAttributesSequence"},
-	"04000562": {"name": "AttributeModificationDateTime"},
-	"04000563": {"name": "ModifyingSystem"},
-	"04000564": {"name": "SourceOfPreviousValues"},
-	"04000565": {"name": "ReasonForTheAttributeModification"},
-	"20000010": {"name": "NumberOfCopies"},
-	"2000001E": {"name": "PrinterConfigurationSequence"},
-	"20000020": {"name": "PrintPriority"},
-	"20000030": {"name": "MediumType"},
-	"20000040": {"name": "FilmDestination"},
-	"20000050": {"name": "FilmSessionLabel"},
-	"20000060": {"name": "MemoryAllocation"},
-	"20000061": {"name": "MaximumMemoryAllocation"},
-	"20000062": {"name": "ColorImagePrintingFlag"},
-	"20000063": {"name": "CollationFlag"},
-	"20000065": {"name": "AnnotationFlag"},
-	"20000067": {"name": "ImageOverlayFlag"},
-	"20000069": {"name": "PresentationLUTFlag"},
-	"2000006A": {"name": "ImageBoxPresentationLUTFlag"},
-	"200000A0": {"name": "MemoryBitDepth"},
-	"200000A1": {"name": "PrintingBitDepth"},
-	"200000A2": {"name": "MediaInstalledSequence"},
-	"200000A4": {"name": "OtherMediaAvailableSequence"},
-	"200000A8": {"name": "SupportedImageDisplayFormatSeq"},
-	"20000500": {"name": "ReferencedFilmBoxSequence"},
-	"20000510": {"name": "ReferencedStoredPrintSequence"},
-	"20100010": {"name": "ImageDisplayFormat"},
-	"20100030": {"name": "AnnotationDisplayFormatID"},
-	"20100040": {"name": "FilmOrientation"},
-	"20100050": {"name": "FilmSizeID"},
-	"20100052": {"name": "PrinterResolutionID"},
-	"20100054": {"name": "DefaultPrinterResolutionID"},
-	"20100060": {"name": "MagnificationType"},
-	"20100080": {"name": "SmoothingType"},
-	"201000A6": {"name": "DefaultMagnificationType"},
-	"201000A7": {"name": "OtherMagnificationTypesAvailable"},
-	"201000A8": {"name": "DefaultSmoothingType"},
-	"201000A9": {"name": "OtherSmoothingTypesAvailable"},
-	"20100100": {"name": "BorderDensity"},
-	"20100110": {"name": "EmptyImageDensity"},
-	"20100120": {"name": "MinDensity"},
-	"20100130": {"name": "MaxDensity"},
-	"20100140": {"name": "Trim"},
-	"20100150": {"name": "ConfigurationInformation"},
-	"20100152": {"name": "ConfigurationInformationDescr"},
-	"20100154": {"name": "MaximumCollatedFilms"},
-	"2010015E": {"name": "Illumination"},
-	"20100160": {"name": "ReflectedAmbientLight"},
-	"20100376": {"name": "PrinterPixelSpacing"},
-	"20100500": {"name": "ReferencedFilmSessionSequence"},
-	"20100510": {"name": "ReferencedImageBoxSequence"},
-	"20100520": {"name": "ReferencedBasicAnnotationBoxSeq"},
-	"20200010": {"name": "ImageBoxPosition"},
-	"20200020": {"name": "Polarity"},
-	"20200030": {"name": "RequestedImageSize"},
-	"20200040": {"name": "RequestedDecimate-CropBehavior"},
-	"20200050": {"name": "RequestedResolutionID"},
-	"202000A0": {"name": "RequestedImageSizeFlag"},
-	"202000A2": {"name": "DecimateCropResult"},
-	"20200110": {"name": "BasicGrayscaleImageSequence"},
-	"20200111": {"name": "BasicColorImageSequence"},
-	"20200130": {"name": "ReferencedImageOverlayBoxSequence"},
-	"20200140": {"name": "ReferencedVOILUTBoxSequence"},
-	"20300010": {"name": "AnnotationPosition"},
-	"20300020": {"name": "TextString"},
-	"20400010": {"name": "ReferencedOverlayPlaneSequence"},
-	"20400011": {"name": "ReferencedOverlayPlaneGroups"},
-	"20400020": {"name": "OverlayPixelDataSequence"},
-	"20400060": {"name": "OverlayMagnificationType"},
-	"20400070": {"name": "OverlaySmoothingType"},
-	"20400072": {"name": "OverlayOrImageMagnification"},
-	"20400074": {"name": "MagnifyToNumberOfColumns"},
-	"20400080": {"name": "OverlayForegroundDensity"},
-	"20400082": {"name": "OverlayBackgroundDensity"},
-	"20400090": {"name": "OverlayMode"},
-	"20400100": {"name": "ThresholdDensity"},
-	"20400500": {"name": "ReferencedImageBoxSequence"},
-	"20500010": {"name": "PresentationLUTSequence"},
-	"20500020": {"name": "PresentationLUTShape"},
-	"20500500": {"name": "ReferencedPresentationLUTSequence"},
-	"21000010": {"name": "PrintJobID"},
-	"21000020": {"name": "ExecutionStatus"},
-	"21000030": {"name": "ExecutionStatusInfo"},
-	"21000040": {"name": "CreationDate"},
-	"21000050": {"name": "CreationTime"},
-	"21000070": {"name": "Originator"},
-	"21000140": {"name": "DestinationAE"},
-	"21000160": {"name": "OwnerID"},
-	"21000170": {"name": "NumberOfFilms"},
-	"21000500": {"name": "ReferencedPrintJobSequence"},
-	"21100010": {"name": "PrinterStatus"},
-	"21100020": {"name": "PrinterStatusInfo"},
-	"21100030": {"name": "PrinterName"},
-	"21100099": {"name": "PrintQueueID"},
-	"21200010": {"name": "QueueStatus"},
-	"21200050": {"name": "PrintJobDescriptionSequence"},
-	"21200070": {"name": "ReferencedPrintJobSequence"},
-	"21300010": {"name": "PrintManagementCapabilitiesSeq"},
-	"21300015": {"name": "PrinterCharacteristicsSequence"},
-	"21300030": {"name": "FilmBoxContentSequence"},
-	"21300040": {"name": "ImageBoxContentSequence"},
-	"21300050": {"name": "AnnotationContentSequence"},
-	"21300060": {"name": "ImageOverlayBoxContentSequence"},
-	"21300080": {"name": "PresentationLUTContentSequence"},
-	"213000A0": {"name": "ProposedStudySequence"},
-	"213000C0": {"name": "OriginalImageSequence"},
-	"22000001": {"name": "LabelFromInfoExtractedFromInstance"},
-	"22000002": {"name": "LabelText"},
-	"22000003": {"name": "LabelStyleSelection"},
-	"22000004": {"name": "MediaDisposition"},
-	"22000005": {"name": "BarcodeValue"},
-	"22000006": {"name": "BarcodeSymbology"},
-	"22000007": {"name": "AllowMediaSplitting"},
-	"22000008": {"name": "IncludeNonDICOMObjects"},
-	"22000009": {"name": "IncludeDisplayApplication"},
-	"2200000A": {"name": "SaveCompInstancesAfterMediaCreate"},
-	"2200000B": {"name": "TotalNumberMediaPiecesCreated"},
-	"2200000C": {"name": "RequestedMediaApplicationProfile"},
-	"2200000D": {"name": "ReferencedStorageMediaSequence"},
-	"2200000E": {"name": "FailureAttributes"},
-	"2200000F": {"name": "AllowLossyCompression"},
-	"22000020": {"name": "RequestPriority"},
-	"30020002": {"name": "RTImageLabel"},
-	"30020003": {"name": "RTImageName"},
-	"30020004": {"name": "RTImageDescription"},
-	"3002000A": {"name": "ReportedValuesOrigin"},
-	"3002000C": {"name": "RTImagePlane"},
-	"3002000D": {"name": "XRayImageReceptorTranslation"},
-	"3002000E": {"name": "XRayImageReceptorAngle"},
-	"30020010": {"name": "RTImageOrientation"},
-	"30020011": {"name": "ImagePlanePixelSpacing"},
-	"30020012": {"name": "RTImagePosition"},
-	"30020020": {"name": "RadiationMachineName"},
-	"30020022": {"name": "RadiationMachineSAD"},
-	"30020024": {"name": "RadiationMachineSSD"},
-	"30020026": {"name": "RTImageSID"},
-	"30020028": {"name": "SourceToReferenceObjectDistance"},
-	"30020029": {"name": "FractionNumber"},
-	"30020030": {"name": "ExposureSequence"},
-	"30020032": {"name": "MetersetExposure"},
-	"30020034": {"name": "DiaphragmPosition"},
-	"30020040": {"name": "FluenceMapSequence"},
-	"30020041": {"name": "FluenceDataSource"},
-	"30020042": {"name": "FluenceDataScale"},
-	"30020051": {"name": "FluenceMode"},
-	"30020052": {"name": "FluenceModeID"},
-	"30040001": {"name": "DVHType"},
-	"30040002": {"name": "DoseUnits"},
-	"30040004": {"name": "DoseType"},
-	"30040006": {"name": "DoseComment"},
-	"30040008": {"name": "NormalizationPoint"},
-	"3004000A": {"name": "DoseSummationType"},
-	"3004000C": {"name": "GridFrameOffsetVector"},
-	"3004000E": {"name": "DoseGridScaling"},
-	"30040010": {"name": "RTDoseROISequence"},
-	"30040012": {"name": "DoseValue"},
-	"30040014": {"name": "TissueHeterogeneityCorrection"},
-	"30040040": {"name": "DVHNormalizationPoint"},
-	"30040042": {"name": "DVHNormalizationDoseValue"},
-	"30040050": {"name": "DVHSequence"},
-	"30040052": {"name": "DVHDoseScaling"},
-	"30040054": {"name": "DVHVolumeUnits"},
-	"30040056": {"name": "DVHNumberOfBins"},
-	"30040058": {"name": "DVHData"},
-	"30040060": {"name": "DVHReferencedROISequence"},
-	"30040062": {"name": "DVHROIContributionType"},
-	"30040070": {"name": "DVHMinimumDose"},
-	"30040072": {"name": "DVHMaximumDose"},
-	"30040074": {"name": "DVHMeanDose"},
-	"30060002": {"name": "StructureSetLabel"},
-	"30060004": {"name": "StructureSetName"},
-	"30060006": {"name": "StructureSetDescription"},
-	"30060008": {"name": "StructureSetDate"},
-	"30060009": {"name": "StructureSetTime"},
-	"30060010": {"name": "ReferencedFrameOfReferenceSequence"},
-	"30060012": {"name": "RTReferencedStudySequence"},
-	"30060014": {"name": "RTReferencedSeriesSequence"},
-	"30060016": {"name": "ContourImageSequence"},
-	"30060020": {"name": "StructureSetROISequence"},
-	"30060022": {"name": "ROINumber"},
-	"30060024": {"name": "ReferencedFrameOfReferenceUID"},
-	"30060026": {"name": "ROIName"},
-	"30060028": {"name": "ROIDescription"},
-	"3006002A": {"name": "ROIDisplayColor"},
-	"3006002C": {"name": "ROIVolume"},
-	"30060030": {"name": "RTRelatedROISequence"},
-	"30060033": {"name": "RTROIRelationship"},
-	"30060036": {"name": "ROIGenerationAlgorithm"},
-	"30060038": {"name": "ROIGenerationDescription"},
-	"30060039": {"name": "ROIContourSequence"},
-	"30060040": {"name": "ContourSequence"},
-	"30060042": {"name": "ContourGeometricType"},
-	"30060044": {"name": "ContourSlabThickness"},
-	"30060045": {"name": "ContourOffsetVector"},
-	"30060046": {"name": "NumberOfContourPoints"},
-	"30060048": {"name": "ContourNumber"},
-	"30060049": {"name": "AttachedContours"},
-	"30060050": {"name": "ContourData"},
-	"30060080": {"name": "RTROIObservationsSequence"},
-	"30060082": {"name": "ObservationNumber"},
-	"30060084": {"name": "ReferencedROINumber"},
-	"30060085": {"name": "ROIObservationLabel"},
-	"30060086": {"name": "RTROIIdentificationCodeSequence"},
-	"30060088": {"name": "ROIObservationDescription"},
-	"300600A0": {"name": "RelatedRTROIObservationsSequence"},
-	"300600A4": {"name": "RTROIInterpretedType"},
-	"300600A6": {"name": "ROIInterpreter"},
-	"300600B0": {"name": "ROIPhysicalPropertiesSequence"},
-	"300600B2": {"name": "ROIPhysicalProperty"},
-	"300600B4": {"name": "ROIPhysicalPropertyValue"},
-	"300600B6": {"name": "ROIElementalCompositionSequence"},
-	"300600B7": {"name": "ROIElementalCompAtomicNumber"},
-	"300600B8": {"name": "ROIElementalCompAtomicMassFraction"},
-	"300600C0": {"name": "FrameOfReferenceRelationshipSeq"},
-	"300600C2": {"name": "RelatedFrameOfReferenceUID"},
-	"300600C4": {"name": "FrameOfReferenceTransformType"},
-	"300600C6": {"name": "FrameOfReferenceTransformMatrix"},
-	"300600C8": {"name": "FrameOfReferenceTransformComment"},
-	"30080010": {"name": "MeasuredDoseReferenceSequence"},
-	"30080012": {"name": "MeasuredDoseDescription"},
-	"30080014": {"name": "MeasuredDoseType"},
-	"30080016": {"name": "MeasuredDoseValue"},
-	"30080020": {"name": "TreatmentSessionBeamSequence"},
-	"30080021": {"name": "TreatmentSessionIonBeamSequence"},
-	"30080022": {"name": "CurrentFractionNumber"},
-	"30080024": {"name": "TreatmentControlPointDate"},
-	"30080025": {"name": "TreatmentControlPointTime"},
-	"3008002A": {"name": "TreatmentTerminationStatus"},
-	"3008002B": {"name": "TreatmentTerminationCode"},
-	"3008002C": {"name": "TreatmentVerificationStatus"},
-	"30080030": {"name": "ReferencedTreatmentRecordSequence"},
-	"30080032": {"name": "SpecifiedPrimaryMeterset"},
-	"30080033": {"name": "SpecifiedSecondaryMeterset"},
-	"30080036": {"name": "DeliveredPrimaryMeterset"},
-	"30080037": {"name": "DeliveredSecondaryMeterset"},
-	"3008003A": {"name": "SpecifiedTreatmentTime"},
-	"3008003B": {"name": "DeliveredTreatmentTime"},
-	"30080040": {"name": "ControlPointDeliverySequence"},
-	"30080041": {"name": "IonControlPointDeliverySequence"},
-	"30080042": {"name": "SpecifiedMeterset"},
-	"30080044": {"name": "DeliveredMeterset"},
-	"30080045": {"name": "MetersetRateSet"},
-	"30080046": {"name": "MetersetRateDelivered"},
-	"30080047": {"name": "ScanSpotMetersetsDelivered"},
-	"30080048": {"name": "DoseRateDelivered"},
-	"30080050": {"name": "TreatmentSummaryCalcDoseRefSeq"},
-	"30080052": {"name": "CumulativeDoseToDoseReference"},
-	"30080054": {"name": "FirstTreatmentDate"},
-	"30080056": {"name": "MostRecentTreatmentDate"},
-	"3008005A": {"name": "NumberOfFractionsDelivered"},
-	"30080060": {"name": "OverrideSequence"},
-	"30080061": {"name": "ParameterSequencePointer"},
-	"30080062": {"name": "OverrideParameterPointer"},
-	"30080063": {"name": "ParameterItemIndex"},
-	"30080064": {"name": "MeasuredDoseReferenceNumber"},
-	"30080065": {"name": "ParameterPointer"},
-	"30080066": {"name": "OverrideReason"},
-	"30080068": {"name": "CorrectedParameterSequence"},
-	"3008006A": {"name": "CorrectionValue"},
-	"30080070": {"name": "CalculatedDoseReferenceSequence"},
-	"30080072": {"name": "CalculatedDoseReferenceNumber"},
-	"30080074": {"name": "CalculatedDoseReferenceDescription"},
-	"30080076": {"name": "CalculatedDoseReferenceDoseValue"},
-	"30080078": {"name": "StartMeterset"},
-	"3008007A": {"name": "EndMeterset"},
-	"30080080": {"name": "ReferencedMeasuredDoseReferenceSeq"},
-	"30080082": {"name": "ReferencedMeasuredDoseReferenceNum"},
-	"30080090": {"name": "ReferencedCalculatedDoseRefSeq"},
-	"30080092": {"name": "ReferencedCalculatedDoseRefNumber"},
-	"300800A0": {"name": "BeamLimitingDeviceLeafPairsSeq"},
-	"300800B0": {"name": "RecordedWedgeSequence"},
-	"300800C0": {"name": "RecordedCompensatorSequence"},
-	"300800D0": {"name": "RecordedBlockSequence"},
-	"300800E0": {"name": "TreatmentSummaryMeasuredDoseRefSeq"},
-	"300800F0": {"name": "RecordedSnoutSequence"},
-	"300800F2": {"name": "RecordedRangeShifterSequence"},
-	"300800F4": {"name": "RecordedLateralSpreadingDeviceSeq"},
-	"300800F6": {"name": "RecordedRangeModulatorSequence"},
-	"30080100": {"name": "RecordedSourceSequence"},
-	"30080105": {"name": "SourceSerialNumber"},
-	"30080110": {"name": "TreatmentSessionAppSetupSeq"},
-	"30080116": {"name": "ApplicationSetupCheck"},
-	"30080120": {"name": "RecordedBrachyAccessoryDeviceSeq"},
-	"30080122": {"name": "ReferencedBrachyAccessoryDeviceNum"},
-	"30080130": {"name": "RecordedChannelSequence"},
-	"30080132": {"name": "SpecifiedChannelTotalTime"},
-	"30080134": {"name": "DeliveredChannelTotalTime"},
-	"30080136": {"name": "SpecifiedNumberOfPulses"},
-	"30080138": {"name": "DeliveredNumberOfPulses"},
-	"3008013A": {"name": "SpecifiedPulseRepetitionInterval"},
-	"3008013C": {"name": "DeliveredPulseRepetitionInterval"},
-	"30080140": {"name": "RecordedSourceApplicatorSequence"},
-	"30080142": {"name": "ReferencedSourceApplicatorNumber"},
-	"30080150": {"name": "RecordedChannelShieldSequence"},
-	"30080152": {"name": "ReferencedChannelShieldNumber"},
-	"30080160": {"name": "BrachyControlPointDeliveredSeq"},
-	"30080162": {"name": "SafePositionExitDate"},
-	"30080164": {"name": "SafePositionExitTime"},
-	"30080166": {"name": "SafePositionReturnDate"},
-	"30080168": {"name": "SafePositionReturnTime"},
-	"30080200": {"name": "CurrentTreatmentStatus"},
-	"30080202": {"name": "TreatmentStatusComment"},
-	"30080220": {"name": "FractionGroupSummarySequence"},
-	"30080223": {"name": "ReferencedFractionNumber"},
-	"30080224": {"name": "FractionGroupType"},
-	"30080230": {"name": "BeamStopperPosition"},
-	"30080240": {"name": "FractionStatusSummarySequence"},
-	"30080250": {"name": "TreatmentDate"},
-	"30080251": {"name": "TreatmentTime"},
-	"300A0002": {"name": "RTPlanLabel"},
-	"300A0003": {"name": "RTPlanName"},
-	"300A0004": {"name": "RTPlanDescription"},
-	"300A0006": {"name": "RTPlanDate"},
-	"300A0007": {"name": "RTPlanTime"},
-	"300A0009": {"name": "TreatmentProtocols"},
-	"300A000A": {"name": "PlanIntent"},
-	"300A000B": {"name": "TreatmentSites"},
-	"300A000C": {"name": "RTPlanGeometry"},
-	"300A000E": {"name": "PrescriptionDescription"},
-	"300A0010": {"name": "DoseReferenceSequence"},
-	"300A0012": {"name": "DoseReferenceNumber"},
-	"300A0013": {"name": "DoseReferenceUID"},
-	"300A0014": {"name": "DoseReferenceStructureType"},
-	"300A0015": {"name": "NominalBeamEnergyUnit"},
-	"300A0016": {"name": "DoseReferenceDescription"},
-	"300A0018": {"name": "DoseReferencePointCoordinates"},
-	"300A001A": {"name": "NominalPriorDose"},
-	"300A0020": {"name": "DoseReferenceType"},
-	"300A0021": {"name": "ConstraintWeight"},
-	"300A0022": {"name": "DeliveryWarningDose"},
-	"300A0023": {"name": "DeliveryMaximumDose"},
-	"300A0025": {"name": "TargetMinimumDose"},
-	"300A0026": {"name": "TargetPrescriptionDose"},
-	"300A0027": {"name": "TargetMaximumDose"},
-	"300A0028": {"name": "TargetUnderdoseVolumeFraction"},
-	"300A002A": {"name": "OrganAtRiskFullVolumeDose"},
-	"300A002B": {"name": "OrganAtRiskLimitDose"},
-	"300A002C": {"name": "OrganAtRiskMaximumDose"},
-	"300A002D": {"name": "OrganAtRiskOverdoseVolumeFraction"},
-	"300A0040": {"name": "ToleranceTableSequence"},
-	"300A0042": {"name": "ToleranceTableNumber"},
-	"300A0043": {"name": "ToleranceTableLabel"},
-	"300A0044": {"name": "GantryAngleTolerance"},
-	"300A0046": {"name": "BeamLimitingDeviceAngleTolerance"},
-	"300A0048": {"name": "BeamLimitingDeviceToleranceSeq"},
-	"300A004A": {"name": "BeamLimitingDevicePositionTol"},
-	"300A004B": {"name": "SnoutPositionTolerance"},
-	"300A004C": {"name": "PatientSupportAngleTolerance"},
-	"300A004E": {"name": "TableTopEccentricAngleTolerance"},
-	"300A004F": {"name": "TableTopPitchAngleTolerance"},
-	"300A0050": {"name": "TableTopRollAngleTolerance"},
-	"300A0051": {"name": "TableTopVerticalPositionTolerance"},
-	"300A0052": {"name": "TableTopLongitudinalPositionTol"},
-	"300A0053": {"name": "TableTopLateralPositionTolerance"},
-	"300A0055": {"name": "RTPlanRelationship"},
-	"300A0070": {"name": "FractionGroupSequence"},
-	"300A0071": {"name": "FractionGroupNumber"},
-	"300A0072": {"name": "FractionGroupDescription"},
-	"300A0078": {"name": "NumberOfFractionsPlanned"},
-	"300A0079": {"name": "NumberFractionPatternDigitsPerDay"},
-	"300A007A": {"name": "RepeatFractionCycleLength"},
-	"300A007B": {"name": "FractionPattern"},
-	"300A0080": {"name": "NumberOfBeams"},
-	"300A0082": {"name": "BeamDoseSpecificationPoint"},
-	"300A0084": {"name": "BeamDose"},
-	"300A0086": {"name": "BeamMeterset"},
-	"300A0088": {"name": "BeamDosePointDepth"},
-	"300A0089": {"name": "BeamDosePointEquivalentDepth"},
-	"300A008A": {"name": "BeamDosePointSSD"},
-	"300A00A0": {"name": "NumberOfBrachyApplicationSetups"},
-	"300A00A2": {"name": "BrachyAppSetupDoseSpecPoint"},
-	"300A00A4": {"name": "BrachyApplicationSetupDose"},
-	"300A00B0": {"name": "BeamSequence"},
-	"300A00B2": {"name": "TreatmentMachineName"},
-	"300A00B3": {"name": "PrimaryDosimeterUnit"},
-	"300A00B4": {"name": "SourceAxisDistance"},
-	"300A00B6": {"name": "BeamLimitingDeviceSequence"},
-	"300A00B8": {"name": "RTBeamLimitingDeviceType"},
-	"300A00BA": {"name": "SourceToBeamLimitingDeviceDistance"},
-	"300A00BB": {"name": "IsocenterToBeamLimitingDeviceDist"},
-	"300A00BC": {"name": "NumberOfLeafJawPairs"},
-	"300A00BE": {"name": "LeafPositionBoundaries"},
-	"300A00C0": {"name": "BeamNumber"},
-	"300A00C2": {"name": "BeamName"},
-	"300A00C3": {"name": "BeamDescription"},
-	"300A00C4": {"name": "BeamType"},
-	"300A00C6": {"name": "RadiationType"},
-	"300A00C7": {"name": "HighDoseTechniqueType"},
-	"300A00C8": {"name": "ReferenceImageNumber"},
-	"300A00CA": {"name": "PlannedVerificationImageSequence"},
-	"300A00CC": {"name": "ImagingDeviceSpecificAcqParams"},
-	"300A00CE": {"name": "TreatmentDeliveryType"},
-	"300A00D0": {"name": "NumberOfWedges"},
-	"300A00D1": {"name": "WedgeSequence"},
-	"300A00D2": {"name": "WedgeNumber"},
-	"300A00D3": {"name": "WedgeType"},
-	"300A00D4": {"name": "WedgeID"},
-	"300A00D5": {"name": "WedgeAngle"},
-	"300A00D6": {"name": "WedgeFactor"},
-	"300A00D7": {"name": "TotalWedgeTrayWaterEquivThickness"},
-	"300A00D8": {"name": "WedgeOrientation"},
-	"300A00D9": {"name": "IsocenterToWedgeTrayDistance"},
-	"300A00DA": {"name": "SourceToWedgeTrayDistance"},
-	"300A00DB": {"name": "WedgeThinEdgePosition"},
-	"300A00DC": {"name": "BolusID"},
-	"300A00DD": {"name": "BolusDescription"},
-	"300A00E0": {"name": "NumberOfCompensators"},
-	"300A00E1": {"name": "MaterialID"},
-	"300A00E2": {"name": "TotalCompensatorTrayFactor"},
-	"300A00E3": {"name": "CompensatorSequence"},
-	"300A00E4": {"name": "CompensatorNumber"},
-	"300A00E5": {"name": "CompensatorID"},
-	"300A00E6": {"name": "SourceToCompensatorTrayDistance"},
-	"300A00E7": {"name": "CompensatorRows"},
-	"300A00E8": {"name": "CompensatorColumns"},
-	"300A00E9": {"name": "CompensatorPixelSpacing"},
-	"300A00EA": {"name": "CompensatorPosition"},
-	"300A00EB": {"name": "CompensatorTransmissionData"},
-	"300A00EC": {"name": "CompensatorThicknessData"},
-	"300A00ED": {"name": "NumberOfBoli"},
-	"300A00EE": {"name": "CompensatorType"},
-	"300A00F0": {"name": "NumberOfBlocks"},
-	"300A00F2": {"name": "TotalBlockTrayFactor"},
-	"300A00F3": {"name": "TotalBlockTrayWaterEquivThickness"},
-	"300A00F4": {"name": "BlockSequence"},
-	"300A00F5": {"name": "BlockTrayID"},
-	"300A00F6": {"name": "SourceToBlockTrayDistance"},
-	"300A00F7": {"name": "IsocenterToBlockTrayDistance"},
-	"300A00F8": {"name": "BlockType"},
-	"300A00F9": {"name": "AccessoryCode"},
-	"300A00FA": {"name": "BlockDivergence"},
-	"300A00FB": {"name": "BlockMountingPosition"},
-	"300A00FC": {"name": "BlockNumber"},
-	"300A00FE": {"name": "BlockName"},
-	"300A0100": {"name": "BlockThickness"},
-	"300A0102": {"name": "BlockTransmission"},
-	"300A0104": {"name": "BlockNumberOfPoints"},
-	"300A0106": {"name": "BlockData"},
-	"300A0107": {"name": "ApplicatorSequence"},
-	"300A0108": {"name": "ApplicatorID"},
-	"300A0109": {"name": "ApplicatorType"},
-	"300A010A": {"name": "ApplicatorDescription"},
-	"300A010C": {"name": "CumulativeDoseReferenceCoefficient"},
-	"300A010E": {"name": "FinalCumulativeMetersetWeight"},
-	"300A0110": {"name": "NumberOfControlPoints"},
-	"300A0111": {"name": "ControlPointSequence"},
-	"300A0112": {"name": "ControlPointIndex"},
-	"300A0114": {"name": "NominalBeamEnergy"},
-	"300A0115": {"name": "DoseRateSet"},
-	"300A0116": {"name": "WedgePositionSequence"},
-	"300A0118": {"name": "WedgePosition"},
-	"300A011A": {"name": "BeamLimitingDevicePositionSequence"},
-	"300A011C": {"name": "LeafJawPositions"},
-	"300A011E": {"name": "GantryAngle"},
-	"300A011F": {"name": "GantryRotationDirection"},
-	"300A0120": {"name": "BeamLimitingDeviceAngle"},
-	"300A0121": {"name": "BeamLimitingDeviceRotateDirection"},
-	"300A0122": {"name": "PatientSupportAngle"},
-	"300A0123": {"name": "PatientSupportRotationDirection"},
-	"300A0124": {"name": "TableTopEccentricAxisDistance"},
-	"300A0125": {"name": "TableTopEccentricAngle"},
-	"300A0126": {"name": "TableTopEccentricRotateDirection"},
-	"300A0128": {"name": "TableTopVerticalPosition"},
-	"300A0129": {"name": "TableTopLongitudinalPosition"},
-	"300A012A": {"name": "TableTopLateralPosition"},
-	"300A012C": {"name": "IsocenterPosition"},
-	"300A012E": {"name": "SurfaceEntryPoint"},
-	"300A0130": {"name": "SourceToSurfaceDistance"},
-	"300A0134": {"name": "CumulativeMetersetWeight"},
-	"300A0140": {"name": "TableTopPitchAngle"},
-	"300A0142": {"name": "TableTopPitchRotationDirection"},
-	"300A0144": {"name": "TableTopRollAngle"},
-	"300A0146": {"name": "TableTopRollRotationDirection"},
-	"300A0148": {"name": "HeadFixationAngle"},
-	"300A014A": {"name": "GantryPitchAngle"},
-	"300A014C": {"name": "GantryPitchRotationDirection"},
-	"300A014E": {"name": "GantryPitchAngleTolerance"},
-	"300A0180": {"name": "PatientSetupSequence"},
-	"300A0182": {"name": "PatientSetupNumber"},
-	"300A0183": {"name": "PatientSetupLabel"},
-	"300A0184": {"name": "PatientAdditionalPosition"},
-	"300A0190": {"name": "FixationDeviceSequence"},
-	"300A0192": {"name": "FixationDeviceType"},
-	"300A0194": {"name": "FixationDeviceLabel"},
-	"300A0196": {"name": "FixationDeviceDescription"},
-	"300A0198": {"name": "FixationDevicePosition"},
-	"300A0199": {"name": "FixationDevicePitchAngle"},
-	"300A019A": {"name": "FixationDeviceRollAngle"},
-	"300A01A0": {"name": "ShieldingDeviceSequence"},
-	"300A01A2": {"name": "ShieldingDeviceType"},
-	"300A01A4": {"name": "ShieldingDeviceLabel"},
-	"300A01A6": {"name": "ShieldingDeviceDescription"},
-	"300A01A8": {"name": "ShieldingDevicePosition"},
-	"300A01B0": {"name": "SetupTechnique"},
-	"300A01B2": {"name": "SetupTechniqueDescription"},
-	"300A01B4": {"name": "SetupDeviceSequence"},
-	"300A01B6": {"name": "SetupDeviceType"},
-	"300A01B8": {"name": "SetupDeviceLabel"},
-	"300A01BA": {"name": "SetupDeviceDescription"},
-	"300A01BC": {"name": "SetupDeviceParameter"},
-	"300A01D0": {"name": "SetupReferenceDescription"},
-	"300A01D2": {"name": "TableTopVerticalSetupDisplacement"},
-	"300A01D4": {"name": "TableTopLongitudinalSetupDisplace"},
-	"300A01D6": {"name": "TableTopLateralSetupDisplacement"},
-	"300A0200": {"name": "BrachyTreatmentTechnique"},
-	"300A0202": {"name": "BrachyTreatmentType"},
-	"300A0206": {"name": "TreatmentMachineSequence"},
-	"300A0210": {"name": "SourceSequence"},
-	"300A0212": {"name": "SourceNumber"},
-	"300A0214": {"name": "SourceType"},
-	"300A0216": {"name": "SourceManufacturer"},
-	"300A0218": {"name": "ActiveSourceDiameter"},
-	"300A021A": {"name": "ActiveSourceLength"},
-	"300A0222": {"name": "SourceEncapsulationNomThickness"},
-	"300A0224": {"name": "SourceEncapsulationNomTransmission"},
-	"300A0226": {"name": "SourceIsotopeName"},
-	"300A0228": {"name": "SourceIsotopeHalfLife"},
-	"300A0229": {"name": "SourceStrengthUnits"},
-	"300A022A": {"name": "ReferenceAirKermaRate"},
-	"300A022B": {"name": "SourceStrength"},
-	"300A022C": {"name": "SourceStrengthReferenceDate"},
-	"300A022E": {"name": "SourceStrengthReferenceTime"},
-	"300A0230": {"name": "ApplicationSetupSequence"},
-	"300A0232": {"name": "ApplicationSetupType"},
-	"300A0234": {"name": "ApplicationSetupNumber"},
-	"300A0236": {"name": "ApplicationSetupName"},
-	"300A0238": {"name": "ApplicationSetupManufacturer"},
-	"300A0240": {"name": "TemplateNumber"},
-	"300A0242": {"name": "TemplateType"},
-	"300A0244": {"name": "TemplateName"},
-	"300A0250": {"name": "TotalReferenceAirKerma"},
-	"300A0260": {"name": "BrachyAccessoryDeviceSequence"},
-	"300A0262": {"name": "BrachyAccessoryDeviceNumber"},
-	"300A0263": {"name": "BrachyAccessoryDeviceID"},
-	"300A0264": {"name": "BrachyAccessoryDeviceType"},
-	"300A0266": {"name": "BrachyAccessoryDeviceName"},
-	"300A026A": {"name": "BrachyAccessoryDeviceNomThickness"},
-	"300A026C": {"name": "BrachyAccessoryDevNomTransmission"},
-	"300A0280": {"name": "ChannelSequence"},
-	"300A0282": {"name": "ChannelNumber"},
-	"300A0284": {"name": "ChannelLength"},
-	"300A0286": {"name": "ChannelTotalTime"},
-	"300A0288": {"name": "SourceMovementType"},
-	"300A028A": {"name": "NumberOfPulses"},
-	"300A028C": {"name": "PulseRepetitionInterval"},
-	"300A0290": {"name": "SourceApplicatorNumber"},
-	"300A0291": {"name": "SourceApplicatorID"},
-	"300A0292": {"name": "SourceApplicatorType"},
-	"300A0294": {"name": "SourceApplicatorName"},
-	"300A0296": {"name": "SourceApplicatorLength"},
-	"300A0298": {"name": "SourceApplicatorManufacturer"},
-	"300A029C": {"name": "SourceApplicatorWallNomThickness"},
-	"300A029E": {"name": "SourceApplicatorWallNomTrans"},
-	"300A02A0": {"name": "SourceApplicatorStepSize"},
-	"300A02A2": {"name": "TransferTubeNumber"},
-	"300A02A4": {"name": "TransferTubeLength"},
-	"300A02B0": {"name": "ChannelShieldSequence"},
-	"300A02B2": {"name": "ChannelShieldNumber"},
-	"300A02B3": {"name": "ChannelShieldID"},
-	"300A02B4": {"name": "ChannelShieldName"},
-	"300A02B8": {"name": "ChannelShieldNominalThickness"},
-	"300A02BA": {"name": "ChannelShieldNominalTransmission"},
-	"300A02C8": {"name": "FinalCumulativeTimeWeight"},
-	"300A02D0": {"name": "BrachyControlPointSequence"},
-	"300A02D2": {"name": "ControlPointRelativePosition"},
-	"300A02D4": {"name": "ControlPoint3DPosition"},
-	"300A02D6": {"name": "CumulativeTimeWeight"},
-	"300A02E0": {"name": "CompensatorDivergence"},
-	"300A02E1": {"name": "CompensatorMountingPosition"},
-	"300A02E2": {"name": "SourceToCompensatorDistance"},
-	"300A02E3": {"name": "TotalCompTrayWaterEquivThickness"},
-	"300A02E4": {"name": "IsocenterToCompensatorTrayDistance"},
-	"300A02E5": {"name": "CompensatorColumnOffset"},
-	"300A02E6": {"name": "IsocenterToCompensatorDistances"},
-	"300A02E7": {"name": "CompensatorRelStoppingPowerRatio"},
-	"300A02E8": {"name": "CompensatorMillingToolDiameter"},
-	"300A02EA": {"name": "IonRangeCompensatorSequence"},
-	"300A02EB": {"name": "CompensatorDescription"},
-	"300A0302": {"name": "RadiationMassNumber"},
-	"300A0304": {"name": "RadiationAtomicNumber"},
-	"300A0306": {"name": "RadiationChargeState"},
-	"300A0308": {"name": "ScanMode"},
-	"300A030A": {"name": "VirtualSourceAxisDistances"},
-	"300A030C": {"name": "SnoutSequence"},
-	"300A030D": {"name": "SnoutPosition"},
-	"300A030F": {"name": "SnoutID"},
-	"300A0312": {"name": "NumberOfRangeShifters"},
-	"300A0314": {"name": "RangeShifterSequence"},
-	"300A0316": {"name": "RangeShifterNumber"},
-	"300A0318": {"name": "RangeShifterID"},
-	"300A0320": {"name": "RangeShifterType"},
-	"300A0322": {"name": "RangeShifterDescription"},
-	"300A0330": {"name": "NumberOfLateralSpreadingDevices"},
-	"300A0332": {"name": "LateralSpreadingDeviceSequence"},
-	"300A0334": {"name": "LateralSpreadingDeviceNumber"},
-	"300A0336": {"name": "LateralSpreadingDeviceID"},
-	"300A0338": {"name": "LateralSpreadingDeviceType"},
-	"300A033A": {"name": "LateralSpreadingDeviceDescription"},
-	"300A033C": {"name": "LateralSpreadingDevWaterEquivThick"},
-	"300A0340": {"name": "NumberOfRangeModulators"},
-	"300A0342": {"name": "RangeModulatorSequence"},
-	"300A0344": {"name": "RangeModulatorNumber"},
-	"300A0346": {"name": "RangeModulatorID"},
-	"300A0348": {"name": "RangeModulatorType"},
-	"300A034A": {"name": "RangeModulatorDescription"},
-	"300A034C": {"name": "BeamCurrentModulationID"},
-	"300A0350": {"name": "PatientSupportType"},
-	"300A0352": {"name": "PatientSupportID"},
-	"300A0354": {"name": "PatientSupportAccessoryCode"},
-	"300A0356": {"name": "FixationLightAzimuthalAngle"},
-	"300A0358": {"name": "FixationLightPolarAngle"},
-	"300A035A": {"name": "MetersetRate"},
-	"300A0360": {"name": "RangeShifterSettingsSequence"},
-	"300A0362": {"name": "RangeShifterSetting"},
-	"300A0364": {"name": "IsocenterToRangeShifterDistance"},
-	"300A0366": {"name": "RangeShifterWaterEquivThickness"},
-	"300A0370": {"name": "LateralSpreadingDeviceSettingsSeq"},
-	"300A0372": {"name": "LateralSpreadingDeviceSetting"},
-	"300A0374": {"name": "IsocenterToLateralSpreadingDevDist"},
-	"300A0380": {"name": "RangeModulatorSettingsSequence"},
-	"300A0382": {"name": "RangeModulatorGatingStartValue"},
-	"300A0384": {"name": "RangeModulatorGatingStopValue"},
-	"300A038A": {"name": "IsocenterToRangeModulatorDistance"},
-	"300A0390": {"name": "ScanSpotTuneID"},
-	"300A0392": {"name": "NumberOfScanSpotPositions"},
-	"300A0394": {"name": "ScanSpotPositionMap"},
-	"300A0396": {"name": "ScanSpotMetersetWeights"},
-	"300A0398": {"name": "ScanningSpotSize"},
-	"300A039A": {"name": "NumberOfPaintings"},
-	"300A03A0": {"name": "IonToleranceTableSequence"},
-	"300A03A2": {"name": "IonBeamSequence"},
-	"300A03A4": {"name": "IonBeamLimitingDeviceSequence"},
-	"300A03A6": {"name": "IonBlockSequence"},
-	"300A03A8": {"name": "IonControlPointSequence"},
-	"300A03AA": {"name": "IonWedgeSequence"},
-	"300A03AC": {"name": "IonWedgePositionSequence"},
-	"300A0401": {"name": "ReferencedSetupImageSequence"},
-	"300A0402": {"name": "SetupImageComment"},
-	"300A0410": {"name": "MotionSynchronizationSequence"},
-	"300A0412": {"name": "ControlPointOrientation"},
-	"300A0420": {"name": "GeneralAccessorySequence"},
-	"300A0421": {"name": "GeneralAccessoryID"},
-	"300A0422": {"name": "GeneralAccessoryDescription"},
-	"300A0423": {"name": "GeneralAccessoryType"},
-	"300A0424": {"name": "GeneralAccessoryNumber"},
-	"300C0002": {"name": "ReferencedRTPlanSequence"},
-	"300C0004": {"name": "ReferencedBeamSequence"},
-	"300C0006": {"name": "ReferencedBeamNumber"},
-	"300C0007": {"name": "ReferencedReferenceImageNumber"},
-	"300C0008": {"name": "StartCumulativeMetersetWeight"},
-	"300C0009": {"name": "EndCumulativeMetersetWeight"},
-	"300C000A": {"name": "ReferencedBrachyAppSetupSeq"},
-	"300C000C": {"name": "ReferencedBrachyAppSetupNumber"},
-	"300C000E": {"name": "ReferencedSourceNumber"},
-	"300C0020": {"name": "ReferencedFractionGroupSequence"},
-	"300C0022": {"name": "ReferencedFractionGroupNumber"},
-	"300C0040": {"name": "ReferencedVerificationImageSeq"},
-	"300C0042": {"name": "ReferencedReferenceImageSequence"},
-	"300C0050": {"name": "ReferencedDoseReferenceSequence"},
-	"300C0051": {"name": "ReferencedDoseReferenceNumber"},
-	"300C0055": {"name": "BrachyReferencedDoseReferenceSeq"},
-	"300C0060": {"name": "ReferencedStructureSetSequence"},
-	"300C006A": {"name": "ReferencedPatientSetupNumber"},
-	"300C0080": {"name": "ReferencedDoseSequence"},
-	"300C00A0": {"name": "ReferencedToleranceTableNumber"},
-	"300C00B0": {"name": "ReferencedBolusSequence"},
-	"300C00C0": {"name": "ReferencedWedgeNumber"},
-	"300C00D0": {"name": "ReferencedCompensatorNumber"},
-	"300C00E0": {"name": "ReferencedBlockNumber"},
-	"300C00F0": {"name": "ReferencedControlPointIndex"},
-	"300C00F2": {"name": "ReferencedControlPointSequence"},
-	"300C00F4": {"name": "ReferencedStartControlPointIndex"},
-	"300C00F6": {"name": "ReferencedStopControlPointIndex"},
-	"300C0100": {"name": "ReferencedRangeShifterNumber"},
-	"300C0102": {"name": "ReferencedLateralSpreadingDevNum"},
-	"300C0104": {"name": "ReferencedRangeModulatorNumber"},
-	"300E0002": {"name": "ApprovalStatus"},
-	"300E0004": {"name": "ReviewDate"},
-	"300E0005": {"name": "ReviewTime"},
-	"300E0008": {"name": "ReviewerName"},
-	"40000000": {"name": "TextGroupLength"},
-	"40000010": {"name": "Arbitrary"},
-	"40004000": {"name": "TextComments"},
-	"40080040": {"name": "ResultsID"},
-	"40080042": {"name": "ResultsIDIssuer"},
-	"40080050": {"name": "ReferencedInterpretationSequence"},
-	"40080100": {"name": "InterpretationRecordedDate"},
-	"40080101": {"name": "InterpretationRecordedTime"},
-	"40080102": {"name": "InterpretationRecorder"},
-	"40080103": {"name": "ReferenceToRecordedSound"},
-	"40080108": {"name": "InterpretationTranscriptionDate"},
-	"40080109": {"name": "InterpretationTranscriptionTime"},
-	"4008010A": {"name": "InterpretationTranscriber"},
-	"4008010B": {"name": "InterpretationText"},
-	"4008010C": {"name": "InterpretationAuthor"},
-	"40080111": {"name": "InterpretationApproverSequence"},
-	"40080112": {"name": "InterpretationApprovalDate"},
-	"40080113": {"name": "InterpretationApprovalTime"},
-	"40080114": {"name": "PhysicianApprovingInterpretation"},
-	"40080115": {"name": "InterpretationDiagnosisDescription"},
-	"40080117": {"name": "InterpretationDiagnosisCodeSeq"},
-	"40080118": {"name": "ResultsDistributionListSequence"},
-	"40080119": {"name": "DistributionName"},
-	"4008011A": {"name": "DistributionAddress"},
-	"40080200": {"name": "InterpretationID"},
-	"40080202": {"name": "InterpretationIDIssuer"},
-	"40080210": {"name": "InterpretationTypeID"},
-	"40080212": {"name": "InterpretationStatusID"},
-	"40080300": {"name": "Impressions"},
-	"40084000": {"name": "ResultsComments"},
-	"4FFE0001": {"name": "MACParametersSequence"},
-	"52009229": {"name": "SharedFunctionalGroupsSequence"},
-	"52009230": {"name": "PerFrameFunctionalGroupsSequence"},
-	"54000100": {"name": "WaveformSequence"},
-	"54000110": {"name": "ChannelMinimumValue"},
-	"54000112": {"name": "ChannelMaximumValue"},
-	"54001004": {"name": "WaveformBitsAllocated"},
-	"54001006": {"name": "WaveformSampleInterpretation"},
-	"5400100A": {"name": "WaveformPaddingValue"},
-	"54001010": {"name": "WaveformData"},
-	"56000010": {"name": "FirstOrderPhaseCorrectionAngle"},
-	"56000020": {"name": "SpectroscopyData"},
-	"60000000": {"name": "OverlayGroupLength"},
-	"7FE00008": {"name": "FloatPixelData"},
-	"7FE00009": {"name": "DoubleFloatPixelData"},
-	"7FE00010": {"name": "PixelData"},
-	"FFFAFFFA": {"name": "DigitalSignaturesSequence"},
-	"FFFCFFFC": {"name": "DataSetTrailingPadding"},
-	"FFFEE000": {"name": "Item"},
-	"FFFEE00D": {"name": "ItemDelimitationItem"},
-	"FFFEE0DD": {"name": "SequenceDelimitationItem"},
-}
-
-// TagRange -
-// http://dicom.nema.org/medical/dicom/current/output/html/part06.html#chapter_6
-// Table 6-1. Registry of DICOM Data Elements
-// http://www.sno.phy.queensu.ca/~phil/exiftool/TagNames/DICOM.html
-var TagRange = map[string]map[string]string{
-	"002031XX": {"name": "SourceImageIDs"},
-	"002804X2": {"name": "CoefficientCoding"},
-	"002804X3": {"name": "CoefficientCodingPointers"},
-	"002808X0": {"name": "CodeLabel"},
-	"002808X2": {"name": "NumberOfTables"},
-	"002808X3": {"name": "CodeTableLocation"},
-	"002808X4": {"name": "BitsForCodeWord"},
-	"002808X8": {"name": "ImageDataLocation"},
-	"1000XXX0": {"name": "EscapeTriplet"},
-	"1000XXX1": {"name": "RunLengthTriplet"},
-	"1000XXX2": {"name": "HuffmanTableSize"},
-	"1000XXX3": {"name": "HuffmanTableTriplet"},
-	"1000XXX4": {"name": "ShiftTableSize"},
-	"1000XXX5": {"name": "ShiftTableTriplet"},
-	"1010XXXX": {"name": "ZonalMap"},
-	"50XX0005": {"name": "CurveDimensions"},
-	"50XX0010": {"name": "NumberOfPoints"},
-	"50XX0020": {"name": "TypeOfData"},
-	"50XX0022": {"name": "CurveDescription"},
-	"50XX0030": {"name": "AxisUnits"},
-	"50XX0040": {"name": "AxisLabels"},
-	"50XX0103": {"name": "DataValueRepresentation"},
-	"50XX0104": {"name": "MinimumCoordinateValue"},
-	"50XX0105": {"name": "MaximumCoordinateValue"},
-	"50XX0106": {"name": "CurveRange"},
-	"50XX0110": {"name": "CurveDataDescriptor"},
-	"50XX0112": {"name": "CoordinateStartValue"},
-	"50XX0114": {"name": "CoordinateStepValue"},
-	"50XX1001": {"name": "CurveActivationLayer"},
-	"50XX2000": {"name": "AudioType"},
-	"50XX2002": {"name": "AudioSampleFormat"},
-	"50XX2004": {"name": "NumberOfChannels"},
-	"50XX2006": {"name": "NumberOfSamples"},
-	"50XX2008": {"name": "SampleRate"},
-	"50XX200A": {"name": "TotalTime"},
-	"50XX200C": {"name": "AudioSampleData"},
-	"50XX200E": {"name": "AudioComments"},
-	"50XX2500": {"name": "CurveLabel"},
-	"50XX2600": {"name": "ReferencedOverlaySequence"},
-	"50XX2610": {"name": "ReferencedOverlayGroup"},
-	"50XX3000": {"name": "CurveData"},
-	"60XX0010": {"name": "OverlayRows"},
-	"60XX0011": {"name": "OverlayColumns"},
-	"60XX0012": {"name": "OverlayPlanes"},
-	"60XX0015": {"name": "NumberOfFramesInOverlay"},
-	"60XX0022": {"name": "OverlayDescription"},
-	"60XX0040": {"name": "OverlayType"},
-	"60XX0045": {"name": "OverlaySubtype"},
-	"60XX0050": {"name": "OverlayOrigin"},
-	"60XX0051": {"name": "ImageFrameOrigin"},
-	"60XX0052": {"name": "OverlayPlaneOrigin"},
-	"60XX0060": {"name": "OverlayCompressionCode"},
-	"60XX0061": {"name": "OverlayCompressionOriginator"},
-	"60XX0062": {"name": "OverlayCompressionLabel"},
-	"60XX0063": {"name": "OverlayCompressionDescription"},
-	"60XX0066": {"name": "OverlayCompressionStepPointers"},
-	"60XX0068": {"name": "OverlayRepeatInterval"},
-	"60XX0069": {"name": "OverlayBitsGrouped"},
-	"60XX0100": {"name": "OverlayBitsAllocated"},
-	"60XX0102": {"name": "OverlayBitPosition"},
-	"60XX0110": {"name": "OverlayFormat"},
-	"60XX0200": {"name": "OverlayLocation"},
-	"60XX0800": {"name": "OverlayCodeLabel"},
-	"60XX0802": {"name": "OverlayNumberOfTables"},
-	"60XX0803": {"name": "OverlayCodeTableLocation"},
-	"60XX0804": {"name": "OverlayBitsForCodeWord"},
-	"60XX1001": {"name": "OverlayActivationLayer"},
-	"60XX1100": {"name": "OverlayDescriptorGray"},
-	"60XX1101": {"name": "OverlayDescriptorRed"},
-	"60XX1102": {"name": "OverlayDescriptorGreen"},
-	"60XX1103": {"name": "OverlayDescriptorBlue"},
-	"60XX1200": {"name": "OverlaysGray"},
-	"60XX1201": {"name": "OverlaysRed"},
-	"60XX1202": {"name": "OverlaysGreen"},
-	"60XX1203": {"name": "OverlaysBlue"},
-	"60XX1301": {"name": "ROIArea"},
-	"60XX1302": {"name": "ROIMean"},
-	"60XX1303": {"name": "ROIStandardDeviation"},
-	"60XX1500": {"name": "OverlayLabel"},
-	"60XX3000": {"name": "OverlayData"},
-	"60XX4000": {"name": "OverlayComments"},
-	"7FXX0000": {"name": "PixelDataGroupLength"},
-	"7FXX0010": {"name": "PixelData"},
-	"7FXX0011": {"name": "VariableNextDataGroup"},
-	"7FXX0020": {"name": "VariableCoefficientsSDVN"},
-	"7FXX0030": {"name": "VariableCoefficientsSDHN"},
-	"7FXX0040": {"name": "VariableCoefficientsSDDN"},
-}
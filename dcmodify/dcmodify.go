@@ -0,0 +1,210 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package main is a script that inserts, modifies and erases elements of
+// DICOM files in place.
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom"
+	"github.com/davidgamba/go-getoptions"
+)
+
+var debug bool
+
+func debugf(format string, a ...interface{}) (n int, err error) {
+	if debug {
+		return fmt.Printf(format, a...)
+	}
+	return 0, nil
+}
+
+// edit - Change to apply to the dataset.
+type edit struct {
+	op    string
+	path  dicom.Path
+	value string
+}
+
+// parseEdit parses the argument of an edit option, "path=value" for modify
+// and insert and "path" for erase.
+func parseEdit(op, s string) (edit, error) {
+	e := edit{op: op}
+	p := s
+	if op != "e" {
+		i := strings.Index(s, "=")
+		if i < 0 {
+			return e, fmt.Errorf("missing value in '%s', use path=value", s)
+		}
+		p, e.value = s[:i], s[i+1:]
+	}
+	path, err := dicom.ParsePath(p)
+	if err != nil {
+		return e, err
+	}
+	e.path = path
+	return e, nil
+}
+
+func (e edit) apply(ds *dicom.Dataset) error {
+	switch e.op {
+	case "m":
+		debugf("-m %s=%s\n", e.path, e.value)
+		return ds.Modify(e.path, e.value)
+	case "i":
+		debugf("-i %s=%s\n", e.path, e.value)
+		return ds.Insert(e.path, e.value)
+	default:
+		debugf("-e %s\n", e.path)
+		return ds.Delete(e.path)
+	}
+}
+
+// modify applies the edits to the file and rewrites it in its transfer
+// syntax, keeping the original as <file>.bak when backup is set.
+func modify(filename string, edits []edit, backup bool) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	d := dicom.NewDecoder(f, dicom.Options{})
+	ds, err := d.Decode()
+	if err != nil {
+		return err
+	}
+	for _, e := range edits {
+		if err := e.apply(ds); err != nil {
+			return fmt.Errorf("%s: %s", filename, err)
+		}
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(filename), filepath.Base(filename)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	// The temporary file is created 0600, keep the mode of the original.
+	err = tmp.Chmod(fi.Mode().Perm())
+	if err == nil {
+		err = dicom.Write(tmp, ds, dicom.WriteOptions{TransferSyntax: d.TransferSyntax()})
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %s", filename, err)
+	}
+	if backup {
+		if err := copyFile(filename, filename+".bak"); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmp.Name(), filename)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func synopsis() {
+	synopsis := `dcmodify <dcm_file>... [-m <path=value>]... [-i <path=value>]... [-e <path>]... [--backup] [--debug]
+
+    -m        Modify the value of an existing element.
+    -i        Insert an element, or modify it when it exists. Missing
+              sequences and the item after the last one are created.
+    -e        Erase an element.
+    --backup  Keep the original file as <dcm_file>.bak.
+
+    Edits are applied in the order modify, insert, erase.
+    A path is a tag, as (gggg,eeee), ggggeeee or its keyword, or tags
+    separated by "." with the index of the sequence item to follow:
+
+        -m (0010,0020)=12345
+        -i AccessionNumber=A001
+        -m (0040,0275)[0].(0040,0009)=SPS1
+`
+	fmt.Fprintln(os.Stderr, synopsis)
+}
+
+func main() {
+	opt := getoptions.New()
+	opt.Bool("help", false)
+	opt.BoolVar(&debug, "debug", false)
+	modifies := opt.StringSlice("m", 1, 1)
+	inserts := opt.StringSlice("i", 1, 1)
+	erases := opt.StringSlice("e", 1, 1)
+	backup := opt.Bool("backup", false)
+	remaining, err := opt.Parse(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	if opt.Called("help") {
+		synopsis()
+		os.Exit(1)
+	}
+	if len(remaining) < 1 {
+		fmt.Fprintf(os.Stderr, "ERROR: Missing file\n")
+		synopsis()
+		os.Exit(1)
+	}
+	edits := []edit{}
+	for _, args := range []struct {
+		op   string
+		list []string
+	}{{"m", *modifies}, {"i", *inserts}, {"e", *erases}} {
+		for _, s := range args.list {
+			e, err := parseEdit(args.op, s)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+				os.Exit(1)
+			}
+			edits = append(edits, e)
+		}
+	}
+	if len(edits) == 0 {
+		fmt.Fprintf(os.Stderr, "ERROR: Missing -m, -i or -e option\n")
+		synopsis()
+		os.Exit(1)
+	}
+	failed := false
+	for _, file := range remaining {
+		err := modify(file, edits, *backup)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
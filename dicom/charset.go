@@ -0,0 +1,329 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// SpecificCharacterSetTag selects the character set of the text values of
+// its dataset and of the items nested in it.
+var SpecificCharacterSetTag = Tag{0x0008, 0x0005}
+
+// textVRs - VRs whose values are affected by the Specific Character Set, the
+// rest only use the default repertoire.
+// PS3.5 Section 6.1.2.3
+var textVRs = map[string]bool{
+	"LO": true, "LT": true, "PN": true, "SH": true, "ST": true, "UC": true,
+	"UT": true,
+}
+
+// charset - Coded character set that can be designated with ISO 2022 code
+// extensions.
+type charset struct {
+	// escape sequence that designates the set.
+	escape string
+	// g0 sets are multi-byte sets using 7 bit codes, the others are invoked
+	// by the bytes with the high bit set.
+	g0 bool
+	// width in bytes of each character.
+	width int
+	// prefix of the characters in the form understood by encoding, like
+	// JIS X 0212 characters in EUC-JP.
+	prefix string
+	// encoding decodes the characters with the high bit set, nil for ASCII.
+	encoding encoding.Encoding
+}
+
+// ascii is the default character repertoire, ISO-IR 6.
+var ascii = &charset{escape: "\x1b(B", g0: true, width: 1}
+
+// charsets - Character sets by the number of their ISO-IR registration.
+// PS3.3 Section C.12.1.1.2
+var charsets = map[string]*charset{
+	"6":   ascii,
+	"100": {escape: "\x1b-A", width: 1, encoding: charmap.ISO8859_1},
+	"101": {escape: "\x1b-B", width: 1, encoding: charmap.ISO8859_2},
+	"109": {escape: "\x1b-C", width: 1, encoding: charmap.ISO8859_3},
+	"110": {escape: "\x1b-D", width: 1, encoding: charmap.ISO8859_4},
+	"144": {escape: "\x1b-L", width: 1, encoding: charmap.ISO8859_5},
+	"127": {escape: "\x1b-G", width: 1, encoding: charmap.ISO8859_6},
+	"126": {escape: "\x1b-F", width: 1, encoding: charmap.ISO8859_7},
+	"138": {escape: "\x1b-H", width: 1, encoding: charmap.ISO8859_8},
+	"148": {escape: "\x1b-M", width: 1, encoding: charmap.ISO8859_9},
+	"203": {escape: "\x1b-b", width: 1, encoding: charmap.ISO8859_15},
+	"166": {escape: "\x1b-T", width: 1, encoding: charmap.Windows874},
+	// JIS X 0201 katakana, single bytes in Shift JIS.
+	"13": {escape: "\x1b)I", width: 1, encoding: japanese.ShiftJIS},
+	// JIS X 0208 and JIS X 0212 through their EUC-JP form.
+	"87":  {escape: "\x1b$B", g0: true, width: 2, encoding: japanese.EUCJP},
+	"159": {escape: "\x1b$(D", g0: true, width: 2, prefix: "\x8f", encoding: japanese.EUCJP},
+	// KS X 1001 and GB 2312 through their EUC form.
+	"149": {escape: "\x1b$)C", width: 2, encoding: korean.EUCKR},
+	"58":  {escape: "\x1b$)A", width: 2, encoding: simplifiedchinese.GBK},
+}
+
+// escapes - Character sets by escape sequence, including JIS X 0201 romaji
+// which is read as ASCII.
+var escapes = map[string]*charset{"\x1b(J": ascii}
+
+func init() {
+	for _, c := range charsets {
+		escapes[c.escape] = c
+	}
+}
+
+// CharacterSet decodes and encodes text values according to the Specific
+// Character Set (0008,0005) of their dataset.
+// PS3.5 Section 6.1
+type CharacterSet struct {
+	// sets in the order of the Specific Character Set values, the first one
+	// is active at the start of each value.
+	sets []*charset
+	// codeExtensions is set for ISO 2022 terms, which switch between the sets
+	// with escape sequences.
+	codeExtensions bool
+}
+
+// NewCharacterSet returns the CharacterSet for the values of a Specific
+// Character Set element, or nil for the default repertoire.
+func NewCharacterSet(terms []string) (*CharacterSet, error) {
+	if len(terms) == 0 || len(terms) == 1 && (terms[0] == "" || terms[0] == "ISO_IR 6") {
+		return nil, nil
+	}
+	cs := &CharacterSet{}
+	for i, term := range terms {
+		var c *charset
+		switch {
+		case term == "" && i == 0:
+			c = ascii
+		case term == "ISO_IR 192" && len(terms) == 1:
+			c = &charset{encoding: unicode.UTF8}
+		case term == "GB18030" && len(terms) == 1:
+			c = &charset{encoding: simplifiedchinese.GB18030}
+		case term == "GBK" && len(terms) == 1:
+			c = &charset{encoding: simplifiedchinese.GBK}
+		case strings.HasPrefix(term, "ISO_IR ") && len(terms) == 1:
+			c = charsets[strings.TrimPrefix(term, "ISO_IR ")]
+			if c != nil && c.width != 1 {
+				c = nil
+			}
+		case strings.HasPrefix(term, "ISO 2022 IR "):
+			c = charsets[strings.TrimPrefix(term, "ISO 2022 IR ")]
+			cs.codeExtensions = true
+		}
+		if c == nil {
+			return nil, fmt.Errorf("unknown Specific Character Set '%s'", strings.Join(terms, "\\"))
+		}
+		cs.sets = append(cs.sets, c)
+	}
+	if cs.sets[0].g0 && cs.sets[0] != ascii {
+		return nil, fmt.Errorf("Specific Character Set '%s' can't start with a multi-byte set", strings.Join(terms, "\\"))
+	}
+	return cs, nil
+}
+
+// initial returns the sets active at the start of a value.
+func (cs *CharacterSet) initial() (g0, g1 *charset) {
+	if cs.sets[0] == ascii {
+		return ascii, nil
+	}
+	return ascii, cs.sets[0]
+}
+
+// isDelimiter reports whether c makes the sets return to their initial
+// state in a value with the given VR.
+// PS3.5 Section 6.1.2.5.3
+func isDelimiter(c byte, vr string) bool {
+	switch c {
+	case '\\', '\r', '\n', '\t', '\f':
+		return true
+	case '^', '=':
+		return vr == "PN"
+	}
+	return false
+}
+
+// Decode returns the value b of an element with the given VR as UTF-8.
+// Invalid characters are replaced by utf8.RuneError.
+func (cs *CharacterSet) Decode(b []byte, vr string) string {
+	if cs == nil {
+		return string(b)
+	}
+	if !cs.codeExtensions {
+		return cs.sets[0].decode(b)
+	}
+	var out strings.Builder
+	g0, g1 := cs.initial()
+	for i := 0; i < len(b); {
+		c := b[i]
+		j := i + 1
+		switch {
+		case c == 0x1b:
+			// Intermediate bytes followed by a final byte.
+			// ISO/IEC 2022 Section 13.1
+			for j < len(b) && b[j] >= 0x20 && b[j] <= 0x2f {
+				j++
+			}
+			if j < len(b) {
+				j++
+			}
+			if set, ok := escapes[string(b[i:j])]; ok {
+				if set.g0 {
+					g0 = set
+				} else {
+					g1 = set
+				}
+			}
+		case c >= 0x80:
+			for j < len(b) && b[j] >= 0x80 {
+				j++
+			}
+			if g1 == nil {
+				out.WriteString(strings.Repeat(string(utf8.RuneError), j-i))
+			} else {
+				out.WriteString(g1.decode(b[i:j]))
+			}
+		case g0.width == 2 && c > 0x20 && c < 0x7f:
+			for j < len(b) && b[j] > 0x20 && b[j] < 0x7f {
+				j++
+			}
+			out.WriteString(g0.decode(b[i:j]))
+		default:
+			out.WriteByte(c)
+			if isDelimiter(c, vr) {
+				g0, g1 = cs.initial()
+			}
+		}
+		i = j
+	}
+	return out.String()
+}
+
+// Encode returns the UTF-8 string s encoded for an element with the given VR.
+// With code extensions each character is encoded with the first set that has
+// it and the initial sets are restored before each delimiter.
+func (cs *CharacterSet) Encode(s string, vr string) ([]byte, error) {
+	if cs == nil || !cs.codeExtensions {
+		set := ascii
+		if cs != nil {
+			set = cs.sets[0]
+		}
+		return set.encode(s)
+	}
+	out := []byte{}
+	g0, g1 := cs.initial()
+	init0, init1 := g0, g1
+	for _, r := range s {
+		if r < utf8.RuneSelf {
+			if g0 != ascii {
+				out = append(out, ascii.escape...)
+				g0 = ascii
+			}
+			out = append(out, byte(r))
+			if isDelimiter(byte(r), vr) {
+				g0, g1 = init0, init1
+			}
+			continue
+		}
+		var set *charset
+		var b []byte
+		for _, c := range cs.sets {
+			if b = c.encodeRune(r); b != nil {
+				set = c
+				break
+			}
+		}
+		if set == nil {
+			return nil, fmt.Errorf("character '%c' not in the Specific Character Set", r)
+		}
+		if set.g0 && g0 != set {
+			out = append(out, set.escape...)
+			g0 = set
+		} else if !set.g0 && g1 != set {
+			out = append(out, set.escape...)
+			g1 = set
+		}
+		out = append(out, b...)
+	}
+	if g0 != ascii {
+		out = append(out, ascii.escape...)
+	}
+	return out, nil
+}
+
+// decode returns the characters of the set in b as UTF-8.
+func (c *charset) decode(b []byte) string {
+	if c.encoding == nil {
+		return string(b)
+	}
+	if c.g0 || c.prefix != "" {
+		euc := make([]byte, 0, len(b)+len(b)/c.width*len(c.prefix))
+		for i, x := range b {
+			if i%c.width == 0 {
+				euc = append(euc, c.prefix...)
+			}
+			euc = append(euc, x|0x80)
+		}
+		b = euc
+	}
+	s, err := c.encoding.NewDecoder().Bytes(b)
+	if err != nil {
+		return string(utf8.RuneError)
+	}
+	return string(s)
+}
+
+// encode returns s encoded with the set alone.
+func (c *charset) encode(s string) ([]byte, error) {
+	if c.encoding == nil {
+		for _, r := range s {
+			if r >= utf8.RuneSelf {
+				return nil, fmt.Errorf("character '%c' not in the default character repertoire", r)
+			}
+		}
+		return []byte(s), nil
+	}
+	b, err := c.encoding.NewEncoder().Bytes([]byte(s))
+	if err != nil {
+		return nil, fmt.Errorf("'%s' not in the Specific Character Set: %s", s, err)
+	}
+	return b, nil
+}
+
+// encodeRune returns the bytes of r in the set as written in a value with
+// code extensions, or nil when the set doesn't have it.
+func (c *charset) encodeRune(r rune) []byte {
+	if c.encoding == nil {
+		return nil
+	}
+	b, err := c.encoding.NewEncoder().Bytes([]byte(string(r)))
+	if err != nil || len(b) != len(c.prefix)+c.width || string(b[:len(c.prefix)]) != c.prefix {
+		return nil
+	}
+	b = b[len(c.prefix):]
+	for i := range b {
+		if b[i] < 0xa0 {
+			return nil
+		}
+		if c.g0 {
+			b[i] &^= 0x80
+		}
+	}
+	return b
+}
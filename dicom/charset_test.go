@@ -0,0 +1,88 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// Examples from PS3.5 Annexes H, I and J.
+var charsetTests = []struct {
+	terms []string
+	value []byte
+	s     string
+}{
+	{[]string{"ISO_IR 100"}, []byte("Buc^J\xe9r\xf4me"), "Buc^Jérôme"},
+	{[]string{"ISO_IR 192"}, []byte("Wang^XiaoDong=王^小東="), "Wang^XiaoDong=王^小東="},
+	{[]string{"GB18030"}, []byte("Wang^XiaoDong=\xcd\xf5^\xd0\xa1\xb6\xab="), "Wang^XiaoDong=王^小东="},
+	{
+		[]string{"", "ISO 2022 IR 87"},
+		[]byte("Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B=\x1b$B$d$^$@\x1b(B^\x1b$B$?$m$&\x1b(B"),
+		"Yamada^Tarou=山田^太郎=やまだ^たろう",
+	},
+	{
+		[]string{"ISO 2022 IR 13", "ISO 2022 IR 87"},
+		[]byte("\xd4\xcf\xc0\xde^\xc0\xdb\xb3=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B=\x1b$B$d$^$@\x1b(B^\x1b$B$?$m$&\x1b(B"),
+		"ﾔﾏﾀﾞ^ﾀﾛｳ=山田^太郎=やまだ^たろう",
+	},
+	{
+		[]string{"", "ISO 2022 IR 149"},
+		[]byte("Hong^Gildong=\x1b$)C\xfb\xf3^\x1b$)C\xd1\xce\xd4\xd7=\x1b$)C\xc8\xab^\x1b$)C\xb1\xe6\xb5\xbf"),
+		"Hong^Gildong=洪^吉洞=홍^길동",
+	},
+}
+
+func TestCharacterSet(t *testing.T) {
+	for _, test := range charsetTests {
+		cs, err := NewCharacterSet(test.terms)
+		if err != nil {
+			t.Fatalf("Fail: %s", err)
+		}
+		s := cs.Decode(test.value, "PN")
+		if s != test.s {
+			t.Errorf("Fail: %q != %q", s, test.s)
+		}
+		b, err := cs.Encode(test.s, "PN")
+		if err != nil || !reflect.DeepEqual(b, test.value) {
+			t.Errorf("Fail: %q != %q, %v", b, test.value, err)
+		}
+	}
+	if _, err := NewCharacterSet([]string{"ISO_IR 999"}); err == nil {
+		t.Errorf("Fail: expected error")
+	}
+}
+
+func TestParseCharacterSet(t *testing.T) {
+	sq := explicitLE(Tag{0x0008, 0x1140}, "SQ", nil)
+	binary.LittleEndian.PutUint32(sq[8:], UndefinedLength)
+	sq = append(sq, header(ItemTag, UndefinedLength)...)
+	sq = append(sq, explicitLE(Tag{0x0010, 0x0010}, "PN", []byte("Buc^J\xe9r\xf4me"))...)
+	sq = append(sq, header(ItemDelimitationItemTag, 0)...)
+	sq = append(sq, header(SequenceDelimitationItemTag, 0)...)
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.1\x00")),
+		explicitLE(SpecificCharacterSetTag, "CS", []byte("ISO_IR 100")),
+		sq,
+	)
+	ds, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	e := ds.Elements[2].Items[0].Elements[0]
+	strs, err := e.Strings()
+	if err != nil || !reflect.DeepEqual(strs, []string{"Buc^Jérôme"}) {
+		t.Errorf("Fail: %v, %v", strs, err)
+	}
+	if err := e.SetStrings([]string{"Buc^Jérôm"}); err != nil || !reflect.DeepEqual(e.Value, []byte("Buc^J\xe9r\xf4m ")) {
+		t.Errorf("Fail: %q, %v", e.Value, err)
+	}
+}
@@ -0,0 +1,35 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+// Dataset is an ordered list of data elements.
+// Sequence items are Datasets themselves.
+type Dataset struct {
+	Elements []*Element
+}
+
+// FindElement returns the element with the given tag from the top level of
+// the dataset.
+func (ds *Dataset) FindElement(t Tag) (*Element, bool) {
+	for _, e := range ds.Elements {
+		if e.Tag == t {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// TransferSyntax returns the transfer syntax named by the Transfer Syntax UID
+// (0002,0010), defaulting to Explicit VR Little Endian when it is missing.
+func (ds *Dataset) TransferSyntax() TransferSyntax {
+	if e, ok := ds.FindElement(TransferSyntaxUIDTag); ok {
+		return LookupTransferSyntax(string(e.Value))
+	}
+	return ExplicitVRLittleEndian
+}
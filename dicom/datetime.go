@@ -0,0 +1,424 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimezoneOffsetFromUTCTag holds the UTC offset of the DA, TM and DT values
+// of the dataset that don't carry their own.
+var TimezoneOffsetFromUTCTag = Tag{0x0008, 0x0201}
+
+// Precision - Last component present in a DA, TM or DT value, values can
+// leave out the trailing components.
+type Precision int
+
+// Precisions from the least to the most precise.
+const (
+	PrecisionYear Precision = iota
+	PrecisionMonth
+	PrecisionDay
+	PrecisionHour
+	PrecisionMinute
+	PrecisionSecond
+	// PrecisionFraction - Seconds with a fraction of up to 6 digits.
+	PrecisionFraction
+)
+
+// DateTime - DA, TM or DT value.
+// TM values are on January 1st of year 0.
+// PS3.5 Table 6.2-1
+type DateTime struct {
+	Time      time.Time
+	VR        string
+	Precision Precision
+	// Offset is set when a DT value carries its own UTC offset.
+	Offset bool
+}
+
+// fieldWidths of the components of a DT value, TM values start at the hour.
+var fieldWidths = []int{4, 2, 2, 2, 2, 2}
+
+// ParseDateTime parses a DA, TM or DT value.
+// loc is the location of the values without a UTC offset, see
+// Dataset.Location.
+// The ACR-NEMA "YYYY.MM.DD" dates and "HH:MM:SS" times are accepted.
+func ParseDateTime(vr, s string, loc *time.Location) (DateTime, error) {
+	dt := DateTime{VR: vr}
+	body := s
+	first := PrecisionYear
+	switch vr {
+	case "DA":
+		if len(body) == 10 && body[4] == '.' && body[7] == '.' {
+			body = strings.Replace(body, ".", "", -1)
+		}
+	case "TM":
+		body = strings.Replace(body, ":", "", -1)
+		first = PrecisionHour
+	case "DT":
+		if i := strings.IndexAny(body, "+-"); i >= 0 {
+			zone, err := ParseTimezoneOffset(body[i:])
+			if err != nil {
+				return dt, fmt.Errorf("invalid DT value '%s': %s", s, err)
+			}
+			body, loc, dt.Offset = body[:i], zone, true
+		}
+	default:
+		return dt, fmt.Errorf("VR %s is not a date or time", vr)
+	}
+	fraction := ""
+	if i := strings.IndexByte(body, '.'); i >= 0 {
+		body, fraction = body[:i], body[i+1:]
+	}
+	values := []int{0, 1, 1, 0, 0, 0, 0}
+	p := 0
+	dt.Precision = first - 1
+	for k := first; k <= PrecisionSecond && p < len(body); k++ {
+		w := fieldWidths[k]
+		if p+w > len(body) {
+			break
+		}
+		v, err := strconv.Atoi(body[p : p+w])
+		if err != nil || v < 0 {
+			break
+		}
+		values[k] = v
+		dt.Precision = k
+		p += w
+	}
+	if p != len(body) || dt.Precision < first || vr == "DA" && dt.Precision != PrecisionDay {
+		return dt, fmt.Errorf("invalid %s value '%s'", vr, s)
+	}
+	if fraction != "" || strings.HasSuffix(s, ".") {
+		if dt.Precision != PrecisionSecond || len(fraction) > 6 {
+			return dt, fmt.Errorf("invalid %s value '%s'", vr, s)
+		}
+		v, err := strconv.Atoi(fraction)
+		if err != nil || v < 0 {
+			return dt, fmt.Errorf("invalid %s value '%s'", vr, s)
+		}
+		values[6] = v * pow10(9-len(fraction))
+		dt.Precision = PrecisionFraction
+	}
+	if values[1] < 1 || values[1] > 12 || values[2] < 1 || values[2] > daysIn(values[0], values[1]) ||
+		values[3] > 23 || values[4] > 59 || values[5] > 60 {
+		return dt, fmt.Errorf("invalid %s value '%s'", vr, s)
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	dt.Time = time.Date(values[0], time.Month(values[1]), values[2], values[3], values[4], values[5], values[6], loc)
+	return dt, nil
+}
+
+func pow10(n int) int {
+	p := 1
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
+
+func daysIn(year, month int) int {
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// ParseTimezoneOffset parses a "&ZZXX" UTC offset, as used in DT values and
+// the Timezone Offset From UTC (0008,0201).
+func ParseTimezoneOffset(s string) (*time.Location, error) {
+	if len(s) != 5 || s[0] != '+' && s[0] != '-' {
+		return nil, fmt.Errorf("invalid UTC offset '%s'", s)
+	}
+	h, err := strconv.Atoi(s[1:3])
+	if err != nil || h < 0 {
+		return nil, fmt.Errorf("invalid UTC offset '%s'", s)
+	}
+	m, err := strconv.Atoi(s[3:5])
+	if err != nil || m < 0 || m > 59 {
+		return nil, fmt.Errorf("invalid UTC offset '%s'", s)
+	}
+	offset := h*3600 + m*60
+	if s[0] == '-' {
+		offset = -offset
+	}
+	if offset < -12*3600 || offset > 14*3600 {
+		return nil, fmt.Errorf("invalid UTC offset '%s'", s)
+	}
+	return time.FixedZone(s, offset), nil
+}
+
+// layouts of the DT components in time.Format notation.
+var layouts = []string{"2006", "01", "02", "15", "04", "05"}
+
+// String returns the value encoded as in its VR, up to its precision.
+func (dt DateTime) String() string {
+	first, last := PrecisionYear, dt.Precision
+	switch dt.VR {
+	case "DA":
+		last = PrecisionDay
+	case "TM":
+		first = PrecisionHour
+	}
+	layout := ""
+	for k := first; k <= last && k <= PrecisionSecond; k++ {
+		layout += layouts[k]
+	}
+	s := dt.Time.Format(layout)
+	if last == PrecisionFraction {
+		s += dt.fraction()
+	}
+	if dt.VR == "DT" && dt.Offset {
+		s += dt.Time.Format("-0700")
+	}
+	return s
+}
+
+// fraction returns the fraction of second, with at least one digit.
+func (dt DateTime) fraction() string {
+	f := fmt.Sprintf("%06d", dt.Time.Nanosecond()/1000)
+	f = strings.TrimRight(f, "0")
+	if f == "" {
+		f = "0"
+	}
+	return "." + f
+}
+
+// ISO8601 returns the value in ISO 8601 notation, up to its precision.
+func (dt DateTime) ISO8601() string {
+	first := PrecisionYear
+	if dt.VR == "TM" {
+		first = PrecisionHour
+	}
+	separators := []string{"", "-", "-", "T", ":", ":"}
+	layout := ""
+	for k := first; k <= dt.Precision && k <= PrecisionSecond; k++ {
+		if k != first {
+			layout += separators[k]
+		}
+		layout += layouts[k]
+	}
+	s := dt.Time.Format(layout)
+	if dt.Precision == PrecisionFraction {
+		s += dt.fraction()
+	}
+	if dt.VR == "DT" && dt.Offset {
+		s += dt.Time.Format("-07:00")
+	}
+	return s
+}
+
+// End returns the first instant after the period covered by the value at its
+// precision, 20200101 ends at 20200102.
+func (dt DateTime) End() time.Time {
+	t := dt.Time
+	switch dt.Precision {
+	case PrecisionYear:
+		return t.AddDate(1, 0, 0)
+	case PrecisionMonth:
+		return t.AddDate(0, 1, 0)
+	case PrecisionDay:
+		return t.AddDate(0, 0, 1)
+	case PrecisionHour:
+		return t.Add(time.Hour)
+	case PrecisionMinute:
+		return t.Add(time.Minute)
+	case PrecisionSecond:
+		return t.Add(time.Second)
+	}
+	return t.Add(time.Microsecond)
+}
+
+// DateTimeRange - Range matching key of a DA, TM or DT attribute in a
+// C-FIND query, "From-To" where either end may be left open.
+// A single value is a range with the same From and To.
+// PS3.4 Section C.2.2.2.5
+type DateTimeRange struct {
+	From, To *DateTime
+}
+
+// ParseDateTimeRange parses a DA, TM or DT range matching key.
+// A DT value with a negative UTC offset is read as a single value when
+// it is valid, "2020-0500" is the year 2020 at UTC-05:00.
+func ParseDateTimeRange(vr, s string, loc *time.Location) (DateTimeRange, error) {
+	if dt, err := ParseDateTime(vr, s, loc); err == nil {
+		return DateTimeRange{From: &dt, To: &dt}, nil
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] != '-' {
+			continue
+		}
+		r, err := parseRangeEnds(vr, s[:i], s[i+1:], loc)
+		if err == nil {
+			return r, nil
+		}
+		if vr != "DT" {
+			return r, err
+		}
+	}
+	return DateTimeRange{}, fmt.Errorf("invalid %s range '%s'", vr, s)
+}
+
+func parseRangeEnds(vr, from, to string, loc *time.Location) (DateTimeRange, error) {
+	r := DateTimeRange{}
+	if from == "" && to == "" {
+		return r, fmt.Errorf("invalid %s range '-'", vr)
+	}
+	if from != "" {
+		dt, err := ParseDateTime(vr, from, loc)
+		if err != nil {
+			return r, err
+		}
+		r.From = &dt
+	}
+	if to != "" {
+		dt, err := ParseDateTime(vr, to, loc)
+		if err != nil {
+			return r, err
+		}
+		r.To = &dt
+	}
+	return r, nil
+}
+
+// String returns the range matching key.
+func (r DateTimeRange) String() string {
+	if r.From != nil && r.To != nil && *r.From == *r.To {
+		return r.From.String()
+	}
+	s := "-"
+	if r.From != nil {
+		s = r.From.String() + s
+	}
+	if r.To != nil {
+		s += r.To.String()
+	}
+	return s
+}
+
+// Contains reports whether t falls in the range, the To end is included up to
+// its precision.
+func (r DateTimeRange) Contains(t time.Time) bool {
+	if r.From != nil && t.Before(r.From.Time) {
+		return false
+	}
+	return r.To == nil || t.Before(r.To.End())
+}
+
+// Age - AS value, a number of days, weeks, months or years.
+type Age struct {
+	Value int
+	// Unit is one of 'D', 'W', 'M' or 'Y'.
+	Unit byte
+}
+
+// ParseAge parses an AS value, "nnnD", "nnnW", "nnnM" or "nnnY".
+func ParseAge(s string) (Age, error) {
+	if len(s) != 4 || !strings.ContainsRune("DWMY", rune(s[3])) {
+		return Age{}, fmt.Errorf("invalid AS value '%s'", s)
+	}
+	v, err := strconv.Atoi(s[:3])
+	if err != nil || v < 0 {
+		return Age{}, fmt.Errorf("invalid AS value '%s'", s)
+	}
+	return Age{Value: v, Unit: s[3]}, nil
+}
+
+// String returns the AS value.
+func (a Age) String() string {
+	return fmt.Sprintf("%03d%c", a.Value, a.Unit)
+}
+
+// AddTo returns t plus the age, use a negative age to get a birth date from
+// the date the age was recorded.
+func (a Age) AddTo(t time.Time) time.Time {
+	switch a.Unit {
+	case 'D':
+		return t.AddDate(0, 0, a.Value)
+	case 'W':
+		return t.AddDate(0, 0, 7*a.Value)
+	case 'M':
+		return t.AddDate(0, a.Value, 0)
+	}
+	return t.AddDate(a.Value, 0, 0)
+}
+
+// Duration returns the age as a duration, months and years use their
+// average length in the Gregorian calendar.
+func (a Age) Duration() time.Duration {
+	day := 24 * time.Hour
+	switch a.Unit {
+	case 'D':
+		return time.Duration(a.Value) * day
+	case 'W':
+		return time.Duration(a.Value) * 7 * day
+	case 'M':
+		return time.Duration(a.Value) * 2629746 * time.Second
+	}
+	return time.Duration(a.Value) * 31556952 * time.Second
+}
+
+// DateTimes returns the values of a DA, TM or DT element.
+// loc is the location of the values without a UTC offset, see
+// Dataset.Location.
+func (e *Element) DateTimes(loc *time.Location) ([]DateTime, error) {
+	if e.VR != "DA" && e.VR != "TM" && e.VR != "DT" {
+		return nil, fmt.Errorf("%s: VR %s is not a date or time", e.Tag, e.VR)
+	}
+	strs, err := e.Strings()
+	if err != nil {
+		return nil, err
+	}
+	dts := make([]DateTime, len(strs))
+	for i, s := range strs {
+		dts[i], err = ParseDateTime(e.VR, s, loc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", e.Tag, err)
+		}
+	}
+	return dts, nil
+}
+
+// Ages returns the values of an AS element.
+func (e *Element) Ages() ([]Age, error) {
+	if e.VR != "AS" {
+		return nil, fmt.Errorf("%s: VR %s is not an age", e.Tag, e.VR)
+	}
+	strs, err := e.Strings()
+	if err != nil {
+		return nil, err
+	}
+	ages := make([]Age, len(strs))
+	for i, s := range strs {
+		ages[i], err = ParseAge(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", e.Tag, err)
+		}
+	}
+	return ages, nil
+}
+
+// Location returns the time zone of the DA, TM and DT values of the dataset
+// from its Timezone Offset From UTC (0008,0201).
+// Without it values are in the unknown local time of their creator and
+// time.Local is returned.
+func (ds *Dataset) Location() *time.Location {
+	if e, ok := ds.FindElement(TimezoneOffsetFromUTCTag); ok {
+		strs, err := e.Strings()
+		if err == nil && len(strs) == 1 {
+			if loc, err := ParseTimezoneOffset(strs[0]); err == nil {
+				return loc
+			}
+		}
+	}
+	return time.Local
+}
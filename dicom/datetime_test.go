@@ -0,0 +1,88 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDateTime(t *testing.T) {
+	est := time.FixedZone("-0500", -5*3600)
+	cases := []struct {
+		vr, s     string
+		expected  time.Time
+		precision Precision
+		iso       string
+	}{
+		{"DA", "20200229", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC), PrecisionDay, "2020-02-29"},
+		{"DA", "1993.08.22", time.Date(1993, 8, 22, 0, 0, 0, 0, time.UTC), PrecisionDay, "1993-08-22"},
+		{"TM", "0701", time.Date(0, 1, 1, 7, 1, 0, 0, time.UTC), PrecisionMinute, "07:01"},
+		{"TM", "070101.25", time.Date(0, 1, 1, 7, 1, 1, 250000000, time.UTC), PrecisionFraction, "07:01:01.25"},
+		{"TM", "07:01:01", time.Date(0, 1, 1, 7, 1, 1, 0, time.UTC), PrecisionSecond, "07:01:01"},
+		{"DT", "2020", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), PrecisionYear, "2020"},
+		{"DT", "20200301123000-0500", time.Date(2020, 3, 1, 12, 30, 0, 0, est), PrecisionSecond, "2020-03-01T12:30:00-05:00"},
+	}
+	for _, c := range cases {
+		dt, err := ParseDateTime(c.vr, c.s, time.UTC)
+		if err != nil {
+			t.Fatalf("Fail: %s", err)
+		}
+		if !dt.Time.Equal(c.expected) || dt.Precision != c.precision {
+			t.Errorf("Fail: %s: %v %v != %v %v", c.s, dt.Time, dt.Precision, c.expected, c.precision)
+		}
+		if dt.ISO8601() != c.iso {
+			t.Errorf("Fail: %s != %s", dt.ISO8601(), c.iso)
+		}
+	}
+	for _, c := range []struct{ vr, s string }{{"DA", "20200230"}, {"DA", "2020"}, {"TM", "2500"}, {"TM", "1200.5"}, {"DT", "2020+1500"}} {
+		if _, err := ParseDateTime(c.vr, c.s, time.UTC); err == nil {
+			t.Errorf("Fail: %s should be invalid", c.s)
+		}
+	}
+	dt, _ := ParseDateTime("TM", "070101.250", time.UTC)
+	if dt.String() != "070101.25" {
+		t.Errorf("Fail: %s", dt)
+	}
+}
+
+func TestParseDateTimeRange(t *testing.T) {
+	r, err := ParseDateTimeRange("DA", "20200101-20201231", time.UTC)
+	if err != nil || r.String() != "20200101-20201231" {
+		t.Fatalf("Fail: %s, %v", r, err)
+	}
+	if !r.Contains(time.Date(2020, 12, 31, 23, 59, 0, 0, time.UTC)) || r.Contains(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Fail: %s", r)
+	}
+	r, err = ParseDateTimeRange("TM", "-1200", time.UTC)
+	if err != nil || r.From != nil || r.To.String() != "1200" {
+		t.Errorf("Fail: %s, %v", r, err)
+	}
+	r, err = ParseDateTimeRange("DT", "2019-2020-0500", time.UTC)
+	if err != nil || r.From.String() != "2019" || r.To.String() != "2020-0500" {
+		t.Errorf("Fail: %s, %v", r, err)
+	}
+	if _, err := ParseDateTimeRange("DA", "-", time.UTC); err == nil {
+		t.Errorf("Fail: expected error")
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	a, err := ParseAge("045Y")
+	if err != nil || a != (Age{45, 'Y'}) || a.String() != "045Y" {
+		t.Errorf("Fail: %v, %v", a, err)
+	}
+	birth := Age{-3, 'M'}.AddTo(time.Date(2020, 5, 31, 0, 0, 0, 0, time.UTC))
+	if !birth.Equal(time.Date(2020, 3, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Fail: %v", birth)
+	}
+	if _, err := ParseAge("45Y"); err == nil {
+		t.Errorf("Fail: expected error")
+	}
+}
@@ -0,0 +1,233 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package dicom reads DICOM files into a Dataset of Elements.
+//
+// http://dicom.nema.org/medical/dicom/current/output/html/part10.html
+package dicom
+
+import (
+	"bufio"
+	"compress/flate"
+	"io"
+)
+
+// preambleLen is the size of the File Preamble that precedes the "DICM" prefix.
+const preambleLen = 128
+
+// TransferSyntaxUIDTag is the File Meta Information element that selects the
+// encoding of the rest of the file.
+var TransferSyntaxUIDTag = Tag{0x0002, 0x0010}
+
+// Options - Controls what the Decoder reads into memory.
+// The zero value reads the whole dataset.
+type Options struct {
+	// StopAtTag stops decoding before the first top level data element with
+	// a tag greater or equal to it, e.g. PixelDataTag for header only reads.
+	// The zero Tag reads the whole dataset.
+	StopAtTag Tag
+	// LargeValueLength is the value length above which values are not read
+	// into memory. They are referenced by offset and can be read later with
+	// Element.ReadValue when the stream is an io.ReaderAt, like an os.File,
+	// otherwise they are skipped.
+	// 0 reads all values.
+	LargeValueLength uint32
+	// Lenient records the errors that can be recovered from as warnings and
+	// keeps going, see Decoder.Warnings. A truncated stream ends the dataset.
+	Lenient bool
+}
+
+// Decoder reads the data elements of a DICOM file from a stream, one top
+// level element at a time.
+type Decoder struct {
+	p       *parser
+	ts      TransferSyntax
+	started bool
+	// body is set once the File Meta Information has been read.
+	body bool
+	// metaEnd is the offset where the File Meta Information ends according
+	// to its group length, 0 when unknown.
+	metaEnd int64
+	// last is the tag of the last File Meta Information element read.
+	last Tag
+	err  error
+}
+
+// NewDecoder returns a Decoder reading from r.
+func NewDecoder(r io.Reader, opts Options) *Decoder {
+	return &Decoder{p: newParser(r, opts)}
+}
+
+// NewDatasetDecoder returns a Decoder reading a dataset encoded in the
+// transfer syntax ts, without File Preamble or File Meta Information, as
+// received in P-DATA-TF PDUs.
+// Deflated datasets are inflated, offsets are relative to the inflated data.
+func NewDatasetDecoder(r io.Reader, ts TransferSyntax, opts Options) *Decoder {
+	p := newParser(r, opts)
+	p.ts = ts
+	p.creators = map[Tag]string{}
+	if ts.Deflated {
+		p.r = bufio.NewReader(flate.NewReader(p.r))
+		p.source = nil
+	}
+	return &Decoder{p: p, ts: ts, started: true, body: true}
+}
+
+// TransferSyntax returns the transfer syntax of the dataset, known once the
+// first element after the File Meta Information has been read.
+func (d *Decoder) TransferSyntax() TransferSyntax {
+	return d.ts
+}
+
+// Warnings returns the errors recovered from in lenient mode.
+func (d *Decoder) Warnings() []*Error {
+	return d.p.warnings
+}
+
+// Next returns the next top level data element, starting with the File Meta
+// Information elements. Sequences are returned with all their items.
+// io.EOF is returned at the end of the stream or when reaching
+// Options.StopAtTag.
+//
+// The File Preamble and the "DICM" prefix are optional, and so is the File
+// Meta Information group, which is normally Explicit VR Little Endian. The
+// rest of the file is read using the Transfer Syntax UID (0002,0010). When it
+// is missing the transfer syntax is guessed from the first data element.
+// For Deflated Explicit VR Little Endian the offsets of the elements after the
+// meta group are relative to the inflated data.
+func (d *Decoder) Next() (*Element, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	e, err := d.next()
+	if err != nil {
+		d.err = err
+	}
+	return e, err
+}
+
+func (d *Decoder) next() (*Element, error) {
+	p := d.p
+	if !d.started {
+		d.started = true
+		if err := d.start(); err != nil {
+			return nil, err
+		}
+	}
+	t, err := p.peekTag()
+	if err != nil {
+		return nil, err
+	}
+	if !d.body && !d.inMeta(t) {
+		d.body = true
+		if d.ts.ByteOrder == nil {
+			d.ts = ExplicitVRLittleEndian
+			b, _ := p.r.Peek(sniffLen)
+			if ts, ok := sniffTransferSyntax(b); ok {
+				d.ts = ts
+			}
+		}
+		p.ts = d.ts
+		if p.ts.Deflated {
+			p.r = bufio.NewReader(flate.NewReader(p.r))
+			p.offset = 0
+			p.source = nil
+		}
+		t, err = p.peekTag()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if p.opts.StopAtTag != (Tag{}) && !t.less(p.opts.StopAtTag) {
+		return nil, io.EOF
+	}
+	e, err := p.parseElement(noLimit)
+	if err != nil {
+		if err, ok := err.(*Error); ok && p.fail(err) == nil {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	if !d.body {
+		d.last = e.Tag
+		if e.Tag == FileMetaInformationGroupLengthTag && len(e.Value) == 4 {
+			d.metaEnd = p.offset + int64(p.ts.ByteOrder.Uint32(e.Value))
+		}
+	}
+	if e.Tag == TransferSyntaxUIDTag {
+		d.ts = LookupTransferSyntax(string(e.Value))
+	}
+	return e, nil
+}
+
+// inMeta reports whether the next element, with tag t, belongs to the File
+// Meta Information.
+// The data of a Deflated transfer syntax can start with the same bytes as a
+// group 0002 element, the meta group then ends where its group length says,
+// or at the first element without a valid VR or out of order.
+func (d *Decoder) inMeta(t Tag) bool {
+	if t.Group != 0x0002 {
+		return false
+	}
+	if !d.ts.Deflated {
+		return true
+	}
+	if d.metaEnd > 0 {
+		return d.p.offset < d.metaEnd
+	}
+	b, _ := d.p.r.Peek(6)
+	return len(b) == 6 && vrs[string(b[4:6])] && d.last.less(t)
+}
+
+// Decode reads the remaining data elements into a Dataset.
+func (d *Decoder) Decode() (*Dataset, error) {
+	ds := &Dataset{}
+	for {
+		e, err := d.Next()
+		if err == io.EOF {
+			return ds, nil
+		}
+		if err != nil {
+			return ds, err
+		}
+		ds.Elements = append(ds.Elements, e)
+	}
+}
+
+// Parse reads a DICOM file from r and returns its Dataset, including the
+// File Meta Information elements.
+// See Decoder.Next for how the dataset is decoded.
+func Parse(r io.Reader) (*Dataset, error) {
+	return NewDecoder(r, Options{}).Decode()
+}
+
+// start skips the File Preamble and the "DICM" prefix when present and
+// guesses the encoding of the first data element, which is either the start of
+// the File Meta Information or of a raw dataset.
+func (d *Decoder) start() error {
+	p := d.p
+	p.creators = map[Tag]string{}
+	b, _ := p.r.Peek(preambleLen + 4)
+	switch {
+	case len(b) == preambleLen+4 && string(b[preambleLen:]) == "DICM":
+		p.skip(preambleLen + 4)
+	case len(b) >= 4 && string(b[:4]) == "DICM":
+		p.skip(4)
+	}
+	b, _ = p.r.Peek(sniffLen)
+	ts, ok := sniffTransferSyntax(b)
+	if !ok {
+		return p.errorf(ErrNotDICOM, p.offset, "missing DICM prefix and no data element found")
+	}
+	p.ts = ts
+	if ts.ByteOrder.Uint16(b[0:2]) != 0x0002 {
+		d.body = true
+		d.ts = ts
+	}
+	return nil
+}
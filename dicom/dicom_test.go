@@ -0,0 +1,450 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// explicitLE encodes an Explicit VR Little Endian data element.
+func explicitLE(t Tag, vr string, value []byte) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint16(b, t.Group)
+	binary.LittleEndian.PutUint16(b[2:], t.Element)
+	b = append(b, vr...)
+	if hasLongLength(vr) {
+		l := make([]byte, 6)
+		binary.LittleEndian.PutUint32(l[2:], uint32(len(value)))
+		b = append(b, l...)
+	} else {
+		l := make([]byte, 2)
+		binary.LittleEndian.PutUint16(l, uint16(len(value)))
+		b = append(b, l...)
+	}
+	return append(b, value...)
+}
+
+// header encodes a tag followed by a 32 bit length as used by items and
+// delimiters.
+func header(t Tag, l uint32) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint16(b, t.Group)
+	binary.LittleEndian.PutUint16(b[2:], t.Element)
+	binary.LittleEndian.PutUint32(b[4:], l)
+	return b
+}
+
+func part10(elements ...[]byte) []byte {
+	b := make([]byte, preambleLen)
+	b = append(b, "DICM"...)
+	for _, e := range elements {
+		b = append(b, e...)
+	}
+	return b
+}
+
+func TestParse(t *testing.T) {
+	sq := explicitLE(Tag{0x0008, 0x1140}, "SQ", nil)
+	binary.LittleEndian.PutUint32(sq[8:], UndefinedLength)
+	sq = append(sq, header(ItemTag, UndefinedLength)...)
+	sq = append(sq, explicitLE(Tag{0x0008, 0x1155}, "UI", []byte("1.2.3\x00"))...)
+	sq = append(sq, header(ItemDelimitationItemTag, 0)...)
+	sq = append(sq, header(SequenceDelimitationItemTag, 0)...)
+	b := part10(
+		explicitLE(Tag{0x0002, 0x0010}, "UI", []byte("1.2.840.10008.1.2.1\x00")),
+		explicitLE(Tag{0x0010, 0x0010}, "PN", []byte("Doe^John")),
+		sq,
+		explicitLE(Tag{0x0020, 0x0013}, "IS", []byte("1 ")),
+	)
+	ds, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(ds.Elements) != 4 {
+		t.Fatalf("Fail: %d elements", len(ds.Elements))
+	}
+	e, ok := ds.FindElement(Tag{0x0010, 0x0010})
+	if !ok || e.VR != "PN" || string(e.Value) != "Doe^John" || e.Offset != 160 {
+		t.Errorf("Fail: %v", e)
+	}
+	e, ok = ds.FindElement(Tag{0x0008, 0x1140})
+	if !ok || len(e.Items) != 1 {
+		t.Fatalf("Fail: %v", e)
+	}
+	item, ok := e.Items[0].FindElement(Tag{0x0008, 0x1155})
+	if !ok || !reflect.DeepEqual(item.Value, []byte("1.2.3\x00")) {
+		t.Errorf("Fail: %v", item)
+	}
+	e, ok = ds.FindElement(Tag{0x0020, 0x0013})
+	if !ok || string(e.Value) != "1 " {
+		t.Errorf("Fail: %v", e)
+	}
+}
+
+func TestParseMissingPrefix(t *testing.T) {
+	_, err := Parse(bytes.NewReader(make([]byte, 200)))
+	if err == nil {
+		t.Errorf("Fail: expected error")
+	}
+}
+
+// implicitLE encodes an Implicit VR Little Endian data element.
+func implicitLE(t Tag, value []byte) []byte {
+	b := header(t, uint32(len(value)))
+	return append(b, value...)
+}
+
+func TestParseImplicitVR(t *testing.T) {
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2\x00")),
+		implicitLE(Tag{0x0010, 0x0010}, []byte("Doe^John")),
+		implicitLE(Tag{0x0010, 0x0020}, []byte("123456")),
+		implicitLE(PixelRepresentationTag, []byte{1, 0}),
+		implicitLE(Tag{0x0028, 0x0106}, []byte{0xFF, 0xFF}),
+		implicitLE(Tag{0x0029, 0x1001}, []byte{1, 2}),
+	)
+	ds, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	e, ok := ds.FindElement(Tag{0x0010, 0x0020})
+	if !ok || e.VR != "LO" || string(e.Value) != "123456" {
+		t.Errorf("Fail: %v", e)
+	}
+	vrs := []string{}
+	for _, e := range ds.Elements {
+		vrs = append(vrs, e.VR)
+	}
+	if !reflect.DeepEqual(vrs, []string{"UI", "PN", "LO", "US", "SS", "UN"}) {
+		t.Errorf("Fail: %v", vrs)
+	}
+}
+
+func TestParseUN(t *testing.T) {
+	item := append(header(ItemTag, 14), implicitLE(Tag{0x0008, 0x1155}, []byte("1.2.3\x00"))...)
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.1\x00")),
+		explicitLE(Tag{0x0008, 0x1140}, "UN", item),
+		explicitLE(Tag{0x0010, 0x0020}, "UN", []byte("123456")),
+	)
+	ds, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	e, ok := ds.FindElement(Tag{0x0008, 0x1140})
+	if !ok || e.VR != "SQ" || len(e.Items) != 1 || e.Items[0].Elements[0].VR != "UI" {
+		t.Errorf("Fail: %v", e)
+	}
+	e, ok = ds.FindElement(Tag{0x0010, 0x0020})
+	if !ok || e.VR != "LO" || string(e.Value) != "123456" {
+		t.Errorf("Fail: %v", e)
+	}
+}
+
+func TestParseNestedSequences(t *testing.T) {
+	undefined := func(t Tag, b ...[]byte) []byte {
+		e := header(t, UndefinedLength)
+		for _, v := range b {
+			e = append(e, v...)
+		}
+		return e
+	}
+	// The value holds the bytes of a Sequence Delimitation Item.
+	value := []byte{0xFE, 0xFF, 0xDD, 0xE0, 0, 0, 0, 0}
+	inner := implicitLE(Tag{0x0008, 0x0100}, value)
+	defined := append(header(ItemTag, uint32(len(inner))), inner...)
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2\x00")),
+		undefined(Tag{0x0008, 0x1140},
+			undefined(ItemTag,
+				undefined(Tag{0x0040, 0xA730},
+					undefined(ItemTag, inner, header(ItemDelimitationItemTag, 0)),
+					defined,
+					header(SequenceDelimitationItemTag, 0)),
+				header(ItemDelimitationItemTag, 0)),
+			header(SequenceDelimitationItemTag, 0)),
+		implicitLE(Tag{0x0010, 0x0020}, []byte("123456")),
+	)
+	ds, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	e, ok := ds.FindElement(Tag{0x0008, 0x1140})
+	if !ok || e.VR != "SQ" || len(e.Items) != 1 {
+		t.Fatalf("Fail: %v", e)
+	}
+	e = e.Items[0].Elements[0]
+	if e.Tag != (Tag{0x0040, 0xA730}) || len(e.Items) != 2 {
+		t.Fatalf("Fail: %v", e)
+	}
+	for _, item := range e.Items {
+		if len(item.Elements) != 1 || !reflect.DeepEqual(item.Elements[0].Value, value) {
+			t.Errorf("Fail: %v", item.Elements)
+		}
+	}
+	e, ok = ds.FindElement(Tag{0x0010, 0x0020})
+	if !ok || string(e.Value) != "123456" {
+		t.Errorf("Fail: %v", e)
+	}
+}
+
+func TestParsePrivate(t *testing.T) {
+	item := implicitLE(Tag{0x0029, 0x1208}, []byte("IMAGE NUM 4 "))
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2\x00")),
+		implicitLE(Tag{0x0029, 0x0010}, []byte("SIEMENS CSA HEADER")),
+		implicitLE(Tag{0x0029, 0x0012}, []byte("UNKNOWN ")),
+		implicitLE(Tag{0x0029, 0x1008}, []byte("IMAGE NUM 4 ")),
+		implicitLE(Tag{0x0029, 0x1208}, []byte{1, 2}),
+		// Sequence items reserve their own private blocks.
+		append(header(Tag{0x0029, 0x1010}, uint32(8+len(item))), append(header(ItemTag, uint32(len(item))), item...)...),
+	)
+	ds, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	cases := []struct {
+		tag     Tag
+		vr      string
+		creator string
+	}{
+		{Tag{0x0029, 0x0010}, "LO", ""},
+		{Tag{0x0029, 0x0012}, "LO", ""},
+		{Tag{0x0029, 0x1008}, "CS", "SIEMENS CSA HEADER"},
+		{Tag{0x0029, 0x1208}, "UN", "UNKNOWN"},
+		{Tag{0x0029, 0x1010}, "OB", "SIEMENS CSA HEADER"},
+	}
+	for _, c := range cases {
+		e, ok := ds.FindElement(c.tag)
+		if !ok || e.VR != c.vr || e.Creator != c.creator {
+			t.Errorf("Fail: %v", e)
+		}
+	}
+}
+
+func TestParseDeflated(t *testing.T) {
+	var body bytes.Buffer
+	w, _ := flate.NewWriter(&body, flate.DefaultCompression)
+	w.Write(explicitLE(Tag{0x0010, 0x0020}, "LO", []byte("123456")))
+	w.Close()
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte(DeflatedExplicitVRLittleEndian.UID)),
+		body.Bytes(),
+	)
+	ds, err := Parse(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	e, ok := ds.FindElement(Tag{0x0010, 0x0020})
+	if !ok || e.VR != "LO" || string(e.Value) != "123456" {
+		t.Errorf("Fail: %v", e)
+	}
+}
+
+func TestParseDeflatedMetaLookalike(t *testing.T) {
+	// An empty fixed Huffman block and an empty stored block, their bytes
+	// read as the tag (0002,0000).
+	body := bytes.NewBuffer([]byte{0x02, 0x00, 0x00, 0x00, 0xFF, 0xFF})
+	w, _ := flate.NewWriter(body, flate.DefaultCompression)
+	w.Write(explicitLE(Tag{0x0010, 0x0020}, "LO", []byte("123456")))
+	w.Close()
+	ts := explicitLE(TransferSyntaxUIDTag, "UI", []byte(DeflatedExplicitVRLittleEndian.UID))
+	groupLength := make([]byte, 4)
+	binary.LittleEndian.PutUint32(groupLength, uint32(len(ts)))
+	tests := [][]byte{
+		part10(explicitLE(FileMetaInformationGroupLengthTag, "UL", groupLength), ts, body.Bytes()),
+		part10(ts, body.Bytes()),
+	}
+	for _, b := range tests {
+		ds, err := Parse(bytes.NewReader(b))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		e, ok := ds.FindElement(Tag{0x0010, 0x0020})
+		if !ok || e.VR != "LO" || string(e.Value) != "123456" {
+			t.Errorf("Fail: %v", e)
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	pixels := bytes.Repeat([]byte{1, 2}, 64)
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.1\x00")),
+		explicitLE(Tag{0x0010, 0x0010}, "PN", []byte("Doe^John")),
+		explicitLE(PixelDataTag, "OW", pixels),
+		explicitLE(Tag{0xFFFC, 0xFFFC}, "OB", []byte{0, 0}),
+	)
+
+	// Header only
+	ds, err := NewDecoder(bytes.NewReader(b), Options{StopAtTag: PixelDataTag}).Decode()
+	if err != nil || len(ds.Elements) != 2 {
+		t.Errorf("Fail: %v, %v", ds, err)
+	}
+
+	// Lazy values
+	ds, err = NewDecoder(bytes.NewReader(b), Options{LargeValueLength: 64}).Decode()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	e, _ := ds.FindElement(PixelDataTag)
+	if !e.Lazy || e.Value != nil || e.ValueOffset != e.Offset+12 {
+		t.Errorf("Fail: %v", e)
+	}
+	err = e.ReadValue()
+	if err != nil || e.Lazy || !reflect.DeepEqual(e.Value, pixels) {
+		t.Errorf("Fail: %v, %v", e, err)
+	}
+	e, _ = ds.FindElement(Tag{0xFFFC, 0xFFFC})
+	if e.Lazy || len(e.Value) != 2 {
+		t.Errorf("Fail: %v", e)
+	}
+
+	// Skipped values
+	ds, err = NewDecoder(io.MultiReader(bytes.NewReader(b)), Options{LargeValueLength: 64}).Decode()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	e, _ = ds.FindElement(PixelDataTag)
+	err = e.ReadValue()
+	if err == nil || !e.Lazy {
+		t.Errorf("Fail: %v", e)
+	}
+}
+
+func TestDecoderLazyFragments(t *testing.T) {
+	f1 := append([]byte{0xFF, 0xD8}, bytes.Repeat([]byte{1}, 100)...)
+	f2 := append([]byte{0xFF, 0xD8}, bytes.Repeat([]byte{2}, 100)...)
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.4.50")),
+		explicitLE(NumberOfFramesTag, "IS", []byte("2 ")),
+		encapsulated(nil, f1, f2),
+	)
+	ds, err := NewDecoder(bytes.NewReader(b), Options{LargeValueLength: 64}).Decode()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	e, _ := ds.FindElement(PixelDataTag)
+	if !e.Lazy || e.Fragments != nil {
+		t.Errorf("Fail: %v", e)
+	}
+	frame, err := ds.Frame(1)
+	if err != nil || !reflect.DeepEqual(frame, f2) {
+		t.Errorf("Fail: %v, %v", frame, err)
+	}
+}
+
+func TestParseTag(t *testing.T) {
+	for _, s := range []string{"7FE00010", "(7fe0,0010)", "PixelData"} {
+		tag, err := ParseTag(s)
+		if err != nil || tag != PixelDataTag {
+			t.Errorf("Fail: %s %v, %v", s, tag, err)
+		}
+	}
+	_, err := ParseTag("OverlayData")
+	if err == nil {
+		t.Errorf("Fail: repeating group keyword parsed")
+	}
+}
+
+// explicitBE encodes an Explicit VR Big Endian data element with a short
+// length.
+func explicitBE(t Tag, vr string, value []byte) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint16(b, t.Group)
+	binary.BigEndian.PutUint16(b[2:], t.Element)
+	copy(b[4:], vr)
+	binary.BigEndian.PutUint16(b[6:], uint16(len(value)))
+	return append(b, value...)
+}
+
+func TestDecoderSniff(t *testing.T) {
+	meta := explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2\x00"))
+	name := []byte("DOE^JOHN")
+	tests := []struct {
+		b  []byte
+		ts TransferSyntax
+	}{
+		{append(append([]byte("DICM"), meta...), implicitLE(Tag{0x0010, 0x0010}, name)...), ImplicitVRLittleEndian},
+		{part10(implicitLE(Tag{0x0010, 0x0010}, name)), ImplicitVRLittleEndian},
+		{implicitLE(Tag{0x0010, 0x0010}, name), ImplicitVRLittleEndian},
+		{explicitLE(Tag{0x0010, 0x0010}, "PN", name), ExplicitVRLittleEndian},
+		{explicitBE(Tag{0x0010, 0x0010}, "PN", name), ExplicitVRBigEndian},
+	}
+	for _, test := range tests {
+		d := NewDecoder(bytes.NewReader(test.b), Options{})
+		ds, err := d.Decode()
+		if err != nil {
+			t.Fatalf("Fail: %s", err)
+		}
+		if d.TransferSyntax() != test.ts {
+			t.Errorf("Fail: %v != %v", d.TransferSyntax(), test.ts)
+		}
+		e, ok := ds.FindElement(Tag{0x0010, 0x0010})
+		if !ok || !reflect.DeepEqual(e.Value, name) {
+			t.Errorf("Fail: %v", e)
+		}
+	}
+}
+
+func TestDecoderSniffPrivate(t *testing.T) {
+	creator := explicitLE(Tag{0x0009, 0x0010}, "LO", []byte("GEMS_IDEN_01"))
+	data := explicitLE(Tag{0x0009, 0x1001}, "LO", []byte("CT01"))
+	name := explicitLE(Tag{0x0010, 0x0010}, "PN", []byte("DOE^JOHN"))
+	implicitCreator := implicitLE(Tag{0x0009, 0x0010}, []byte("GEMS_IDEN_01"))
+	implicitName := implicitLE(Tag{0x0010, 0x0010}, []byte("DOE^JOHN"))
+	tests := []struct {
+		b  []byte
+		ts TransferSyntax
+	}{
+		{append(append(append([]byte{}, creator...), data...), name...), ExplicitVRLittleEndian},
+		{append(append([]byte{}, creator...), name...), ExplicitVRLittleEndian},
+		{append(append([]byte{}, implicitCreator...), implicitName...), ImplicitVRLittleEndian},
+	}
+	for _, test := range tests {
+		d := NewDecoder(bytes.NewReader(test.b), Options{})
+		ds, err := d.Decode()
+		if err != nil {
+			t.Fatalf("Fail: %s", err)
+		}
+		if d.TransferSyntax() != test.ts {
+			t.Errorf("Fail: %v != %v", d.TransferSyntax(), test.ts)
+		}
+		if _, ok := ds.FindElement(Tag{0x0010, 0x0010}); !ok {
+			t.Errorf("Fail: %v", ds.Elements)
+		}
+	}
+}
+
+func TestDecoderSniffNotDICOM(t *testing.T) {
+	tests := []string{
+		"// Package main is a script\npackage main\n",
+		"#!/bin/sh\necho hello\n",
+		"hello world\n",
+		"{\"00100010\": {\"vr\": \"PN\"}}\n",
+		"<?xml version=\"1.0\"?>\n<NativeDicomModel/>\n",
+		"%PDF-1.4\n%\xe2\xe3\xcf\xd3\n",
+		"",
+		// A private creator alone, without an element after it.
+		string(explicitLE(Tag{0x0009, 0x0010}, "LO", []byte("GEMS_IDEN_01"))),
+		// An odd group outside of the private creator range.
+		string(explicitLE(Tag{0x0009, 0x1001}, "LO", []byte("CT01"))) + string(explicitLE(Tag{0x0010, 0x0010}, "PN", []byte("DOE^JOHN"))),
+	}
+	for _, test := range tests {
+		_, err := Parse(bytes.NewReader([]byte(test)))
+		if e, ok := err.(*Error); !ok || e.Kind != ErrNotDICOM {
+			t.Errorf("Fail: %q: %v", test, err)
+		}
+	}
+}
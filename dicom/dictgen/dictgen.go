@@ -0,0 +1,400 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package main generates the dicom/tag and dicom/uid registries from the
+// DocBook source of PS3.6 Data Dictionary.
+//
+// http://dicom.nema.org/medical/dicom/current/source/docbook/part06/part06.xml
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/davidgamba/go-getoptions"
+)
+
+// Tables read from PS3.6.
+var (
+	elementTables = []string{"table_6-1", "table_7-1", "table_8-1"}
+	uidTable      = "table_A-1"
+	frameTable    = "table_A-2"
+)
+
+type element struct {
+	Tag     string // "GGGGEEEE" with X for masked digits.
+	Name    string
+	Keyword string
+	VR      string
+	VM      string
+	Retired bool
+}
+
+type byTag []element
+
+func (s byTag) Len() int           { return len(s) }
+func (s byTag) Less(i, j int) bool { return s[i].Tag < s[j].Tag }
+func (s byTag) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+type uid struct {
+	UID     string
+	Name    string
+	Keyword string
+	Type    string
+	Retired bool
+}
+
+// readTables returns the body rows of the given tables, each row as a list of
+// cell texts.
+func readTables(r io.Reader, ids ...string) (map[string][][]string, error) {
+	wanted := map[string]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	tables := map[string][][]string{}
+	d := xml.NewDecoder(r)
+	var table string
+	var inBody bool
+	var row []string
+	var cell *bytes.Buffer
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return tables, nil
+		}
+		if err != nil {
+			return tables, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "table":
+				for _, a := range t.Attr {
+					if a.Name.Local == "id" && wanted[a.Value] {
+						table = a.Value
+					}
+				}
+			case "tbody":
+				inBody = table != ""
+			case "tr":
+				row = []string{}
+			case "td":
+				cell = &bytes.Buffer{}
+			}
+		case xml.CharData:
+			if cell != nil {
+				cell.Write(t)
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "table":
+				table = ""
+			case "tbody":
+				inBody = false
+			case "tr":
+				if inBody {
+					tables[table] = append(tables[table], row)
+				}
+			case "td":
+				row = append(row, cleanText(cell.String()))
+				cell = nil
+			}
+		}
+	}
+}
+
+// cleanText removes the zero width spaces used to break long keywords and
+// collapses white space.
+func cleanText(s string) string {
+	s = strings.Replace(s, "\u200b", "", -1)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// parseElements converts Data Element rows into elements.
+// Rows are Tag, Name, Keyword, VR, VM and an optional RET marker.
+func parseElements(rows [][]string) ([]element, error) {
+	elements := []element{}
+	for _, r := range rows {
+		if len(r) < 5 {
+			return elements, fmt.Errorf("unexpected row: %q", r)
+		}
+		t := strings.ToUpper(strings.NewReplacer("(", "", ")", "", ",", "").Replace(r[0]))
+		if len(t) != 8 {
+			return elements, fmt.Errorf("unexpected tag: %q", r[0])
+		}
+		e := element{
+			Tag:     t,
+			Name:    r[1],
+			Keyword: r[2],
+			VR:      parseVR(r[3]),
+			VM:      r[4],
+		}
+		if len(r) > 5 && strings.HasPrefix(r[5], "RET") {
+			e.Retired = true
+		}
+		// Items and delimiters have no VR nor VM.
+		if e.VR == "" {
+			e.VM = ""
+		}
+		elements = append(elements, e)
+	}
+	return elements, nil
+}
+
+// parseVR returns vr when it is a list of VRs like "US or SS" and an empty
+// string for notes.
+func parseVR(vr string) string {
+	for _, v := range strings.Split(vr, " or ") {
+		if len(v) != 2 || strings.ToUpper(v) != v {
+			return ""
+		}
+	}
+	return vr
+}
+
+// parseUIDs converts UID rows into uids.
+// Rows are UID Value, UID Name, UID Keyword and UID Type unless uidType is
+// given.
+func parseUIDs(rows [][]string, uidType string) ([]uid, error) {
+	uids := []uid{}
+	for _, r := range rows {
+		if len(r) < 3 || (uidType == "" && len(r) < 4) {
+			return uids, fmt.Errorf("unexpected row: %q", r)
+		}
+		u := uid{UID: r[0], Name: r[1], Keyword: r[2], Type: uidType}
+		if uidType == "" {
+			u.Type = r[3]
+		}
+		if strings.HasSuffix(u.Name, "(Retired)") {
+			u.Name = strings.TrimSpace(strings.TrimSuffix(u.Name, "(Retired)"))
+			u.Retired = true
+		}
+		uids = append(uids, u)
+	}
+	return uids, nil
+}
+
+func header(source, pkg string) *bytes.Buffer {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by dictgen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(b, "package %s\n\n", pkg)
+	return b
+}
+
+// literal returns the Entry composite literal for e, without empty fields.
+func (e element) literal() string {
+	fields := []string{fmt.Sprintf("Name: %q", e.Name)}
+	if e.Keyword != "" {
+		fields = append(fields, fmt.Sprintf("Keyword: %q", e.Keyword))
+	}
+	if e.VR != "" {
+		fields = append(fields, fmt.Sprintf("VR: %q", e.VR), fmt.Sprintf("VM: %q", e.VM))
+	}
+	if e.Retired {
+		fields = append(fields, "Retired: true")
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// generateTags returns the source of the tag package registry.
+func generateTags(source string, elements []element) ([]byte, error) {
+	b := header(source, "tag")
+	fmt.Fprintln(b, `// Tag - Registry of DICOM Data Elements keyed by "GGGGEEEE".`)
+	fmt.Fprintln(b, "// PS3.6 Table 6-1, Table 7-1 and Table 8-1.")
+	fmt.Fprintln(b, "var Tag = map[string]Entry{")
+	for _, e := range elements {
+		if !strings.Contains(e.Tag, "X") {
+			fmt.Fprintf(b, "%q: %s,\n", e.Tag, e.literal())
+		}
+	}
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, `// TagRange - Data Elements of repeating groups keyed by "GGGGEEEE" where`)
+	fmt.Fprintln(b, "// X matches any hexadecimal digit.")
+	fmt.Fprintln(b, "var TagRange = map[string]Entry{")
+	for _, e := range elements {
+		if strings.Contains(e.Tag, "X") {
+			fmt.Fprintf(b, "%q: %s,\n", e.Tag, e.literal())
+		}
+	}
+	fmt.Fprintln(b, "}")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "// Keyword - Tag and TagRange keys by keyword.")
+	fmt.Fprintln(b, "var Keyword = map[string]string{")
+	for _, e := range elements {
+		if e.Keyword != "" {
+			fmt.Fprintf(b, "%q: %q,\n", e.Keyword, e.Tag)
+		}
+	}
+	fmt.Fprintln(b, "}")
+	return format.Source(b.Bytes())
+}
+
+// exported reports whether the keyword can be used as an exported constant.
+func exported(keyword string) bool {
+	return keyword != "" && unicode.IsUpper([]rune(keyword)[0])
+}
+
+// generateUIDs returns the source of the uid package registry.
+func generateUIDs(source string, uids []uid) ([]byte, error) {
+	b := header(source, "uid")
+	fmt.Fprintln(b, "// UID values by keyword.")
+	fmt.Fprintln(b, "// PS3.6 Table A-1 and Table A-2.")
+	fmt.Fprintln(b, "const (")
+	for _, u := range uids {
+		if exported(u.Keyword) {
+			fmt.Fprintf(b, "// %s %s\n", u.Keyword, u.Name)
+			fmt.Fprintf(b, "%s = %q\n", u.Keyword, u.UID)
+		}
+	}
+	fmt.Fprintln(b, ")")
+	fmt.Fprintln(b)
+	fmt.Fprintln(b, "// UID - Registry of DICOM Unique Identifiers keyed by UID value.")
+	fmt.Fprintln(b, "var UID = map[string]Entry{")
+	for _, u := range uids {
+		key := fmt.Sprintf("%q", u.UID)
+		if exported(u.Keyword) {
+			key = u.Keyword
+		}
+		fmt.Fprintf(b, "%s: {Name: %q, Keyword: %q, Type: %q", key, u.Name, u.Keyword, u.Type)
+		if u.Retired {
+			fmt.Fprint(b, ", Retired: true")
+		}
+		fmt.Fprintln(b, "},")
+	}
+	fmt.Fprintln(b, "}")
+	return format.Source(b.Bytes())
+}
+
+// open returns a reader for a local file or an http URL.
+func open(name string) (io.ReadCloser, error) {
+	if strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") {
+		resp, err := http.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("%s: %s", name, resp.Status)
+		}
+		return resp.Body, nil
+	}
+	return os.Open(name)
+}
+
+// generate writes the registries read from xmlPath to tagFile and uidFile.
+// With check set the files are left untouched and an error is returned when
+// they don't match what would be written.
+func generate(xmlPath, tagFile, uidFile string, check bool) error {
+	r, err := open(xmlPath)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	tables, err := readTables(r, append(elementTables, uidTable, frameTable)...)
+	if err != nil {
+		return err
+	}
+	elements := []element{}
+	for _, id := range elementTables {
+		e, err := parseElements(tables[id])
+		if err != nil {
+			return fmt.Errorf("%s: %s", id, err)
+		}
+		elements = append(elements, e...)
+	}
+	sort.Sort(byTag(elements))
+	uids, err := parseUIDs(tables[uidTable], "")
+	if err != nil {
+		return fmt.Errorf("%s: %s", uidTable, err)
+	}
+	frames, err := parseUIDs(tables[frameTable], "Well-known frame of reference")
+	if err != nil {
+		return fmt.Errorf("%s: %s", frameTable, err)
+	}
+	uids = append(uids, frames...)
+	if len(elements) == 0 || len(uids) == 0 {
+		return fmt.Errorf("no registry tables found in %s", xmlPath)
+	}
+
+	source := path.Base(xmlPath)
+	tagSrc, err := generateTags(source, elements)
+	if err != nil {
+		return err
+	}
+	uidSrc, err := generateUIDs(source, uids)
+	if err != nil {
+		return err
+	}
+	files := []string{tagFile, uidFile}
+	for i, src := range [][]byte{tagSrc, uidSrc} {
+		if check {
+			err = compare(files[i], src)
+		} else {
+			err = ioutil.WriteFile(files[i], src, 0644)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compare returns an error when the contents of file differ from src.
+func compare(file string, src []byte) error {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(b, src) {
+		return fmt.Errorf("%s is out of date, run go generate", file)
+	}
+	return nil
+}
+
+func synopsis() {
+	synopsis := `dictgen --xml <part06.xml|url> --tag <tag.go> --uid <uid.go> [--check]
+
+    --check  Fail when the registries differ from the generated ones, without writing them.
+`
+	fmt.Fprintln(os.Stderr, synopsis)
+}
+
+func main() {
+	var xmlPath, tagFile, uidFile string
+	var check bool
+	opt := getoptions.New()
+	opt.Bool("help", false)
+	opt.BoolVar(&check, "check", false)
+	opt.StringVar(&xmlPath, "xml", "part06.xml")
+	opt.StringVar(&tagFile, "tag", "tag/tag.go")
+	opt.StringVar(&uidFile, "uid", "uid/uid.go")
+	_, err := opt.Parse(os.Args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	if opt.Called("help") {
+		synopsis()
+		os.Exit(1)
+	}
+	if err := generate(xmlPath, tagFile, uidFile, check); err != nil {
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,147 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func readFixture(t *testing.T) map[string][][]string {
+	f, err := os.Open("testdata/part06.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	tables, err := readTables(f, append(elementTables, uidTable, frameTable)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tables
+}
+
+func TestParseElements(t *testing.T) {
+	tables := readFixture(t)
+	elements, err := parseElements(tables["table_6-1"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := map[string]element{}
+	for _, e := range elements {
+		found[e.Tag] = e
+	}
+	cases := []element{
+		{Tag: "00100010", Name: "Patient's Name", Keyword: "PatientName", VR: "PN", VM: "1"},
+		{Tag: "00100024", Name: "Issuer of Patient ID Qualifiers Sequence", Keyword: "IssuerOfPatientIDQualifiersSequence", VR: "SQ", VM: "1"},
+		{Tag: "00101000", Name: "Other Patient IDs", Keyword: "OtherPatientIDs", VR: "LO", VM: "1-n", Retired: true},
+		{Tag: "00080001", Name: "Length to End", Keyword: "LengthToEnd", VR: "UL", VM: "1", Retired: true},
+		{Tag: "00186038", Name: "Doppler Sample Volume X Position (Retired)", Keyword: "DopplerSampleVolumeXPositionRetired", VR: "UL", VM: "1", Retired: true},
+		{Tag: "00280106", Name: "Smallest Image Pixel Value", Keyword: "SmallestImagePixelValue", VR: "US or SS", VM: "1"},
+		{Tag: "60XX3000", Name: "Overlay Data", Keyword: "OverlayData", VR: "OB or OW", VM: "1"},
+		{Tag: "FFFEE000", Name: "Item", Keyword: "Item"},
+	}
+	for _, c := range cases {
+		if !reflect.DeepEqual(found[c.Tag], c) {
+			t.Errorf("Fail: %#v", found[c.Tag])
+		}
+	}
+	elements, err = parseElements(tables["table_8-1"])
+	if err != nil || len(elements) != 1 || elements[0].Keyword != "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity" {
+		t.Errorf("Fail: %#v, %v", elements, err)
+	}
+}
+
+func TestParseUIDs(t *testing.T) {
+	tables := readFixture(t)
+	uids, err := parseUIDs(tables[uidTable], "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(uids) != 5 {
+		t.Fatalf("Fail: %#v", uids)
+	}
+	u := uid{UID: "1.2.840.10008.1.2.2", Name: "Explicit VR Big Endian", Keyword: "ExplicitVRBigEndian", Type: "Transfer Syntax", Retired: true}
+	if !reflect.DeepEqual(uids[2], u) {
+		t.Errorf("Fail: %#v", uids[2])
+	}
+	frames, err := parseUIDs(tables[frameTable], "Well-known frame of reference")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u = uid{UID: "1.2.840.10008.1.4.1.1", Name: "Talairach Brain Atlas Frame of Reference", Keyword: "TalairachBrainAtlas", Type: "Well-known frame of reference"}
+	if len(frames) != 1 || !reflect.DeepEqual(frames[0], u) {
+		t.Errorf("Fail: %#v", frames)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tables := readFixture(t)
+	elements, _ := parseElements(tables["table_6-1"])
+	src, err := generateTags("part06.xml", elements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"\t\"00100010\": {Name: \"Patient's Name\", Keyword: \"PatientName\", VR: \"PN\", VM: \"1\"},\n",
+		"\t\"FFFEE000\": {Name: \"Item\", Keyword: \"Item\"},\n",
+		"var TagRange = map[string]Entry{\n\t\"1000XXX0\"",
+	} {
+		if !bytes.Contains(src, []byte(s)) {
+			t.Errorf("Fail: missing %q in\n%s", s, src)
+		}
+	}
+	uids, _ := parseUIDs(tables[uidTable], "")
+	src, err = generateUIDs("part06.xml", uids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"\tVerification = \"1.2.840.10008.1.1\"\n",
+		"\tExplicitVRBigEndian: ",
+		"\t\"1.2.840.10008.15.0.3.1\": ",
+	} {
+		if !bytes.Contains(src, []byte(s)) {
+			t.Errorf("Fail: missing %q in\n%s", s, src)
+		}
+	}
+}
+
+func TestGenerateCheck(t *testing.T) {
+	dir := t.TempDir()
+	tagFile, uidFile := dir+"/tag.go", dir+"/uid.go"
+	err := generate("testdata/part06.xml", tagFile, uidFile, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = generate("testdata/part06.xml", tagFile, uidFile, true)
+	if err != nil {
+		t.Errorf("Fail: %s", err)
+	}
+	src, _ := os.ReadFile(tagFile)
+	os.WriteFile(tagFile, bytes.Replace(src, []byte("PatientName"), []byte("PatientsName"), 1), 0644)
+	err = generate("testdata/part06.xml", tagFile, uidFile, true)
+	if err == nil || err.Error() != tagFile+" is out of date, run go generate" {
+		t.Errorf("Fail: %v", err)
+	}
+}
+
+// TestRegistries checks the committed registries against the PS3.6 edition
+// pinned in dicom/dictionary.go, given as a file or URL in DICTGEN_XML.
+func TestRegistries(t *testing.T) {
+	xmlPath := os.Getenv("DICTGEN_XML")
+	if xmlPath == "" {
+		t.Skip("DICTGEN_XML not set")
+	}
+	err := generate(xmlPath, "../tag/tag.go", "../uid/uid.go", true)
+	if err != nil {
+		t.Errorf("Fail: %s", err)
+	}
+}
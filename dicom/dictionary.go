@@ -0,0 +1,57 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom/tag"
+)
+
+// The registries are generated from a fixed edition of PS3.6, so they only
+// change when the edition is bumped here.
+//go:generate go run dictgen/dictgen.go --xml https://dicom.nema.org/medical/dicom/2024c/source/docbook/part06/part06.xml --tag tag/tag.go --uid uid/uid.go
+
+// PixelRepresentationTag - (0028,0103) selects between US and SS for elements
+// that allow both.
+var PixelRepresentationTag = Tag{0x0028, 0x0103}
+
+// lookupVR returns the VR for t from the data dictionary, UN when unknown.
+// Private elements are looked up in the dictionary of their creator.
+// Elements with more than one VR resolve as described in PS3.5 Annex A.1:
+// OW for pixel and LUT data and US or SS depending on Pixel Representation.
+func lookupVR(t Tag, creator string, pixelRepresentation uint16) string {
+	// Group Length
+	if t.Element == 0x0000 {
+		return "UL"
+	}
+	if t.isPrivateCreator() {
+		return "LO"
+	}
+	var entry tag.Entry
+	var ok bool
+	if t.IsPrivate() {
+		entry, ok = tag.LookupPrivate(creator, t.Group, t.Element)
+	} else {
+		entry, _, ok = tag.Lookup(t.Group, t.Element)
+	}
+	if !ok || entry.VR == "" {
+		return "UN"
+	}
+	options := strings.Split(entry.VR, " or ")
+	for _, vr := range options {
+		switch {
+		case vr == "OW":
+			return vr
+		case vr == "SS" && pixelRepresentation == 1:
+			return vr
+		}
+	}
+	return options[0]
+}
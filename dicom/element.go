@@ -0,0 +1,101 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// UndefinedLength is the length value used by sequences, items and
+// encapsulated pixel data whose end is marked by a delimitation item.
+const UndefinedLength uint32 = 0xFFFFFFFF
+
+// Element is a single DICOM data element.
+type Element struct {
+	Tag    Tag
+	VR     string
+	Len    uint32     // Value length as encoded, may be UndefinedLength.
+	Offset int64      // Offset of the element tag from the start of the stream.
+	Value  []byte     // Raw value bytes, empty for sequences and encapsulated pixel data.
+	Items  []*Dataset // Sequence items, only set for SQ elements.
+	// ValueOffset is the offset of the value from the start of the stream.
+	ValueOffset int64
+	// Lazy is set when the value was left out of memory, see ReadValue.
+	Lazy bool
+	// OffsetTable and Fragments are only set for encapsulated pixel data.
+	// OffsetTable holds the Basic Offset Table, empty when not encoded.
+	OffsetTable []uint32
+	Fragments   [][]byte
+	// Creator is the Private Creator that reserved the block of a private
+	// data element, empty when unknown.
+	Creator string
+	// CharacterSet of the text values, from the Specific Character Set of
+	// the dataset. nil for the default repertoire.
+	CharacterSet *CharacterSet
+	// BulkDataURI locates the value of an element read from the DICOM JSON
+	// or XML models without it.
+	BulkDataURI string
+	// byteOrder of the binary values, set by the parser.
+	byteOrder binary.ByteOrder
+	// source and valueLen locate lazy values.
+	source   io.ReaderAt
+	valueLen int64
+}
+
+// ReadValue reads a value left out of memory by Options.LargeValueLength
+// into Value, or into OffsetTable and Fragments for encapsulated pixel data.
+// It fails when the value was skipped because the stream it was read from
+// doesn't support random access.
+func (e *Element) ReadValue() error {
+	if !e.Lazy {
+		return nil
+	}
+	if e.source == nil {
+		return fmt.Errorf("%s: value at offset %d was skipped, the stream doesn't support random access", e.Tag, e.ValueOffset)
+	}
+	b := make([]byte, e.valueLen)
+	n, err := e.source.ReadAt(b, e.ValueOffset)
+	if n < len(b) {
+		return fmt.Errorf("%s: reading value at offset %d: %s", e.Tag, e.ValueOffset, err)
+	}
+	if e.Len == UndefinedLength {
+		p := newParser(bytes.NewReader(b), Options{})
+		p.ts = TransferSyntax{Explicit: true, ByteOrder: e.ByteOrder()}
+		p.offset = e.ValueOffset
+		if err := p.parseFragments(e, noLimit); err != nil {
+			return err
+		}
+	} else {
+		e.Value = b
+	}
+	e.Lazy = false
+	e.source = nil
+	return nil
+}
+
+// ValueLength returns the number of bytes the value takes in the stream it
+// was read from, including the items of encapsulated pixel data.
+func (e *Element) ValueLength() int64 {
+	if e.valueLen == 0 && e.Len != UndefinedLength {
+		return int64(e.Len)
+	}
+	return e.valueLen
+}
+
+// ByteOrder returns the byte order of the element binary values,
+// Little Endian unless the element was read from a Big Endian dataset.
+func (e *Element) ByteOrder() binary.ByteOrder {
+	if e.byteOrder == nil {
+		return binary.LittleEndian
+	}
+	return e.byteOrder
+}
@@ -0,0 +1,77 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorKind - Class of problem found while parsing.
+type ErrorKind int
+
+// Parse error kinds.
+const (
+	// ErrTruncated - The stream ends in the middle of a data element.
+	ErrTruncated ErrorKind = iota
+	// ErrInvalidVR - Explicit VR that is not in PS3.5 Table 6.2-1.
+	ErrInvalidVR
+	// ErrBadLength - Length that goes past its enclosing item or sequence.
+	ErrBadLength
+	// ErrOddLength - Odd value length, values are always even.
+	// PS3.5 Section 7.1.1
+	ErrOddLength
+	// ErrMissingDelimiter - Item or sequence of undefined length that is
+	// not closed by its delimitation item.
+	ErrMissingDelimiter
+	// ErrUnexpectedTag - Data element found where an item was expected.
+	ErrUnexpectedTag
+	// ErrNotDICOM - Stream that doesn't start with a DICOM prefix or a
+	// recognizable data element.
+	ErrNotDICOM
+	// ErrCharacterSet - Specific Character Set that is not supported, text
+	// values are read with the default repertoire.
+	ErrCharacterSet
+)
+
+var errorKindNames = map[ErrorKind]string{
+	ErrTruncated:        "truncated element",
+	ErrInvalidVR:        "invalid VR",
+	ErrBadLength:        "bad length",
+	ErrOddLength:        "odd length",
+	ErrMissingDelimiter: "missing delimiter",
+	ErrUnexpectedTag:    "unexpected tag",
+	ErrNotDICOM:         "not DICOM",
+	ErrCharacterSet:     "unknown character set",
+}
+
+func (k ErrorKind) String() string {
+	return errorKindNames[k]
+}
+
+// Error - Problem found while parsing, with the byte offset where it was
+// found and the path of tags from the top level dataset to the element at
+// fault.
+type Error struct {
+	Kind   ErrorKind
+	Offset int64
+	Path   []Tag
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	path := make([]string, len(e.Path))
+	for i, t := range e.Path {
+		path[i] = t.String()
+	}
+	if len(path) == 0 {
+		return fmt.Sprintf("%d: %s: %s", e.Offset, e.Kind, e.Msg)
+	}
+	return fmt.Sprintf("%d: %s: %s: %s", e.Offset, strings.Join(path, "."), e.Kind, e.Msg)
+}
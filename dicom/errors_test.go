@@ -0,0 +1,84 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestParseErrors(t *testing.T) {
+	ts := explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.1\x00"))
+	name := explicitLE(Tag{0x0010, 0x0010}, "PN", []byte("Doe^John"))
+	item := append(header(ItemTag, uint32(len(name))), name...)
+	huge := explicitLE(Tag{0x0009, 0x1001}, "OB", []byte("Doe^John"))
+	binary.LittleEndian.PutUint32(huge[8:], 0xFFFFFFFC)
+	cases := []struct {
+		name     string
+		b        []byte
+		expected Error
+		// elements read in lenient mode
+		elements int
+	}{
+		{"truncated", part10(ts, name[:12]),
+			Error{Kind: ErrTruncated, Offset: 172, Path: []Tag{{0x0010, 0x0010}}, Msg: "unexpected end of data, 4 bytes missing"}, 2},
+		{"invalid VR", part10(ts, explicitLE(Tag{0x0010, 0x0010}, "XX", []byte("Doe^John")), name),
+			Error{Kind: ErrInvalidVR, Offset: 164, Path: []Tag{{0x0010, 0x0010}}, Msg: "unknown VR 'XX'"}, 2},
+		{"odd length", part10(ts, explicitLE(Tag{0x0010, 0x0010}, "PN", []byte("Doe")), name),
+			Error{Kind: ErrOddLength, Offset: 160, Path: []Tag{{0x0010, 0x0010}}, Msg: "value length 3 is odd"}, 3},
+		{"bad item length", part10(ts, explicitLE(Tag{0x0008, 0x1140}, "SQ", append(header(ItemTag, 100), name...)), name),
+			Error{Kind: ErrBadLength, Offset: 180, Path: []Tag{{0x0008, 0x1140}}, Msg: "item length 100 exceeds the 16 bytes left in sequence"}, 3},
+		{"missing delimiter", part10(ts, append(explicitLE(Tag{0x0008, 0x1140}, "SQ", nil)[:8], append([]byte{0xFF, 0xFF, 0xFF, 0xFF}, item...)...)),
+			Error{Kind: ErrMissingDelimiter, Offset: 196, Path: []Tag{{0x0008, 0x1140}}, Msg: "couldn't find (FFFE,E0DD)"}, 2},
+		{"unexpected tag", part10(ts, explicitLE(Tag{0x0008, 0x1140}, "SQ", name), name),
+			Error{Kind: ErrUnexpectedTag, Offset: 172, Path: []Tag{{0x0008, 0x1140}}, Msg: "expected item, found (0010,0010)"}, 3},
+		{"huge length", part10(ts, huge),
+			Error{Kind: ErrTruncated, Offset: 180, Path: []Tag{{0x0009, 0x1001}}, Msg: "unexpected end of data, 4294967284 bytes missing"}, 2},
+	}
+	for _, c := range cases {
+		_, err := Parse(bytes.NewReader(c.b))
+		e, ok := err.(*Error)
+		if !ok || !reflect.DeepEqual(*e, c.expected) {
+			t.Errorf("Fail: %s: %v", c.name, err)
+		}
+		d := NewDecoder(bytes.NewReader(c.b), Options{Lenient: true})
+		ds, err := d.Decode()
+		if err != nil || len(ds.Elements) != c.elements || len(d.Warnings()) == 0 || !reflect.DeepEqual(*d.Warnings()[0], c.expected) {
+			t.Errorf("Fail: %s lenient: %v, %d elements, %v", c.name, err, len(ds.Elements), d.Warnings())
+		}
+	}
+}
+
+func TestParseHugeLength(t *testing.T) {
+	ts := explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.1\x00"))
+	huge := explicitLE(Tag{0x0009, 0x1001}, "OB", make([]byte, 3<<20))
+	binary.LittleEndian.PutUint32(huge[8:], 0xFFFFFFFC)
+	b := part10(ts, huge)
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := Parse(bytes.NewReader(b))
+	runtime.ReadMemStats(&after)
+	if e, ok := err.(*Error); !ok || e.Kind != ErrTruncated {
+		t.Errorf("Fail: %v", err)
+	}
+	// The value is read as it arrives, not allocated from its length.
+	if n := after.TotalAlloc - before.TotalAlloc; n > 64<<20 {
+		t.Errorf("Fail: %d bytes allocated", n)
+	}
+}
+
+func TestErrorString(t *testing.T) {
+	err := &Error{Kind: ErrTruncated, Offset: 300, Path: []Tag{{0x0008, 0x1140}, {0x0008, 0x1155}}, Msg: "unexpected end of data, 4 bytes missing"}
+	if err.Error() != "300: (0008,1140).(0008,1155): truncated element: unexpected end of data, 4 bytes missing" {
+		t.Errorf("Fail: %s", err)
+	}
+}
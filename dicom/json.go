@@ -0,0 +1,355 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonNumberVRs - VRs whose values are JSON numbers.
+// PS3.18 Table F.2.3-1
+var jsonNumberVRs = map[string]bool{
+	"DS": true, "FD": true, "FL": true, "IS": true, "SL": true, "SS": true,
+	"SV": true, "UL": true, "US": true, "UV": true,
+}
+
+// jsonBinaryVRs - VRs whose values are written as InlineBinary or
+// BulkDataURI.
+var jsonBinaryVRs = map[string]bool{
+	"OB": true, "OD": true, "OF": true, "OL": true, "OV": true, "OW": true,
+	"UN": true,
+}
+
+// jsonElement - Attribute object of the DICOM JSON Model.
+// DataFragment holds encapsulated pixel data as written by dcm4che, the
+// Basic Offset Table followed by the fragments.
+// PS3.18 Section F.2.2
+type jsonElement struct {
+	VR           string            `json:"vr"`
+	Value        []json.RawMessage `json:"Value,omitempty"`
+	InlineBinary string            `json:"InlineBinary,omitempty"`
+	BulkDataURI  string            `json:"BulkDataURI,omitempty"`
+	DataFragment []jsonFragment    `json:"DataFragment,omitempty"`
+}
+
+type jsonFragment struct {
+	InlineBinary string `json:"InlineBinary,omitempty"`
+}
+
+// jsonPersonName - PN value of the DICOM JSON Model.
+// PS3.18 Section F.2.2
+type jsonPersonName struct {
+	Alphabetic  string `json:",omitempty"`
+	Ideographic string `json:",omitempty"`
+	Phonetic    string `json:",omitempty"`
+}
+
+// JSONOptions - Controls how EncodeJSON writes binary values.
+type JSONOptions struct {
+	// BulkDataURI returns the URI written instead of the value of an OB, OD,
+	// OF, OL, OV, OW or UN element, or "" to write the value as InlineBinary.
+	BulkDataURI func(e *Element) string
+}
+
+// EncodeJSON writes ds in the DICOM JSON Model.
+// Group lengths are left out and elements keep their BulkDataURI when their
+// value wasn't read.
+// PS3.18 Annex F
+func EncodeJSON(w io.Writer, ds *Dataset, opts JSONOptions) error {
+	m, err := opts.dataset(ds)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(m)
+}
+
+// DecodeJSON reads a dataset in the DICOM JSON Model.
+// Text values are encoded with the Specific Character Set of the dataset.
+// PS3.18 Annex F
+func DecodeJSON(r io.Reader) (*Dataset, error) {
+	var b json.RawMessage
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return nil, err
+	}
+	return decodeJSONDataset(b, nil)
+}
+
+// MarshalJSON returns the dataset in the DICOM JSON Model, with binary
+// values as InlineBinary.
+func (ds *Dataset) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := EncodeJSON(&buf, ds, JSONOptions{}); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// UnmarshalJSON reads a dataset in the DICOM JSON Model.
+func (ds *Dataset) UnmarshalJSON(b []byte) error {
+	d, err := decodeJSONDataset(b, nil)
+	if err != nil {
+		return err
+	}
+	*ds = *d
+	return nil
+}
+
+// dataset returns the attribute objects of ds by tag. encoding/json sorts
+// the keys, which keeps the elements in tag order.
+func (opts JSONOptions) dataset(ds *Dataset) (map[string]jsonElement, error) {
+	m := map[string]jsonElement{}
+	for _, e := range ds.Elements {
+		if e.Tag.Element == 0x0000 {
+			continue
+		}
+		je, err := opts.element(e)
+		if err != nil {
+			return nil, err
+		}
+		m[fmt.Sprintf("%04X%04X", e.Tag.Group, e.Tag.Element)] = je
+	}
+	return m, nil
+}
+
+func (opts JSONOptions) element(e *Element) (jsonElement, error) {
+	je := jsonElement{VR: e.VR}
+	if e.BulkDataURI != "" && (e.Lazy || len(e.Value) == 0) {
+		je.BulkDataURI = e.BulkDataURI
+		return je, nil
+	}
+	if jsonBinaryVRs[e.VR] && opts.BulkDataURI != nil {
+		if uri := opts.BulkDataURI(e); uri != "" {
+			je.BulkDataURI = uri
+			return je, nil
+		}
+	}
+	if err := e.ReadValue(); err != nil {
+		return je, err
+	}
+	values := []interface{}{}
+	switch {
+	case e.VR == "SQ":
+		for _, item := range e.Items {
+			m, err := opts.dataset(item)
+			if err != nil {
+				return je, err
+			}
+			values = append(values, m)
+		}
+	case e.Fragments != nil:
+		table := make([]byte, 4*len(e.OffsetTable))
+		for i, o := range e.OffsetTable {
+			binary.LittleEndian.PutUint32(table[4*i:], o)
+		}
+		je.DataFragment = []jsonFragment{{base64.StdEncoding.EncodeToString(table)}}
+		for _, f := range e.Fragments {
+			je.DataFragment = append(je.DataFragment, jsonFragment{base64.StdEncoding.EncodeToString(f)})
+		}
+	case len(e.Value) == 0:
+	case jsonBinaryVRs[e.VR]:
+		je.InlineBinary = base64.StdEncoding.EncodeToString(swapValue(e, binary.LittleEndian))
+	case e.VR == "PN":
+		names, _ := e.PersonNames()
+		for _, pn := range names {
+			if pn.String() == "" {
+				values = append(values, nil)
+				continue
+			}
+			values = append(values, jsonPersonName{pn.Alphabetic.String(), pn.Ideographic.String(), pn.Phonetic.String()})
+		}
+	default:
+		for _, s := range modelValues(e) {
+			switch {
+			case s == "":
+				values = append(values, nil)
+			case jsonNumberVRs[e.VR]:
+				// DS and IS values like "+1" or ".5" are not JSON numbers.
+				f, err := strconv.ParseFloat(s, 64)
+				if err == nil && (e.VR == "FL" || e.VR == "FD") && (math.IsInf(f, 0) || math.IsNaN(f)) {
+					values = append(values, jsonSpecialFloat(f))
+					continue
+				}
+				if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+					return je, fmt.Errorf("%s: invalid %s value '%s'", e.Tag, e.VR, s)
+				}
+				if !json.Valid([]byte(s)) {
+					s = strconv.FormatFloat(f, 'g', -1, 64)
+				}
+				values = append(values, json.Number(s))
+			default:
+				values = append(values, s)
+			}
+		}
+	}
+	for _, v := range values {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return je, err
+		}
+		je.Value = append(je.Value, b)
+	}
+	return je, nil
+}
+
+// jsonSpecialFloat returns the string written for an FL or FD value that
+// is not a JSON number.
+// PS3.18 Section F.2.3.1
+func jsonSpecialFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case f > 0:
+		return "Infinity"
+	}
+	return "-Infinity"
+}
+
+// modelValues returns the values of an element other than SQ, PN and the
+// binary VRs as written in the DICOM JSON and XML models.
+func modelValues(e *Element) []string {
+	if e.VR == "AT" {
+		strs := []string{}
+		tags, _ := e.Tags()
+		for _, t := range tags {
+			strs = append(strs, fmt.Sprintf("%04X%04X", t.Group, t.Element))
+		}
+		return strs
+	}
+	if _, ok := binaryWidth[e.VR]; ok {
+		return strings.Split(e.ValueString(), "\\")
+	}
+	strs, _ := e.Strings()
+	return strs
+}
+
+// decodeJSONDataset reads a dataset whose text values are encoded with cs,
+// unless it has its own Specific Character Set.
+func decodeJSONDataset(b []byte, cs *CharacterSet) (*Dataset, error) {
+	m := map[string]jsonElement{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	if je, ok := m[fmt.Sprintf("%04X%04X", SpecificCharacterSetTag.Group, SpecificCharacterSetTag.Element)]; ok {
+		terms := []string{}
+		for _, raw := range je.Value {
+			var s string
+			json.Unmarshal(raw, &s)
+			terms = append(terms, s)
+		}
+		var err error
+		cs, err = NewCharacterSet(terms)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ds := &Dataset{}
+	for key, je := range m {
+		t, err := ParseTag(key)
+		if err != nil {
+			return nil, err
+		}
+		e, err := je.element(t, cs)
+		if err != nil {
+			return nil, err
+		}
+		ds.Elements = append(ds.Elements, e)
+	}
+	sort.Slice(ds.Elements, func(i, j int) bool { return ds.Elements[i].Tag.less(ds.Elements[j].Tag) })
+	for _, e := range ds.Elements {
+		e.Creator = ds.creator(e.Tag)
+	}
+	return ds, nil
+}
+
+func (je jsonElement) element(t Tag, cs *CharacterSet) (*Element, error) {
+	if !vrs[je.VR] {
+		return nil, fmt.Errorf("%s: unknown VR '%s'", t, je.VR)
+	}
+	e := &Element{Tag: t, VR: je.VR}
+	if textVRs[e.VR] {
+		e.CharacterSet = cs
+	}
+	switch {
+	case je.BulkDataURI != "":
+		e.BulkDataURI = je.BulkDataURI
+	case je.DataFragment != nil:
+		e.Len = UndefinedLength
+		e.Fragments = [][]byte{}
+		for i, f := range je.DataFragment {
+			b, err := base64.StdEncoding.DecodeString(f.InlineBinary)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", t, err)
+			}
+			if i > 0 {
+				e.Fragments = append(e.Fragments, b)
+				continue
+			}
+			for n := 0; n+4 <= len(b); n += 4 {
+				e.OffsetTable = append(e.OffsetTable, binary.LittleEndian.Uint32(b[n:]))
+			}
+		}
+	case je.InlineBinary != "":
+		b, err := base64.StdEncoding.DecodeString(je.InlineBinary)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", t, err)
+		}
+		e.Value = b
+		e.Len = uint32(len(b))
+	case e.VR == "SQ":
+		e.Len = UndefinedLength
+		for _, raw := range je.Value {
+			item, err := decodeJSONDataset(raw, cs)
+			if err != nil {
+				return nil, err
+			}
+			e.Items = append(e.Items, item)
+		}
+	case len(je.Value) > 0:
+		strs := []string{}
+		for _, raw := range je.Value {
+			var s string
+			var err error
+			switch {
+			case string(raw) == "null":
+				// A binary value can't be empty, it is left out.
+				if _, ok := binaryWidth[e.VR]; ok {
+					continue
+				}
+			case e.VR == "PN":
+				var pn jsonPersonName
+				err = json.Unmarshal(raw, &pn)
+				s = strings.TrimRight(strings.Join([]string{pn.Alphabetic, pn.Ideographic, pn.Phonetic}, "="), "=")
+			case raw[0] == '"':
+				err = json.Unmarshal(raw, &s)
+			default:
+				// Numbers keep their text, a valid DS or IS value.
+				s = string(raw)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", t, err)
+			}
+			strs = append(strs, s)
+		}
+		if err := e.SetValueString(strings.Join(strs, "\\")); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
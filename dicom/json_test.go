@@ -0,0 +1,134 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncodeJSON(t *testing.T) {
+	ds := &Dataset{Elements: []*Element{
+		{Tag: Tag{0x0008, 0x0000}, VR: "UL", Value: []byte{0, 0, 0, 0}},
+		{Tag: Tag{0x0008, 0x0005}, VR: "CS", Value: []byte("ISO_IR 100")},
+		{Tag: Tag{0x0008, 0x0060}, VR: "CS", Value: []byte("MR")},
+		{Tag: Tag{0x0010, 0x0010}, VR: "PN", Value: []byte("Buc^J\xe9r\xf4me"), CharacterSet: &CharacterSet{sets: []*charset{charsets["100"]}}},
+		{Tag: Tag{0x0018, 0x0050}, VR: "DS", Value: []byte(".5\\+2 ")},
+		{Tag: Tag{0x0020, 0x9165}, VR: "AT", Value: []byte{0x28, 0, 0x10, 0}},
+		{Tag: Tag{0x0028, 0x0010}, VR: "US", Value: []byte{2, 0}, byteOrder: binary.BigEndian},
+		{Tag: Tag{0x0040, 0x0275}, VR: "SQ", Items: []*Dataset{
+			{Elements: []*Element{{Tag: Tag{0x0040, 0x0009}, VR: "SH", Value: []byte("SPS1")}}},
+		}},
+		{Tag: Tag{0x7FE0, 0x0010}, VR: "OW", Value: []byte{0, 1, 0, 2}, byteOrder: binary.BigEndian},
+	}}
+	var buf bytes.Buffer
+	err := EncodeJSON(&buf, ds, JSONOptions{})
+	expected := `{"00080005":{"vr":"CS","Value":["ISO_IR 100"]},"00080060":{"vr":"CS","Value":["MR"]},` +
+		`"00100010":{"vr":"PN","Value":[{"Alphabetic":"Buc^Jérôme"}]},"00180050":{"vr":"DS","Value":[0.5,2]},` +
+		`"00209165":{"vr":"AT","Value":["00280010"]},"00280010":{"vr":"US","Value":[512]},` +
+		`"00400275":{"vr":"SQ","Value":[{"00400009":{"vr":"SH","Value":["SPS1"]}}]},` +
+		`"7FE00010":{"vr":"OW","InlineBinary":"AQACAA=="}}` + "\n"
+	if err != nil || buf.String() != expected {
+		t.Errorf("Fail: %s, %v", buf.String(), err)
+	}
+	buf.Reset()
+	EncodeJSON(&buf, ds, JSONOptions{BulkDataURI: func(e *Element) string { return "file:///tmp/1.dcm?offset=10&length=4" }})
+	if !strings.Contains(buf.String(), `"7FE00010":{"vr":"OW","BulkDataURI":"file:///tmp/1.dcm?offset=10&length=4"}`) {
+		t.Errorf("Fail: %s", buf.String())
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	s := `{"00080005":{"vr":"CS","Value":["ISO_IR 100"]},
+		"00100010":{"vr":"PN","Value":[{"Alphabetic":"Buc^Jérôme","Phonetic":"buc^jerome"}]},
+		"00100020":{"vr":"LO"},
+		"00180050":{"vr":"DS","Value":[0.5]},
+		"00280010":{"vr":"US","Value":[512]},
+		"00400275":{"vr":"SQ","Value":[{"00400009":{"vr":"SH","Value":["SPS1"]}}]},
+		"7FE00010":{"vr":"OB","DataFragment":[{},{"InlineBinary":"AAEC"}]}}`
+	ds, err := DecodeJSON(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	values := []string{}
+	for _, e := range ds.Elements {
+		values = append(values, e.Tag.String()+" "+string(e.Value))
+	}
+	expected := []string{"(0008,0005) ISO_IR 100", "(0010,0010) Buc^J\xe9r\xf4me==buc^jerome", "(0010,0020) ",
+		"(0018,0050) 0.5 ", "(0028,0010) \x00\x02", "(0040,0275) ", "(7FE0,0010) "}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Fail: %q", values)
+	}
+	if e, ok := ds.FindPath(Path{{Tag{0x0040, 0x0275}, 0}, {Tag{0x0040, 0x0009}, -1}}); !ok || string(e.Value) != "SPS1" {
+		t.Errorf("Fail: %v", e)
+	}
+	if e, _ := ds.FindElement(PixelDataTag); !reflect.DeepEqual(e.Fragments, [][]byte{{0, 1, 2}}) || e.Len != UndefinedLength {
+		t.Errorf("Fail: %v", e)
+	}
+	// Round trip.
+	var buf bytes.Buffer
+	EncodeJSON(&buf, ds, JSONOptions{})
+	if !strings.Contains(buf.String(), `"00100010":{"vr":"PN","Value":[{"Alphabetic":"Buc^Jérôme","Phonetic":"buc^jerome"}]}`) ||
+		!strings.Contains(buf.String(), `"7FE00010":{"vr":"OB","DataFragment":[{},{"InlineBinary":"AAEC"}]}`) {
+		t.Errorf("Fail: %s", buf.String())
+	}
+	_, err = DecodeJSON(strings.NewReader(`{"00100010":{"vr":"XX"}}`))
+	if err == nil || err.Error() != "(0010,0010): unknown VR 'XX'" {
+		t.Errorf("Fail: %v", err)
+	}
+}
+
+func TestDecodeJSONInvalidAT(t *testing.T) {
+	_, err := DecodeJSON(strings.NewReader(`{"00205000":{"vr":"AT","Value":["garbage"]}}`))
+	if err == nil || err.Error() != "(0020,5000): invalid AT value 'garbage'" {
+		t.Errorf("Fail: %v", err)
+	}
+}
+
+func TestJSONSpecialValues(t *testing.T) {
+	nan := make([]byte, 12)
+	binary.LittleEndian.PutUint32(nan[0:], math.Float32bits(float32(math.NaN())))
+	binary.LittleEndian.PutUint32(nan[4:], math.Float32bits(float32(math.Inf(1))))
+	binary.LittleEndian.PutUint32(nan[8:], math.Float32bits(1.5))
+	ds := &Dataset{Elements: []*Element{
+		{Tag: Tag{0x0018, 0x9087}, VR: "FD", Value: make([]byte, 8)},
+		{Tag: Tag{0x0070, 0x0022}, VR: "FL", Value: nan},
+	}}
+	binary.LittleEndian.PutUint64(ds.Elements[0].Value, math.Float64bits(math.Inf(-1)))
+	var buf bytes.Buffer
+	err := EncodeJSON(&buf, ds, JSONOptions{})
+	expected := `{"00189087":{"vr":"FD","Value":["-Infinity"]},"00700022":{"vr":"FL","Value":["NaN","Infinity",1.5]}}` + "\n"
+	if err != nil || buf.String() != expected {
+		t.Errorf("Fail: %s, %v", buf.String(), err)
+	}
+	got, err := DecodeJSON(&buf)
+	if err != nil || !reflect.DeepEqual(got.Elements[0].Value, ds.Elements[0].Value) || !reflect.DeepEqual(got.Elements[1].Value[4:], nan[4:]) {
+		t.Errorf("Fail: %v, %v", got, err)
+	}
+	if f, _ := got.Elements[1].Floats(); len(f) != 3 || !math.IsNaN(f[0]) {
+		t.Errorf("Fail: %v", f)
+	}
+
+	// null values of binary VRs are left out.
+	got, err = DecodeJSON(strings.NewReader(`{"00280010":{"vr":"US","Value":[null]},"00281050":{"vr":"DS","Value":[null,"40"]},"00700022":{"vr":"FL","Value":[null,2]}}`))
+	if err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	values := []string{}
+	for _, e := range got.Elements {
+		values = append(values, e.ValueString())
+	}
+	if !reflect.DeepEqual(values, []string{"", "\\40", "2"}) {
+		t.Errorf("Fail: %q", values)
+	}
+}
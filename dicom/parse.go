@@ -0,0 +1,471 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// noLimit is the limit of the top level dataset, which ends with the stream.
+const noLimit int64 = -1
+
+// parser decodes data elements from a stream using the given transfer
+// syntax.
+type parser struct {
+	r *bufio.Reader
+	// offset of the next byte of r from the start of the stream.
+	offset int64
+	// source gives random access to the stream for lazy values, nil when
+	// the stream doesn't support it.
+	source io.ReaderAt
+	ts     TransferSyntax
+	opts   Options
+	// pixelRepresentation of the dataset, used to resolve US or SS elements.
+	pixelRepresentation uint16
+	// charset of the text values in the current dataset, inherited by its
+	// sequence items.
+	charset *CharacterSet
+	// creators of the private blocks in the current dataset keyed by
+	// Tag{group, block}.
+	creators map[Tag]string
+	// path of the element being parsed.
+	path []Tag
+	// warnings are the errors recovered from in lenient mode.
+	warnings []*Error
+}
+
+func newParser(r io.Reader, opts Options) *parser {
+	p := &parser{r: bufio.NewReader(r), ts: ExplicitVRLittleEndian, opts: opts}
+	if ra, ok := r.(io.ReaderAt); ok {
+		p.source = ra
+	}
+	return p
+}
+
+// errorf returns an Error at the given offset for the element being parsed.
+func (p *parser) errorf(kind ErrorKind, offset int64, format string, a ...interface{}) *Error {
+	return &Error{
+		Kind:   kind,
+		Offset: offset,
+		Path:   append([]Tag{}, p.path...),
+		Msg:    fmt.Sprintf(format, a...),
+	}
+}
+
+// fail returns err, or records it as a warning and returns nil in lenient
+// mode so the caller can recover.
+func (p *parser) fail(err *Error) error {
+	if p.opts.Lenient {
+		p.warnings = append(p.warnings, err)
+		return nil
+	}
+	return err
+}
+
+// readChunk - Values longer than this are read into a growing buffer, the
+// length of a corrupt element shouldn't allocate more than the data there is.
+const readChunk = 1 << 20
+
+// read returns the next n bytes of the stream, or the bytes left together
+// with an ErrTruncated error.
+func (p *parser) read(n int64) ([]byte, error) {
+	if n > readChunk {
+		var buf bytes.Buffer
+		m, err := io.CopyN(&buf, p.r, n)
+		p.offset += m
+		if err != nil {
+			return buf.Bytes(), p.errorf(ErrTruncated, p.offset, "unexpected end of data, %d bytes missing", n-m)
+		}
+		return buf.Bytes(), nil
+	}
+	b := make([]byte, n)
+	m, err := io.ReadFull(p.r, b)
+	p.offset += int64(m)
+	if err != nil {
+		return b[:m], p.errorf(ErrTruncated, p.offset, "unexpected end of data, %d bytes missing", n-int64(m))
+	}
+	return b, nil
+}
+
+// skip discards the next n bytes of the stream.
+func (p *parser) skip(n int64) error {
+	m, err := io.CopyN(ioutil.Discard, p.r, n)
+	p.offset += m
+	if err != nil {
+		return p.errorf(ErrTruncated, p.offset, "unexpected end of data, %d bytes missing", n-m)
+	}
+	return nil
+}
+
+// peekTag returns the tag of the next element without consuming it.
+// io.EOF is returned when less than a tag is left in the stream.
+func (p *parser) peekTag() (Tag, error) {
+	b, err := p.r.Peek(4)
+	if len(b) < 4 {
+		if err == nil {
+			err = io.EOF
+		}
+		return Tag{}, err
+	}
+	return Tag{
+		Group:   p.ts.ByteOrder.Uint16(b[0:2]),
+		Element: p.ts.ByteOrder.Uint16(b[2:4]),
+	}, nil
+}
+
+// readItemHeader reads a tag and a 32 bit length, the header of items and
+// delimiters.
+func (p *parser) readItemHeader() (Tag, uint32, error) {
+	b, err := p.read(8)
+	if err != nil {
+		return Tag{}, 0, err
+	}
+	bo := p.ts.ByteOrder
+	return Tag{bo.Uint16(b[0:2]), bo.Uint16(b[2:4])}, bo.Uint32(b[4:8]), nil
+}
+
+// exceeds reports whether n more bytes go past limit.
+func (p *parser) exceeds(n, limit int64) bool {
+	return limit != noLimit && p.offset+n > limit
+}
+
+// parseDataset parses the data elements up to limit.
+// Items of undefined length end at their Item Delimitation Item, which is
+// consumed.
+func (p *parser) parseDataset(limit int64, undefinedLength bool) (*Dataset, error) {
+	ds := &Dataset{}
+	// Private blocks are reserved per dataset, sequence items have their own.
+	creators := p.creators
+	p.creators = map[Tag]string{}
+	charset := p.charset
+	defer func() {
+		p.creators = creators
+		p.charset = charset
+	}()
+	for limit == noLimit || p.offset+4 <= limit {
+		t, err := p.peekTag()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return ds, err
+		}
+		if undefinedLength && t == ItemDelimitationItemTag {
+			_, _, err := p.readItemHeader()
+			return ds, err
+		}
+		e, err := p.parseElement(limit)
+		if e != nil {
+			ds.Elements = append(ds.Elements, e)
+		}
+		if err != nil {
+			if err, ok := err.(*Error); ok && limit != noLimit && !undefinedLength && p.fail(err) == nil {
+				// Resume after the item.
+				return ds, p.skip(limit - p.offset)
+			}
+			return ds, err
+		}
+	}
+	if undefinedLength {
+		return ds, p.fail(p.errorf(ErrMissingDelimiter, p.offset, "couldn't find %s", ItemDelimitationItemTag))
+	}
+	if limit != noLimit && p.offset < limit {
+		// Trailing bytes shorter than a tag.
+		return ds, p.skip(limit - p.offset)
+	}
+	return ds, nil
+}
+
+// parseElement parses the next data element, which must end before limit.
+// In lenient mode errors found in the value are recorded as warnings and the
+// element is returned with the part of the value that could be read.
+func (p *parser) parseElement(limit int64) (*Element, error) {
+	if p.exceeds(8, limit) {
+		return nil, p.errorf(ErrBadLength, p.offset, "%d bytes left in item, too short for an element", limit-p.offset)
+	}
+	bo := p.ts.ByteOrder
+	e := &Element{Offset: p.offset, byteOrder: bo}
+	b, err := p.read(8)
+	if err != nil {
+		return nil, err
+	}
+	e.Tag = Tag{bo.Uint16(b[0:2]), bo.Uint16(b[2:4])}
+	p.path = append(p.path, e.Tag)
+	defer func() { p.path = p.path[:len(p.path)-1] }()
+	if e.Tag.IsPrivate() && e.Tag.Element >= 0x1000 {
+		e.Creator = p.creators[Tag{e.Tag.Group, e.Tag.Element >> 8}]
+	}
+	explicit := p.ts.Explicit
+	if explicit && !vrs[string(b[4:6])] {
+		err := p.fail(p.errorf(ErrInvalidVR, e.Offset+4, "unknown VR '%s'", b[4:6]))
+		if err != nil {
+			return nil, err
+		}
+		// Assume the element is Implicit VR, common in files written with
+		// the wrong transfer syntax.
+		explicit = false
+	}
+	if !explicit {
+		e.Len = bo.Uint32(b[4:8])
+		e.VR = lookupVR(e.Tag, e.Creator, p.pixelRepresentation)
+		// Only an undefined length identifies a sequence missing from the
+		// dictionary.
+		if e.Len == UndefinedLength && e.VR == "UN" {
+			e.VR = "SQ"
+		}
+	} else {
+		e.VR = string(b[4:6])
+		if hasLongLength(e.VR) {
+			if p.exceeds(4, limit) {
+				return nil, p.errorf(ErrBadLength, p.offset, "%d bytes left in item, too short for an element", limit-e.Offset)
+			}
+			// The 2 reserved bytes were read with the header, the 32-bit
+			// value length follows them.
+			b, err = p.read(4)
+			if err != nil {
+				return nil, err
+			}
+			e.Len = bo.Uint32(b)
+		} else {
+			e.Len = uint32(bo.Uint16(b[6:8]))
+		}
+	}
+	e.ValueOffset = p.offset
+	if e.Len != UndefinedLength && e.Len%2 == 1 {
+		if err := p.fail(p.errorf(ErrOddLength, e.Offset, "value length %d is odd", e.Len)); err != nil {
+			return nil, err
+		}
+	}
+	// Values of UN elements keep their original encoding, sequences among
+	// them are always Implicit VR Little Endian.
+	// PS3.5 Section 6.2.2
+	if e.VR == "UN" && p.ts.Explicit {
+		e.VR = lookupVR(e.Tag, e.Creator, p.pixelRepresentation)
+		if e.Len == UndefinedLength {
+			e.VR = "SQ"
+		}
+		if e.VR == "SQ" {
+			ts := p.ts
+			p.ts = ImplicitVRLittleEndian
+			defer func() { p.ts = ts }()
+		}
+	}
+	if textVRs[e.VR] {
+		e.CharacterSet = p.charset
+	}
+
+	switch {
+	case e.VR == "SQ":
+		e.Items, err = p.parseItems(limit, e.Len)
+	case e.Len == UndefinedLength:
+		err = p.parseFragments(e, limit)
+	default:
+		err = p.parseValue(e, limit)
+	}
+	if err != nil {
+		if err, ok := err.(*Error); ok && p.fail(err) == nil {
+			return e, nil
+		}
+		return nil, err
+	}
+	if e.Tag == PixelRepresentationTag && len(e.Value) == 2 {
+		p.pixelRepresentation = bo.Uint16(e.Value)
+	}
+	if e.Tag == SpecificCharacterSetTag {
+		terms, _ := e.Strings()
+		cs, err := NewCharacterSet(terms)
+		if err != nil {
+			if err := p.fail(p.errorf(ErrCharacterSet, e.Offset, "%s", err)); err != nil {
+				return nil, err
+			}
+		}
+		p.charset = cs
+	}
+	if e.Tag.isPrivateCreator() {
+		p.creators[Tag{e.Tag.Group, e.Tag.Element}] = strings.TrimRight(string(e.Value), " \x00")
+	}
+	return e, nil
+}
+
+// parseValue reads the value of e, or leaves it out of memory when it is
+// large.
+func (p *parser) parseValue(e *Element, limit int64) error {
+	l := int64(e.Len)
+	if p.exceeds(l, limit) {
+		err := p.fail(p.errorf(ErrBadLength, e.Offset, "value length %d exceeds the %d bytes left in item", e.Len, limit-p.offset))
+		if err != nil {
+			return err
+		}
+		l = limit - p.offset
+	}
+	if p.isLarge(uint32(l)) {
+		e.Lazy = true
+		e.source = p.source
+		e.valueLen = l
+		return p.skip(l)
+	}
+	var err error
+	e.Value, err = p.read(l)
+	return err
+}
+
+// isLarge reports whether a value of length l is left out of memory.
+func (p *parser) isLarge(l uint32) bool {
+	return p.opts.LargeValueLength > 0 && l > p.opts.LargeValueLength
+}
+
+// parseItems parses the items of a sequence with the given length.
+// Sequences of undefined length end at their Sequence Delimitation Item.
+// PS3.5 Section 7.5
+func (p *parser) parseItems(limit int64, length uint32) ([]*Dataset, error) {
+	items := []*Dataset{}
+	end := limit
+	if length != UndefinedLength {
+		end = p.offset + int64(length)
+		if p.exceeds(int64(length), limit) {
+			err := p.fail(p.errorf(ErrBadLength, p.offset, "sequence length %d exceeds the %d bytes left in item", length, limit-p.offset))
+			if err != nil {
+				return items, err
+			}
+			end = limit
+		}
+	}
+	for end == noLimit || p.offset+8 <= end {
+		t, err := p.peekTag()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return items, err
+		}
+		if t != ItemTag && (t != SequenceDelimitationItemTag || length != UndefinedLength) {
+			err := p.fail(p.errorf(ErrUnexpectedTag, p.offset, "expected item, found %s", t))
+			if err != nil || length == UndefinedLength {
+				// The sequence ends where the next element starts.
+				return items, err
+			}
+			return items, p.skip(end - p.offset)
+		}
+		t, l, err := p.readItemHeader()
+		if err != nil {
+			return items, err
+		}
+		if t == SequenceDelimitationItemTag {
+			return items, nil
+		}
+		var ds *Dataset
+		if l == UndefinedLength {
+			ds, err = p.parseDataset(end, true)
+		} else {
+			itemEnd := p.offset + int64(l)
+			if p.exceeds(int64(l), end) {
+				err := p.fail(p.errorf(ErrBadLength, p.offset, "item length %d exceeds the %d bytes left in sequence", l, end-p.offset))
+				if err != nil {
+					return items, err
+				}
+				itemEnd = end
+			}
+			ds, err = p.parseDataset(itemEnd, false)
+		}
+		items = append(items, ds)
+		if err != nil {
+			return items, err
+		}
+	}
+	if length == UndefinedLength {
+		return items, p.fail(p.errorf(ErrMissingDelimiter, p.offset, "couldn't find %s", SequenceDelimitationItemTag))
+	}
+	if p.offset < end {
+		return items, p.skip(end - p.offset)
+	}
+	return items, nil
+}
+
+// parseFragments reads the items of encapsulated pixel data into the Basic
+// Offset Table and Fragments of e, consuming the Sequence Delimitation Item
+// that ends them.
+// Large fragments are left out of memory, see Element.ReadValue.
+// PS3.5 Section A.4
+func (p *parser) parseFragments(e *Element, limit int64) error {
+	bo := p.ts.ByteOrder
+	lazy := false
+	fragments := [][]byte{}
+	done := func() {
+		e.valueLen = p.offset - e.ValueOffset
+		if lazy {
+			e.Lazy = true
+			e.source = p.source
+			return
+		}
+		e.Fragments = fragments
+	}
+	for i := 0; limit == noLimit || p.offset+8 <= limit; i++ {
+		t, err := p.peekTag()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if t != ItemTag && t != SequenceDelimitationItemTag {
+			done()
+			return p.fail(p.errorf(ErrUnexpectedTag, p.offset, "expected fragment, found %s", t))
+		}
+		t, l, err := p.readItemHeader()
+		if err != nil {
+			return err
+		}
+		if t == SequenceDelimitationItemTag {
+			done()
+			return nil
+		}
+		if p.exceeds(int64(l), limit) {
+			err := p.fail(p.errorf(ErrBadLength, p.offset, "fragment length %d exceeds the %d bytes left in item", l, limit-p.offset))
+			if err != nil {
+				return err
+			}
+			l = uint32(limit - p.offset)
+		}
+		if i > 0 && (lazy || p.isLarge(l)) {
+			lazy = true
+			if err := p.skip(int64(l)); err != nil {
+				return err
+			}
+			continue
+		}
+		b, err := p.read(int64(l))
+		if err != nil {
+			fragments = append(fragments, b)
+			done()
+			return err
+		}
+		if i > 0 {
+			fragments = append(fragments, b)
+			continue
+		}
+		if l%4 != 0 {
+			err := p.fail(p.errorf(ErrBadLength, p.offset-int64(l), "Basic Offset Table length %d is not a multiple of 4", l))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		e.OffsetTable = make([]uint32, 0, l/4)
+		for j := 0; j < len(b); j += 4 {
+			e.OffsetTable = append(e.OffsetTable, bo.Uint32(b[j:j+4]))
+		}
+	}
+	done()
+	return p.fail(p.errorf(ErrMissingDelimiter, p.offset, "couldn't find %s", SequenceDelimitationItemTag))
+}
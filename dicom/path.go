@@ -0,0 +1,191 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PathStep - Element of a Path, with the index of the sequence item to
+// descend into for every step but the last one.
+type PathStep struct {
+	Tag  Tag
+	Item int
+}
+
+// Path locates an element in a dataset or in the items of its sequences,
+// like "(0040,0275)[0].(0040,0009)".
+type Path []PathStep
+
+// ParsePath parses "." separated tags, in any of the forms accepted by
+// ParseTag, where every tag but the last one is followed by the "[n]" index
+// of a sequence item.
+func ParsePath(s string) (Path, error) {
+	p := Path{}
+	steps := strings.Split(s, ".")
+	for i, step := range steps {
+		ps := PathStep{Item: -1}
+		if i < len(steps)-1 {
+			open := strings.Index(step, "[")
+			if open < 0 || !strings.HasSuffix(step, "]") {
+				return nil, fmt.Errorf("invalid path '%s': missing item index after '%s'", s, step)
+			}
+			n, err := strconv.Atoi(step[open+1 : len(step)-1])
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid path '%s': invalid item index in '%s'", s, step)
+			}
+			ps.Item = n
+			step = step[:open]
+		}
+		t, err := ParseTag(step)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path '%s': %s", s, err)
+		}
+		ps.Tag = t
+		p = append(p, ps)
+	}
+	return p, nil
+}
+
+// String returns the path with tags in the (gggg,eeee) form.
+func (p Path) String() string {
+	steps := []string{}
+	for _, ps := range p {
+		s := ps.Tag.String()
+		if ps.Item >= 0 {
+			s += fmt.Sprintf("[%d]", ps.Item)
+		}
+		steps = append(steps, s)
+	}
+	return strings.Join(steps, ".")
+}
+
+// FindPath returns the element at the path.
+func (ds *Dataset) FindPath(p Path) (*Element, bool) {
+	item, ok := ds.item(p, false)
+	if !ok {
+		return nil, false
+	}
+	return item.FindElement(p[len(p)-1].Tag)
+}
+
+// Modify replaces the value of the existing element at the path with value,
+// in the form returned by ValueString.
+func (ds *Dataset) Modify(p Path, value string) error {
+	e, ok := ds.FindPath(p)
+	if !ok {
+		return fmt.Errorf("%s: element not found", p)
+	}
+	return e.SetValueString(value)
+}
+
+// Insert sets the value of the element at the path, creating it when missing
+// with the VR from the data dictionary. Missing sequences are created and
+// the item right after the last one of a sequence is appended to it.
+func (ds *Dataset) Insert(p Path, value string) error {
+	item, ok := ds.item(p, true)
+	if !ok {
+		return fmt.Errorf("%s: item not found", p)
+	}
+	t := p[len(p)-1].Tag
+	e, ok := item.FindElement(t)
+	if !ok {
+		e = &Element{Tag: t, Creator: item.creator(t)}
+		e.VR = lookupVR(t, e.Creator, 0)
+		if e.VR == "UN" {
+			return fmt.Errorf("%s: unknown VR, element not in the data dictionary", p)
+		}
+		e.CharacterSet = ds.characterSet(p)
+		item.set(e)
+	}
+	return e.SetValueString(value)
+}
+
+// Delete removes the element at the path.
+func (ds *Dataset) Delete(p Path) error {
+	item, ok := ds.item(p, false)
+	if !ok {
+		return fmt.Errorf("%s: element not found", p)
+	}
+	t := p[len(p)-1].Tag
+	for i, e := range item.Elements {
+		if e.Tag == t {
+			item.Elements = append(item.Elements[:i], item.Elements[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("%s: element not found", p)
+}
+
+// item returns the dataset holding the last element of the path.
+// With create set missing sequences and the next item of a sequence are
+// added.
+func (ds *Dataset) item(p Path, create bool) (*Dataset, bool) {
+	if len(p) == 0 {
+		return nil, false
+	}
+	for _, ps := range p[:len(p)-1] {
+		e, ok := ds.FindElement(ps.Tag)
+		if !ok {
+			if !create {
+				return nil, false
+			}
+			e = &Element{Tag: ps.Tag, VR: "SQ", Len: UndefinedLength}
+			ds.set(e)
+		}
+		if e.VR != "SQ" {
+			return nil, false
+		}
+		if create && ps.Item == len(e.Items) {
+			e.Items = append(e.Items, &Dataset{})
+		}
+		if ps.Item >= len(e.Items) {
+			return nil, false
+		}
+		ds = e.Items[ps.Item]
+	}
+	return ds, true
+}
+
+// characterSet returns the CharacterSet in effect for the last element of
+// the path, items inherit the one of their parent dataset.
+func (ds *Dataset) characterSet(p Path) *CharacterSet {
+	var cs *CharacterSet
+	for i := range p {
+		if e, ok := ds.FindElement(SpecificCharacterSetTag); ok {
+			strs, _ := e.Strings()
+			if c, err := NewCharacterSet(strs); err == nil {
+				cs = c
+			}
+		}
+		if i == len(p)-1 {
+			break
+		}
+		e, _ := ds.FindElement(p[i].Tag)
+		ds = e.Items[p[i].Item]
+	}
+	return cs
+}
+
+// creator returns the Private Creator reserving the block of the private tag
+// t in the dataset.
+func (ds *Dataset) creator(t Tag) string {
+	if !t.IsPrivate() || t.isPrivateCreator() {
+		return ""
+	}
+	if e, ok := ds.FindElement(Tag{t.Group, t.Element >> 8}); ok {
+		strs, _ := e.Strings()
+		if len(strs) > 0 {
+			return strs[0]
+		}
+	}
+	return ""
+}
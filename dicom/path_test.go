@@ -0,0 +1,68 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	p, err := ParsePath("(0040,0275)[1].ScheduledProcedureStepID")
+	expected := Path{{Tag{0x0040, 0x0275}, 1}, {Tag{0x0040, 0x0009}, -1}}
+	if err != nil || !reflect.DeepEqual(p, expected) {
+		t.Errorf("Fail: %v, %v", p, err)
+	}
+	if p.String() != "(0040,0275)[1].(0040,0009)" {
+		t.Errorf("Fail: %s", p)
+	}
+	for _, s := range []string{"(0040,0275).(0040,0009)", "(0040,0275)[x].(0040,0009)", "(0010,0020)[0]", "PatientsID"} {
+		_, err := ParsePath(s)
+		if err == nil {
+			t.Errorf("Fail: %s parsed", s)
+		}
+	}
+}
+
+func TestEditPath(t *testing.T) {
+	ds := &Dataset{Elements: []*Element{
+		{Tag: Tag{0x0010, 0x0020}, VR: "LO", Value: []byte("WRONG ")},
+	}}
+	id, _ := ParsePath("PatientID")
+	if err := ds.Modify(id, "12345"); err != nil {
+		t.Errorf("Fail: %v", err)
+	}
+	step, _ := ParsePath("(0040,0275)[0].(0040,0009)")
+	if err := ds.Modify(step, "SPS1"); err == nil {
+		t.Errorf("Fail: modified missing element")
+	}
+	if err := ds.Insert(step, "SPS1"); err != nil {
+		t.Errorf("Fail: %v", err)
+	}
+	next, _ := ParsePath("(0040,0275)[2].(0040,0009)")
+	if err := ds.Insert(next, "SPS3"); err == nil {
+		t.Errorf("Fail: inserted past the next item")
+	}
+	e, ok := ds.FindPath(step)
+	if !ok || e.VR != "SH" || string(e.Value) != "SPS1" {
+		t.Errorf("Fail: %v", e)
+	}
+	if len(ds.Elements) != 2 || ds.Elements[0].Tag != (Tag{0x0010, 0x0020}) || ds.Elements[0].ValueString() != "12345" {
+		t.Errorf("Fail: %v", ds.Elements)
+	}
+	if err := ds.Delete(id); err != nil {
+		t.Errorf("Fail: %v", err)
+	}
+	if err := ds.Delete(id); err == nil {
+		t.Errorf("Fail: deleted missing element")
+	}
+	if _, ok := ds.FindPath(id); ok {
+		t.Errorf("Fail: element not deleted")
+	}
+}
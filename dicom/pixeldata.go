@@ -0,0 +1,252 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Tags used to locate the frames of encapsulated pixel data.
+var (
+	NumberOfFramesTag      = Tag{0x0028, 0x0008}
+	ExtendedOffsetTableTag = Tag{0x7FE0, 0x0001}
+	PixelDataTag           = Tag{0x7FE0, 0x0010}
+)
+
+// Frame returns frame i, starting at 0, of the encapsulated Pixel Data of the
+// dataset. When the Pixel Data was left out of memory only the fragments of
+// that frame are read, a frame encoded in a single fragment in memory is
+// returned without copying it.
+func (ds *Dataset) Frame(i int) ([]byte, error) {
+	e, fragments, frames, err := ds.frameFragments()
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 || i >= len(frames) {
+		return nil, fmt.Errorf("frame %d out of range, found %d frames", i, len(frames))
+	}
+	return e.frame(fragments[frames[i][0]:frames[i][1]])
+}
+
+// Frames returns all the frames of the encapsulated Pixel Data of the
+// dataset.
+func (ds *Dataset) Frames() ([][]byte, error) {
+	e, fragments, frames, err := ds.frameFragments()
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]byte, len(frames))
+	for i, f := range frames {
+		out[i], err = e.frame(fragments[f[0]:f[1]])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// fragment - Encapsulated Pixel Data fragment, with the offset of its value
+// in the stream when it was left out of memory.
+type fragment struct {
+	index  int
+	offset int64
+	length int64
+	// start is set when the fragment begins with a JPEG or JPEG 2000 marker.
+	start bool
+}
+
+// frame returns the joined values of the fragments of a frame of e.
+func (e *Element) frame(fragments []fragment) ([]byte, error) {
+	if !e.Lazy {
+		if len(fragments) == 1 {
+			return e.Fragments[fragments[0].index], nil
+		}
+		values := make([][]byte, len(fragments))
+		for i, f := range fragments {
+			values[i] = e.Fragments[f.index]
+		}
+		return bytes.Join(values, nil), nil
+	}
+	var n int64
+	for _, f := range fragments {
+		n += f.length
+	}
+	b := make([]byte, n)
+	pos := b
+	for _, f := range fragments {
+		m, err := e.source.ReadAt(pos[:f.length], f.offset)
+		if int64(m) < f.length {
+			return nil, fmt.Errorf("%s: reading fragment at offset %d: %s", e.Tag, f.offset, err)
+		}
+		pos = pos[f.length:]
+	}
+	return b, nil
+}
+
+// fragments returns the Basic Offset Table and the fragments of encapsulated
+// Pixel Data. When the value was left out of memory only the item headers
+// are read.
+// PS3.5 Section A.4
+func (e *Element) fragments() ([]uint32, []fragment, error) {
+	if !e.Lazy {
+		if e.Fragments == nil {
+			return nil, nil, fmt.Errorf("Pixel Data %s is not encapsulated", PixelDataTag)
+		}
+		fragments := make([]fragment, len(e.Fragments))
+		for i, f := range e.Fragments {
+			fragments[i] = fragment{index: i, length: int64(len(f)), start: isFrameStart(f)}
+		}
+		return e.OffsetTable, fragments, nil
+	}
+	if e.Len != UndefinedLength {
+		return nil, nil, fmt.Errorf("Pixel Data %s is not encapsulated", PixelDataTag)
+	}
+	if e.source == nil {
+		return nil, nil, e.ReadValue()
+	}
+	bo := e.ByteOrder()
+	var table []uint32
+	fragments := []fragment{}
+	end := e.ValueOffset + e.valueLen
+	for pos := e.ValueOffset; pos+8 <= end; {
+		// The item header and the first bytes of the fragment.
+		h := make([]byte, 10)
+		n, err := e.source.ReadAt(h, pos)
+		if n < 8 {
+			return nil, nil, fmt.Errorf("%s: reading fragment at offset %d: %s", e.Tag, pos, err)
+		}
+		t := Tag{bo.Uint16(h[0:2]), bo.Uint16(h[2:4])}
+		l := int64(bo.Uint32(h[4:8]))
+		if t == SequenceDelimitationItemTag {
+			break
+		}
+		if t != ItemTag {
+			return nil, nil, fmt.Errorf("%s: expected fragment at offset %d, found %s", e.Tag, pos, t)
+		}
+		if pos+8+l > end {
+			return nil, nil, fmt.Errorf("%s: fragment length %d at offset %d exceeds the Pixel Data", e.Tag, l, pos)
+		}
+		if table == nil {
+			b := make([]byte, l)
+			if n, err := e.source.ReadAt(b, pos+8); int64(n) < l {
+				return nil, nil, fmt.Errorf("%s: reading Basic Offset Table at offset %d: %s", e.Tag, pos+8, err)
+			}
+			table = []uint32{}
+			for i := 0; i+4 <= len(b); i += 4 {
+				table = append(table, bo.Uint32(b[i:]))
+			}
+		} else {
+			head := h[8:n]
+			if int64(len(head)) > l {
+				head = head[:l]
+			}
+			fragments = append(fragments, fragment{index: len(fragments), offset: pos + 8, length: l, start: isFrameStart(head)})
+		}
+		pos += 8 + l
+	}
+	return table, fragments, nil
+}
+
+// frameFragments returns the Pixel Data element, its fragments and, for each
+// frame, the index of its first fragment and the index after its last one.
+// Frame boundaries come from the Extended Offset Table, the Basic Offset
+// Table, the Number of Frames or the JPEG and JPEG 2000 start markers, in that
+// order.
+// PS3.5 Section A.4
+func (ds *Dataset) frameFragments() (*Element, []fragment, [][2]int, error) {
+	e, ok := ds.FindElement(PixelDataTag)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("missing Pixel Data %s", PixelDataTag)
+	}
+	table, fragments, err := e.fragments()
+	if err != nil {
+		return e, nil, nil, err
+	}
+	// Offsets are measured from the first byte of the first fragment item
+	// tag.
+	index := map[uint64]int{}
+	var pos uint64
+	for i, f := range fragments {
+		index[pos] = i
+		pos += 8 + uint64(f.length)
+	}
+	var offsets []uint64
+	eot, ok := ds.FindElement(ExtendedOffsetTableTag)
+	if ok {
+		if err := eot.ReadValue(); err != nil {
+			return e, nil, nil, err
+		}
+	}
+	if ok && len(eot.Value) > 0 {
+		bo := eot.ByteOrder()
+		for _, v := range eot.Values() {
+			offsets = append(offsets, bo.Uint64(v))
+		}
+	} else {
+		for _, o := range table {
+			offsets = append(offsets, uint64(o))
+		}
+	}
+	frames := 1
+	if nf, ok := ds.FindElement(NumberOfFramesTag); ok {
+		ints, err := nf.Ints()
+		if err == nil && len(ints) == 1 && ints[0] > 0 {
+			frames = int(ints[0])
+		}
+	}
+	starts := []int{}
+	switch {
+	case len(offsets) > 0:
+		for _, o := range offsets {
+			i, ok := index[o]
+			if !ok {
+				return e, nil, nil, fmt.Errorf("frame offset %d doesn't start a fragment", o)
+			}
+			starts = append(starts, i)
+		}
+		frames = len(starts)
+	case frames == 1:
+		starts = append(starts, 0)
+	case frames == len(fragments):
+		for i := range fragments {
+			starts = append(starts, i)
+		}
+	default:
+		for i, f := range fragments {
+			if f.start {
+				starts = append(starts, i)
+			}
+		}
+	}
+	if len(starts) != frames {
+		return e, nil, nil, fmt.Errorf("found %d frames, expected %d", len(starts), frames)
+	}
+	if len(starts) > 0 && len(fragments) > 0 && starts[0] != 0 {
+		return e, nil, nil, fmt.Errorf("first frame starts at fragment %d", starts[0])
+	}
+	out := make([][2]int, len(starts))
+	for i, s := range starts {
+		end := len(fragments)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		if end <= s {
+			return e, nil, nil, fmt.Errorf("frame %d has no fragments", i)
+		}
+		out[i] = [2]int{s, end}
+	}
+	return e, fragments, out, nil
+}
+
+// isFrameStart reports whether the fragment begins with a JPEG Start of Image
+// or a JPEG 2000 Start of Codestream marker.
+func isFrameStart(f []byte) bool {
+	return len(f) >= 2 && f[0] == 0xFF && (f[1] == 0xD8 || f[1] == 0x4F)
+}
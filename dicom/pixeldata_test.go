@@ -0,0 +1,125 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// encapsulated encodes Pixel Data with the given Basic Offset Table and
+// fragments in Explicit VR Little Endian.
+func encapsulated(offsets []uint32, fragments ...[]byte) []byte {
+	b := []byte{0xE0, 0x7F, 0x10, 0x00, 'O', 'B', 0, 0, 0xFF, 0xFF, 0xFF, 0xFF}
+	bot := make([]byte, 4*len(offsets))
+	for i, o := range offsets {
+		binary.LittleEndian.PutUint32(bot[4*i:], o)
+	}
+	b = append(b, header(ItemTag, uint32(len(bot)))...)
+	b = append(b, bot...)
+	for _, f := range fragments {
+		b = append(b, header(ItemTag, uint32(len(f)))...)
+		b = append(b, f...)
+	}
+	return append(b, header(SequenceDelimitationItemTag, 0)...)
+}
+
+func parseFrames(t *testing.T, elements ...[]byte) *Dataset {
+	elements = append([][]byte{explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.4.50"))}, elements...)
+	ds, err := Parse(bytes.NewReader(part10(elements...)))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return ds
+}
+
+func TestFrames(t *testing.T) {
+	f1a := []byte{0xFF, 0xD8, 1, 2}
+	f1b := []byte{3, 4, 0xFF, 0xD9}
+	f2 := []byte{0xFF, 0xD8, 5, 0xFF, 0xD9, 0}
+	expected := [][]byte{{0xFF, 0xD8, 1, 2, 3, 4, 0xFF, 0xD9}, f2}
+
+	// Basic Offset Table
+	ds := parseFrames(t, encapsulated([]uint32{0, 24}, f1a, f1b, f2))
+	e, _ := ds.FindElement(PixelDataTag)
+	if !reflect.DeepEqual(e.OffsetTable, []uint32{0, 24}) || len(e.Fragments) != 3 {
+		t.Errorf("Fail: %v %v", e.OffsetTable, e.Fragments)
+	}
+	frames, err := ds.Frames()
+	if err != nil || !reflect.DeepEqual(frames, expected) {
+		t.Errorf("Fail: %v, %v", frames, err)
+	}
+
+	// Extended Offset Table
+	eot := make([]byte, 16)
+	binary.LittleEndian.PutUint64(eot[8:], 24)
+	ds = parseFrames(t,
+		explicitLE(ExtendedOffsetTableTag, "OV", eot),
+		encapsulated(nil, f1a, f1b, f2))
+	frames, err = ds.Frames()
+	if err != nil || !reflect.DeepEqual(frames, expected) {
+		t.Errorf("Fail: %v, %v", frames, err)
+	}
+
+	// JPEG markers
+	ds = parseFrames(t,
+		explicitLE(NumberOfFramesTag, "IS", []byte("2 ")),
+		encapsulated(nil, f1a, f1b, f2))
+	frame, err := ds.Frame(1)
+	if err != nil || !reflect.DeepEqual(frame, f2) {
+		t.Errorf("Fail: %v, %v", frame, err)
+	}
+	_, err = ds.Frame(2)
+	if err == nil || err.Error() != "frame 2 out of range, found 2 frames" {
+		t.Errorf("Fail: %v", err)
+	}
+
+	// Single frame in several fragments
+	ds = parseFrames(t, encapsulated(nil, f1a, f1b))
+	frame, err = ds.Frame(0)
+	if err != nil || !reflect.DeepEqual(frame, expected[0]) {
+		t.Errorf("Fail: %v, %v", frame, err)
+	}
+
+	// Fragments left out of memory
+	ts := explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2.4.50"))
+	for _, b := range [][]byte{
+		part10(ts, encapsulated([]uint32{0, 24}, f1a, f1b, f2)),
+		part10(ts, explicitLE(NumberOfFramesTag, "IS", []byte("2 ")), encapsulated(nil, f1a, f1b, f2)),
+	} {
+		ds, err = NewDecoder(bytes.NewReader(b), Options{LargeValueLength: 4}).Decode()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		frames, err = ds.Frames()
+		if err != nil || !reflect.DeepEqual(frames, expected) {
+			t.Errorf("Fail: %v, %v", frames, err)
+		}
+		frame, err = ds.Frame(1)
+		if err != nil || !reflect.DeepEqual(frame, f2) {
+			t.Errorf("Fail: %v, %v", frame, err)
+		}
+		e, _ := ds.FindElement(PixelDataTag)
+		if !e.Lazy || e.Fragments != nil {
+			t.Errorf("Fail: Pixel Data read into memory")
+		}
+		// Items and Sequence Delimitation Item after the element header.
+		if end := e.ValueOffset + e.ValueLength(); end != int64(len(b)) {
+			t.Errorf("Fail: value ends at %d of %d", end, len(b))
+		}
+	}
+
+	ds = parseFrames(t, encapsulated([]uint32{0, 20}, f1a, f1b, f2))
+	_, err = ds.Frames()
+	if err == nil || err.Error() != "frame offset 20 doesn't start a fragment" {
+		t.Errorf("Fail: %v", err)
+	}
+}
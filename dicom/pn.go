@@ -0,0 +1,150 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// PersonNameComponents - Components of one representation of a person name.
+// Field names match the elements of the PS3.19 and dcm4che XML encodings.
+type PersonNameComponents struct {
+	FamilyName string
+	GivenName  string
+	MiddleName string
+	NamePrefix string
+	NameSuffix string
+}
+
+// PersonName - PN value with its alphabetic, ideographic and phonetic
+// component groups.
+// PS3.5 Section 6.2.1
+type PersonName struct {
+	Alphabetic  PersonNameComponents
+	Ideographic PersonNameComponents
+	Phonetic    PersonNameComponents
+}
+
+// ParsePersonName splits a PN value into its "=" separated component groups
+// and "^" separated components.
+// Groups and components beyond the ones defined are ignored.
+func ParsePersonName(s string) PersonName {
+	pn := PersonName{}
+	groups := strings.SplitN(s, "=", 4)
+	for i, g := range pn.groups() {
+		if i < len(groups) {
+			*g = parseComponents(groups[i])
+		}
+	}
+	return pn
+}
+
+func parseComponents(s string) PersonNameComponents {
+	c := PersonNameComponents{}
+	parts := strings.SplitN(s, "^", 6)
+	for i, p := range c.components() {
+		if i < len(parts) {
+			*p = parts[i]
+		}
+	}
+	return c
+}
+
+func (pn *PersonName) groups() []*PersonNameComponents {
+	return []*PersonNameComponents{&pn.Alphabetic, &pn.Ideographic, &pn.Phonetic}
+}
+
+func (c *PersonNameComponents) components() []*string {
+	return []*string{&c.FamilyName, &c.GivenName, &c.MiddleName, &c.NamePrefix, &c.NameSuffix}
+}
+
+// String returns the PN value of the component group, without trailing
+// empty components.
+func (c PersonNameComponents) String() string {
+	parts := []string{}
+	for _, p := range c.components() {
+		parts = append(parts, *p)
+	}
+	return strings.TrimRight(strings.Join(parts, "^"), "^")
+}
+
+// String returns the PN value of the name, without trailing empty
+// components and component groups.
+func (pn PersonName) String() string {
+	groups := []string{}
+	for _, g := range pn.groups() {
+		groups = append(groups, g.String())
+	}
+	return strings.TrimRight(strings.Join(groups, "="), "=")
+}
+
+// Match reports whether the name matches pattern, a PN value whose
+// components may use the "*" and "?" wildcards.
+// Empty components and component groups of the pattern match any value and
+// the comparison is case insensitive.
+// PS3.4 Section C.2.2.2
+func (pn PersonName) Match(pattern string) bool {
+	p := ParsePersonName(pattern)
+	pGroups, groups := p.groups(), pn.groups()
+	for i := range groups {
+		pComponents, components := pGroups[i].components(), groups[i].components()
+		for j := range components {
+			if *pComponents[j] == "" {
+				continue
+			}
+			if !wildcardMatch(*pComponents[j], *components[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// wildcardMatch reports whether s matches pattern, where "*" matches any
+// sequence of characters and "?" a single character, ignoring case.
+func wildcardMatch(pattern, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+	// Position to retry from after the last "*".
+	star, retry := -1, 0
+	i, j := 0, 0
+	for j < len(r) {
+		switch {
+		case i < len(p) && p[i] == '*':
+			star, retry = i, j
+			i++
+		case i < len(p) && (p[i] == '?' || unicode.ToLower(p[i]) == unicode.ToLower(r[j])):
+			i++
+			j++
+		case star >= 0:
+			retry++
+			i, j = star+1, retry
+		default:
+			return false
+		}
+	}
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+	return i == len(p)
+}
+
+// PersonNames returns the values of a PN element.
+func (e *Element) PersonNames() ([]PersonName, error) {
+	if e.VR != "PN" {
+		return nil, fmt.Errorf("%s: VR %s is not a person name", e.Tag, e.VR)
+	}
+	strs, err := e.Strings()
+	names := make([]PersonName, len(strs))
+	for i, s := range strs {
+		names[i] = ParsePersonName(s)
+	}
+	return names, err
+}
@@ -0,0 +1,66 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPersonName(t *testing.T) {
+	cases := []struct {
+		s        string
+		expected PersonName
+	}{
+		{"Adams^John Robert Quincy^^Rev.^B.A. M.Div.", PersonName{
+			Alphabetic: PersonNameComponents{FamilyName: "Adams", GivenName: "John Robert Quincy", NamePrefix: "Rev.", NameSuffix: "B.A. M.Div."},
+		}},
+		{"Yamada^Tarou=山田^太郎=やまだ^たろう", PersonName{
+			Alphabetic:  PersonNameComponents{FamilyName: "Yamada", GivenName: "Tarou"},
+			Ideographic: PersonNameComponents{FamilyName: "山田", GivenName: "太郎"},
+			Phonetic:    PersonNameComponents{FamilyName: "やまだ", GivenName: "たろう"},
+		}},
+		{"=洪^吉洞", PersonName{Ideographic: PersonNameComponents{FamilyName: "洪", GivenName: "吉洞"}}},
+	}
+	for _, c := range cases {
+		pn := ParsePersonName(c.s)
+		if !reflect.DeepEqual(pn, c.expected) {
+			t.Errorf("Fail: %v != %v", pn, c.expected)
+		}
+		if pn.String() != c.s {
+			t.Errorf("Fail: %s != %s", pn, c.s)
+		}
+	}
+	if s := ParsePersonName("Doe^John^^^=").String(); s != "Doe^John" {
+		t.Errorf("Fail: %s", s)
+	}
+}
+
+func TestPersonNameMatch(t *testing.T) {
+	pn := ParsePersonName("Yamada^Tarou=山田^太郎=やまだ^たろう")
+	cases := []struct {
+		pattern  string
+		expected bool
+	}{
+		{"*", true},
+		{"yamada", true},
+		{"Yam*", true},
+		{"Yamad?^T*u", true},
+		{"*^Tarou", true},
+		{"=山田", true},
+		{"Yamada^Jiro", false},
+		{"Yam", false},
+		{"Yamada^Tarou=山*^次郎", false},
+	}
+	for _, c := range cases {
+		if pn.Match(c.pattern) != c.expected {
+			t.Errorf("Fail: %s, expected %v", c.pattern, c.expected)
+		}
+	}
+}
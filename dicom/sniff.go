@@ -0,0 +1,125 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom/tag"
+)
+
+// sniffLen - Bytes looked at to guess the transfer syntax, enough for a
+// private creator and the header of the element after it.
+const sniffLen = 256
+
+// sniffTransferSyntax guesses the encoding of a dataset from the header of
+// its first data element, b holds up to sniffLen bytes.
+// Explicit VR is recognized by a valid VR after the tag and the byte order
+// by the tag being a known, private or group length one.
+// Implicit VR Big Endian is not a DICOM transfer syntax and is not guessed.
+func sniffTransferSyntax(b []byte) (TransferSyntax, bool) {
+	for _, ts := range []TransferSyntax{ExplicitVRLittleEndian, ExplicitVRBigEndian, ImplicitVRLittleEndian} {
+		h, ok := readElementHeader(ts, b)
+		if !ok || !plausibleElement(h) {
+			continue
+		}
+		if !ts.Explicit && h.l != UndefinedLength && h.l%2 != 0 {
+			continue
+		}
+		if h.tag.IsPrivate() && !plausiblePrivate(ts, b, h) {
+			continue
+		}
+		return ts, true
+	}
+	return TransferSyntax{}, false
+}
+
+// elementHeader - Tag, VR and value length of an element, with the size n
+// of its header.
+type elementHeader struct {
+	tag Tag
+	vr  string
+	l   uint32
+	n   int
+}
+
+// readElementHeader decodes the element header at the start of b with the encoding
+// of ts. The VR is empty for Implicit VR.
+func readElementHeader(ts TransferSyntax, b []byte) (elementHeader, bool) {
+	if len(b) < 8 {
+		return elementHeader{}, false
+	}
+	bo := ts.ByteOrder
+	h := elementHeader{tag: Tag{bo.Uint16(b[0:2]), bo.Uint16(b[2:4])}, n: 8}
+	if !ts.Explicit {
+		h.l = bo.Uint32(b[4:8])
+		return h, true
+	}
+	h.vr = string(b[4:6])
+	if !vrs[h.vr] {
+		return h, false
+	}
+	if !hasLongLength(h.vr) {
+		h.l = uint32(bo.Uint16(b[6:8]))
+		return h, true
+	}
+	if len(b) < 12 {
+		return h, false
+	}
+	h.l = bo.Uint32(b[8:12])
+	h.n = 12
+	return h, true
+}
+
+// plausibleElement reports whether the tag of h is one that can start a
+// dataset. For explicit VR the VR must match the dictionary.
+// Group length elements are only accepted with their 4 byte value, this
+// rejects runs of zeros. Private groups start with their group length or a
+// private creator.
+func plausibleElement(h elementHeader) bool {
+	t := h.tag
+	if t.Element == 0x0000 {
+		return h.l == 4
+	}
+	// Groups 0001, 0003, 0005, 0007 and FFFF are not private.
+	// PS3.5 Section 7.8.1
+	if t.IsPrivate() {
+		return t.Group > 0x0008 && t.Group != 0xFFFF && t.isPrivateCreator() && (h.vr == "" || h.vr == "LO")
+	}
+	entry, _, ok := tag.Lookup(t.Group, t.Element)
+	return ok && (h.vr == "" || h.vr == "UN" || strings.Contains(entry.VR, h.vr))
+}
+
+// plausiblePrivate reports whether the private element h at the start of b
+// is followed by another element, any odd group is a private one and a
+// single tag is too weak a signal. The value of h must fit in b and a
+// private creator must be text.
+func plausiblePrivate(ts TransferSyntax, b []byte, h elementHeader) bool {
+	if h.l == UndefinedLength || int64(h.n)+int64(h.l) > int64(len(b)) {
+		return false
+	}
+	end := h.n + int(h.l)
+	if h.tag.isPrivateCreator() && strings.IndexFunc(string(b[h.n:end]), isControl) >= 0 {
+		return false
+	}
+	next, ok := readElementHeader(ts, b[end:])
+	if !ok || !h.tag.less(next.tag) {
+		return false
+	}
+	if next.tag.Group == h.tag.Group {
+		return next.l == UndefinedLength || next.l%2 == 0
+	}
+	return plausibleElement(next)
+}
+
+// isControl reports whether r is not allowed in an LO value, padding with
+// NULL is tolerated.
+func isControl(r rune) bool {
+	return r < 0x20 && r != 0x00 && r != 0x1B
+}
@@ -0,0 +1,72 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom/tag"
+)
+
+// Tag identifies a data element by its group and element numbers.
+type Tag struct {
+	Group   uint16
+	Element uint16
+}
+
+// Tags with special meaning to the parser.
+var (
+	ItemTag                     = Tag{0xFFFE, 0xE000}
+	ItemDelimitationItemTag     = Tag{0xFFFE, 0xE00D}
+	SequenceDelimitationItemTag = Tag{0xFFFE, 0xE0DD}
+)
+
+// String returns the tag in (gggg,eeee) notation.
+func (t Tag) String() string {
+	return fmt.Sprintf("(%04X,%04X)", t.Group, t.Element)
+}
+
+// ParseTag returns the tag written as "GGGGEEEE", "(gggg,eeee)" or as a
+// data dictionary keyword.
+func ParseTag(s string) (Tag, error) {
+	h := strings.NewReplacer("(", "", ")", "", ",", "").Replace(s)
+	if k, ok := tag.Keyword[s]; ok && !strings.Contains(k, "X") {
+		h = k
+	}
+	if len(h) != 8 {
+		return Tag{}, fmt.Errorf("invalid tag '%s'", s)
+	}
+	t, err := strconv.ParseUint(h, 16, 32)
+	if err != nil {
+		return Tag{}, fmt.Errorf("invalid tag '%s'", s)
+	}
+	return Tag{uint16(t >> 16), uint16(t)}, nil
+}
+
+// less reports whether t sorts before u in a dataset.
+func (t Tag) less(u Tag) bool {
+	if t.Group != u.Group {
+		return t.Group < u.Group
+	}
+	return t.Element < u.Element
+}
+
+// IsPrivate reports whether t belongs to a private (odd) group.
+func (t Tag) IsPrivate() bool {
+	return t.Group%2 == 1
+}
+
+// isPrivateCreator reports whether t is a Private Creator data element
+// (gggg,0010-00FF) reserving the private block (gggg,xx00-xxFF).
+// PS3.5 Section 7.8.1
+func (t Tag) isPrivateCreator() bool {
+	return t.IsPrivate() && t.Element >= 0x0010 && t.Element <= 0x00FF
+}
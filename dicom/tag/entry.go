@@ -0,0 +1,23 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package tag contains the DICOM data dictionary.
+//
+// tag.go is generated from PS3.6 with dictgen, see dicom/dictionary.go.
+package tag
+
+// Entry - Data dictionary entry.
+type Entry struct {
+	Name    string
+	Keyword string
+	// VR lists the possible VRs as in PS3.6, e.g. "US or SS".
+	// Empty for items and delimiters.
+	VR      string
+	VM      string
+	Retired bool
+}
@@ -0,0 +1,86 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tag
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// tagRange - TagRange key split into the bits that must match and the bits
+// that hold the repeat index.
+type tagRange struct {
+	key   string
+	mask  uint32
+	value uint32
+	// group is true for repeating groups (50xx, 60xx, 7Fxx) where only even
+	// groups are valid.
+	group bool
+}
+
+var ranges []tagRange
+
+func init() {
+	keys := make([]string, 0, len(TagRange))
+	for k := range TagRange {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		r := tagRange{key: k}
+		for i, c := range k {
+			r.mask <<= 4
+			r.value <<= 4
+			if c == 'X' {
+				if i < 4 {
+					r.group = true
+				}
+				continue
+			}
+			d, err := strconv.ParseUint(string(c), 16, 4)
+			if err != nil {
+				panic(fmt.Sprintf("tag: invalid TagRange key %q", k))
+			}
+			r.mask |= 0xF
+			r.value |= uint32(d)
+		}
+		ranges = append(ranges, r)
+	}
+}
+
+// Lookup - Returns the dictionary entry for the data element (group,element).
+// Exact matches in Tag take precedence, otherwise the masked TagRange entries
+// are tried and index holds the repeat number taken from the masked digits:
+// (6002,3000) is OverlayData with index 1 and (1000,0053) is
+// HuffmanTableTriplet with index 5.
+func Lookup(group, element uint16) (entry Entry, index int, ok bool) {
+	t := uint32(group)<<16 | uint32(element)
+	if entry, ok = Tag[fmt.Sprintf("%08X", t)]; ok {
+		return entry, 0, true
+	}
+	for _, r := range ranges {
+		if t&r.mask != r.value {
+			continue
+		}
+		if r.group && group%2 == 1 {
+			continue
+		}
+		for shift := 28; shift >= 0; shift -= 4 {
+			if r.mask>>uint(shift)&0xF == 0 {
+				index = index<<4 | int(t>>uint(shift)&0xF)
+			}
+		}
+		if r.group {
+			index /= 2
+		}
+		return TagRange[r.key], index, true
+	}
+	return Entry{}, 0, false
+}
@@ -0,0 +1,84 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tag
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	cases := []struct {
+		group, element uint16
+		keyword        string
+		index          int
+		ok             bool
+	}{
+		{0x0010, 0x0010, "PatientName", 0, true},
+		{0x6000, 0x3000, "OverlayData", 0, true},
+		{0x6002, 0x3000, "OverlayData", 1, true},
+		{0x601E, 0x0010, "OverlayRows", 15, true},
+		{0x5004, 0x3000, "CurveData", 2, true},
+		{0x7FE0, 0x0010, "PixelData", 0, true},
+		{0x7F02, 0x0010, "VariablePixelData", 1, true},
+		{0x1000, 0x0053, "HuffmanTableTriplet", 5, true},
+		{0x0020, 0x3105, "SourceImageIDs", 5, true},
+		// Odd groups are private, not overlays.
+		{0x6001, 0x3000, "", 0, false},
+		{0x0011, 0x1234, "", 0, false},
+	}
+	for _, c := range cases {
+		entry, index, ok := Lookup(c.group, c.element)
+		if entry.Keyword != c.keyword || index != c.index || ok != c.ok {
+			t.Errorf("Fail: (%04X,%04X) %s %d %v", c.group, c.element, entry.Keyword, index, ok)
+		}
+	}
+}
+
+func TestLookupPrivate(t *testing.T) {
+	entry, ok := LookupPrivate("GEMS_ACQU_01", 0x0019, 0x1004)
+	if !ok || entry != (Entry{Name: "CellSpacing", VR: "DS", VM: "1"}) {
+		t.Errorf("Fail: %v %v", entry, ok)
+	}
+	// The block number does not matter.
+	entry, ok = LookupPrivate("SIEMENS CSA HEADER", 0x0029, 0x1110)
+	if !ok || entry.Name != "CSAImageHeaderInfo" {
+		t.Errorf("Fail: %v %v", entry, ok)
+	}
+	_, ok = LookupPrivate("GEMS_ACQU_01", 0x0029, 0x1002)
+	if ok {
+		t.Errorf("Fail: found private element in the wrong group")
+	}
+}
+
+func TestLoadPrivate(t *testing.T) {
+	dict := `# Test dictionary
+(0033,"ACME 1.1",01)	LO	AcmeComment	1	PrivateTag
+(0033,"ACME 1.1",02)	xs	AcmeValue	1-n	PrivateTag
+
+(6001-o-60ff,"ACME 1.1",03)	LO	AcmeOverlay	1	PrivateTag
+`
+	err := LoadPrivate(strings.NewReader(dict))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer delete(Private, "ACME 1.1")
+	expected := map[string]Entry{
+		"0033xx01": {Name: "AcmeComment", VR: "LO", VM: "1"},
+		"0033xx02": {Name: "AcmeValue", VR: "US or SS", VM: "1-n"},
+	}
+	if !reflect.DeepEqual(Private["ACME 1.1"], expected) {
+		t.Errorf("Fail: %v", Private["ACME 1.1"])
+	}
+	err = LoadPrivate(strings.NewReader("(0033,01)\tLO\tBad\t1\n"))
+	if err == nil || err.Error() != "line 1: invalid private tag '(0033,01)'" {
+		t.Errorf("Fail: %v", err)
+	}
+}
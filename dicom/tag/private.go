@@ -0,0 +1,106 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tag
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Private - Private data element dictionaries keyed by Private Creator and
+// then by "GGGGxxEE", where EE is the element number within the private block
+// reserved by the creator.
+// These are not part of PS3.6 and are maintained by hand, more can be added
+// at runtime with LoadPrivate.
+var Private = map[string]map[string]Entry{}
+
+func init() {
+	for _, vendor := range []map[string]map[string]Entry{privateGE, privateSiemens, privatePhilips} {
+		for creator, entries := range vendor {
+			Private[creator] = entries
+		}
+	}
+}
+
+// PrivateKey - Returns the Private dictionary key for a private data element.
+func PrivateKey(group, element uint16) string {
+	return fmt.Sprintf("%04Xxx%02X", group, element&0x00FF)
+}
+
+// LookupPrivate - Returns the dictionary entry for the private data element
+// (group,element) reserved by creator.
+func LookupPrivate(creator string, group, element uint16) (Entry, bool) {
+	entry, ok := Private[creator][PrivateKey(group, element)]
+	return entry, ok
+}
+
+// privateVR - DCMTK dictionary VRs for elements that allow more than one.
+var privateVR = map[string]string{
+	"ox": "OB or OW",
+	"xs": "US or SS",
+	"lt": "US or SS or OW",
+	"up": "UL",
+}
+
+// LoadPrivate - Adds the entries of a DCMTK style private dictionary to
+// Private.
+// Each line holds the tab separated tag, VR, name and VM:
+//
+//	(0019,"GEMS_ACQU_01",02)	SL	NumberOfCellsInDetector	1	PrivateTag
+//
+// Lines starting with # are comments. Entries for group ranges are skipped.
+func LoadPrivate(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		s := strings.TrimSpace(scanner.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+		fields := strings.Split(s, "\t")
+		if len(fields) < 4 {
+			return fmt.Errorf("line %d: expected tag, VR, name and VM: '%s'", line, s)
+		}
+		t := strings.TrimSpace(fields[0])
+		first, last := strings.Index(t, ","), strings.LastIndex(t, ",")
+		if !strings.HasPrefix(t, "(") || !strings.HasSuffix(t, ")") || first == last {
+			return fmt.Errorf("line %d: invalid private tag '%s'", line, t)
+		}
+		g := t[1:first]
+		if strings.Contains(g, "-") {
+			continue
+		}
+		group, err := strconv.ParseUint(g, 16, 16)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid group '%s'", line, g)
+		}
+		element, err := strconv.ParseUint(t[last+1:len(t)-1], 16, 16)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid element '%s'", line, t[last+1:len(t)-1])
+		}
+		creator := strings.Trim(t[first+1:last], `"`)
+		vr := strings.TrimSpace(fields[1])
+		if v, ok := privateVR[vr]; ok {
+			vr = v
+		}
+		if _, ok := Private[creator]; !ok {
+			Private[creator] = map[string]Entry{}
+		}
+		Private[creator][PrivateKey(uint16(group), uint16(element))] = Entry{
+			Name: strings.TrimSpace(fields[2]),
+			VR:   vr,
+			VM:   strings.TrimSpace(fields[3]),
+		}
+	}
+	return scanner.Err()
+}
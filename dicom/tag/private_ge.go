@@ -0,0 +1,397 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tag
+
+// privateGE - GE Medical Systems private data elements, with the VR and VM
+// listed in the GE CT and MR DICOM Conformance Statements.
+var privateGE = map[string]map[string]Entry{
+	"GEMS_IDEN_01": {
+		"0009xx01": {Name: "FullFidelity", VR: "LO", VM: "1"},
+		"0009xx02": {Name: "SuiteID", VR: "SH", VM: "1"},
+		"0009xx04": {Name: "ProductID", VR: "SH", VM: "1"},
+		"0009xx27": {Name: "ImageActualDate", VR: "SL", VM: "1"},
+		"0009xx30": {Name: "ServiceID", VR: "SH", VM: "1"},
+		"0009xx31": {Name: "MobileLocationNumber", VR: "SH", VM: "1"},
+		"0009xxE3": {Name: "EquipmentUID", VR: "UI", VM: "1"},
+		"0009xxE6": {Name: "GenesisVersionNow", VR: "SH", VM: "1"},
+		"0009xxE7": {Name: "ExamRecordChecksum", VR: "UL", VM: "1"},
+		"0009xxE9": {Name: "ActualSeriesDateTimeStamp", VR: "SL", VM: "1"},
+	},
+	"GEMS_PATI_01": {
+		"0011xx10": {Name: "PatientStatus", VR: "SS", VM: "1"},
+	},
+	"GEMS_ACQU_01": {
+		"0019xx02": {Name: "NumberOfCellsInDetector", VR: "SL", VM: "1"},
+		"0019xx03": {Name: "CellNumberAtTheta", VR: "DS", VM: "1"},
+		"0019xx04": {Name: "CellSpacing", VR: "DS", VM: "1"},
+		"0019xx0F": {Name: "HorizFrameOfRef", VR: "DS", VM: "1"},
+		"0019xx11": {Name: "SeriesContrast", VR: "SS", VM: "1"},
+		"0019xx12": {Name: "LastPseq", VR: "SS", VM: "1"},
+		"0019xx13": {Name: "StartNumberForBaseline", VR: "SS", VM: "1"},
+		"0019xx14": {Name: "EndNumberForBaseline", VR: "SS", VM: "1"},
+		"0019xx15": {Name: "StartNumberForEnhancedScans", VR: "SS", VM: "1"},
+		"0019xx16": {Name: "EndNumberForEnhancedScans", VR: "SS", VM: "1"},
+		"0019xx17": {Name: "SeriesPlane", VR: "SS", VM: "1"},
+		"0019xx18": {Name: "FirstScanRas", VR: "LO", VM: "1"},
+		"0019xx19": {Name: "FirstScanLocation", VR: "DS", VM: "1"},
+		"0019xx1A": {Name: "LastScanRas", VR: "LO", VM: "1"},
+		"0019xx1B": {Name: "LastScanLocation", VR: "DS", VM: "1"},
+		"0019xx1E": {Name: "DisplayFieldOfView", VR: "DS", VM: "1"},
+		"0019xx23": {Name: "TableSpeed", VR: "DS", VM: "1"},
+		"0019xx24": {Name: "MidScanTime", VR: "DS", VM: "1"},
+		"0019xx25": {Name: "MidScanFlag", VR: "SS", VM: "1"},
+		"0019xx26": {Name: "DegreesOfAzimuth", VR: "SL", VM: "1"},
+		"0019xx27": {Name: "GantryPeriod", VR: "DS", VM: "1"},
+		"0019xx2A": {Name: "XRayOnPosition", VR: "DS", VM: "1"},
+		"0019xx2B": {Name: "XRayOffPosition", VR: "DS", VM: "1"},
+		"0019xx2C": {Name: "NumberOfTriggers", VR: "SL", VM: "1"},
+		"0019xx2E": {Name: "AngleOfFirstView", VR: "DS", VM: "1"},
+		"0019xx2F": {Name: "TriggerFrequency", VR: "DS", VM: "1"},
+		"0019xx39": {Name: "ScanFOVType", VR: "SS", VM: "1"},
+		"0019xx40": {Name: "StatReconFlag", VR: "SS", VM: "1"},
+		"0019xx41": {Name: "ComputeType", VR: "SS", VM: "1"},
+		"0019xx42": {Name: "SegmentNumber", VR: "SS", VM: "1"},
+		"0019xx43": {Name: "TotalSegmentsRequested", VR: "SS", VM: "1"},
+		"0019xx44": {Name: "InterscanDelay", VR: "DS", VM: "1"},
+		"0019xx47": {Name: "ViewCompressionFactor", VR: "SS", VM: "1"},
+		"0019xx4A": {Name: "TotalNumberOfRefChannels", VR: "SS", VM: "1"},
+		"0019xx4B": {Name: "DataSizeForScanData", VR: "SL", VM: "1"},
+		"0019xx52": {Name: "ReconPostProcFlag", VR: "SS", VM: "1"},
+		"0019xx57": {Name: "CTWaterNumber", VR: "SS", VM: "1"},
+		"0019xx58": {Name: "CTBoneNumber", VR: "SS", VM: "1"},
+		"0019xx5A": {Name: "AcquisitionDuration", VR: "FL", VM: "1"},
+		"0019xx5E": {Name: "NumberOfChannels", VR: "SL", VM: "1"},
+		"0019xx5F": {Name: "IncrementBetweenChannels", VR: "DS", VM: "1"},
+		"0019xx60": {Name: "StartingView", VR: "SL", VM: "1"},
+		"0019xx61": {Name: "NumberOfViews", VR: "SL", VM: "1"},
+		"0019xx62": {Name: "IncrementBetweenViews", VR: "SL", VM: "1"},
+		"0019xx6A": {Name: "DependentOnNumberOfViewsProcessed", VR: "SS", VM: "1"},
+		"0019xx6B": {Name: "FieldOfViewInDetectorCells", VR: "SS", VM: "1"},
+		"0019xx70": {Name: "ValueOfBackProjectionButton", VR: "SS", VM: "1"},
+		"0019xx71": {Name: "SetIfFatqEstimatesWereUsed", VR: "SS", VM: "1"},
+		"0019xx72": {Name: "ZChanAvgOverViews", VR: "DS", VM: "1"},
+		"0019xx73": {Name: "AvgOfLeftRefChansOverViews", VR: "DS", VM: "1"},
+		"0019xx74": {Name: "MaxLeftChanOverViews", VR: "DS", VM: "1"},
+		"0019xx75": {Name: "AvgOfRightRefChansOverViews", VR: "DS", VM: "1"},
+		"0019xx76": {Name: "MaxRightChanOverViews", VR: "DS", VM: "1"},
+		"0019xx7D": {Name: "SecondEcho", VR: "DS", VM: "1"},
+		"0019xx7E": {Name: "NumberOfEchoes", VR: "SS", VM: "1"},
+		"0019xx7F": {Name: "TableDelta", VR: "DS", VM: "1"},
+		"0019xx81": {Name: "Contiguous", VR: "SS", VM: "1"},
+		"0019xx84": {Name: "PeakSAR", VR: "DS", VM: "1"},
+		"0019xx85": {Name: "MonitorSAR", VR: "SS", VM: "1"},
+		"0019xx87": {Name: "CardiacRepetitionTime", VR: "DS", VM: "1"},
+		"0019xx88": {Name: "ImagesPerCardiacCycle", VR: "SS", VM: "1"},
+		"0019xx8A": {Name: "ActualReceiveGainAnalog", VR: "SS", VM: "1"},
+		"0019xx8B": {Name: "ActualReceiveGainDigital", VR: "SS", VM: "1"},
+		"0019xx8D": {Name: "DelayAfterTrigger", VR: "DS", VM: "1"},
+		"0019xx8F": {Name: "SwapPhaseFrequency", VR: "SS", VM: "1"},
+		"0019xx90": {Name: "PauseInterval", VR: "SS", VM: "1"},
+		"0019xx91": {Name: "PulseTime", VR: "DS", VM: "1"},
+		"0019xx92": {Name: "SliceOffsetOnFreqAxis", VR: "SL", VM: "1"},
+		"0019xx93": {Name: "CenterFrequency", VR: "DS", VM: "1"},
+		"0019xx94": {Name: "TransmitGain", VR: "SS", VM: "1"},
+		"0019xx95": {Name: "AnalogReceiverGain", VR: "SS", VM: "1"},
+		"0019xx96": {Name: "DigitalReceiverGain", VR: "SS", VM: "1"},
+		"0019xx97": {Name: "BitmapDefiningCVs", VR: "SL", VM: "1"},
+		"0019xx98": {Name: "CenterFreqMethod", VR: "SS", VM: "1"},
+		"0019xx9B": {Name: "PulseSeqMode", VR: "SS", VM: "1"},
+		"0019xx9C": {Name: "PulseSeqName", VR: "LO", VM: "1"},
+		"0019xx9D": {Name: "PulseSeqDate", VR: "DT", VM: "1"},
+		"0019xx9E": {Name: "InternalPulseSeqName", VR: "LO", VM: "1"},
+		"0019xx9F": {Name: "TransmittingCoil", VR: "SS", VM: "1"},
+		"0019xxA0": {Name: "SurfaceCoilType", VR: "SS", VM: "1"},
+		"0019xxA1": {Name: "ExtremityCoilFlag", VR: "SS", VM: "1"},
+		"0019xxA2": {Name: "RawDataRunNumber", VR: "SL", VM: "1"},
+		"0019xxA3": {Name: "CalibratedFieldStrength", VR: "UL", VM: "1"},
+		"0019xxA4": {Name: "SATFatWaterBone", VR: "SS", VM: "1"},
+		"0019xxA5": {Name: "ReceiveBandwidth", VR: "DS", VM: "1"},
+		"0019xxA7": {Name: "UserData01", VR: "DS", VM: "1"},
+		"0019xxA8": {Name: "UserData02", VR: "DS", VM: "1"},
+		"0019xxA9": {Name: "UserData03", VR: "DS", VM: "1"},
+		"0019xxAA": {Name: "UserData04", VR: "DS", VM: "1"},
+		"0019xxAB": {Name: "UserData05", VR: "DS", VM: "1"},
+		"0019xxAC": {Name: "UserData06", VR: "DS", VM: "1"},
+		"0019xxAD": {Name: "UserData07", VR: "DS", VM: "1"},
+		"0019xxAE": {Name: "UserData08", VR: "DS", VM: "1"},
+		"0019xxAF": {Name: "UserData09", VR: "DS", VM: "1"},
+		"0019xxB0": {Name: "UserData10", VR: "DS", VM: "1"},
+		"0019xxB1": {Name: "UserData11", VR: "DS", VM: "1"},
+		"0019xxB2": {Name: "UserData12", VR: "DS", VM: "1"},
+		"0019xxB3": {Name: "UserData13", VR: "DS", VM: "1"},
+		"0019xxB4": {Name: "UserData14", VR: "DS", VM: "1"},
+		"0019xxB5": {Name: "UserData15", VR: "DS", VM: "1"},
+		"0019xxB6": {Name: "UserData16", VR: "DS", VM: "1"},
+		"0019xxB7": {Name: "UserData17", VR: "DS", VM: "1"},
+		"0019xxB8": {Name: "UserData18", VR: "DS", VM: "1"},
+		"0019xxB9": {Name: "UserData19", VR: "DS", VM: "1"},
+		"0019xxBA": {Name: "UserData20", VR: "DS", VM: "1"},
+		"0019xxBB": {Name: "UserData21", VR: "DS", VM: "1"},
+		"0019xxBC": {Name: "UserData22", VR: "DS", VM: "1"},
+		"0019xxBD": {Name: "UserData23", VR: "DS", VM: "1"},
+		"0019xxBE": {Name: "ProjectionAngle", VR: "DS", VM: "1"},
+		"0019xxC0": {Name: "SaturationPlanes", VR: "SS", VM: "1"},
+		"0019xxC1": {Name: "SurfaceCoilIntensity", VR: "SS", VM: "1"},
+		"0019xxC2": {Name: "SATLocationR", VR: "SS", VM: "1"},
+		"0019xxC3": {Name: "SATLocationL", VR: "SS", VM: "1"},
+		"0019xxC4": {Name: "SATLocationA", VR: "SS", VM: "1"},
+		"0019xxC5": {Name: "SATLocationP", VR: "SS", VM: "1"},
+		"0019xxC6": {Name: "SATLocationH", VR: "SS", VM: "1"},
+		"0019xxC7": {Name: "SATLocationF", VR: "SS", VM: "1"},
+		"0019xxC8": {Name: "SATThicknessRL", VR: "SS", VM: "1"},
+		"0019xxC9": {Name: "SATThicknessAP", VR: "SS", VM: "1"},
+		"0019xxCA": {Name: "SATThicknessHF", VR: "SS", VM: "1"},
+		"0019xxCB": {Name: "PrescribedFlowAxis", VR: "SS", VM: "1"},
+		"0019xxCC": {Name: "VelocityEncoding", VR: "SS", VM: "1"},
+		"0019xxCD": {Name: "ThicknessDisclaimer", VR: "SS", VM: "1"},
+		"0019xxCE": {Name: "PrescanType", VR: "SS", VM: "1"},
+		"0019xxCF": {Name: "PrescanStatus", VR: "SS", VM: "1"},
+		"0019xxD0": {Name: "RawDataType", VR: "SH", VM: "1"},
+		"0019xxD2": {Name: "ProjectionAlgorithm", VR: "SS", VM: "1"},
+		"0019xxD3": {Name: "ProjectionAlgorithmName", VR: "SH", VM: "1"},
+		"0019xxD5": {Name: "FractionalEcho", VR: "SS", VM: "1"},
+		"0019xxD6": {Name: "PrepPulse", VR: "SS", VM: "1"},
+		"0019xxD7": {Name: "CardiacPhases", VR: "SS", VM: "1"},
+		"0019xxD8": {Name: "VariableEchoFlag", VR: "SS", VM: "1"},
+		"0019xxD9": {Name: "ConcatenatedSAT", VR: "DS", VM: "1"},
+		"0019xxDA": {Name: "ReferenceChannelUsed", VR: "SS", VM: "1"},
+		"0019xxDB": {Name: "BackProjectorCoefficient", VR: "DS", VM: "1"},
+		"0019xxDC": {Name: "PrimarySpeedCorrectionUsed", VR: "SS", VM: "1"},
+		"0019xxDD": {Name: "OverrangeCorrectionUsed", VR: "SS", VM: "1"},
+		"0019xxDE": {Name: "DynamicZAlphaValue", VR: "DS", VM: "1"},
+		"0019xxDF": {Name: "UserData", VR: "DS", VM: "1"},
+		"0019xxE0": {Name: "UserData", VR: "DS", VM: "1"},
+		"0019xxE2": {Name: "VelocityEncodeScale", VR: "DS", VM: "1"},
+		"0019xxF2": {Name: "FastPhases", VR: "SS", VM: "1"},
+		"0019xxF9": {Name: "TransmissionGain", VR: "DS", VM: "1"},
+	},
+	"GEMS_RELA_01": {
+		"0021xx03": {Name: "SeriesFromWhichPrescribed", VR: "SS", VM: "1"},
+		"0021xx05": {Name: "GenesisVersionNow", VR: "SH", VM: "1"},
+		"0021xx07": {Name: "SeriesRecordChecksum", VR: "UL", VM: "1"},
+		"0021xx18": {Name: "GenesisVersionNow", VR: "SH", VM: "1"},
+		"0021xx19": {Name: "AcqreconRecordChecksum", VR: "UL", VM: "1"},
+		"0021xx20": {Name: "TableStartLocation", VR: "DS", VM: "1"},
+		"0021xx35": {Name: "SeriesFromWhichPrescribed", VR: "SS", VM: "1"},
+		"0021xx36": {Name: "ImageFromWhichPrescribed", VR: "SS", VM: "1"},
+		"0021xx37": {Name: "ScreenFormat", VR: "SS", VM: "1"},
+		"0021xx4A": {Name: "AnatomicalReferenceForScout", VR: "LO", VM: "1"},
+		"0021xx4F": {Name: "LocationsInAcquisition", VR: "SS", VM: "1"},
+		"0021xx50": {Name: "GraphicallyPrescribed", VR: "SS", VM: "1"},
+		"0021xx51": {Name: "RotationFromSourceXRot", VR: "DS", VM: "1"},
+		"0021xx52": {Name: "RotationFromSourceYRot", VR: "DS", VM: "1"},
+		"0021xx53": {Name: "RotationFromSourceZRot", VR: "DS", VM: "1"},
+		"0021xx54": {Name: "ImagePosition", VR: "SH", VM: "3"},
+		"0021xx55": {Name: "ImageOrientation", VR: "SH", VM: "6"},
+		"0021xx56": {Name: "IntegerSlop", VR: "SL", VM: "1"},
+		"0021xx57": {Name: "IntegerSlop", VR: "SL", VM: "1"},
+		"0021xx58": {Name: "IntegerSlop", VR: "SL", VM: "1"},
+		"0021xx59": {Name: "IntegerSlop", VR: "SL", VM: "1"},
+		"0021xx5A": {Name: "IntegerSlop", VR: "SL", VM: "1"},
+		"0021xx5B": {Name: "FloatSlop", VR: "DS", VM: "1"},
+		"0021xx5C": {Name: "FloatSlop", VR: "DS", VM: "1"},
+		"0021xx5D": {Name: "FloatSlop", VR: "DS", VM: "1"},
+		"0021xx5E": {Name: "FloatSlop", VR: "DS", VM: "1"},
+		"0021xx5F": {Name: "FloatSlop", VR: "DS", VM: "1"},
+		"0021xx81": {Name: "AutoWindowLevelAlpha", VR: "DS", VM: "1"},
+		"0021xx82": {Name: "AutoWindowLevelBeta", VR: "DS", VM: "1"},
+		"0021xx83": {Name: "AutoWindowLevelWindow", VR: "DS", VM: "1"},
+		"0021xx84": {Name: "AutoWindowLevelLevel", VR: "DS", VM: "1"},
+		"0021xx90": {Name: "TubeFocalSpotPosition", VR: "SS", VM: "1"},
+		"0021xx91": {Name: "BiopsyPosition", VR: "SS", VM: "1"},
+		"0021xx92": {Name: "BiopsyTLocation", VR: "FL", VM: "1"},
+		"0021xx93": {Name: "BiopsyRefLocation", VR: "FL", VM: "1"},
+	},
+	"GEMS_STDY_01": {
+		"0023xx01": {Name: "NumberOfSeriesInStudy", VR: "SL", VM: "1"},
+		"0023xx02": {Name: "NumberOfUnarchivedSeries", VR: "SL", VM: "1"},
+		"0023xx10": {Name: "ReferenceImageField", VR: "SS", VM: "1"},
+		"0023xx50": {Name: "SummaryImage", VR: "SS", VM: "1"},
+		"0023xx70": {Name: "StartTimeSecsInFirstAxial", VR: "FD", VM: "1"},
+		"0023xx74": {Name: "NumberOfUpdatesToHeader", VR: "SL", VM: "1"},
+		"0023xx7D": {Name: "IndicatesIfTheStudyHasCompleteInfo", VR: "SS", VM: "1"},
+	},
+	"GEMS_SERS_01": {
+		"0025xx06": {Name: "LastPulseSequenceUsed", VR: "SS", VM: "1"},
+		"0025xx07": {Name: "ImagesInSeries", VR: "SL", VM: "1"},
+		"0025xx10": {Name: "LandmarkCounter", VR: "SL", VM: "1"},
+		"0025xx11": {Name: "NumberOfAcquisitions", VR: "SS", VM: "1"},
+		"0025xx14": {Name: "IndicatesNumberOfUpdatesToHeader", VR: "SL", VM: "1"},
+		"0025xx17": {Name: "SeriesCompleteFlag", VR: "SL", VM: "1"},
+		"0025xx18": {Name: "NumberOfImagesArchived", VR: "SL", VM: "1"},
+		"0025xx19": {Name: "LastImageNumberUsed", VR: "SL", VM: "1"},
+		"0025xx1A": {Name: "PrimaryReceiverSuiteAndHost", VR: "SH", VM: "1"},
+	},
+	"GEMS_IMAG_01": {
+		"0027xx06": {Name: "ImageArchiveFlag", VR: "SL", VM: "1"},
+		"0027xx10": {Name: "ScoutType", VR: "SS", VM: "1"},
+		"0027xx1C": {Name: "VmaMamp", VR: "SL", VM: "1"},
+		"0027xx1D": {Name: "VmaPhase", VR: "SS", VM: "1"},
+		"0027xx1E": {Name: "VmaMod", VR: "SL", VM: "1"},
+		"0027xx1F": {Name: "VmaClip", VR: "SL", VM: "1"},
+		"0027xx20": {Name: "SmartScanOnOffFlag", VR: "SS", VM: "1"},
+		"0027xx30": {Name: "ForeignImageRevision", VR: "SH", VM: "1"},
+		"0027xx31": {Name: "ImagingMode", VR: "SS", VM: "1"},
+		"0027xx32": {Name: "PulseSequence", VR: "SS", VM: "1"},
+		"0027xx33": {Name: "ImagingOptions", VR: "SL", VM: "1"},
+		"0027xx35": {Name: "PlaneType", VR: "SS", VM: "1"},
+		"0027xx36": {Name: "ObliquePlane", VR: "SL", VM: "1"},
+		"0027xx40": {Name: "RASLetterOfImageLocation", VR: "SH", VM: "1"},
+		"0027xx41": {Name: "ImageLocation", VR: "FL", VM: "1"},
+		"0027xx42": {Name: "CenterRCoordOfPlaneImage", VR: "FL", VM: "1"},
+		"0027xx43": {Name: "CenterACoordOfPlaneImage", VR: "FL", VM: "1"},
+		"0027xx44": {Name: "CenterSCoordOfPlaneImage", VR: "FL", VM: "1"},
+		"0027xx45": {Name: "NormalRCoord", VR: "FL", VM: "1"},
+		"0027xx46": {Name: "NormalACoord", VR: "FL", VM: "1"},
+		"0027xx47": {Name: "NormalSCoord", VR: "FL", VM: "1"},
+		"0027xx48": {Name: "RCoordOfTopRightCorner", VR: "FL", VM: "1"},
+		"0027xx49": {Name: "ACoordOfTopRightCorner", VR: "FL", VM: "1"},
+		"0027xx4A": {Name: "SCoordOfTopRightCorner", VR: "FL", VM: "1"},
+		"0027xx4B": {Name: "RCoordOfBottomRightCorner", VR: "FL", VM: "1"},
+		"0027xx4C": {Name: "ACoordOfBottomRightCorner", VR: "FL", VM: "1"},
+		"0027xx4D": {Name: "SCoordOfBottomRightCorner", VR: "FL", VM: "1"},
+		"0027xx50": {Name: "TableStartLocation", VR: "FL", VM: "1"},
+		"0027xx51": {Name: "TableEndLocation", VR: "FL", VM: "1"},
+		"0027xx52": {Name: "RASLetterForSideOfImage", VR: "SH", VM: "1"},
+		"0027xx53": {Name: "RASLetterForAnteriorPosterior", VR: "SH", VM: "1"},
+		"0027xx54": {Name: "RASLetterForScoutStartLoc", VR: "SH", VM: "1"},
+		"0027xx55": {Name: "RASLetterForScoutEndLoc", VR: "SH", VM: "1"},
+		"0027xx60": {Name: "ImageDimensionX", VR: "FL", VM: "1"},
+		"0027xx61": {Name: "ImageDimensionY", VR: "FL", VM: "1"},
+		"0027xx62": {Name: "NumberOfExcitations", VR: "FL", VM: "1"},
+	},
+	"GEMS_IMPS_01": {
+		"0029xx04": {Name: "LowerRangeOfPixels1a", VR: "SL", VM: "1"},
+		"0029xx05": {Name: "LowerRangeOfPixels1b", VR: "DS", VM: "1"},
+		"0029xx06": {Name: "LowerRangeOfPixels1c", VR: "DS", VM: "1"},
+		"0029xx07": {Name: "LowerRangeOfPixels1d", VR: "SL", VM: "1"},
+		"0029xx08": {Name: "LowerRangeOfPixels1e", VR: "SH", VM: "1"},
+		"0029xx09": {Name: "LowerRangeOfPixels1f", VR: "SH", VM: "1"},
+		"0029xx0A": {Name: "LowerRangeOfPixels1g", VR: "SS", VM: "1"},
+		"0029xx15": {Name: "LowerRangeOfPixels1h", VR: "SL", VM: "1"},
+		"0029xx16": {Name: "LowerRangeOfPixels1i", VR: "SL", VM: "1"},
+		"0029xx17": {Name: "LowerRangeOfPixels2", VR: "SL", VM: "1"},
+		"0029xx18": {Name: "UpperRangeOfPixels2", VR: "SL", VM: "1"},
+		"0029xx1A": {Name: "LengthOfTotalHeaderInBytes", VR: "SL", VM: "1"},
+		"0029xx26": {Name: "VersionOfTheHeaderStructure", VR: "SS", VM: "1"},
+		"0029xx34": {Name: "AdvantageCompOverflow", VR: "SL", VM: "1"},
+		"0029xx35": {Name: "AdvantageCompUnderflow", VR: "SL", VM: "1"},
+	},
+	"GEMS_PARM_01": {
+		"0043xx01": {Name: "BitmapOfPrescanOptions", VR: "SS", VM: "1"},
+		"0043xx02": {Name: "GradientOffsetInX", VR: "SS", VM: "1"},
+		"0043xx03": {Name: "GradientOffsetInY", VR: "SS", VM: "1"},
+		"0043xx04": {Name: "GradientOffsetInZ", VR: "SS", VM: "1"},
+		"0043xx05": {Name: "ImgIsOriginalOrUnoriginal", VR: "SS", VM: "1"},
+		"0043xx06": {Name: "NumberOfEPIShots", VR: "SS", VM: "1"},
+		"0043xx07": {Name: "ViewsPerSegment", VR: "SS", VM: "1"},
+		"0043xx08": {Name: "RespiratoryRateBpm", VR: "SS", VM: "1"},
+		"0043xx09": {Name: "RespiratoryTriggerPoint", VR: "SS", VM: "1"},
+		"0043xx0A": {Name: "TypeOfReceiverUsed", VR: "SS", VM: "1"},
+		"0043xx0B": {Name: "PeakRateOfChangeOfGradientField", VR: "DS", VM: "1"},
+		"0043xx0C": {Name: "LimitsInUnitsOfPercent", VR: "DS", VM: "1"},
+		"0043xx0D": {Name: "PSDEstimatedLimit", VR: "DS", VM: "1"},
+		"0043xx0E": {Name: "PSDEstimatedLimitInTeslaPerSecond", VR: "DS", VM: "1"},
+		"0043xx0F": {Name: "SARAvgHead", VR: "DS", VM: "1"},
+		"0043xx10": {Name: "WindowValue", VR: "US", VM: "1"},
+		"0043xx11": {Name: "TotalInputViews", VR: "US", VM: "1"},
+		"0043xx12": {Name: "XRayChain", VR: "SS", VM: "3"},
+		"0043xx13": {Name: "DeconKernelParameters", VR: "SS", VM: "5"},
+		"0043xx14": {Name: "CalibrationParameters", VR: "SS", VM: "3"},
+		"0043xx15": {Name: "TotalOutputViews", VR: "SS", VM: "3"},
+		"0043xx16": {Name: "NumberOfOverranges", VR: "SS", VM: "5"},
+		"0043xx17": {Name: "IBHImageScaleFactors", VR: "DS", VM: "1"},
+		"0043xx18": {Name: "BBHCoefficients", VR: "DS", VM: "3"},
+		"0043xx19": {Name: "NumberOfBBHChainsToBlend", VR: "SS", VM: "1"},
+		"0043xx1A": {Name: "StartingChannelNumber", VR: "SL", VM: "1"},
+		"0043xx1B": {Name: "PPScanParameters", VR: "SS", VM: "1"},
+		"0043xx1C": {Name: "GEImageIntegrity", VR: "SS", VM: "1"},
+		"0043xx1D": {Name: "LevelValue", VR: "SS", VM: "1"},
+		"0043xx1E": {Name: "DeltaStartTime", VR: "DS", VM: "1"},
+		"0043xx1F": {Name: "MaxOverrangesInAView", VR: "SL", VM: "1"},
+		"0043xx20": {Name: "AvgOverrangesAllViews", VR: "DS", VM: "1"},
+		"0043xx21": {Name: "CorrectedAfterGlowTerms", VR: "SS", VM: "1"},
+		"0043xx25": {Name: "ReferenceChannels", VR: "SS", VM: "6"},
+		"0043xx26": {Name: "NumberOfViewsRefChannelsBlocked", VR: "US", VM: "6"},
+		"0043xx27": {Name: "ScanPitchRatio", VR: "SH", VM: "1"},
+		"0043xx28": {Name: "UniqueImageIdentifier", VR: "OB", VM: "1"},
+		"0043xx29": {Name: "HistogramTables", VR: "OB", VM: "1"},
+		"0043xx2A": {Name: "UserDefinedData", VR: "OB", VM: "1"},
+		"0043xx2B": {Name: "PrivateScanOptions", VR: "SS", VM: "4"},
+		"0043xx2C": {Name: "EffectiveEchoSpacing", VR: "SS", VM: "1"},
+		"0043xx2D": {Name: "StringSlopField1", VR: "SH", VM: "1"},
+		"0043xx2E": {Name: "StringSlopField2", VR: "SH", VM: "1"},
+		"0043xx2F": {Name: "RawDataType", VR: "SS", VM: "1"},
+		"0043xx30": {Name: "RawDataType", VR: "SS", VM: "1"},
+		"0043xx31": {Name: "RACoordOfTargetReconCenter", VR: "DS", VM: "2"},
+		"0043xx32": {Name: "RawDataType", VR: "SS", VM: "1"},
+		"0043xx33": {Name: "NegativeScanSpacing", VR: "FL", VM: "1"},
+		"0043xx34": {Name: "OffsetFrequency", VR: "IS", VM: "1"},
+		"0043xx35": {Name: "UserUsageTag", VR: "UL", VM: "1"},
+		"0043xx36": {Name: "UserFillMapMSW", VR: "UL", VM: "1"},
+		"0043xx37": {Name: "UserFillMapLSW", VR: "UL", VM: "1"},
+		"0043xx38": {Name: "User25ToUser48", VR: "FL", VM: "24"},
+		"0043xx39": {Name: "SlopInteger6ToSlopInteger9", VR: "IS", VM: "4"},
+		"0043xx40": {Name: "TriggerOnPosition", VR: "FL", VM: "4"},
+		"0043xx41": {Name: "DegreeOfRotation", VR: "FL", VM: "4"},
+		"0043xx42": {Name: "DASTriggerSource", VR: "SL", VM: "4"},
+		"0043xx43": {Name: "DASFpaGain", VR: "SL", VM: "4"},
+		"0043xx44": {Name: "DASOutputSource", VR: "SL", VM: "4"},
+		"0043xx45": {Name: "DASAdInput", VR: "SL", VM: "4"},
+		"0043xx46": {Name: "DASCalMode", VR: "SL", VM: "4"},
+		"0043xx47": {Name: "DASCalFrequency", VR: "SL", VM: "4"},
+		"0043xx48": {Name: "DASRegXm", VR: "SL", VM: "4"},
+		"0043xx49": {Name: "DASAutoZero", VR: "SL", VM: "4"},
+		"0043xx4A": {Name: "StartingChannelOfView", VR: "SS", VM: "4"},
+		"0043xx4B": {Name: "DASXmPattern", VR: "SL", VM: "4"},
+		"0043xx4C": {Name: "TGGCTriggerMode", VR: "SS", VM: "4"},
+		"0043xx4D": {Name: "StartScanToXrayOnDelay", VR: "FL", VM: "4"},
+		"0043xx4E": {Name: "DurationOfXrayOn", VR: "FL", VM: "4"},
+		"0043xx60": {Name: "SlopInteger10ToSlopInteger17", VR: "IS", VM: "8"},
+		"0043xx61": {Name: "ScannerStudyEntityUID", VR: "UI", VM: "1"},
+		"0043xx62": {Name: "ScannerStudyID", VR: "SH", VM: "1"},
+		"0043xx6F": {Name: "ScannerTableEntry", VR: "DS", VM: "3-4"},
+	},
+	"GEMS_HELIOS_01": {
+		"0045xx01": {Name: "NumberOfMacroRowsInDetector", VR: "SS", VM: "1"},
+		"0045xx02": {Name: "MacroWidthAtISOCenter", VR: "FL", VM: "1"},
+		"0045xx03": {Name: "DASType", VR: "SS", VM: "1"},
+		"0045xx04": {Name: "DASGain", VR: "SS", VM: "1"},
+		"0045xx05": {Name: "DASTemperature", VR: "SS", VM: "1"},
+		"0045xx06": {Name: "TableDirectionInOrOut", VR: "CS", VM: "1"},
+		"0045xx07": {Name: "ZSmoothingFactor", VR: "FL", VM: "1"},
+		"0045xx08": {Name: "ViewWeightingMode", VR: "SS", VM: "1"},
+		"0045xx09": {Name: "SigmaRowNumberWhichRowsWereUsed", VR: "SS", VM: "1"},
+		"0045xx0A": {Name: "MinimumDasValueFoundInTheScanData", VR: "FL", VM: "1"},
+		"0045xx0B": {Name: "MaximumOffsetShiftValueUsed", VR: "FL", VM: "1"},
+		"0045xx0C": {Name: "NumberOfViewsShifted", VR: "SS", VM: "1"},
+		"0045xx0D": {Name: "ZTrackingFlag", VR: "SS", VM: "1"},
+		"0045xx0E": {Name: "MeanZError", VR: "FL", VM: "1"},
+		"0045xx0F": {Name: "ZTrackingMaximumError", VR: "FL", VM: "1"},
+		"0045xx10": {Name: "StartingViewForRow2a", VR: "SS", VM: "1"},
+		"0045xx11": {Name: "NumberOfViewsInRow2a", VR: "SS", VM: "1"},
+		"0045xx12": {Name: "StartingViewForRow1a", VR: "SS", VM: "1"},
+		"0045xx13": {Name: "SigmaMode", VR: "SS", VM: "1"},
+		"0045xx14": {Name: "NumberOfViewsInRow1a", VR: "SS", VM: "1"},
+		"0045xx15": {Name: "StartingViewForRow2b", VR: "SS", VM: "1"},
+		"0045xx16": {Name: "NumberOfViewsInRow2b", VR: "SS", VM: "1"},
+		"0045xx17": {Name: "StartingViewForRow1b", VR: "SS", VM: "1"},
+		"0045xx18": {Name: "NumberOfViewsInRow1b", VR: "SS", VM: "1"},
+		"0045xx19": {Name: "AirFilterCalibrationDate", VR: "SS", VM: "1"},
+		"0045xx1A": {Name: "AirFilterCalibrationTime", VR: "SS", VM: "1"},
+		"0045xx1B": {Name: "PhantomCalibrationDate", VR: "SS", VM: "1"},
+		"0045xx1C": {Name: "PhantomCalibrationTime", VR: "SS", VM: "1"},
+		"0045xx1D": {Name: "ZSlopeCalibrationDate", VR: "SS", VM: "1"},
+		"0045xx1E": {Name: "ZSlopeCalibrationTime", VR: "SS", VM: "1"},
+		"0045xx1F": {Name: "CrosstalkCalibrationDate", VR: "SS", VM: "1"},
+		"0045xx20": {Name: "CrosstalkCalibrationTime", VR: "SS", VM: "1"},
+		"0045xx21": {Name: "IterboneOptionFlag", VR: "SS", VM: "1"},
+		"0045xx22": {Name: "PeristalticFlagOption", VR: "SS", VM: "1"},
+	},
+}
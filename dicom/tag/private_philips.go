@@ -0,0 +1,50 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tag
+
+// privatePhilips - Philips private data elements.
+var privatePhilips = map[string]map[string]Entry{
+	"Philips Imaging DD 001": {
+		"2001xx01": {Name: "ChemicalShift", VR: "FL", VM: "1"},
+		"2001xx02": {Name: "ChemicalShiftNumberMR", VR: "IS", VM: "1"},
+		"2001xx03": {Name: "DiffusionBFactor", VR: "FL", VM: "1"},
+		"2001xx04": {Name: "DiffusionDirection", VR: "CS", VM: "1"},
+		"2001xx06": {Name: "ImageEnhanced", VR: "CS", VM: "1"},
+		"2001xx07": {Name: "ImageTypeEDES", VR: "CS", VM: "1"},
+		"2001xx08": {Name: "PhaseNumber", VR: "IS", VM: "1"},
+		"2001xx09": {Name: "ImagePrepulseDelay", VR: "FL", VM: "1"},
+		"2001xx0A": {Name: "SliceNumberMR", VR: "IS", VM: "1"},
+		"2001xx0B": {Name: "SliceOrientation", VR: "CS", VM: "1"},
+		"2001xx10": {Name: "CardiacSync", VR: "CS", VM: "1"},
+		"2001xx11": {Name: "DiffusionEchoTime", VR: "FL", VM: "1"},
+		"2001xx12": {Name: "DynamicSeries", VR: "CS", VM: "1"},
+		"2001xx13": {Name: "EPIFactor", VR: "SL", VM: "1"},
+		"2001xx14": {Name: "NumberOfEchoes", VR: "SL", VM: "1"},
+		"2001xx15": {Name: "NumberOfLocations", VR: "SS", VM: "1"},
+		"2001xx16": {Name: "NumberOfPCDirections", VR: "SS", VM: "1"},
+		"2001xx17": {Name: "NumberOfPhasesMR", VR: "SL", VM: "1"},
+		"2001xx18": {Name: "NumberOfSlicesMR", VR: "SL", VM: "1"},
+		"2001xx19": {Name: "PartialMatrixScanned", VR: "CS", VM: "1"},
+		"2001xx1A": {Name: "PCVelocity", VR: "FL", VM: "3"},
+		"2001xx1B": {Name: "PrepulseDelay", VR: "FL", VM: "1"},
+		"2001xx1C": {Name: "PrepulseType", VR: "CS", VM: "1"},
+		"2001xx1D": {Name: "ReconstructionNumberMR", VR: "IS", VM: "1"},
+		"2001xx1F": {Name: "RespirationSync", VR: "CS", VM: "1"},
+		"2001xx20": {Name: "ScanningTechnique", VR: "LO", VM: "1"},
+		"2001xx21": {Name: "SPIR", VR: "CS", VM: "1"},
+		"2001xx22": {Name: "WaterFatShift", VR: "FL", VM: "1"},
+		"2001xx23": {Name: "FlipAnglePhilips", VR: "DS", VM: "1"},
+		"2001xx24": {Name: "Interactive", VR: "CS", VM: "1"},
+		"2001xx25": {Name: "EchoTimeDisplayMR", VR: "SH", VM: "1"},
+	},
+	"Philips MR Imaging DD 001": {
+		"2005xx0D": {Name: "ScaleIntercept", VR: "FL", VM: "1"},
+		"2005xx0E": {Name: "ScaleSlope", VR: "FL", VM: "1"},
+	},
+}
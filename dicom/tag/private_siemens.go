@@ -0,0 +1,47 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tag
+
+// privateSiemens - Siemens private data elements, including the CSA headers.
+var privateSiemens = map[string]map[string]Entry{
+	"SIEMENS CSA HEADER": {
+		"0029xx08": {Name: "CSAImageHeaderType", VR: "CS", VM: "1"},
+		"0029xx09": {Name: "CSAImageHeaderVersion", VR: "LO", VM: "1"},
+		"0029xx10": {Name: "CSAImageHeaderInfo", VR: "OB", VM: "1"},
+		"0029xx18": {Name: "CSASeriesHeaderType", VR: "CS", VM: "1"},
+		"0029xx19": {Name: "CSASeriesHeaderVersion", VR: "LO", VM: "1"},
+		"0029xx20": {Name: "CSASeriesHeaderInfo", VR: "OB", VM: "1"},
+	},
+	"SIEMENS CSA NON-IMAGE": {
+		"0029xx08": {Name: "CSADataType", VR: "CS", VM: "1"},
+		"0029xx09": {Name: "CSADataVersion", VR: "LO", VM: "1"},
+		"0029xx10": {Name: "CSADataInfo", VR: "OB", VM: "1"},
+	},
+	"SIEMENS MR HEADER": {
+		"0019xx08": {Name: "CSAImageHeaderType", VR: "CS", VM: "1"},
+		"0019xx09": {Name: "CSAImageHeaderVersion", VR: "LO", VM: "1"},
+		"0019xx0A": {Name: "NumberOfImagesInMosaic", VR: "US", VM: "1"},
+		"0019xx0B": {Name: "SliceMeasurementDuration", VR: "DS", VM: "1"},
+		"0019xx0C": {Name: "BValue", VR: "IS", VM: "1"},
+		"0019xx0D": {Name: "DiffusionDirectionality", VR: "CS", VM: "1"},
+		"0019xx0E": {Name: "DiffusionGradientDirection", VR: "FD", VM: "3"},
+		"0019xx0F": {Name: "GradientMode", VR: "SH", VM: "1"},
+		"0019xx11": {Name: "FlowCompensation", VR: "SH", VM: "1"},
+		"0019xx12": {Name: "TablePositionOrigin", VR: "SL", VM: "3"},
+		"0019xx13": {Name: "ImaAbsTablePosition", VR: "SL", VM: "3"},
+		"0019xx14": {Name: "ImaRelTablePosition", VR: "IS", VM: "3"},
+		"0019xx15": {Name: "SlicePositionPCS", VR: "FD", VM: "3"},
+		"0019xx16": {Name: "TimeAfterStart", VR: "DS", VM: "1"},
+		"0019xx17": {Name: "SliceResolution", VR: "DS", VM: "1"},
+		"0019xx18": {Name: "RealDwellTime", VR: "IS", VM: "1"},
+		"0019xx27": {Name: "BMatrix", VR: "FD", VM: "6"},
+		"0019xx28": {Name: "BandwidthPerPixelPhaseEncode", VR: "FD", VM: "1"},
+		"0019xx29": {Name: "MosaicRefAcqTimes", VR: "FD", VM: "1-n"},
+	},
+}
@@ -0,0 +1,5589 @@
+// Code generated by dictgen from part06.xml. DO NOT EDIT.
+
+package tag
+
+// Tag - Registry of DICOM Data Elements keyed by "GGGGEEEE".
+// PS3.6 Table 6-1, Table 7-1 and Table 8-1.
+var Tag = map[string]Entry{
+	"00020000": {Name: "File Meta Information Group Length", Keyword: "FileMetaInformationGroupLength", VR: "UL", VM: "1"},
+	"00020001": {Name: "File Meta Information Version", Keyword: "FileMetaInformationVersion", VR: "OB", VM: "1"},
+	"00020002": {Name: "Media Storage SOP Class UID", Keyword: "MediaStorageSOPClassUID", VR: "UI", VM: "1"},
+	"00020003": {Name: "Media Storage SOP Instance UID", Keyword: "MediaStorageSOPInstanceUID", VR: "UI", VM: "1"},
+	"00020010": {Name: "Transfer Syntax UID", Keyword: "TransferSyntaxUID", VR: "UI", VM: "1"},
+	"00020012": {Name: "Implementation Class UID", Keyword: "ImplementationClassUID", VR: "UI", VM: "1"},
+	"00020013": {Name: "Implementation Version Name", Keyword: "ImplementationVersionName", VR: "SH", VM: "1"},
+	"00020016": {Name: "Source Application Entity Title", Keyword: "SourceApplicationEntityTitle", VR: "AE", VM: "1"},
+	"00020100": {Name: "Private Information Creator UID", Keyword: "PrivateInformationCreatorUID", VR: "UI", VM: "1"},
+	"00020102": {Name: "Private Information", Keyword: "PrivateInformation", VR: "OB", VM: "1"},
+	"00041130": {Name: "File Set ID", Keyword: "FileSetID", VR: "CS", VM: "1"},
+	"00041141": {Name: "File Set Descriptor File ID", Keyword: "FileSetDescriptorFileID", VR: "CS", VM: "1-8"},
+	"00041142": {Name: "Specific Character Set of File", Keyword: "SpecificCharacterSetOfFile", VR: "CS", VM: "1"},
+	"00041200": {Name: "Offset of the First Directory Record of the Root Directory Entity", Keyword: "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity", VR: "UL", VM: "1"},
+	"00041202": {Name: "Offset of the Last Directory Record of the Root Directory Entity", Keyword: "OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity", VR: "UL", VM: "1"},
+	"00041212": {Name: "File Set Consistency Flag", Keyword: "FileSetConsistencyFlag", VR: "US", VM: "1"},
+	"00041220": {Name: "Directory Record Sequence", Keyword: "DirectoryRecordSequence", VR: "SQ", VM: "1"},
+	"00041400": {Name: "Offset of the Next Directory Record", Keyword: "OffsetOfTheNextDirectoryRecord", VR: "UL", VM: "1"},
+	"00041410": {Name: "Record in Use Flag", Keyword: "RecordInUseFlag", VR: "US", VM: "1"},
+	"00041420": {Name: "Offset of Referenced Lower Level Directory Entity", Keyword: "OffsetOfReferencedLowerLevelDirectoryEntity", VR: "UL", VM: "1"},
+	"00041430": {Name: "Directory Record Type", Keyword: "DirectoryRecordType", VR: "CS", VM: "1"},
+	"00041432": {Name: "Private Record UID", Keyword: "PrivateRecordUID", VR: "UI", VM: "1"},
+	"00041500": {Name: "Referenced File ID", Keyword: "ReferencedFileID", VR: "CS", VM: "1-8"},
+	"00041504": {Name: "MRDR Directory Record Offset", Keyword: "MRDRDirectoryRecordOffset", VR: "UL", VM: "1", Retired: true},
+	"00041510": {Name: "Referenced SOP Class UID in File", Keyword: "ReferencedSOPClassUIDInFile", VR: "UI", VM: "1"},
+	"00041511": {Name: "Referenced SOP Instance UID in File", Keyword: "ReferencedSOPInstanceUIDInFile", VR: "UI", VM: "1"},
+	"00041512": {Name: "Referenced Transfer Syntax UID in File", Keyword: "ReferencedTransferSyntaxUIDInFile", VR: "UI", VM: "1"},
+	"0004151A": {Name: "Referenced Related SOP Class UID in File", Keyword: "ReferencedRelatedSOPClassUIDInFile", VR: "UI", VM: "1-n"},
+	"00041600": {Name: "Number of References", Keyword: "NumberOfReferences", VR: "UL", VM: "1", Retired: true},
+	"00080000": {Name: "Identifying Group Length", Keyword: "IdentifyingGroupLength", VR: "UL", VM: "1", Retired: true},
+	"00080001": {Name: "Length to End", Keyword: "LengthToEnd", VR: "UL", VM: "1", Retired: true},
+	"00080005": {Name: "Specific Character Set", Keyword: "SpecificCharacterSet", VR: "CS", VM: "1-n"},
+	"00080006": {Name: "Language Code Sequence", Keyword: "LanguageCodeSequence", VR: "SQ", VM: "1"},
+	"00080008": {Name: "Image Type", Keyword: "ImageType", VR: "CS", VM: "2-n"},
+	"00080010": {Name: "Recognition Code", Keyword: "RecognitionCode", VR: "SH", VM: "1", Retired: true},
+	"00080012": {Name: "Instance Creation Date", Keyword: "InstanceCreationDate", VR: "DA", VM: "1"},
+	"00080013": {Name: "Instance Creation Time", Keyword: "InstanceCreationTime", VR: "TM", VM: "1"},
+	"00080014": {Name: "Instance Creator UID", Keyword: "InstanceCreatorUID", VR: "UI", VM: "1"},
+	"00080016": {Name: "SOP Class UID", Keyword: "SOPClassUID", VR: "UI", VM: "1"},
+	"00080018": {Name: "SOP Instance UID", Keyword: "SOPInstanceUID", VR: "UI", VM: "1"},
+	"0008001A": {Name: "Related General SOP Class UID", Keyword: "RelatedGeneralSOPClassUID", VR: "UI", VM: "1-n"},
+	"0008001B": {Name: "Original Specialized SOP Class UID", Keyword: "OriginalSpecializedSOPClassUID", VR: "UI", VM: "1"},
+	"00080020": {Name: "Study Date", Keyword: "StudyDate", VR: "DA", VM: "1"},
+	"00080021": {Name: "Series Date", Keyword: "SeriesDate", VR: "DA", VM: "1"},
+	"00080022": {Name: "Acquisition Date", Keyword: "AcquisitionDate", VR: "DA", VM: "1"},
+	"00080023": {Name: "Content Date", Keyword: "ContentDate", VR: "DA", VM: "1"},
+	"00080024": {Name: "Overlay Date", Keyword: "OverlayDate", VR: "DA", VM: "1", Retired: true},
+	"00080025": {Name: "Curve Date", Keyword: "CurveDate", VR: "DA", VM: "1", Retired: true},
+	"0008002A": {Name: "Acquisition Date Time", Keyword: "AcquisitionDateTime", VR: "DT", VM: "1"},
+	"00080030": {Name: "Study Time", Keyword: "StudyTime", VR: "TM", VM: "1"},
+	"00080031": {Name: "Series Time", Keyword: "SeriesTime", VR: "TM", VM: "1"},
+	"00080032": {Name: "Acquisition Time", Keyword: "AcquisitionTime", VR: "TM", VM: "1"},
+	"00080033": {Name: "Content Time", Keyword: "ContentTime", VR: "TM", VM: "1"},
+	"00080034": {Name: "Overlay Time", Keyword: "OverlayTime", VR: "TM", VM: "1", Retired: true},
+	"00080035": {Name: "Curve Time", Keyword: "CurveTime", VR: "TM", VM: "1", Retired: true},
+	"00080040": {Name: "Data Set Type", Keyword: "DataSetType", VR: "US", VM: "1", Retired: true},
+	"00080041": {Name: "Data Set Subtype", Keyword: "DataSetSubtype", VR: "LO", VM: "1", Retired: true},
+	"00080042": {Name: "Nuclear Medicine Series Type", Keyword: "NuclearMedicineSeriesType", VR: "CS", VM: "1", Retired: true},
+	"00080050": {Name: "Accession Number", Keyword: "AccessionNumber", VR: "SH", VM: "1"},
+	"00080052": {Name: "Query Retrieve Level", Keyword: "QueryRetrieveLevel", VR: "CS", VM: "1"},
+	"00080054": {Name: "Retrieve AE Title", Keyword: "RetrieveAETitle", VR: "AE", VM: "1-n"},
+	"00080056": {Name: "Instance Availability", Keyword: "InstanceAvailability", VR: "CS", VM: "1"},
+	"00080058": {Name: "Failed SOP Instance UID List", Keyword: "FailedSOPInstanceUIDList", VR: "UI", VM: "1-n"},
+	"00080060": {Name: "Modality", Keyword: "Modality", VR: "CS", VM: "1"},
+	"00080061": {Name: "Modalities in Study", Keyword: "ModalitiesInStudy", VR: "CS", VM: "1-n"},
+	"00080062": {Name: "SOP Classes in Study", Keyword: "SOPClassesInStudy", VR: "UI", VM: "1-n"},
+	"00080064": {Name: "Conversion Type", Keyword: "ConversionType", VR: "CS", VM: "1"},
+	"00080068": {Name: "Presentation Intent Type", Keyword: "PresentationIntentType", VR: "CS", VM: "1"},
+	"00080070": {Name: "Manufacturer", Keyword: "Manufacturer", VR: "LO", VM: "1"},
+	"00080080": {Name: "Institution Name", Keyword: "InstitutionName", VR: "LO", VM: "1"},
+	"00080081": {Name: "Institution Address", Keyword: "InstitutionAddress", VR: "ST", VM: "1"},
+	"00080082": {Name: "Institution Code Sequence", Keyword: "InstitutionCodeSequence", VR: "SQ", VM: "1"},
+	"00080090": {Name: "Referring Physician Name", Keyword: "ReferringPhysicianName", VR: "PN", VM: "1"},
+	"00080092": {Name: "Referring Physician Address", Keyword: "ReferringPhysicianAddress", VR: "ST", VM: "1"},
+	"00080094": {Name: "Referring Physician Telephone Number", Keyword: "ReferringPhysicianTelephoneNumber", VR: "SH", VM: "1-n"},
+	"00080096": {Name: "Referring Physician ID Sequence", Keyword: "ReferringPhysicianIDSequence", VR: "SQ", VM: "1"},
+	"00080100": {Name: "Code Value", Keyword: "CodeValue", VR: "SH", VM: "1"},
+	"00080102": {Name: "Coding Scheme Designator", Keyword: "CodingSchemeDesignator", VR: "SH", VM: "1"},
+	"00080103": {Name: "Coding Scheme Version", Keyword: "CodingSchemeVersion", VR: "SH", VM: "1"},
+	"00080104": {Name: "Code Meaning", Keyword: "CodeMeaning", VR: "LO", VM: "1"},
+	"00080105": {Name: "Mapping Resource", Keyword: "MappingResource", VR: "CS", VM: "1"},
+	"00080106": {Name: "Context Group Version", Keyword: "ContextGroupVersion", VR: "DT", VM: "1"},
+	"00080107": {Name: "Context Group Local Version", Keyword: "ContextGroupLocalVersion", VR: "DT", VM: "1"},
+	"0008010B": {Name: "Context Group Extension Flag", Keyword: "ContextGroupExtensionFlag", VR: "CS", VM: "1"},
+	"0008010C": {Name: "Coding Scheme UID", Keyword: "CodingSchemeUID", VR: "UI", VM: "1"},
+	"0008010D": {Name: "Context Group Extension Creator UID", Keyword: "ContextGroupExtensionCreatorUID", VR: "UI", VM: "1"},
+	"0008010F": {Name: "Context Identifier", Keyword: "ContextIdentifier", VR: "CS", VM: "1"},
+	"00080110": {Name: "Coding Scheme ID Sequence", Keyword: "CodingSchemeIDSequence", VR: "SQ", VM: "1"},
+	"00080112": {Name: "Coding Scheme Registry", Keyword: "CodingSchemeRegistry", VR: "LO", VM: "1"},
+	"00080114": {Name: "Coding Scheme External ID", Keyword: "CodingSchemeExternalID", VR: "ST", VM: "1"},
+	"00080115": {Name: "Coding Scheme Name", Keyword: "CodingSchemeName", VR: "ST", VM: "1"},
+	"00080116": {Name: "Coding Scheme Responsible Organization", Keyword: "CodingSchemeResponsibleOrganization", VR: "ST", VM: "1"},
+	"00080117": {Name: "Context UID", Keyword: "ContextUID", VR: "UI", VM: "1-n"},
+	"00080201": {Name: "Timezone Offset from UTC", Keyword: "TimezoneOffsetFromUTC", VR: "SH", VM: "1"},
+	"00081000": {Name: "Network ID", Keyword: "NetworkID", VR: "AE", VM: "1", Retired: true},
+	"00081010": {Name: "Station Name", Keyword: "StationName", VR: "SH", VM: "1"},
+	"00081030": {Name: "Study Description", Keyword: "StudyDescription", VR: "LO", VM: "1"},
+	"00081032": {Name: "Procedure Code Sequence", Keyword: "ProcedureCodeSequence", VR: "SQ", VM: "1"},
+	"0008103E": {Name: "Series Description", Keyword: "SeriesDescription", VR: "LO", VM: "1"},
+	"00081040": {Name: "Institutional Department Name", Keyword: "InstitutionalDepartmentName", VR: "LO", VM: "1"},
+	"00081048": {Name: "Physicians of Record", Keyword: "PhysiciansOfRecord", VR: "PN", VM: "1-n"},
+	"00081049": {Name: "Physicians of Record ID Sequence", Keyword: "PhysiciansOfRecordIDSequence", VR: "SQ", VM: "1"},
+	"00081050": {Name: "Performing Physician Name", Keyword: "PerformingPhysicianName", VR: "PN", VM: "1-n"},
+	"00081052": {Name: "Performing Physician ID Sequence", Keyword: "PerformingPhysicianIDSequence", VR: "SQ", VM: "1"},
+	"00081060": {Name: "Name of Physician Reading Study", Keyword: "NameOfPhysicianReadingStudy", VR: "PN", VM: "1-n"},
+	"00081062": {Name: "Physician Reading Study ID Sequence", Keyword: "PhysicianReadingStudyIDSequence", VR: "SQ", VM: "1"},
+	"00081070": {Name: "Operators Name", Keyword: "OperatorsName", VR: "PN", VM: "1-n"},
+	"00081072": {Name: "Operator ID Sequence", Keyword: "OperatorIDSequence", VR: "SQ", VM: "1"},
+	"00081080": {Name: "Admitting Diagnoses Description", Keyword: "AdmittingDiagnosesDescription", VR: "LO", VM: "1-n"},
+	"00081084": {Name: "Admitting Diagnoses Code Sequence", Keyword: "AdmittingDiagnosesCodeSequence", VR: "SQ", VM: "1"},
+	"00081090": {Name: "Manufacturer Model Name", Keyword: "ManufacturerModelName", VR: "LO", VM: "1"},
+	"00081100": {Name: "Referenced Results Sequence", Keyword: "ReferencedResultsSequence", VR: "SQ", VM: "1", Retired: true},
+	"00081110": {Name: "Referenced Study Sequence", Keyword: "ReferencedStudySequence", VR: "SQ", VM: "1"},
+	"00081111": {Name: "Referenced Performed Procedure Step Sequence", Keyword: "ReferencedPerformedProcedureStepSequence", VR: "SQ", VM: "1"},
+	"00081115": {Name: "Referenced Series Sequence", Keyword: "ReferencedSeriesSequence", VR: "SQ", VM: "1"},
+	"00081120": {Name: "Referenced Patient Sequence", Keyword: "ReferencedPatientSequence", VR: "SQ", VM: "1"},
+	"00081125": {Name: "Referenced Visit Sequence", Keyword: "ReferencedVisitSequence", VR: "SQ", VM: "1"},
+	"00081130": {Name: "Referenced Overlay Sequence", Keyword: "ReferencedOverlaySequence", VR: "SQ", VM: "1", Retired: true},
+	"0008113A": {Name: "Referenced Waveform Sequence", Keyword: "ReferencedWaveformSequence", VR: "SQ", VM: "1"},
+	"00081140": {Name: "Referenced Image Sequence", Keyword: "ReferencedImageSequence", VR: "SQ", VM: "1"},
+	"00081145": {Name: "Referenced Curve Sequence", Keyword: "ReferencedCurveSequence", VR: "SQ", VM: "1"},
+	"0008114A": {Name: "Referenced Instance Sequence", Keyword: "ReferencedInstanceSequence", VR: "SQ", VM: "1"},
+	"00081150": {Name: "Referenced SOP Class UID", Keyword: "ReferencedSOPClassUID", VR: "UI", VM: "1"},
+	"00081155": {Name: "Referenced SOP Instance UID", Keyword: "ReferencedSOPInstanceUID", VR: "UI", VM: "1"},
+	"0008115A": {Name: "SOP Classes Supported", Keyword: "SOPClassesSupported", VR: "UI", VM: "1-n"},
+	"00081160": {Name: "Referenced Frame Number", Keyword: "ReferencedFrameNumber", VR: "IS", VM: "1-n"},
+	"00081161": {Name: "Simple Frame List", Keyword: "SimpleFrameList", VR: "UL", VM: "1-n"},
+	"00081162": {Name: "Calculated Frame List", Keyword: "CalculatedFrameList", VR: "UL", VM: "3-3n"},
+	"00081163": {Name: "Time Range", Keyword: "TimeRange", VR: "FD", VM: "2"},
+	"00081164": {Name: "Frame Extraction Sequence", Keyword: "FrameExtractionSequence", VR: "SQ", VM: "1"},
+	"00081195": {Name: "Transaction UID", Keyword: "TransactionUID", VR: "UI", VM: "1"},
+	"00081197": {Name: "Failure Reason", Keyword: "FailureReason", VR: "US", VM: "1"},
+	"00081198": {Name: "Failed SOP Sequence", Keyword: "FailedSOPSequence", VR: "SQ", VM: "1"},
+	"00081199": {Name: "Referenced SOP Sequence", Keyword: "ReferencedSOPSequence", VR: "SQ", VM: "1"},
+	"00081200": {Name: "Studies Containing Other Referenced Instances Sequence", Keyword: "StudiesContainingOtherReferencedInstancesSequence", VR: "SQ", VM: "1"},
+	"00081250": {Name: "Related Series Sequence", Keyword: "RelatedSeriesSequence", VR: "SQ", VM: "1"},
+	"00082110": {Name: "Lossy Image Compression (Retired)", Keyword: "LossyImageCompressionRetired", VR: "CS", VM: "1", Retired: true},
+	"00082111": {Name: "Derivation Description", Keyword: "DerivationDescription", VR: "ST", VM: "1"},
+	"00082112": {Name: "Source Image Sequence", Keyword: "SourceImageSequence", VR: "SQ", VM: "1"},
+	"00082120": {Name: "Stage Name", Keyword: "StageName", VR: "SH", VM: "1"},
+	"00082122": {Name: "Stage Number", Keyword: "StageNumber", VR: "IS", VM: "1"},
+	"00082124": {Name: "Number of Stages", Keyword: "NumberOfStages", VR: "IS", VM: "1"},
+	"00082127": {Name: "View Name", Keyword: "ViewName", VR: "SH", VM: "1"},
+	"00082128": {Name: "View Number", Keyword: "ViewNumber", VR: "IS", VM: "1"},
+	"00082129": {Name: "Number of Event Timers", Keyword: "NumberOfEventTimers", VR: "IS", VM: "1"},
+	"0008212A": {Name: "Number of Views in Stage", Keyword: "NumberOfViewsInStage", VR: "IS", VM: "1"},
+	"00082130": {Name: "Event Elapsed Times", Keyword: "EventElapsedTimes", VR: "DS", VM: "1-n"},
+	"00082132": {Name: "Event Timer Names", Keyword: "EventTimerNames", VR: "LO", VM: "1-n"},
+	"00082133": {Name: "Event Timer Sequence", Keyword: "EventTimerSequence", VR: "SQ", VM: "1"},
+	"00082134": {Name: "Event Time Offset", Keyword: "EventTimeOffset", VR: "FD", VM: "1"},
+	"00082135": {Name: "Event Code Sequence", Keyword: "EventCodeSequence", VR: "SQ", VM: "1"},
+	"00082142": {Name: "Start Trim", Keyword: "StartTrim", VR: "IS", VM: "1"},
+	"00082143": {Name: "Stop Trim", Keyword: "StopTrim", VR: "IS", VM: "1"},
+	"00082144": {Name: "Recommended Display Frame Rate", Keyword: "RecommendedDisplayFrameRate", VR: "IS", VM: "1"},
+	"00082200": {Name: "Transducer Position", Keyword: "TransducerPosition", VR: "CS", VM: "1", Retired: true},
+	"00082204": {Name: "Transducer Orientation", Keyword: "TransducerOrientation", VR: "CS", VM: "1", Retired: true},
+	"00082208": {Name: "Anatomic Structure", Keyword: "AnatomicStructure", VR: "CS", VM: "1", Retired: true},
+	"00082218": {Name: "Anatomic Region Sequence", Keyword: "AnatomicRegionSequence", VR: "SQ", VM: "1"},
+	"00082220": {Name: "Anatomic Region Modifier Sequence", Keyword: "AnatomicRegionModifierSequence", VR: "SQ", VM: "1"},
+	"00082228": {Name: "Primary Anatomic Structure Sequence", Keyword: "PrimaryAnatomicStructureSequence", VR: "SQ", VM: "1"},
+	"00082229": {Name: "Anatomic Structure or Region Sequence", Keyword: "AnatomicStructureOrRegionSequence", VR: "SQ", VM: "1"},
+	"00082230": {Name: "Anatomic Structure Modifier Sequence", Keyword: "AnatomicStructureModifierSequence", VR: "SQ", VM: "1"},
+	"00082240": {Name: "Transducer Position Sequence", Keyword: "TransducerPositionSequence", VR: "SQ", VM: "1", Retired: true},
+	"00082242": {Name: "Transducer Position Modifier Sequence", Keyword: "TransducerPositionModifierSequence", VR: "SQ", VM: "1", Retired: true},
+	"00082244": {Name: "Transducer Orientation Sequence", Keyword: "TransducerOrientationSequence", VR: "SQ", VM: "1", Retired: true},
+	"00082246": {Name: "Transducer Orientation Modifier Sequence", Keyword: "TransducerOrientationModifierSequence", VR: "SQ", VM: "1", Retired: true},
+	"00082253": {Name: "Anatomic Entrance Portal Code Sequence (Trial)", Keyword: "AnatomicEntrancePortalCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	"00082255": {Name: "Anatomic Approach Direction Code Sequence (Trial)", Keyword: "AnatomicApproachDirectionCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	"00082256": {Name: "Anatomic Perspective Description (Trial)", Keyword: "AnatomicPerspectiveDescriptionTrial", VR: "ST", VM: "1", Retired: true},
+	"00082257": {Name: "Anatomic Perspective Code Sequence (Trial)", Keyword: "AnatomicPerspectiveCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	"00083001": {Name: "Alternate Representation Sequence", Keyword: "AlternateRepresentationSequence", VR: "SQ", VM: "1"},
+	"00083010": {Name: "Irradiation Event UID", Keyword: "IrradiationEventUID", VR: "UI", VM: "1-n"},
+	"00084000": {Name: "Identifying Comments", Keyword: "IdentifyingComments", VR: "LT", VM: "1", Retired: true},
+	"00089007": {Name: "Frame Type", Keyword: "FrameType", VR: "CS", VM: "4"},
+	"00089092": {Name: "Referenced Image Evidence Sequence", Keyword: "ReferencedImageEvidenceSequence", VR: "SQ", VM: "1"},
+	"00089121": {Name: "Referenced Raw Data Sequence", Keyword: "ReferencedRawDataSequence", VR: "SQ", VM: "1"},
+	"00089123": {Name: "Creator Version UID", Keyword: "CreatorVersionUID", VR: "UI", VM: "1"},
+	"00089124": {Name: "Derivation Image Sequence", Keyword: "DerivationImageSequence", VR: "SQ", VM: "1"},
+	"00089154": {Name: "Source Image Evidence Sequence", Keyword: "SourceImageEvidenceSequence", VR: "SQ", VM: "1"},
+	"00089205": {Name: "Pixel Presentation", Keyword: "PixelPresentation", VR: "CS", VM: "1"},
+	"00089206": {Name: "Volumetric Properties", Keyword: "VolumetricProperties", VR: "CS", VM: "1"},
+	"00089207": {Name: "Volume Based Calculation Technique", Keyword: "VolumeBasedCalculationTechnique", VR: "CS", VM: "1"},
+	"00089208": {Name: "Complex Image Component", Keyword: "ComplexImageComponent", VR: "CS", VM: "1"},
+	"00089209": {Name: "Acquisition Contrast", Keyword: "AcquisitionContrast", VR: "CS", VM: "1"},
+	"00089215": {Name: "Derivation Code Sequence", Keyword: "DerivationCodeSequence", VR: "SQ", VM: "1"},
+	"00089237": {Name: "Grayscale Presentation State Sequence", Keyword: "GrayscalePresentationStateSequence", VR: "SQ", VM: "1"},
+	"00089410": {Name: "Referenced Other Plane Sequence", Keyword: "ReferencedOtherPlaneSequence", VR: "SQ", VM: "1"},
+	"00089458": {Name: "Frame Display Sequence", Keyword: "FrameDisplaySequence", VR: "SQ", VM: "1"},
+	"00089459": {Name: "Recommended Display Frame Rate in Float", Keyword: "RecommendedDisplayFrameRateInFloat", VR: "FL", VM: "1"},
+	"00089460": {Name: "Skip Frame Range Flag", Keyword: "SkipFrameRangeFlag", VR: "CS", VM: "1"},
+	"00100000": {Name: "Patient Group Length", Keyword: "PatientGroupLength", VR: "UL", VM: "1", Retired: true},
+	"00100010": {Name: "Patient's Name", Keyword: "PatientName", VR: "PN", VM: "1"},
+	"00100020": {Name: "Patient's ID", Keyword: "PatientID", VR: "LO", VM: "1"},
+	"00100021": {Name: "Issuer of Patient ID", Keyword: "IssuerOfPatientID", VR: "LO", VM: "1"},
+	"00100022": {Name: "Type of Patient ID", Keyword: "TypeOfPatientID", VR: "CS", VM: "1"},
+	"00100030": {Name: "Patient's Birth Date", Keyword: "PatientBirthDate", VR: "DA", VM: "1"},
+	"00100032": {Name: "Patient's Birth Time", Keyword: "PatientBirthTime", VR: "TM", VM: "1"},
+	"00100040": {Name: "Patient's Sex", Keyword: "PatientSex", VR: "CS", VM: "1"},
+	"00100050": {Name: "Patient's Insurance Plan Code Sequence", Keyword: "PatientInsurancePlanCodeSequence", VR: "SQ", VM: "1"},
+	"00100101": {Name: "Patient's Primary Language Code Sequence", Keyword: "PatientPrimaryLanguageCodeSequence", VR: "SQ", VM: "1"},
+	"00100102": {Name: "Patient's Primary Language Modifier Code Sequence", Keyword: "PatientPrimaryLanguageModifierCodeSequence", VR: "SQ", VM: "1"},
+	"00101000": {Name: "Other Patient I Ds", Keyword: "OtherPatientIDs", VR: "LO", VM: "1-n", Retired: true},
+	"00101001": {Name: "Other Patient Names", Keyword: "OtherPatientNames", VR: "PN", VM: "1-n"},
+	"00101002": {Name: "Other Patient I Ds Sequence", Keyword: "OtherPatientIDsSequence", VR: "SQ", VM: "1"},
+	"00101005": {Name: "Patient's Birth Name", Keyword: "PatientBirthName", VR: "PN", VM: "1"},
+	"00101010": {Name: "Patient's Age", Keyword: "PatientAge", VR: "AS", VM: "1"},
+	"00101020": {Name: "Patient's Size", Keyword: "PatientSize", VR: "DS", VM: "1"},
+	"00101030": {Name: "Patient's Weight", Keyword: "PatientWeight", VR: "DS", VM: "1"},
+	"00101040": {Name: "Patient's Address", Keyword: "PatientAddress", VR: "LO", VM: "1"},
+	"00101050": {Name: "Insurance Plan Identification", Keyword: "InsurancePlanIdentification", VR: "LO", VM: "1-n", Retired: true},
+	"00101060": {Name: "Patient's Mother Birth Name", Keyword: "PatientMotherBirthName", VR: "PN", VM: "1"},
+	"00101080": {Name: "Military Rank", Keyword: "MilitaryRank", VR: "LO", VM: "1"},
+	"00101081": {Name: "Branch of Service", Keyword: "BranchOfService", VR: "LO", VM: "1"},
+	"00101090": {Name: "Medical Record Locator", Keyword: "MedicalRecordLocator", VR: "LO", VM: "1", Retired: true},
+	"00102000": {Name: "Medical Alerts", Keyword: "MedicalAlerts", VR: "LO", VM: "1-n"},
+	"00102110": {Name: "Allergies", Keyword: "Allergies", VR: "LO", VM: "1-n"},
+	"00102150": {Name: "Country of Residence", Keyword: "CountryOfResidence", VR: "LO", VM: "1"},
+	"00102152": {Name: "Region of Residence", Keyword: "RegionOfResidence", VR: "LO", VM: "1"},
+	"00102154": {Name: "Patient's Telephone Numbers", Keyword: "PatientTelephoneNumbers", VR: "SH", VM: "1-n"},
+	"00102160": {Name: "Ethnic Group", Keyword: "EthnicGroup", VR: "SH", VM: "1"},
+	"00102180": {Name: "Occupation", Keyword: "Occupation", VR: "SH", VM: "1"},
+	"001021A0": {Name: "Smoking Status", Keyword: "SmokingStatus", VR: "CS", VM: "1"},
+	"001021B0": {Name: "Additional Patient History", Keyword: "AdditionalPatientHistory", VR: "LT", VM: "1"},
+	"001021C0": {Name: "Pregnancy Status", Keyword: "PregnancyStatus", VR: "US", VM: "1"},
+	"001021D0": {Name: "Last Menstrual Date", Keyword: "LastMenstrualDate", VR: "DA", VM: "1"},
+	"001021F0": {Name: "Patient's Religious Preference", Keyword: "PatientReligiousPreference", VR: "LO", VM: "1"},
+	"00102201": {Name: "Patient's Species Description", Keyword: "PatientSpeciesDescription", VR: "LO", VM: "1"},
+	"00102202": {Name: "Patient's Species Code Sequence", Keyword: "PatientSpeciesCodeSequence", VR: "SQ", VM: "1"},
+	"00102203": {Name: "Patient's Sex Neutered", Keyword: "PatientSexNeutered", VR: "CS", VM: "1"},
+	"00102210": {Name: "Anatomical Orientation Type", Keyword: "AnatomicalOrientationType", VR: "CS", VM: "1"},
+	"00102292": {Name: "Patient's Breed Description", Keyword: "PatientBreedDescription", VR: "LO", VM: "1"},
+	"00102293": {Name: "Patient's Breed Code Sequence", Keyword: "PatientBreedCodeSequence", VR: "SQ", VM: "1"},
+	"00102294": {Name: "Breed Registration Sequence", Keyword: "BreedRegistrationSequence", VR: "SQ", VM: "1"},
+	"00102295": {Name: "Breed Registration Number", Keyword: "BreedRegistrationNumber", VR: "LO", VM: "1"},
+	"00102296": {Name: "Breed Registry Code Sequence", Keyword: "BreedRegistryCodeSequence", VR: "SQ", VM: "1"},
+	"00102297": {Name: "Responsible Person", Keyword: "ResponsiblePerson", VR: "PN", VM: "1"},
+	"00102298": {Name: "Responsible Person Role", Keyword: "ResponsiblePersonRole", VR: "CS", VM: "1"},
+	"00102299": {Name: "Responsible Organization", Keyword: "ResponsibleOrganization", VR: "LO", VM: "1"},
+	"00104000": {Name: "Patient's Comments", Keyword: "PatientComments", VR: "LT", VM: "1"},
+	"00109431": {Name: "Examined Body Thickness", Keyword: "ExaminedBodyThickness", VR: "FL", VM: "1"},
+	"00120010": {Name: "Clinical Trial Sponsor Name", Keyword: "ClinicalTrialSponsorName", VR: "LO", VM: "1"},
+	"00120020": {Name: "Clinical Trial Protocol ID", Keyword: "ClinicalTrialProtocolID", VR: "LO", VM: "1"},
+	"00120021": {Name: "Clinical Trial Protocol Name", Keyword: "ClinicalTrialProtocolName", VR: "LO", VM: "1"},
+	"00120030": {Name: "Clinical Trial Site ID", Keyword: "ClinicalTrialSiteID", VR: "LO", VM: "1"},
+	"00120031": {Name: "Clinical Trial Site Name", Keyword: "ClinicalTrialSiteName", VR: "LO", VM: "1"},
+	"00120040": {Name: "Clinical Trial Subject ID", Keyword: "ClinicalTrialSubjectID", VR: "LO", VM: "1"},
+	"00120042": {Name: "Clinical Trial Subject Reading ID", Keyword: "ClinicalTrialSubjectReadingID", VR: "LO", VM: "1"},
+	"00120050": {Name: "Clinical Trial Time Point ID", Keyword: "ClinicalTrialTimePointID", VR: "LO", VM: "1"},
+	"00120051": {Name: "Clinical Trial Time Point Description", Keyword: "ClinicalTrialTimePointDescription", VR: "ST", VM: "1"},
+	"00120060": {Name: "Clinical Trial Coordinating Center", Keyword: "ClinicalTrialCoordinatingCenter", VR: "LO", VM: "1"},
+	"00120062": {Name: "Patient Identity Removed", Keyword: "PatientIdentityRemoved", VR: "CS", VM: "1"},
+	"00120063": {Name: "Deidentification Method", Keyword: "DeidentificationMethod", VR: "LO", VM: "1-n"},
+	"00120064": {Name: "Deidentification Method Code Sequence", Keyword: "DeidentificationMethodCodeSequence", VR: "SQ", VM: "1"},
+	"00120071": {Name: "Clinical Trial Series ID", Keyword: "ClinicalTrialSeriesID", VR: "LO", VM: "1"},
+	"00120072": {Name: "Clinical Trial Series Description", Keyword: "ClinicalTrialSeriesDescription", VR: "LO", VM: "1"},
+	"00120084": {Name: "Distribution Type", Keyword: "DistributionType", VR: "CS", VM: "1"},
+	"00120085": {Name: "Consent for Distribution Flag", Keyword: "ConsentForDistributionFlag", VR: "CS", VM: "1"},
+	"00180000": {Name: "Acquisition Group Length", Keyword: "AcquisitionGroupLength", VR: "UL", VM: "1", Retired: true},
+	"00180010": {Name: "Contrast Bolus Agent", Keyword: "ContrastBolusAgent", VR: "LO", VM: "1"},
+	"00180012": {Name: "Contrast Bolus Agent Sequence", Keyword: "ContrastBolusAgentSequence", VR: "SQ", VM: "1"},
+	"00180014": {Name: "Contrast Bolus Administration Route Sequence", Keyword: "ContrastBolusAdministrationRouteSequence", VR: "SQ", VM: "1"},
+	"00180015": {Name: "Body Part Examined", Keyword: "BodyPartExamined", VR: "CS", VM: "1"},
+	"00180020": {Name: "Scanning Sequence", Keyword: "ScanningSequence", VR: "CS", VM: "1-n"},
+	"00180021": {Name: "Sequence Variant", Keyword: "SequenceVariant", VR: "CS", VM: "1-n"},
+	"00180022": {Name: "Scan Options", Keyword: "ScanOptions", VR: "CS", VM: "1-n"},
+	"00180023": {Name: "MR Acquisition Type", Keyword: "MRAcquisitionType", VR: "CS", VM: "1"},
+	"00180024": {Name: "Sequence Name", Keyword: "SequenceName", VR: "SH", VM: "1"},
+	"00180025": {Name: "Angio Flag", Keyword: "AngioFlag", VR: "CS", VM: "1"},
+	"00180026": {Name: "Intervention Drug Information Sequence", Keyword: "InterventionDrugInformationSequence", VR: "SQ", VM: "1"},
+	"00180027": {Name: "Intervention Drug Stop Time", Keyword: "InterventionDrugStopTime", VR: "TM", VM: "1"},
+	"00180028": {Name: "Intervention Drug Dose", Keyword: "InterventionDrugDose", VR: "DS", VM: "1"},
+	"00180029": {Name: "Intervention Drug Code Sequence", Keyword: "InterventionDrugCodeSequence", VR: "SQ", VM: "1"},
+	"0018002A": {Name: "Additional Drug Sequence", Keyword: "AdditionalDrugSequence", VR: "SQ", VM: "1"},
+	"00180030": {Name: "Radionuclide", Keyword: "Radionuclide", VR: "LO", VM: "1-n", Retired: true},
+	"00180031": {Name: "Radiopharmaceutical", Keyword: "Radiopharmaceutical", VR: "LO", VM: "1"},
+	"00180032": {Name: "Energy Window Centerline", Keyword: "EnergyWindowCenterline", VR: "DS", VM: "1", Retired: true},
+	"00180033": {Name: "Energy Window Total Width", Keyword: "EnergyWindowTotalWidth", VR: "DS", VM: "1-n", Retired: true},
+	"00180034": {Name: "Intervention Drug Name", Keyword: "InterventionDrugName", VR: "LO", VM: "1"},
+	"00180035": {Name: "Intervention Drug Start Time", Keyword: "InterventionDrugStartTime", VR: "TM", VM: "1"},
+	"00180036": {Name: "Intervention Sequence", Keyword: "InterventionSequence", VR: "SQ", VM: "1"},
+	"00180037": {Name: "Therapy Type", Keyword: "TherapyType", VR: "CS", VM: "1", Retired: true},
+	"00180038": {Name: "Intervention Status", Keyword: "InterventionStatus", VR: "CS", VM: "1"},
+	"00180039": {Name: "Therapy Description", Keyword: "TherapyDescription", VR: "CS", VM: "1", Retired: true},
+	"0018003A": {Name: "Intervention Description", Keyword: "InterventionDescription", VR: "ST", VM: "1"},
+	"00180040": {Name: "Cine Rate", Keyword: "CineRate", VR: "IS", VM: "1"},
+	"00180042": {Name: "Initial Cine Run State", Keyword: "InitialCineRunState", VR: "CS", VM: "1"},
+	"00180050": {Name: "Slice Thickness", Keyword: "SliceThickness", VR: "DS", VM: "1"},
+	"00180060": {Name: "KVP", Keyword: "KVP", VR: "DS", VM: "1"},
+	"00180070": {Name: "Counts Accumulated", Keyword: "CountsAccumulated", VR: "IS", VM: "1"},
+	"00180071": {Name: "Acquisition Termination Condition", Keyword: "AcquisitionTerminationCondition", VR: "CS", VM: "1"},
+	"00180072": {Name: "Effective Duration", Keyword: "EffectiveDuration", VR: "DS", VM: "1"},
+	"00180073": {Name: "Acquisition Start Condition", Keyword: "AcquisitionStartCondition", VR: "CS", VM: "1"},
+	"00180074": {Name: "Acquisition Start Condition Data", Keyword: "AcquisitionStartConditionData", VR: "IS", VM: "1"},
+	"00180075": {Name: "Acquisition End Condition Data", Keyword: "AcquisitionEndConditionData", VR: "IS", VM: "1"},
+	"00180080": {Name: "Repetition Time", Keyword: "RepetitionTime", VR: "DS", VM: "1"},
+	"00180081": {Name: "Echo Time", Keyword: "EchoTime", VR: "DS", VM: "1"},
+	"00180082": {Name: "Inversion Time", Keyword: "InversionTime", VR: "DS", VM: "1"},
+	"00180083": {Name: "Number of Averages", Keyword: "NumberOfAverages", VR: "DS", VM: "1"},
+	"00180084": {Name: "Imaging Frequency", Keyword: "ImagingFrequency", VR: "DS", VM: "1"},
+	"00180085": {Name: "Imaged Nucleus", Keyword: "ImagedNucleus", VR: "SH", VM: "1"},
+	"00180086": {Name: "Echo Number", Keyword: "EchoNumber", VR: "IS", VM: "1-n"},
+	"00180087": {Name: "Magnetic Field Strength", Keyword: "MagneticFieldStrength", VR: "DS", VM: "1"},
+	"00180088": {Name: "Spacing Between Slices", Keyword: "SpacingBetweenSlices", VR: "DS", VM: "1"},
+	"00180089": {Name: "Number of Phase Encoding Steps", Keyword: "NumberOfPhaseEncodingSteps", VR: "IS", VM: "1"},
+	"00180090": {Name: "Data Collection Diameter", Keyword: "DataCollectionDiameter", VR: "DS", VM: "1"},
+	"00180091": {Name: "Echo Train Length", Keyword: "EchoTrainLength", VR: "IS", VM: "1"},
+	"00180093": {Name: "Percent Sampling", Keyword: "PercentSampling", VR: "DS", VM: "1"},
+	"00180094": {Name: "Percent Phase Field of View", Keyword: "PercentPhaseFieldOfView", VR: "DS", VM: "1"},
+	"00180095": {Name: "Pixel Bandwidth", Keyword: "PixelBandwidth", VR: "DS", VM: "1"},
+	"00181000": {Name: "Device Serial Number", Keyword: "DeviceSerialNumber", VR: "LO", VM: "1"},
+	"00181002": {Name: "Device UID", Keyword: "DeviceUID", VR: "UI", VM: "1"},
+	"00181003": {Name: "Device ID", Keyword: "DeviceID", VR: "LO", VM: "1"},
+	"00181004": {Name: "Plate ID", Keyword: "PlateID", VR: "LO", VM: "1"},
+	"00181005": {Name: "Generator ID", Keyword: "GeneratorID", VR: "LO", VM: "1"},
+	"00181006": {Name: "Grid ID", Keyword: "GridID", VR: "LO", VM: "1-n"},
+	"00181007": {Name: "Cassette ID", Keyword: "CassetteID", VR: "LO", VM: "1"},
+	"00181008": {Name: "Gantry ID", Keyword: "GantryID", VR: "LO", VM: "1"},
+	"00181010": {Name: "Secondary Capture Device ID", Keyword: "SecondaryCaptureDeviceID", VR: "LO", VM: "1"},
+	"00181011": {Name: "Hardcopy Creation Device ID", Keyword: "HardcopyCreationDeviceID", VR: "LO", VM: "1", Retired: true},
+	"00181012": {Name: "Date of Secondary Capture", Keyword: "DateOfSecondaryCapture", VR: "DA", VM: "1"},
+	"00181014": {Name: "Time of Secondary Capture", Keyword: "TimeOfSecondaryCapture", VR: "TM", VM: "1"},
+	"00181016": {Name: "Secondary Capture Device Manufacturer", Keyword: "SecondaryCaptureDeviceManufacturer", VR: "LO", VM: "1"},
+	"00181017": {Name: "Hardcopy Device Manufacturer", Keyword: "HardcopyDeviceManufacturer", VR: "LO", VM: "1", Retired: true},
+	"00181018": {Name: "Secondary Capture Device Model Name", Keyword: "SecondaryCaptureDeviceModelName", VR: "LO", VM: "1"},
+	"00181019": {Name: "Secondary Capture Device Software Versions", Keyword: "SecondaryCaptureDeviceSoftwareVersions", VR: "LO", VM: "1-n"},
+	"0018101A": {Name: "Hardcopy Device Software Version", Keyword: "HardcopyDeviceSoftwareVersion", VR: "LO", VM: "1-n", Retired: true},
+	"0018101B": {Name: "Hardcopy Device Model Name", Keyword: "HardcopyDeviceModelName", VR: "LO", VM: "1", Retired: true},
+	"00181020": {Name: "Software Versions", Keyword: "SoftwareVersions", VR: "LO", VM: "1-n"},
+	"00181022": {Name: "Video Image Format Acquired", Keyword: "VideoImageFormatAcquired", VR: "SH", VM: "1"},
+	"00181023": {Name: "Digital Image Format Acquired", Keyword: "DigitalImageFormatAcquired", VR: "LO", VM: "1"},
+	"00181030": {Name: "Protocol Name", Keyword: "ProtocolName", VR: "LO", VM: "1"},
+	"00181040": {Name: "Contrast Bolus Route", Keyword: "ContrastBolusRoute", VR: "LO", VM: "1"},
+	"00181041": {Name: "Contrast Bolus Volume", Keyword: "ContrastBolusVolume", VR: "DS", VM: "1"},
+	"00181042": {Name: "Contrast Bolus Start Time", Keyword: "ContrastBolusStartTime", VR: "TM", VM: "1"},
+	"00181043": {Name: "Contrast Bolus Stop Time", Keyword: "ContrastBolusStopTime", VR: "TM", VM: "1"},
+	"00181044": {Name: "Contrast Bolus Total Dose", Keyword: "ContrastBolusTotalDose", VR: "DS", VM: "1"},
+	"00181045": {Name: "Syringe Counts", Keyword: "SyringeCounts", VR: "IS", VM: "1"},
+	"00181046": {Name: "Contrast Flow Rate", Keyword: "ContrastFlowRate", VR: "DS", VM: "1-n"},
+	"00181047": {Name: "Contrast Flow Duration", Keyword: "ContrastFlowDuration", VR: "DS", VM: "1-n"},
+	"00181048": {Name: "Contrast Bolus Ingredient", Keyword: "ContrastBolusIngredient", VR: "CS", VM: "1-n"},
+	"00181049": {Name: "Contrast Bolus Concentration", Keyword: "ContrastBolusConcentration", VR: "DS", VM: "1"},
+	"00181050": {Name: "Spatial Resolution", Keyword: "SpatialResolution", VR: "DS", VM: "1"},
+	"00181060": {Name: "Trigger Time", Keyword: "TriggerTime", VR: "DS", VM: "1"},
+	"00181061": {Name: "Trigger Source or Type", Keyword: "TriggerSourceOrType", VR: "LO", VM: "1"},
+	"00181062": {Name: "Nominal Interval", Keyword: "NominalInterval", VR: "IS", VM: "1"},
+	"00181063": {Name: "Frame Time", Keyword: "FrameTime", VR: "DS", VM: "1"},
+	"00181064": {Name: "Cardiac Framing Type", Keyword: "CardiacFramingType", VR: "LO", VM: "1"},
+	"00181065": {Name: "Frame Time Vector", Keyword: "FrameTimeVector", VR: "DS", VM: "1-n"},
+	"00181066": {Name: "Frame Delay", Keyword: "FrameDelay", VR: "DS", VM: "1"},
+	"00181067": {Name: "Image Trigger Delay", Keyword: "ImageTriggerDelay", VR: "DS", VM: "1"},
+	"00181068": {Name: "Multiplex Group Time Offset", Keyword: "MultiplexGroupTimeOffset", VR: "DS", VM: "1"},
+	"00181069": {Name: "Trigger Time Offset", Keyword: "TriggerTimeOffset", VR: "DS", VM: "1"},
+	"0018106A": {Name: "Synchronization Trigger", Keyword: "SynchronizationTrigger", VR: "CS", VM: "1"},
+	"0018106C": {Name: "Synchronization Channel", Keyword: "SynchronizationChannel", VR: "US", VM: "2"},
+	"0018106E": {Name: "Trigger Sample Position", Keyword: "TriggerSamplePosition", VR: "UL", VM: "1"},
+	"00181070": {Name: "Radiopharmaceutical Route", Keyword: "RadiopharmaceuticalRoute", VR: "LO", VM: "1"},
+	"00181071": {Name: "Radiopharmaceutical Volume", Keyword: "RadiopharmaceuticalVolume", VR: "DS", VM: "1"},
+	"00181072": {Name: "Radiopharmaceutical Start Time", Keyword: "RadiopharmaceuticalStartTime", VR: "TM", VM: "1"},
+	"00181073": {Name: "Radiopharmaceutical Stop Time", Keyword: "RadiopharmaceuticalStopTime", VR: "TM", VM: "1"},
+	"00181074": {Name: "Radionuclide Total Dose", Keyword: "RadionuclideTotalDose", VR: "DS", VM: "1"},
+	"00181075": {Name: "Radionuclide Half Life", Keyword: "RadionuclideHalfLife", VR: "DS", VM: "1"},
+	"00181076": {Name: "Radionuclide Positron Fraction", Keyword: "RadionuclidePositronFraction", VR: "DS", VM: "1"},
+	"00181077": {Name: "Radiopharmaceutical Specific Activity", Keyword: "RadiopharmaceuticalSpecificActivity", VR: "DS", VM: "1"},
+	"00181078": {Name: "Radiopharmaceutical Start Date Time", Keyword: "RadiopharmaceuticalStartDateTime", VR: "DT", VM: "1"},
+	"00181079": {Name: "Radiopharmaceutical Stop Date Time", Keyword: "RadiopharmaceuticalStopDateTime", VR: "DT", VM: "1"},
+	"00181080": {Name: "Beat Rejection Flag", Keyword: "BeatRejectionFlag", VR: "CS", VM: "1"},
+	"00181081": {Name: "Low RR Value", Keyword: "LowRRValue", VR: "IS", VM: "1"},
+	"00181082": {Name: "High RR Value", Keyword: "HighRRValue", VR: "IS", VM: "1"},
+	"00181083": {Name: "Intervals Acquired", Keyword: "IntervalsAcquired", VR: "IS", VM: "1"},
+	"00181084": {Name: "Intervals Rejected", Keyword: "IntervalsRejected", VR: "IS", VM: "1"},
+	"00181085": {Name: "PVC Rejection", Keyword: "PVCRejection", VR: "LO", VM: "1"},
+	"00181086": {Name: "Skip Beats", Keyword: "SkipBeats", VR: "IS", VM: "1"},
+	"00181088": {Name: "Heart Rate", Keyword: "HeartRate", VR: "IS", VM: "1"},
+	"00181090": {Name: "Cardiac Number of Images", Keyword: "CardiacNumberOfImages", VR: "IS", VM: "1"},
+	"00181094": {Name: "Trigger Window", Keyword: "TriggerWindow", VR: "IS", VM: "1"},
+	"00181100": {Name: "Reconstruction Diameter", Keyword: "ReconstructionDiameter", VR: "DS", VM: "1"},
+	"00181110": {Name: "Distance Source to Detector", Keyword: "DistanceSourceToDetector", VR: "DS", VM: "1"},
+	"00181111": {Name: "Distance Source to Patient", Keyword: "DistanceSourceToPatient", VR: "DS", VM: "1"},
+	"00181114": {Name: "Estimated Radiographic Magnification", Keyword: "EstimatedRadiographicMagnification", VR: "DS", VM: "1"},
+	"00181120": {Name: "Gantry Detector Tilt", Keyword: "GantryDetectorTilt", VR: "DS", VM: "1"},
+	"00181121": {Name: "Gantry Detector Slew", Keyword: "GantryDetectorSlew", VR: "DS", VM: "1"},
+	"00181130": {Name: "Table Height", Keyword: "TableHeight", VR: "DS", VM: "1"},
+	"00181131": {Name: "Table Traverse", Keyword: "TableTraverse", VR: "DS", VM: "1"},
+	"00181134": {Name: "Table Motion", Keyword: "TableMotion", VR: "CS", VM: "1"},
+	"00181135": {Name: "Table Vertical Increment", Keyword: "TableVerticalIncrement", VR: "DS", VM: "1-n"},
+	"00181136": {Name: "Table Lateral Increment", Keyword: "TableLateralIncrement", VR: "DS", VM: "1-n"},
+	"00181137": {Name: "Table Longitudinal Increment", Keyword: "TableLongitudinalIncrement", VR: "DS", VM: "1-n"},
+	"00181138": {Name: "Table Angle", Keyword: "TableAngle", VR: "DS", VM: "1"},
+	"0018113A": {Name: "Table Type", Keyword: "TableType", VR: "CS", VM: "1"},
+	"00181140": {Name: "Rotation Direction", Keyword: "RotationDirection", VR: "CS", VM: "1"},
+	"00181141": {Name: "Angular Position", Keyword: "AngularPosition", VR: "DS", VM: "1"},
+	"00181142": {Name: "Radial Position", Keyword: "RadialPosition", VR: "DS", VM: "1-n"},
+	"00181143": {Name: "Scan Arc", Keyword: "ScanArc", VR: "DS", VM: "1"},
+	"00181144": {Name: "Angular Step", Keyword: "AngularStep", VR: "DS", VM: "1"},
+	"00181145": {Name: "Center of Rotation Offset", Keyword: "CenterOfRotationOffset", VR: "DS", VM: "1", Retired: true},
+	"00181146": {Name: "Rotation Offset", Keyword: "RotationOffset", VR: "DS", VM: "1-n"},
+	"00181147": {Name: "Field of View Shape", Keyword: "FieldOfViewShape", VR: "CS", VM: "1"},
+	"00181149": {Name: "Field of View Dimensions", Keyword: "FieldOfViewDimensions", VR: "IS", VM: "1-2"},
+	"00181150": {Name: "Exposure Time", Keyword: "ExposureTime", VR: "IS", VM: "1"},
+	"00181151": {Name: "X-Ray Tube Current", Keyword: "XRayTubeCurrent", VR: "IS", VM: "1"},
+	"00181152": {Name: "Exposure", Keyword: "Exposure", VR: "IS", VM: "1"},
+	"00181153": {Name: "Exposure in uAs", Keyword: "ExposureInuAs", VR: "IS", VM: "1"},
+	"00181154": {Name: "Average Pulse Width", Keyword: "AveragePulseWidth", VR: "DS", VM: "1"},
+	"00181155": {Name: "Radiation Setting", Keyword: "RadiationSetting", VR: "CS", VM: "1"},
+	"00181156": {Name: "Rectification Type", Keyword: "RectificationType", VR: "CS", VM: "1"},
+	"0018115A": {Name: "Radiation Mode", Keyword: "RadiationMode", VR: "CS", VM: "1"},
+	"0018115E": {Name: "Image Area Dose Product", Keyword: "ImageAreaDoseProduct", VR: "DS", VM: "1"},
+	"00181160": {Name: "Filter Type", Keyword: "FilterType", VR: "SH", VM: "1"},
+	"00181161": {Name: "Type of Filters", Keyword: "TypeOfFilters", VR: "LO", VM: "1-n"},
+	"00181162": {Name: "Intensifier Size", Keyword: "IntensifierSize", VR: "DS", VM: "1"},
+	"00181164": {Name: "Imager Pixel Spacing", Keyword: "ImagerPixelSpacing", VR: "DS", VM: "2"},
+	"00181166": {Name: "Grid", Keyword: "Grid", VR: "CS", VM: "1-n"},
+	"00181170": {Name: "Generator Power", Keyword: "GeneratorPower", VR: "IS", VM: "1"},
+	"00181180": {Name: "Collimator Grid Name", Keyword: "CollimatorGridName", VR: "SH", VM: "1"},
+	"00181181": {Name: "Collimator Type", Keyword: "CollimatorType", VR: "CS", VM: "1"},
+	"00181182": {Name: "Focal Distance", Keyword: "FocalDistance", VR: "IS", VM: "1"},
+	"00181183": {Name: "X Focus Center", Keyword: "XFocusCenter", VR: "DS", VM: "1"},
+	"00181184": {Name: "Y Focus Center", Keyword: "YFocusCenter", VR: "DS", VM: "1"},
+	"00181190": {Name: "Focal Spots", Keyword: "FocalSpots", VR: "DS", VM: "1-n"},
+	"00181191": {Name: "Anode Target Material", Keyword: "AnodeTargetMaterial", VR: "CS", VM: "1"},
+	"001811A0": {Name: "Body Part Thickness", Keyword: "BodyPartThickness", VR: "DS", VM: "1"},
+	"001811A2": {Name: "Compression Force", Keyword: "CompressionForce", VR: "DS", VM: "1"},
+	"00181200": {Name: "Date of Last Calibration", Keyword: "DateOfLastCalibration", VR: "DA", VM: "1-n"},
+	"00181201": {Name: "Time of Last Calibration", Keyword: "TimeOfLastCalibration", VR: "TM", VM: "1-n"},
+	"00181210": {Name: "Convolution Kernel", Keyword: "ConvolutionKernel", VR: "SH", VM: "1-n"},
+	"00181240": {Name: "Upper Lower Pixel Values", Keyword: "UpperLowerPixelValues", VR: "IS", VM: "1-n", Retired: true},
+	"00181242": {Name: "Actual Frame Duration", Keyword: "ActualFrameDuration", VR: "IS", VM: "1"},
+	"00181243": {Name: "Count Rate", Keyword: "CountRate", VR: "IS", VM: "1"},
+	"00181244": {Name: "Preferred Playback Sequencing", Keyword: "PreferredPlaybackSequencing", VR: "US", VM: "1"},
+	"00181250": {Name: "Receive Coil Name", Keyword: "ReceiveCoilName", VR: "SH", VM: "1"},
+	"00181251": {Name: "Transmit Coil Name", Keyword: "TransmitCoilName", VR: "SH", VM: "1"},
+	"00181260": {Name: "Plate Type", Keyword: "PlateType", VR: "SH", VM: "1"},
+	"00181261": {Name: "Phosphor Type", Keyword: "PhosphorType", VR: "LO", VM: "1"},
+	"00181300": {Name: "Scan Velocity", Keyword: "ScanVelocity", VR: "DS", VM: "1"},
+	"00181301": {Name: "Whole Body Technique", Keyword: "WholeBodyTechnique", VR: "CS", VM: "1-n"},
+	"00181302": {Name: "Scan Length", Keyword: "ScanLength", VR: "IS", VM: "1"},
+	"00181310": {Name: "Acquisition Matrix", Keyword: "AcquisitionMatrix", VR: "US", VM: "4"},
+	"00181312": {Name: "In Plane Phase Encoding Direction", Keyword: "InPlanePhaseEncodingDirection", VR: "CS", VM: "1"},
+	"00181314": {Name: "Flip Angle", Keyword: "FlipAngle", VR: "DS", VM: "1"},
+	"00181315": {Name: "Variable Flip Angle Flag", Keyword: "VariableFlipAngleFlag", VR: "CS", VM: "1"},
+	"00181316": {Name: "SAR", Keyword: "SAR", VR: "DS", VM: "1"},
+	"00181318": {Name: "d Bdt", Keyword: "dBdt", VR: "DS", VM: "1"},
+	"00181400": {Name: "Acquisition Device Processing Description", Keyword: "AcquisitionDeviceProcessingDescription", VR: "LO", VM: "1"},
+	"00181401": {Name: "Acquisition Device Processing Code", Keyword: "AcquisitionDeviceProcessingCode", VR: "LO", VM: "1"},
+	"00181402": {Name: "Cassette Orientation", Keyword: "CassetteOrientation", VR: "CS", VM: "1"},
+	"00181403": {Name: "Cassette Size", Keyword: "CassetteSize", VR: "CS", VM: "1"},
+	"00181404": {Name: "Exposures on Plate", Keyword: "ExposuresOnPlate", VR: "IS", VM: "1"},
+	"00181405": {Name: "Relative X-Ray Exposure", Keyword: "RelativeXRayExposure", VR: "IS", VM: "1"},
+	"00181450": {Name: "Column Angulation", Keyword: "ColumnAngulation", VR: "DS", VM: "1"},
+	"00181460": {Name: "Tomo Layer Height", Keyword: "TomoLayerHeight", VR: "DS", VM: "1"},
+	"00181470": {Name: "Tomo Angle", Keyword: "TomoAngle", VR: "DS", VM: "1"},
+	"00181480": {Name: "Tomo Time", Keyword: "TomoTime", VR: "DS", VM: "1"},
+	"00181490": {Name: "Tomo Type", Keyword: "TomoType", VR: "CS", VM: "1"},
+	"00181491": {Name: "Tomo Class", Keyword: "TomoClass", VR: "CS", VM: "1"},
+	"00181495": {Name: "Number of Tomosynthesis Source Images", Keyword: "NumberOfTomosynthesisSourceImages", VR: "IS", VM: "1"},
+	"00181500": {Name: "Positioner Motion", Keyword: "PositionerMotion", VR: "CS", VM: "1"},
+	"00181508": {Name: "Positioner Type", Keyword: "PositionerType", VR: "CS", VM: "1"},
+	"00181510": {Name: "Positioner Primary Angle", Keyword: "PositionerPrimaryAngle", VR: "DS", VM: "1"},
+	"00181511": {Name: "Positioner Secondary Angle", Keyword: "PositionerSecondaryAngle", VR: "DS", VM: "1"},
+	"00181520": {Name: "Positioner Primary Angle Increment", Keyword: "PositionerPrimaryAngleIncrement", VR: "DS", VM: "1-n"},
+	"00181521": {Name: "Positioner Secondary Angle Increment", Keyword: "PositionerSecondaryAngleIncrement", VR: "DS", VM: "1-n"},
+	"00181530": {Name: "Detector Primary Angle", Keyword: "DetectorPrimaryAngle", VR: "DS", VM: "1"},
+	"00181531": {Name: "Detector Secondary Angle", Keyword: "DetectorSecondaryAngle", VR: "DS", VM: "1"},
+	"00181600": {Name: "Shutter Shape", Keyword: "ShutterShape", VR: "CS", VM: "1-3"},
+	"00181602": {Name: "Shutter Left Vertical Edge", Keyword: "ShutterLeftVerticalEdge", VR: "IS", VM: "1"},
+	"00181604": {Name: "Shutter Right Vertical Edge", Keyword: "ShutterRightVerticalEdge", VR: "IS", VM: "1"},
+	"00181606": {Name: "Shutter Upper Horizontal Edge", Keyword: "ShutterUpperHorizontalEdge", VR: "IS", VM: "1"},
+	"00181608": {Name: "Shutter Lower Horizontal Edge", Keyword: "ShutterLowerHorizontalEdge", VR: "IS", VM: "1"},
+	"00181610": {Name: "Center of Circular Shutter", Keyword: "CenterOfCircularShutter", VR: "IS", VM: "2"},
+	"00181612": {Name: "Radius of Circular Shutter", Keyword: "RadiusOfCircularShutter", VR: "IS", VM: "1"},
+	"00181620": {Name: "Vertices of Polygonal Shutter", Keyword: "VerticesOfPolygonalShutter", VR: "IS", VM: "2-2n"},
+	"00181622": {Name: "Shutter Presentation Value", Keyword: "ShutterPresentationValue", VR: "US", VM: "1"},
+	"00181623": {Name: "Shutter Overlay Group", Keyword: "ShutterOverlayGroup", VR: "US", VM: "1"},
+	"00181624": {Name: "Shutter Presentation Color CIE Lab Value", Keyword: "ShutterPresentationColorCIELabValue", VR: "US", VM: "3"},
+	"00181700": {Name: "Collimator Shape", Keyword: "CollimatorShape", VR: "CS", VM: "1-3"},
+	"00181702": {Name: "Collimator Left Vertical Edge", Keyword: "CollimatorLeftVerticalEdge", VR: "IS", VM: "1"},
+	"00181704": {Name: "Collimator Right Vertical Edge", Keyword: "CollimatorRightVerticalEdge", VR: "IS", VM: "1"},
+	"00181706": {Name: "Collimator Upper Horizontal Edge", Keyword: "CollimatorUpperHorizontalEdge", VR: "IS", VM: "1"},
+	"00181708": {Name: "Collimator Lower Horizontal Edge", Keyword: "CollimatorLowerHorizontalEdge", VR: "IS", VM: "1"},
+	"00181710": {Name: "Center of Circular Collimator", Keyword: "CenterOfCircularCollimator", VR: "IS", VM: "2"},
+	"00181712": {Name: "Radius of Circular Collimator", Keyword: "RadiusOfCircularCollimator", VR: "IS", VM: "1"},
+	"00181720": {Name: "Vertices of Polygonal Collimator", Keyword: "VerticesOfPolygonalCollimator", VR: "IS", VM: "2-2n"},
+	"00181800": {Name: "Acquisition Time Synchronized", Keyword: "AcquisitionTimeSynchronized", VR: "CS", VM: "1"},
+	"00181801": {Name: "Time Source", Keyword: "TimeSource", VR: "SH", VM: "1"},
+	"00181802": {Name: "Time Distribution Protocol", Keyword: "TimeDistributionProtocol", VR: "CS", VM: "1"},
+	"00181803": {Name: "NTP Source Address", Keyword: "NTPSourceAddress", VR: "LO", VM: "1"},
+	"00182001": {Name: "Page Number Vector", Keyword: "PageNumberVector", VR: "IS", VM: "1-n"},
+	"00182002": {Name: "Frame Label Vector", Keyword: "FrameLabelVector", VR: "SH", VM: "1-n"},
+	"00182003": {Name: "Frame Primary Angle Vector", Keyword: "FramePrimaryAngleVector", VR: "DS", VM: "1-n"},
+	"00182004": {Name: "Frame Secondary Angle Vector", Keyword: "FrameSecondaryAngleVector", VR: "DS", VM: "1-n"},
+	"00182005": {Name: "Slice Location Vector", Keyword: "SliceLocationVector", VR: "DS", VM: "1-n"},
+	"00182006": {Name: "Display Window Label Vector", Keyword: "DisplayWindowLabelVector", VR: "SH", VM: "1-n"},
+	"00182010": {Name: "Nominal Scanned Pixel Spacing", Keyword: "NominalScannedPixelSpacing", VR: "DS", VM: "2"},
+	"00182020": {Name: "Digitizing Device Transport Direction", Keyword: "DigitizingDeviceTransportDirection", VR: "CS", VM: "1"},
+	"00182030": {Name: "Rotation of Scanned Film", Keyword: "RotationOfScannedFilm", VR: "DS", VM: "1"},
+	"00183100": {Name: "IVUS Acquisition", Keyword: "IVUSAcquisition", VR: "CS", VM: "1"},
+	"00183101": {Name: "IVUS Pullback Rate", Keyword: "IVUSPullbackRate", VR: "DS", VM: "1"},
+	"00183102": {Name: "IVUS Gated Rate", Keyword: "IVUSGatedRate", VR: "DS", VM: "1"},
+	"00183103": {Name: "IVUS Pullback Start Frame Number", Keyword: "IVUSPullbackStartFrameNumber", VR: "IS", VM: "1"},
+	"00183104": {Name: "IVUS Pullback Stop Frame Number", Keyword: "IVUSPullbackStopFrameNumber", VR: "IS", VM: "1"},
+	"00183105": {Name: "Lesion Number", Keyword: "LesionNumber", VR: "IS", VM: "1-n"},
+	"00184000": {Name: "Acquisition Comments", Keyword: "AcquisitionComments", VR: "LT", VM: "1", Retired: true},
+	"00185000": {Name: "Output Power", Keyword: "OutputPower", VR: "SH", VM: "1-n"},
+	"00185010": {Name: "Transducer Data", Keyword: "TransducerData", VR: "LO", VM: "1-n"},
+	"00185012": {Name: "Focus Depth", Keyword: "FocusDepth", VR: "DS", VM: "1"},
+	"00185020": {Name: "Processing Function", Keyword: "ProcessingFunction", VR: "LO", VM: "1"},
+	"00185021": {Name: "Postprocessing Function", Keyword: "PostprocessingFunction", VR: "LO", VM: "1", Retired: true},
+	"00185022": {Name: "Mechanical Index", Keyword: "MechanicalIndex", VR: "DS", VM: "1"},
+	"00185024": {Name: "Bone Thermal Index", Keyword: "BoneThermalIndex", VR: "DS", VM: "1"},
+	"00185026": {Name: "Cranial Thermal Index", Keyword: "CranialThermalIndex", VR: "DS", VM: "1"},
+	"00185027": {Name: "Soft Tissue Thermal Index", Keyword: "SoftTissueThermalIndex", VR: "DS", VM: "1"},
+	"00185028": {Name: "Soft Tissue Focus Thermal Index", Keyword: "SoftTissueFocusThermalIndex", VR: "DS", VM: "1"},
+	"00185029": {Name: "Soft Tissue Surface Thermal Index", Keyword: "SoftTissueSurfaceThermalIndex", VR: "DS", VM: "1"},
+	"00185030": {Name: "Dynamic Range", Keyword: "DynamicRange", VR: "DS", VM: "1", Retired: true},
+	"00185040": {Name: "Total Gain", Keyword: "TotalGain", VR: "DS", VM: "1", Retired: true},
+	"00185050": {Name: "Depth of Scan Field", Keyword: "DepthOfScanField", VR: "IS", VM: "1"},
+	"00185100": {Name: "Patient Position", Keyword: "PatientPosition", VR: "CS", VM: "1"},
+	"00185101": {Name: "View Position", Keyword: "ViewPosition", VR: "CS", VM: "1"},
+	"00185104": {Name: "Projection Eponymous Name Code Sequence", Keyword: "ProjectionEponymousNameCodeSequence", VR: "SQ", VM: "1"},
+	"00185210": {Name: "Image Transformation Matrix", Keyword: "ImageTransformationMatrix", VR: "DS", VM: "6", Retired: true},
+	"00185212": {Name: "Image Translation Vector", Keyword: "ImageTranslationVector", VR: "DS", VM: "3", Retired: true},
+	"00186000": {Name: "Sensitivity", Keyword: "Sensitivity", VR: "DS", VM: "1"},
+	"00186011": {Name: "Sequence of Ultrasound Regions", Keyword: "SequenceOfUltrasoundRegions", VR: "SQ", VM: "1"},
+	"00186012": {Name: "Region Spatial Format", Keyword: "RegionSpatialFormat", VR: "US", VM: "1"},
+	"00186014": {Name: "Region Data Type", Keyword: "RegionDataType", VR: "US", VM: "1"},
+	"00186016": {Name: "Region Flags", Keyword: "RegionFlags", VR: "UL", VM: "1"},
+	"00186018": {Name: "Region Location Min X0", Keyword: "RegionLocationMinX0", VR: "UL", VM: "1"},
+	"0018601A": {Name: "Region Location Min Y0", Keyword: "RegionLocationMinY0", VR: "UL", VM: "1"},
+	"0018601C": {Name: "Region Location Max X1", Keyword: "RegionLocationMaxX1", VR: "UL", VM: "1"},
+	"0018601E": {Name: "Region Location Max Y1", Keyword: "RegionLocationMaxY1", VR: "UL", VM: "1"},
+	"00186020": {Name: "Reference Pixel X0", Keyword: "ReferencePixelX0", VR: "SL", VM: "1"},
+	"00186022": {Name: "Reference Pixel Y0", Keyword: "ReferencePixelY0", VR: "SL", VM: "1"},
+	"00186024": {Name: "Physical Units X Direction", Keyword: "PhysicalUnitsXDirection", VR: "US", VM: "1"},
+	"00186026": {Name: "Physical Units Y Direction", Keyword: "PhysicalUnitsYDirection", VR: "US", VM: "1"},
+	"00186028": {Name: "Reference Pixel Physical Value X", Keyword: "ReferencePixelPhysicalValueX", VR: "FD", VM: "1"},
+	"0018602A": {Name: "Reference Pixel Physical Value Y", Keyword: "ReferencePixelPhysicalValueY", VR: "FD", VM: "1"},
+	"0018602C": {Name: "Physical Delta X", Keyword: "PhysicalDeltaX", VR: "FD", VM: "1"},
+	"0018602E": {Name: "Physical Delta Y", Keyword: "PhysicalDeltaY", VR: "FD", VM: "1"},
+	"00186030": {Name: "Transducer Frequency", Keyword: "TransducerFrequency", VR: "UL", VM: "1"},
+	"00186031": {Name: "Transducer Type", Keyword: "TransducerType", VR: "CS", VM: "1"},
+	"00186032": {Name: "Pulse Repetition Frequency", Keyword: "PulseRepetitionFrequency", VR: "UL", VM: "1"},
+	"00186034": {Name: "Doppler Correction Angle", Keyword: "DopplerCorrectionAngle", VR: "FD", VM: "1"},
+	"00186036": {Name: "Steering Angle", Keyword: "SteeringAngle", VR: "FD", VM: "1"},
+	"00186038": {Name: "Doppler Sample Volume X Position (Retired)", Keyword: "DopplerSampleVolumeXPositionRetired", VR: "UL", VM: "1", Retired: true},
+	"00186039": {Name: "Doppler Sample Volume X Position", Keyword: "DopplerSampleVolumeXPosition", VR: "SL", VM: "1"},
+	"0018603A": {Name: "Doppler Sample Volume Y Position (Retired)", Keyword: "DopplerSampleVolumeYPositionRetired", VR: "UL", VM: "1", Retired: true},
+	"0018603B": {Name: "Doppler Sample Volume Y Position", Keyword: "DopplerSampleVolumeYPosition", VR: "SL", VM: "1"},
+	"0018603C": {Name: "TM Line Position X0 (Retired)", Keyword: "TMLinePositionX0Retired", VR: "UL", VM: "1", Retired: true},
+	"0018603D": {Name: "TM Line Position X0", Keyword: "TMLinePositionX0", VR: "SL", VM: "1"},
+	"0018603E": {Name: "TM Line Position Y0 (Retired)", Keyword: "TMLinePositionY0Retired", VR: "UL", VM: "1", Retired: true},
+	"0018603F": {Name: "TM Line Position Y0", Keyword: "TMLinePositionY0", VR: "SL", VM: "1"},
+	"00186040": {Name: "TM Line Position X1 (Retired)", Keyword: "TMLinePositionX1Retired", VR: "UL", VM: "1", Retired: true},
+	"00186041": {Name: "TM Line Position X1", Keyword: "TMLinePositionX1", VR: "SL", VM: "1"},
+	"00186042": {Name: "TM Line Position Y1 (Retired)", Keyword: "TMLinePositionY1Retired", VR: "UL", VM: "1", Retired: true},
+	"00186043": {Name: "TM Line Position Y1", Keyword: "TMLinePositionY1", VR: "SL", VM: "1"},
+	"00186044": {Name: "Pixel Component Organization", Keyword: "PixelComponentOrganization", VR: "US", VM: "1"},
+	"00186046": {Name: "Pixel Component Mask", Keyword: "PixelComponentMask", VR: "UL", VM: "1"},
+	"00186048": {Name: "Pixel Component Range Start", Keyword: "PixelComponentRangeStart", VR: "UL", VM: "1"},
+	"0018604A": {Name: "Pixel Component Range Stop", Keyword: "PixelComponentRangeStop", VR: "UL", VM: "1"},
+	"0018604C": {Name: "Pixel Component Physical Units", Keyword: "PixelComponentPhysicalUnits", VR: "US", VM: "1"},
+	"0018604E": {Name: "Pixel Component Data Type", Keyword: "PixelComponentDataType", VR: "US", VM: "1"},
+	"00186050": {Name: "Number of Table Break Points", Keyword: "NumberOfTableBreakPoints", VR: "UL", VM: "1"},
+	"00186052": {Name: "Table of X Break Points", Keyword: "TableOfXBreakPoints", VR: "UL", VM: "1-n"},
+	"00186054": {Name: "Table of Y Break Points", Keyword: "TableOfYBreakPoints", VR: "FD", VM: "1-n"},
+	"00186056": {Name: "Number of Table Entries", Keyword: "NumberOfTableEntries", VR: "UL", VM: "1"},
+	"00186058": {Name: "Table of Pixel Values", Keyword: "TableOfPixelValues", VR: "UL", VM: "1-n"},
+	"0018605A": {Name: "Table of Parameter Values", Keyword: "TableOfParameterValues", VR: "FL", VM: "1-n"},
+	"00186060": {Name: "R Wave Time Vector", Keyword: "RWaveTimeVector", VR: "FL", VM: "1-n"},
+	"00187000": {Name: "Detector Conditions Nominal Flag", Keyword: "DetectorConditionsNominalFlag", VR: "CS", VM: "1"},
+	"00187001": {Name: "Detector Temperature", Keyword: "DetectorTemperature", VR: "DS", VM: "1"},
+	"00187004": {Name: "Detector Type", Keyword: "DetectorType", VR: "CS", VM: "1"},
+	"00187005": {Name: "Detector Configuration", Keyword: "DetectorConfiguration", VR: "CS", VM: "1"},
+	"00187006": {Name: "Detector Description", Keyword: "DetectorDescription", VR: "LT", VM: "1"},
+	"00187008": {Name: "Detector Mode", Keyword: "DetectorMode", VR: "LT", VM: "1"},
+	"0018700A": {Name: "Detector ID", Keyword: "DetectorID", VR: "SH", VM: "1"},
+	"0018700C": {Name: "Date of Last Detector Calibration", Keyword: "DateOfLastDetectorCalibration", VR: "DA", VM: "1"},
+	"0018700E": {Name: "Time of Last Detector Calibration", Keyword: "TimeOfLastDetectorCalibration", VR: "TM", VM: "1"},
+	"00187010": {Name: "Detector Exposures Since Calibration", Keyword: "DetectorExposuresSinceCalibration", VR: "IS", VM: "1"},
+	"00187011": {Name: "Detector Exposures Since Manufactured", Keyword: "DetectorExposuresSinceManufactured", VR: "IS", VM: "1"},
+	"00187012": {Name: "Detector Time Since Last Exposure", Keyword: "DetectorTimeSinceLastExposure", VR: "DS", VM: "1"},
+	"00187014": {Name: "Detector Active Time", Keyword: "DetectorActiveTime", VR: "DS", VM: "1"},
+	"00187016": {Name: "Detector Active Offset from Exposure", Keyword: "DetectorActiveOffsetFromExposure", VR: "DS", VM: "1"},
+	"0018701A": {Name: "Detector Binning", Keyword: "DetectorBinning", VR: "DS", VM: "2"},
+	"00187020": {Name: "Detector Element Physical Size", Keyword: "DetectorElementPhysicalSize", VR: "DS", VM: "2"},
+	"00187022": {Name: "Detector Element Spacing", Keyword: "DetectorElementSpacing", VR: "DS", VM: "2"},
+	"00187024": {Name: "Detector Active Shape", Keyword: "DetectorActiveShape", VR: "CS", VM: "1"},
+	"00187026": {Name: "Detector Active Dimensions", Keyword: "DetectorActiveDimensions", VR: "DS", VM: "1-2"},
+	"00187028": {Name: "Detector Active Origin", Keyword: "DetectorActiveOrigin", VR: "DS", VM: "2"},
+	"0018702A": {Name: "Detector Manufacturer Name", Keyword: "DetectorManufacturerName", VR: "LO", VM: "1"},
+	"0018702B": {Name: "Detector Manufacturer Model Name", Keyword: "DetectorManufacturerModelName", VR: "LO", VM: "1"},
+	"00187030": {Name: "Field of View Origin", Keyword: "FieldOfViewOrigin", VR: "DS", VM: "2"},
+	"00187032": {Name: "Field of View Rotation", Keyword: "FieldOfViewRotation", VR: "DS", VM: "1"},
+	"00187034": {Name: "Field of View Horizontal Flip", Keyword: "FieldOfViewHorizontalFlip", VR: "CS", VM: "1"},
+	"00187040": {Name: "Grid Absorbing Material", Keyword: "GridAbsorbingMaterial", VR: "LT", VM: "1"},
+	"00187041": {Name: "Grid Spacing Material", Keyword: "GridSpacingMaterial", VR: "LT", VM: "1"},
+	"00187042": {Name: "Grid Thickness", Keyword: "GridThickness", VR: "DS", VM: "1"},
+	"00187044": {Name: "Grid Pitch", Keyword: "GridPitch", VR: "DS", VM: "1"},
+	"00187046": {Name: "Grid Aspect Ratio", Keyword: "GridAspectRatio", VR: "IS", VM: "2"},
+	"00187048": {Name: "Grid Period", Keyword: "GridPeriod", VR: "DS", VM: "1"},
+	"0018704C": {Name: "Grid Focal Distance", Keyword: "GridFocalDistance", VR: "DS", VM: "1"},
+	"00187050": {Name: "Filter Material", Keyword: "FilterMaterial", VR: "CS", VM: "1-n"},
+	"00187052": {Name: "Filter Thickness Minimum", Keyword: "FilterThicknessMinimum", VR: "DS", VM: "1-n"},
+	"00187054": {Name: "Filter Thickness Maximum", Keyword: "FilterThicknessMaximum", VR: "DS", VM: "1-n"},
+	"00187060": {Name: "Exposure Control Mode", Keyword: "ExposureControlMode", VR: "CS", VM: "1"},
+	"00187062": {Name: "Exposure Control Mode Description", Keyword: "ExposureControlModeDescription", VR: "LT", VM: "1"},
+	"00187064": {Name: "Exposure Status", Keyword: "ExposureStatus", VR: "CS", VM: "1"},
+	"00187065": {Name: "Phototimer Setting", Keyword: "PhototimerSetting", VR: "DS", VM: "1"},
+	"00188150": {Name: "Exposure Time in uS", Keyword: "ExposureTimeInuS", VR: "DS", VM: "1"},
+	"00188151": {Name: "X-Ray Tube Current in uA", Keyword: "XRayTubeCurrentInuA", VR: "DS", VM: "1"},
+	"00189004": {Name: "Content Qualification", Keyword: "ContentQualification", VR: "CS", VM: "1"},
+	"00189005": {Name: "Pulse Sequence Name", Keyword: "PulseSequenceName", VR: "SH", VM: "1"},
+	"00189006": {Name: "MR Imaging Modifier Sequence", Keyword: "MRImagingModifierSequence", VR: "SQ", VM: "1"},
+	"00189008": {Name: "Echo Pulse Sequence", Keyword: "EchoPulseSequence", VR: "CS", VM: "1"},
+	"00189009": {Name: "Inversion Recovery", Keyword: "InversionRecovery", VR: "CS", VM: "1"},
+	"00189010": {Name: "Flow Compensation", Keyword: "FlowCompensation", VR: "CS", VM: "1"},
+	"00189011": {Name: "Multiple Spin Echo", Keyword: "MultipleSpinEcho", VR: "CS", VM: "1"},
+	"00189012": {Name: "Multi Planar Excitation", Keyword: "MultiPlanarExcitation", VR: "CS", VM: "1"},
+	"00189014": {Name: "Phase Contrast", Keyword: "PhaseContrast", VR: "CS", VM: "1"},
+	"00189015": {Name: "Time of Flight Contrast", Keyword: "TimeOfFlightContrast", VR: "CS", VM: "1"},
+	"00189016": {Name: "Spoiling", Keyword: "Spoiling", VR: "CS", VM: "1"},
+	"00189017": {Name: "Steady State Pulse Sequence", Keyword: "SteadyStatePulseSequence", VR: "CS", VM: "1"},
+	"00189018": {Name: "Echo Planar Pulse Sequence", Keyword: "EchoPlanarPulseSequence", VR: "CS", VM: "1"},
+	"00189019": {Name: "Tag Angle First Axis", Keyword: "TagAngleFirstAxis", VR: "FD", VM: "1"},
+	"00189020": {Name: "Magnetization Transfer", Keyword: "MagnetizationTransfer", VR: "CS", VM: "1"},
+	"00189021": {Name: "T2 Preparation", Keyword: "T2Preparation", VR: "CS", VM: "1"},
+	"00189022": {Name: "Blood Signal Nulling", Keyword: "BloodSignalNulling", VR: "CS", VM: "1"},
+	"00189024": {Name: "Saturation Recovery", Keyword: "SaturationRecovery", VR: "CS", VM: "1"},
+	"00189025": {Name: "Spectrally Selected Suppression", Keyword: "SpectrallySelectedSuppression", VR: "CS", VM: "1"},
+	"00189026": {Name: "Spectrally Selected Excitation", Keyword: "SpectrallySelectedExcitation", VR: "CS", VM: "1"},
+	"00189027": {Name: "Spatial Presaturation", Keyword: "SpatialPresaturation", VR: "CS", VM: "1"},
+	"00189028": {Name: "Tagging", Keyword: "Tagging", VR: "CS", VM: "1"},
+	"00189029": {Name: "Oversampling Phase", Keyword: "OversamplingPhase", VR: "CS", VM: "1"},
+	"00189030": {Name: "Tag Spacing First Dimension", Keyword: "TagSpacingFirstDimension", VR: "FD", VM: "1"},
+	"00189032": {Name: "Geometry of K Space Traversal", Keyword: "GeometryOfKSpaceTraversal", VR: "CS", VM: "1"},
+	"00189033": {Name: "Segmented K Space Traversal", Keyword: "SegmentedKSpaceTraversal", VR: "CS", VM: "1"},
+	"00189034": {Name: "Rectilinear Phase Encode Reordering", Keyword: "RectilinearPhaseEncodeReordering", VR: "CS", VM: "1"},
+	"00189035": {Name: "Tag Thickness", Keyword: "TagThickness", VR: "FD", VM: "1"},
+	"00189036": {Name: "Partial Fourier Direction", Keyword: "PartialFourierDirection", VR: "CS", VM: "1"},
+	"00189037": {Name: "Cardiac Synchronization Technique", Keyword: "CardiacSynchronizationTechnique", VR: "CS", VM: "1"},
+	"00189041": {Name: "Receive Coil Manufacturer Name", Keyword: "ReceiveCoilManufacturerName", VR: "LO", VM: "1"},
+	"00189042": {Name: "MR Receive Coil Sequence", Keyword: "MRReceiveCoilSequence", VR: "SQ", VM: "1"},
+	"00189043": {Name: "Receive Coil Type", Keyword: "ReceiveCoilType", VR: "CS", VM: "1"},
+	"00189044": {Name: "Quadrature Receive Coil", Keyword: "QuadratureReceiveCoil", VR: "CS", VM: "1"},
+	"00189045": {Name: "Multi Coil Definition Sequence", Keyword: "MultiCoilDefinitionSequence", VR: "SQ", VM: "1"},
+	"00189046": {Name: "Multi Coil Configuration", Keyword: "MultiCoilConfiguration", VR: "LO", VM: "1"},
+	"00189047": {Name: "Multi Coil Element Name", Keyword: "MultiCoilElementName", VR: "SH", VM: "1"},
+	"00189048": {Name: "Multi Coil Element Used", Keyword: "MultiCoilElementUsed", VR: "CS", VM: "1"},
+	"00189049": {Name: "MR Transmit Coil Sequence", Keyword: "MRTransmitCoilSequence", VR: "SQ", VM: "1"},
+	"00189050": {Name: "Transmit Coil Manufacturer Name", Keyword: "TransmitCoilManufacturerName", VR: "LO", VM: "1"},
+	"00189051": {Name: "Transmit Coil Type", Keyword: "TransmitCoilType", VR: "CS", VM: "1"},
+	"00189052": {Name: "Spectral Width", Keyword: "SpectralWidth", VR: "FD", VM: "1-2"},
+	"00189053": {Name: "Chemical Shift Reference", Keyword: "ChemicalShiftReference", VR: "FD", VM: "1-2"},
+	"00189054": {Name: "Volume Localization Technique", Keyword: "VolumeLocalizationTechnique", VR: "CS", VM: "1"},
+	"00189058": {Name: "MR Acquisition Frequency Encode Steps", Keyword: "MRAcquisitionFrequencyEncodeSteps", VR: "US", VM: "1"},
+	"00189059": {Name: "Decoupling", Keyword: "Decoupling", VR: "CS", VM: "1"},
+	"00189060": {Name: "Decoupled Nucleus", Keyword: "DecoupledNucleus", VR: "CS", VM: "1-2"},
+	"00189061": {Name: "Decoupling Frequency", Keyword: "DecouplingFrequency", VR: "FD", VM: "1-2"},
+	"00189062": {Name: "Decoupling Method", Keyword: "DecouplingMethod", VR: "CS", VM: "1"},
+	"00189063": {Name: "Decoupling Chemical Shift Reference", Keyword: "DecouplingChemicalShiftReference", VR: "FD", VM: "1-2"},
+	"00189064": {Name: "K Space Filtering", Keyword: "KSpaceFiltering", VR: "CS", VM: "1"},
+	"00189065": {Name: "Time Domain Filtering", Keyword: "TimeDomainFiltering", VR: "CS", VM: "1-2"},
+	"00189066": {Name: "Number of Zero Fills", Keyword: "NumberOfZeroFills", VR: "US", VM: "1-2"},
+	"00189067": {Name: "Baseline Correction", Keyword: "BaselineCorrection", VR: "CS", VM: "1"},
+	"00189069": {Name: "Parallel Reduction Factor in Plane", Keyword: "ParallelReductionFactorInPlane", VR: "FD", VM: "1"},
+	"00189070": {Name: "Cardiac RR Interval Specified", Keyword: "CardiacRRIntervalSpecified", VR: "IS", VM: "1"},
+	"00189073": {Name: "Acquisition Duration", Keyword: "AcquisitionDuration", VR: "FD", VM: "1"},
+	"00189074": {Name: "Frame Acquisition Date Time", Keyword: "FrameAcquisitionDateTime", VR: "DT", VM: "1"},
+	"00189075": {Name: "Diffusion Directionality", Keyword: "DiffusionDirectionality", VR: "CS", VM: "1"},
+	"00189076": {Name: "Diffusion Gradient Direction Sequence", Keyword: "DiffusionGradientDirectionSequence", VR: "SQ", VM: "1"},
+	"00189077": {Name: "Parallel Acquisition", Keyword: "ParallelAcquisition", VR: "CS", VM: "1"},
+	"00189078": {Name: "Parallel Acquisition Technique", Keyword: "ParallelAcquisitionTechnique", VR: "CS", VM: "1"},
+	"00189079": {Name: "Inversion Times", Keyword: "InversionTimes", VR: "FD", VM: "1-n"},
+	"00189080": {Name: "Metabolite Map Description", Keyword: "MetaboliteMapDescription", VR: "ST", VM: "1"},
+	"00189081": {Name: "Partial Fourier", Keyword: "PartialFourier", VR: "CS", VM: "1"},
+	"00189082": {Name: "Effective Echo Time", Keyword: "EffectiveEchoTime", VR: "FD", VM: "1"},
+	"00189083": {Name: "Metabolite Map Code Sequence", Keyword: "MetaboliteMapCodeSequence", VR: "SQ", VM: "1"},
+	"00189084": {Name: "Chemical Shift Sequence", Keyword: "ChemicalShiftSequence", VR: "SQ", VM: "1"},
+	"00189085": {Name: "Cardiac Signal Source", Keyword: "CardiacSignalSource", VR: "CS", VM: "1"},
+	"00189087": {Name: "Diffusion B Value", Keyword: "DiffusionBValue", VR: "FD", VM: "1"},
+	"00189089": {Name: "Diffusion Gradient Orientation", Keyword: "DiffusionGradientOrientation", VR: "FD", VM: "3"},
+	"00189090": {Name: "Velocity Encoding Direction", Keyword: "VelocityEncodingDirection", VR: "FD", VM: "3"},
+	"00189091": {Name: "Velocity Encoding Minimum Value", Keyword: "VelocityEncodingMinimumValue", VR: "FD", VM: "1"},
+	"00189093": {Name: "Number of K Space Trajectories", Keyword: "NumberOfKSpaceTrajectories", VR: "US", VM: "1"},
+	"00189094": {Name: "Coverage of K Space", Keyword: "CoverageOfKSpace", VR: "CS", VM: "1"},
+	"00189095": {Name: "Spectroscopy Acquisition Phase Rows", Keyword: "SpectroscopyAcquisitionPhaseRows", VR: "UL", VM: "1"},
+	"00189096": {Name: "Parallel Reduction Factor in Plane (Retired)", Keyword: "ParallelReductionFactorInPlaneRetired", VR: "FD", VM: "1", Retired: true},
+	"00189098": {Name: "Transmitter Frequency", Keyword: "TransmitterFrequency", VR: "FD", VM: "1-2"},
+	"00189100": {Name: "Resonant Nucleus", Keyword: "ResonantNucleus", VR: "CS", VM: "1-2"},
+	"00189101": {Name: "Frequency Correction", Keyword: "FrequencyCorrection", VR: "CS", VM: "1"},
+	"00189103": {Name: "MR Spectroscopy FOV Geometry Sequence", Keyword: "MRSpectroscopyFOVGeometrySequence", VR: "SQ", VM: "1"},
+	"00189104": {Name: "Slab Thickness", Keyword: "SlabThickness", VR: "FD", VM: "1"},
+	"00189105": {Name: "Slab Orientation", Keyword: "SlabOrientation", VR: "FD", VM: "3"},
+	"00189106": {Name: "Mid Slab Position", Keyword: "MidSlabPosition", VR: "FD", VM: "3"},
+	"00189107": {Name: "MR Spatial Saturation Sequence", Keyword: "MRSpatialSaturationSequence", VR: "SQ", VM: "1"},
+	"00189112": {Name: "MR Timing and Related Parameters Sequence", Keyword: "MRTimingAndRelatedParametersSequence", VR: "SQ", VM: "1"},
+	"00189114": {Name: "MR Echo Sequence", Keyword: "MREchoSequence", VR: "SQ", VM: "1"},
+	"00189115": {Name: "MR Modifier Sequence", Keyword: "MRModifierSequence", VR: "SQ", VM: "1"},
+	"00189117": {Name: "MR Diffusion Sequence", Keyword: "MRDiffusionSequence", VR: "SQ", VM: "1"},
+	"00189118": {Name: "Cardiac Trigger Sequence", Keyword: "CardiacTriggerSequence", VR: "SQ", VM: "1"},
+	"00189119": {Name: "MR Averages Sequence", Keyword: "MRAveragesSequence", VR: "SQ", VM: "1"},
+	"00189125": {Name: "MRFOV Geometry Sequence", Keyword: "MRFOVGeometrySequence", VR: "SQ", VM: "1"},
+	"00189126": {Name: "Volume Localization Sequence", Keyword: "VolumeLocalizationSequence", VR: "SQ", VM: "1"},
+	"00189127": {Name: "Spectroscopy Acquisition Data Columns", Keyword: "SpectroscopyAcquisitionDataColumns", VR: "UL", VM: "1"},
+	"00189147": {Name: "Diffusion Anisotropy Type", Keyword: "DiffusionAnisotropyType", VR: "CS", VM: "1"},
+	"00189151": {Name: "Frame Reference Date Time", Keyword: "FrameReferenceDateTime", VR: "DT", VM: "1"},
+	"00189152": {Name: "MR Metabolite Map Sequence", Keyword: "MRMetaboliteMapSequence", VR: "SQ", VM: "1"},
+	"00189155": {Name: "Parallel Reduction Factor Out of Plane", Keyword: "ParallelReductionFactorOutOfPlane", VR: "FD", VM: "1"},
+	"00189159": {Name: "Spectroscopy Out of Plane Phase Steps", Keyword: "SpectroscopyOutOfPlanePhaseSteps", VR: "UL", VM: "1"},
+	"00189166": {Name: "Bulk Motion Status", Keyword: "BulkMotionStatus", VR: "CS", VM: "1"},
+	"00189168": {Name: "Parallel Reduction Factor Second in Plane", Keyword: "ParallelReductionFactorSecondInPlane", VR: "FD", VM: "1"},
+	"00189169": {Name: "Cardiac Beat Rejection Technique", Keyword: "CardiacBeatRejectionTechnique", VR: "CS", VM: "1"},
+	"00189170": {Name: "Respiratory Motion Compensation Technique", Keyword: "RespiratoryMotionCompensationTechnique", VR: "CS", VM: "1"},
+	"00189171": {Name: "Respiratory Signal Source", Keyword: "RespiratorySignalSource", VR: "CS", VM: "1"},
+	"00189172": {Name: "Bulk Motion Compensation Technique", Keyword: "BulkMotionCompensationTechnique", VR: "CS", VM: "1"},
+	"00189173": {Name: "Bulk Motion Signal Source", Keyword: "BulkMotionSignalSource", VR: "CS", VM: "1"},
+	"00189174": {Name: "Applicable Safety Standard Agency", Keyword: "ApplicableSafetyStandardAgency", VR: "CS", VM: "1"},
+	"00189175": {Name: "Applicable Safety Standard Description", Keyword: "ApplicableSafetyStandardDescription", VR: "LO", VM: "1"},
+	"00189176": {Name: "Operating Mode Sequence", Keyword: "OperatingModeSequence", VR: "SQ", VM: "1"},
+	"00189177": {Name: "Operating Mode Type", Keyword: "OperatingModeType", VR: "CS", VM: "1"},
+	"00189178": {Name: "Operating Mode", Keyword: "OperatingMode", VR: "CS", VM: "1"},
+	"00189179": {Name: "Specific Absorption Rate Definition", Keyword: "SpecificAbsorptionRateDefinition", VR: "CS", VM: "1"},
+	"00189180": {Name: "Gradient Output Type", Keyword: "GradientOutputType", VR: "CS", VM: "1"},
+	"00189181": {Name: "Specific Absorption Rate Value", Keyword: "SpecificAbsorptionRateValue", VR: "FD", VM: "1"},
+	"00189182": {Name: "Gradient Output", Keyword: "GradientOutput", VR: "FD", VM: "1"},
+	"00189183": {Name: "Flow Compensation Direction", Keyword: "FlowCompensationDirection", VR: "CS", VM: "1"},
+	"00189184": {Name: "Tagging Delay", Keyword: "TaggingDelay", VR: "FD", VM: "1"},
+	"00189185": {Name: "Respiratory Motion Compensation Technique Description", Keyword: "RespiratoryMotionCompensationTechniqueDescription", VR: "ST", VM: "1"},
+	"00189186": {Name: "Respiratory Signal Source ID", Keyword: "RespiratorySignalSourceID", VR: "SH", VM: "1"},
+	"00189195": {Name: "Chemical Shift Minimum Integration Limit in Hz", Keyword: "ChemicalShiftMinimumIntegrationLimitInHz", VR: "FD", VM: "1", Retired: true},
+	"00189196": {Name: "Chemical Shift Maximum Integration Limit in Hz", Keyword: "ChemicalShiftMaximumIntegrationLimitInHz", VR: "FD", VM: "1", Retired: true},
+	"00189197": {Name: "MR Velocity Encoding Sequence", Keyword: "MRVelocityEncodingSequence", VR: "SQ", VM: "1"},
+	"00189198": {Name: "First Order Phase Correction", Keyword: "FirstOrderPhaseCorrection", VR: "CS", VM: "1"},
+	"00189199": {Name: "Water Referenced Phase Correction", Keyword: "WaterReferencedPhaseCorrection", VR: "CS", VM: "1"},
+	"00189200": {Name: "MR Spectroscopy Acquisition Type", Keyword: "MRSpectroscopyAcquisitionType", VR: "CS", VM: "1"},
+	"00189214": {Name: "Respiratory Cycle Position", Keyword: "RespiratoryCyclePosition", VR: "CS", VM: "1"},
+	"00189217": {Name: "Velocity Encoding Maximum Value", Keyword: "VelocityEncodingMaximumValue", VR: "FD", VM: "1"},
+	"00189218": {Name: "Tag Spacing Second Dimension", Keyword: "TagSpacingSecondDimension", VR: "FD", VM: "1"},
+	"00189219": {Name: "Tag Angle Second Axis", Keyword: "TagAngleSecondAxis", VR: "SS", VM: "1"},
+	"00189220": {Name: "Frame Acquisition Duration", Keyword: "FrameAcquisitionDuration", VR: "FD", VM: "1"},
+	"00189226": {Name: "MR Image Frame Type Sequence", Keyword: "MRImageFrameTypeSequence", VR: "SQ", VM: "1"},
+	"00189227": {Name: "MR Spectroscopy Frame Type Sequence", Keyword: "MRSpectroscopyFrameTypeSequence", VR: "SQ", VM: "1"},
+	"00189231": {Name: "MR Acquisition Phase Encoding Steps in Plane", Keyword: "MRAcquisitionPhaseEncodingStepsInPlane", VR: "US", VM: "1"},
+	"00189232": {Name: "MR Acquisition Phase Encoding Steps Out of Plane", Keyword: "MRAcquisitionPhaseEncodingStepsOutOfPlane", VR: "US", VM: "1"},
+	"00189234": {Name: "Spectroscopy Acquisition Phase Columns", Keyword: "SpectroscopyAcquisitionPhaseColumns", VR: "UL", VM: "1"},
+	"00189236": {Name: "Cardiac Cycle Position", Keyword: "CardiacCyclePosition", VR: "CS", VM: "1"},
+	"00189239": {Name: "Specific Absorption Rate Sequence", Keyword: "SpecificAbsorptionRateSequence", VR: "SQ", VM: "1"},
+	"00189240": {Name: "RF Echo Train Length", Keyword: "RFEchoTrainLength", VR: "US", VM: "1"},
+	"00189241": {Name: "Gradient Echo Train Length", Keyword: "GradientEchoTrainLength", VR: "US", VM: "1"},
+	"00189295": {Name: "Chemical Shift Minimum Integration Limit Inppm", Keyword: "ChemicalShiftMinimumIntegrationLimitInppm", VR: "FD", VM: "1"},
+	"00189296": {Name: "Chemical Shift Maximum Integration Limit Inppm", Keyword: "ChemicalShiftMaximumIntegrationLimitInppm", VR: "FD", VM: "1"},
+	"00189301": {Name: "CT Acquisition Type Sequence", Keyword: "CTAcquisitionTypeSequence", VR: "SQ", VM: "1"},
+	"00189302": {Name: "Acquisition Type", Keyword: "AcquisitionType", VR: "CS", VM: "1"},
+	"00189303": {Name: "Tube Angle", Keyword: "TubeAngle", VR: "FD", VM: "1"},
+	"00189304": {Name: "CT Acquisition Details Sequence", Keyword: "CTAcquisitionDetailsSequence", VR: "SQ", VM: "1"},
+	"00189305": {Name: "Revolution Time", Keyword: "RevolutionTime", VR: "FD", VM: "1"},
+	"00189306": {Name: "Single Collimation Width", Keyword: "SingleCollimationWidth", VR: "FD", VM: "1"},
+	"00189307": {Name: "Total Collimation Width", Keyword: "TotalCollimationWidth", VR: "FD", VM: "1"},
+	"00189308": {Name: "CT Table Dynamics Sequence", Keyword: "CTTableDynamicsSequence", VR: "SQ", VM: "1"},
+	"00189309": {Name: "Table Speed", Keyword: "TableSpeed", VR: "FD", VM: "1"},
+	"00189310": {Name: "Table Feed per Rotation", Keyword: "TableFeedPerRotation", VR: "FD", VM: "1"},
+	"00189311": {Name: "Spiral Pitch Factor", Keyword: "SpiralPitchFactor", VR: "FD", VM: "1"},
+	"00189312": {Name: "CT Geometry Sequence", Keyword: "CTGeometrySequence", VR: "SQ", VM: "1"},
+	"00189313": {Name: "Data Collection Center Patient", Keyword: "DataCollectionCenterPatient", VR: "FD", VM: "3"},
+	"00189314": {Name: "CT Reconstruction Sequence", Keyword: "CTReconstructionSequence", VR: "SQ", VM: "1"},
+	"00189315": {Name: "Reconstruction Algorithm", Keyword: "ReconstructionAlgorithm", VR: "CS", VM: "1"},
+	"00189316": {Name: "Convolution Kernel Group", Keyword: "ConvolutionKernelGroup", VR: "CS", VM: "1"},
+	"00189317": {Name: "Reconstruction Field of View", Keyword: "ReconstructionFieldOfView", VR: "FD", VM: "2"},
+	"00189318": {Name: "Reconstruction Target Center Patient", Keyword: "ReconstructionTargetCenterPatient", VR: "FD", VM: "3"},
+	"00189319": {Name: "Reconstruction Angle", Keyword: "ReconstructionAngle", VR: "FD", VM: "1"},
+	"00189320": {Name: "Image Filter", Keyword: "ImageFilter", VR: "SH", VM: "1"},
+	"00189321": {Name: "CT Exposure Sequence", Keyword: "CTExposureSequence", VR: "SQ", VM: "1"},
+	"00189322": {Name: "Reconstruction Pixel Spacing", Keyword: "ReconstructionPixelSpacing", VR: "FD", VM: "2"},
+	"00189323": {Name: "Exposure Modulation Type", Keyword: "ExposureModulationType", VR: "CS", VM: "1"},
+	"00189324": {Name: "Estimated Dose Saving", Keyword: "EstimatedDoseSaving", VR: "FD", VM: "1"},
+	"00189325": {Name: "CTX-Ray Details Sequence", Keyword: "CTXRayDetailsSequence", VR: "SQ", VM: "1"},
+	"00189326": {Name: "CT Position Sequence", Keyword: "CTPositionSequence", VR: "SQ", VM: "1"},
+	"00189327": {Name: "Table Position", Keyword: "TablePosition", VR: "FD", VM: "1"},
+	"00189328": {Name: "Exposure Time in ms", Keyword: "ExposureTimeInms", VR: "FD", VM: "1"},
+	"00189329": {Name: "CT Image Frame Type Sequence", Keyword: "CTImageFrameTypeSequence", VR: "SQ", VM: "1"},
+	"00189330": {Name: "X-Ray Tube Current in mA", Keyword: "XRayTubeCurrentInmA", VR: "FD", VM: "1"},
+	"00189332": {Name: "Exposure in mAs", Keyword: "ExposureInmAs", VR: "FD", VM: "1"},
+	"00189333": {Name: "Constant Volume Flag", Keyword: "ConstantVolumeFlag", VR: "CS", VM: "1"},
+	"00189334": {Name: "Fluoroscopy Flag", Keyword: "FluoroscopyFlag", VR: "CS", VM: "1"},
+	"00189335": {Name: "Distance Source to Data Collection Center", Keyword: "DistanceSourceToDataCollectionCenter", VR: "FD", VM: "1"},
+	"00189337": {Name: "Contrast Bolus Agent Number", Keyword: "ContrastBolusAgentNumber", VR: "US", VM: "1"},
+	"00189338": {Name: "Contrast Bolus Ingredient Code Sequence", Keyword: "ContrastBolusIngredientCodeSequence", VR: "SQ", VM: "1"},
+	"00189340": {Name: "Contrast Administration Profile Sequence", Keyword: "ContrastAdministrationProfileSequence", VR: "SQ", VM: "1"},
+	"00189341": {Name: "Contrast Bolus Usage Sequence", Keyword: "ContrastBolusUsageSequence", VR: "SQ", VM: "1"},
+	"00189342": {Name: "Contrast Bolus Agent Administered", Keyword: "ContrastBolusAgentAdministered", VR: "CS", VM: "1"},
+	"00189343": {Name: "Contrast Bolus Agent Detected", Keyword: "ContrastBolusAgentDetected", VR: "CS", VM: "1"},
+	"00189344": {Name: "Contrast Bolus Agent Phase", Keyword: "ContrastBolusAgentPhase", VR: "CS", VM: "1"},
+	"00189345": {Name: "CTD Ivol", Keyword: "CTDIvol", VR: "FD", VM: "1"},
+	"00189346": {Name: "CTDI Phantom Type Code Sequence", Keyword: "CTDIPhantomTypeCodeSequence", VR: "SQ", VM: "1"},
+	"00189351": {Name: "Calcium Scoring Mass Factor Patient", Keyword: "CalciumScoringMassFactorPatient", VR: "FL", VM: "1"},
+	"00189352": {Name: "Calcium Scoring Mass Factor Device", Keyword: "CalciumScoringMassFactorDevice", VR: "FL", VM: "3"},
+	"00189353": {Name: "Energy Weighting Factor", Keyword: "EnergyWeightingFactor", VR: "FL", VM: "1"},
+	"00189360": {Name: "CT Additional X-Ray Source Sequence", Keyword: "CTAdditionalXRaySourceSequence", VR: "SQ", VM: "1"},
+	"00189401": {Name: "Projection Pixel Calibration Sequence", Keyword: "ProjectionPixelCalibrationSequence", VR: "SQ", VM: "1"},
+	"00189402": {Name: "Distance Source to Isocenter", Keyword: "DistanceSourceToIsocenter", VR: "FL", VM: "1"},
+	"00189403": {Name: "Distance Object to Table Top", Keyword: "DistanceObjectToTableTop", VR: "FL", VM: "1"},
+	"00189404": {Name: "Object Pixel Spacing in Center of Beam", Keyword: "ObjectPixelSpacingInCenterOfBeam", VR: "FL", VM: "2"},
+	"00189405": {Name: "Positioner Position Sequence", Keyword: "PositionerPositionSequence", VR: "SQ", VM: "1"},
+	"00189406": {Name: "Table Position Sequence", Keyword: "TablePositionSequence", VR: "SQ", VM: "1"},
+	"00189407": {Name: "Collimator Shape Sequence", Keyword: "CollimatorShapeSequence", VR: "SQ", VM: "1"},
+	"00189412": {Name: "XAXRF Frame Characteristics Sequence", Keyword: "XAXRFFrameCharacteristicsSequence", VR: "SQ", VM: "1"},
+	"00189417": {Name: "Frame Acquisition Sequence", Keyword: "FrameAcquisitionSequence", VR: "SQ", VM: "1"},
+	"00189420": {Name: "X-Ray Receptor Type", Keyword: "XRayReceptorType", VR: "CS", VM: "1"},
+	"00189423": {Name: "Acquisition Protocol Name", Keyword: "AcquisitionProtocolName", VR: "LO", VM: "1"},
+	"00189424": {Name: "Acquisition Protocol Description", Keyword: "AcquisitionProtocolDescription", VR: "LT", VM: "1"},
+	"00189425": {Name: "Contrast Bolus Ingredient Opaque", Keyword: "ContrastBolusIngredientOpaque", VR: "CS", VM: "1"},
+	"00189426": {Name: "Distance Receptor Plane to Detector Housing", Keyword: "DistanceReceptorPlaneToDetectorHousing", VR: "FL", VM: "1"},
+	"00189427": {Name: "Intensifier Active Shape", Keyword: "IntensifierActiveShape", VR: "CS", VM: "1"},
+	"00189428": {Name: "Intensifier Active Dimensions", Keyword: "IntensifierActiveDimensions", VR: "FL", VM: "1-2"},
+	"00189429": {Name: "Physical Detector Size", Keyword: "PhysicalDetectorSize", VR: "FL", VM: "2"},
+	"00189430": {Name: "Position of Isocenter Projection", Keyword: "PositionOfIsocenterProjection", VR: "FL", VM: "2"},
+	"00189432": {Name: "Field of View Sequence", Keyword: "FieldOfViewSequence", VR: "SQ", VM: "1"},
+	"00189433": {Name: "Field of View Description", Keyword: "FieldOfViewDescription", VR: "LO", VM: "1"},
+	"00189434": {Name: "Exposure Control Sensing Regions Sequence", Keyword: "ExposureControlSensingRegionsSequence", VR: "SQ", VM: "1"},
+	"00189435": {Name: "Exposure Control Sensing Region Shape", Keyword: "ExposureControlSensingRegionShape", VR: "CS", VM: "1"},
+	"00189436": {Name: "Exposure Control Sensing Region Left Vertical Edge", Keyword: "ExposureControlSensingRegionLeftVerticalEdge", VR: "SS", VM: "1"},
+	"00189437": {Name: "Exposure Control Sensing Region Right Vertical Edge", Keyword: "ExposureControlSensingRegionRightVerticalEdge", VR: "SS", VM: "1"},
+	"00189440": {Name: "Center of Circular Exposure Control Sensing Region", Keyword: "CenterOfCircularExposureControlSensingRegion", VR: "SS", VM: "2"},
+	"00189441": {Name: "Radius of Circular Exposure Control Sensing Region", Keyword: "RadiusOfCircularExposureControlSensingRegion", VR: "US", VM: "1"},
+	"00189447": {Name: "Column Angulation Patient", Keyword: "ColumnAngulationPatient", VR: "FL", VM: "1"},
+	"00189449": {Name: "Beam Angle", Keyword: "BeamAngle", VR: "FL", VM: "1"},
+	"00189451": {Name: "Frame Detector Parameters Sequence", Keyword: "FrameDetectorParametersSequence", VR: "SQ", VM: "1"},
+	"00189452": {Name: "Calculated Anatomy Thickness", Keyword: "CalculatedAnatomyThickness", VR: "FL", VM: "1"},
+	"00189455": {Name: "Calibration Sequence", Keyword: "CalibrationSequence", VR: "SQ", VM: "1"},
+	"00189456": {Name: "Object Thickness Sequence", Keyword: "ObjectThicknessSequence", VR: "SQ", VM: "1"},
+	"00189457": {Name: "Plane Identification", Keyword: "PlaneIdentification", VR: "CS", VM: "1"},
+	"00189461": {Name: "Field of View Dimensions in Float", Keyword: "FieldOfViewDimensionsInFloat", VR: "FL", VM: "1-2"},
+	"00189462": {Name: "Isocenter Reference System Sequence", Keyword: "IsocenterReferenceSystemSequence", VR: "SQ", VM: "1"},
+	"00189463": {Name: "Positioner Isocenter Primary Angle", Keyword: "PositionerIsocenterPrimaryAngle", VR: "FL", VM: "1"},
+	"00189464": {Name: "Positioner Isocenter Secondary Angle", Keyword: "PositionerIsocenterSecondaryAngle", VR: "FL", VM: "1"},
+	"00189465": {Name: "Positioner Isocenter Detector Rotation Angle", Keyword: "PositionerIsocenterDetectorRotationAngle", VR: "FL", VM: "1"},
+	"00189466": {Name: "Table X Position to Isocenter", Keyword: "TableXPositionToIsocenter", VR: "FL", VM: "1"},
+	"00189467": {Name: "Table Y Position to Isocenter", Keyword: "TableYPositionToIsocenter", VR: "FL", VM: "1"},
+	"00189468": {Name: "Table Z Position to Isocenter", Keyword: "TableZPositionToIsocenter", VR: "FL", VM: "1"},
+	"00189469": {Name: "Table Horizontal Rotation Angle", Keyword: "TableHorizontalRotationAngle", VR: "FL", VM: "1"},
+	"00189470": {Name: "Table Head Tilt Angle", Keyword: "TableHeadTiltAngle", VR: "FL", VM: "1"},
+	"00189471": {Name: "Table Cradle Tilt Angle", Keyword: "TableCradleTiltAngle", VR: "FL", VM: "1"},
+	"00189472": {Name: "Frame Display Shutter Sequence", Keyword: "FrameDisplayShutterSequence", VR: "SQ", VM: "1"},
+	"00189473": {Name: "Acquired Image Area Dose Product", Keyword: "AcquiredImageAreaDoseProduct", VR: "FL", VM: "1"},
+	"00189474": {Name: "C Arm Positioner Tabletop Relationship", Keyword: "CArmPositionerTabletopRelationship", VR: "CS", VM: "1"},
+	"00189476": {Name: "X-Ray Geometry Sequence", Keyword: "XRayGeometrySequence", VR: "SQ", VM: "1"},
+	"00189477": {Name: "Irradiation Event ID Sequence", Keyword: "IrradiationEventIDSequence", VR: "SQ", VM: "1"},
+	"00189504": {Name: "X-Ray 3 D Frame Type Sequence", Keyword: "XRay3DFrameTypeSequence", VR: "SQ", VM: "1"},
+	"00189506": {Name: "Contributing Sources Sequence", Keyword: "ContributingSourcesSequence", VR: "SQ", VM: "1"},
+	"00189507": {Name: "X-Ray 3 D Acquisition Sequence", Keyword: "XRay3DAcquisitionSequence", VR: "SQ", VM: "1"},
+	"00189508": {Name: "Primary Positioner Scan Arc", Keyword: "PrimaryPositionerScanArc", VR: "FL", VM: "1"},
+	"00189509": {Name: "Secondary Positioner Scan Arc", Keyword: "SecondaryPositionerScanArc", VR: "FL", VM: "1"},
+	"00189510": {Name: "Primary Positioner Scan Start Angle", Keyword: "PrimaryPositionerScanStartAngle", VR: "FL", VM: "1"},
+	"00189511": {Name: "Secondary Positioner Scan Start Angle", Keyword: "SecondaryPositionerScanStartAngle", VR: "FL", VM: "1"},
+	"00189514": {Name: "Primary Positioner Increment", Keyword: "PrimaryPositionerIncrement", VR: "FL", VM: "1"},
+	"00189515": {Name: "Secondary Positioner Increment", Keyword: "SecondaryPositionerIncrement", VR: "FL", VM: "1"},
+	"00189516": {Name: "Start Acquisition Date Time", Keyword: "StartAcquisitionDateTime", VR: "DT", VM: "1"},
+	"00189517": {Name: "End Acquisition Date Time", Keyword: "EndAcquisitionDateTime", VR: "DT", VM: "1"},
+	"00189524": {Name: "Application Name", Keyword: "ApplicationName", VR: "LO", VM: "1"},
+	"00189525": {Name: "Application Version", Keyword: "ApplicationVersion", VR: "LO", VM: "1"},
+	"00189526": {Name: "Application Manufacturer", Keyword: "ApplicationManufacturer", VR: "LO", VM: "1"},
+	"00189527": {Name: "Algorithm Type", Keyword: "AlgorithmType", VR: "CS", VM: "1"},
+	"00189528": {Name: "Algorithm Description", Keyword: "AlgorithmDescription", VR: "LO", VM: "1"},
+	"00189530": {Name: "X-Ray 3 D Reconstruction Sequence", Keyword: "XRay3DReconstructionSequence", VR: "SQ", VM: "1"},
+	"00189531": {Name: "Reconstruction Description", Keyword: "ReconstructionDescription", VR: "LO", VM: "1"},
+	"00189538": {Name: "Per Projection Acquisition Sequence", Keyword: "PerProjectionAcquisitionSequence", VR: "SQ", VM: "1"},
+	"00189601": {Name: "Diffusion B Matrix Sequence", Keyword: "DiffusionBMatrixSequence", VR: "SQ", VM: "1"},
+	"00189602": {Name: "Diffusion B Value XX", Keyword: "DiffusionBValueXX", VR: "FD", VM: "1"},
+	"00189603": {Name: "Diffusion B Value XY", Keyword: "DiffusionBValueXY", VR: "FD", VM: "1"},
+	"00189604": {Name: "Diffusion B Value XZ", Keyword: "DiffusionBValueXZ", VR: "FD", VM: "1"},
+	"00189605": {Name: "Diffusion B Value YY", Keyword: "DiffusionBValueYY", VR: "FD", VM: "1"},
+	"00189606": {Name: "Diffusion B Value YZ", Keyword: "DiffusionBValueYZ", VR: "FD", VM: "1"},
+	"00189607": {Name: "Diffusion B Value ZZ", Keyword: "DiffusionBValueZZ", VR: "FD", VM: "1"},
+	"00189701": {Name: "Decay Correction Date Time", Keyword: "DecayCorrectionDateTime", VR: "DT", VM: "1"},
+	"00189715": {Name: "Start Density Threshold", Keyword: "StartDensityThreshold", VR: "FD", VM: "1"},
+	"00189722": {Name: "Termination Time Threshold", Keyword: "TerminationTimeThreshold", VR: "FD", VM: "1"},
+	"00189725": {Name: "Detector Geometry", Keyword: "DetectorGeometry", VR: "CS", VM: "1"},
+	"00189727": {Name: "Axial Detector Dimension", Keyword: "AxialDetectorDimension", VR: "FD", VM: "1"},
+	"00189735": {Name: "PET Position Sequence", Keyword: "PETPositionSequence", VR: "SQ", VM: "1"},
+	"00189739": {Name: "Number of Iterations", Keyword: "NumberOfIterations", VR: "US", VM: "1"},
+	"00189740": {Name: "Number of Subsets", Keyword: "NumberOfSubsets", VR: "US", VM: "1"},
+	"00189751": {Name: "PET Frame Type Sequence", Keyword: "PETFrameTypeSequence", VR: "SQ", VM: "1"},
+	"00189756": {Name: "Reconstruction Type", Keyword: "ReconstructionType", VR: "CS", VM: "1"},
+	"00189758": {Name: "Decay Corrected", Keyword: "DecayCorrected", VR: "CS", VM: "1"},
+	"00189759": {Name: "Attenuation Corrected", Keyword: "AttenuationCorrected", VR: "CS", VM: "1"},
+	"00189760": {Name: "Scatter Corrected", Keyword: "ScatterCorrected", VR: "CS", VM: "1"},
+	"00189761": {Name: "Dead Time Corrected", Keyword: "DeadTimeCorrected", VR: "CS", VM: "1"},
+	"00189762": {Name: "Gantry Motion Corrected", Keyword: "GantryMotionCorrected", VR: "CS", VM: "1"},
+	"00189763": {Name: "Patient Motion Corrected", Keyword: "PatientMotionCorrected", VR: "CS", VM: "1"},
+	"00189765": {Name: "Randoms Corrected", Keyword: "RandomsCorrected", VR: "CS", VM: "1"},
+	"00189767": {Name: "Sensitivity Calibrated", Keyword: "SensitivityCalibrated", VR: "CS", VM: "1"},
+	"00189801": {Name: "Depths of Focus", Keyword: "DepthsOfFocus", VR: "FD", VM: "1-n"},
+	"00189804": {Name: "Exclusion Start Date Time", Keyword: "ExclusionStartDateTime", VR: "DT", VM: "1"},
+	"00189805": {Name: "Exclusion Duration", Keyword: "ExclusionDuration", VR: "FD", VM: "1"},
+	"00189807": {Name: "Image Data Type Sequence", Keyword: "ImageDataTypeSequence", VR: "SQ", VM: "1"},
+	"00189808": {Name: "Data Type", Keyword: "DataType", VR: "CS", VM: "1"},
+	"0018980B": {Name: "Aliased Data Type", Keyword: "AliasedDataType", VR: "CS", VM: "1"},
+	"0018A001": {Name: "Contributing Equipment Sequence", Keyword: "ContributingEquipmentSequence", VR: "SQ", VM: "1"},
+	"0018A002": {Name: "Contribution Date Time", Keyword: "ContributionDateTime", VR: "DT", VM: "1"},
+	"0018A003": {Name: "Contribution Description", Keyword: "ContributionDescription", VR: "ST", VM: "1"},
+	"00200000": {Name: "Relationship Group Length", Keyword: "RelationshipGroupLength", VR: "UL", VM: "1", Retired: true},
+	"0020000D": {Name: "Study Instance UID", Keyword: "StudyInstanceUID", VR: "UI", VM: "1"},
+	"0020000E": {Name: "Series Instance UID", Keyword: "SeriesInstanceUID", VR: "UI", VM: "1"},
+	"00200010": {Name: "Study ID", Keyword: "StudyID", VR: "SH", VM: "1"},
+	"00200011": {Name: "Series Number", Keyword: "SeriesNumber", VR: "IS", VM: "1"},
+	"00200012": {Name: "Acquisition Number", Keyword: "AcquisitionNumber", VR: "IS", VM: "1"},
+	"00200013": {Name: "Instance Number", Keyword: "InstanceNumber", VR: "IS", VM: "1"},
+	"00200014": {Name: "Isotope Number", Keyword: "IsotopeNumber", VR: "IS", VM: "1", Retired: true},
+	"00200015": {Name: "Phase Number", Keyword: "PhaseNumber", VR: "IS", VM: "1", Retired: true},
+	"00200016": {Name: "Interval Number", Keyword: "IntervalNumber", VR: "IS", VM: "1", Retired: true},
+	"00200017": {Name: "Time Slot Number", Keyword: "TimeSlotNumber", VR: "IS", VM: "1", Retired: true},
+	"00200018": {Name: "Angle Number", Keyword: "AngleNumber", VR: "IS", VM: "1", Retired: true},
+	"00200019": {Name: "Item Number", Keyword: "ItemNumber", VR: "IS", VM: "1"},
+	"00200020": {Name: "Patient Orientation", Keyword: "PatientOrientation", VR: "CS", VM: "2"},
+	"00200022": {Name: "Overlay Number", Keyword: "OverlayNumber", VR: "IS", VM: "1", Retired: true},
+	"00200024": {Name: "Curve Number", Keyword: "CurveNumber", VR: "IS", VM: "1", Retired: true},
+	"00200026": {Name: "Lookup Table Number", Keyword: "LookupTableNumber", VR: "IS", VM: "1", Retired: true},
+	"00200030": {Name: "Image Position", Keyword: "ImagePosition", VR: "DS", VM: "3", Retired: true},
+	"00200032": {Name: "Image Position Patient", Keyword: "ImagePositionPatient", VR: "DS", VM: "3"},
+	"00200035": {Name: "Image Orientation", Keyword: "ImageOrientation", VR: "DS", VM: "6", Retired: true},
+	"00200037": {Name: "Image Orientation Patient", Keyword: "ImageOrientationPatient", VR: "DS", VM: "6"},
+	"00200050": {Name: "Location", Keyword: "Location", VR: "DS", VM: "1", Retired: true},
+	"00200052": {Name: "Frame of Reference UID", Keyword: "FrameOfReferenceUID", VR: "UI", VM: "1"},
+	"00200060": {Name: "Laterality", Keyword: "Laterality", VR: "CS", VM: "1"},
+	"00200062": {Name: "Image Laterality", Keyword: "ImageLaterality", VR: "CS", VM: "1"},
+	"00200070": {Name: "Image Geometry Type", Keyword: "ImageGeometryType", VR: "LO", VM: "1", Retired: true},
+	"00200080": {Name: "Masking Image", Keyword: "MaskingImage", VR: "CS", VM: "1-n", Retired: true},
+	"00200100": {Name: "Temporal Position Identifier", Keyword: "TemporalPositionIdentifier", VR: "IS", VM: "1"},
+	"00200105": {Name: "Number of Temporal Positions", Keyword: "NumberOfTemporalPositions", VR: "IS", VM: "1"},
+	"00200110": {Name: "Temporal Resolution", Keyword: "TemporalResolution", VR: "DS", VM: "1"},
+	"00200200": {Name: "Synchronization Frame of Reference UID", Keyword: "SynchronizationFrameOfReferenceUID", VR: "UI", VM: "1"},
+	"00201000": {Name: "Series in Study", Keyword: "SeriesInStudy", VR: "IS", VM: "1", Retired: true},
+	"00201001": {Name: "Acquisitions in Series", Keyword: "AcquisitionsInSeries", VR: "IS", VM: "1", Retired: true},
+	"00201002": {Name: "Images in Acquisition", Keyword: "ImagesInAcquisition", VR: "IS", VM: "1"},
+	"00201003": {Name: "Images in Series", Keyword: "ImagesInSeries", VR: "IS", VM: "1", Retired: true},
+	"00201004": {Name: "Acquisitions in Study", Keyword: "AcquisitionsInStudy", VR: "IS", VM: "1", Retired: true},
+	"00201005": {Name: "Images in Study", Keyword: "ImagesInStudy", VR: "IS", VM: "1", Retired: true},
+	"00201020": {Name: "Reference", Keyword: "Reference", VR: "LO", VM: "1-n", Retired: true},
+	"00201040": {Name: "Position Reference Indicator", Keyword: "PositionReferenceIndicator", VR: "LO", VM: "1"},
+	"00201041": {Name: "Slice Location", Keyword: "SliceLocation", VR: "DS", VM: "1"},
+	"00201070": {Name: "Other Study Numbers", Keyword: "OtherStudyNumbers", VR: "IS", VM: "1-n", Retired: true},
+	"00201200": {Name: "Number of Patient Related Studies", Keyword: "NumberOfPatientRelatedStudies", VR: "IS", VM: "1"},
+	"00201202": {Name: "Number of Patient Related Series", Keyword: "NumberOfPatientRelatedSeries", VR: "IS", VM: "1"},
+	"00201204": {Name: "Number of Patient Related Instances", Keyword: "NumberOfPatientRelatedInstances", VR: "IS", VM: "1"},
+	"00201206": {Name: "Number of Study Related Series", Keyword: "NumberOfStudyRelatedSeries", VR: "IS", VM: "1"},
+	"00201208": {Name: "Number of Study Related Instances", Keyword: "NumberOfStudyRelatedInstances", VR: "IS", VM: "1"},
+	"00201209": {Name: "Number of Series Related Instances", Keyword: "NumberOfSeriesRelatedInstances", VR: "IS", VM: "1"},
+	"00203401": {Name: "Modifying Device ID", Keyword: "ModifyingDeviceID", VR: "CS", VM: "1", Retired: true},
+	"00203402": {Name: "Modified Image ID", Keyword: "ModifiedImageID", VR: "CS", VM: "1", Retired: true},
+	"00203403": {Name: "Modified Image Date", Keyword: "ModifiedImageDate", VR: "DA", VM: "1", Retired: true},
+	"00203404": {Name: "Modifying Device Manufacturer", Keyword: "ModifyingDeviceManufacturer", VR: "LO", VM: "1", Retired: true},
+	"00203405": {Name: "Modified Image Time", Keyword: "ModifiedImageTime", VR: "TM", VM: "1", Retired: true},
+	"00203406": {Name: "Modified Image Description", Keyword: "ModifiedImageDescription", VR: "LO", VM: "1", Retired: true},
+	"00204000": {Name: "Image Comments", Keyword: "ImageComments", VR: "LT", VM: "1"},
+	"00205000": {Name: "Original Image Identification", Keyword: "OriginalImageIdentification", VR: "AT", VM: "1-n", Retired: true},
+	"00205002": {Name: "Original Image Identification Nomenclature", Keyword: "OriginalImageIdentificationNomenclature", VR: "LO", VM: "1-n", Retired: true},
+	"00209056": {Name: "Stack ID", Keyword: "StackID", VR: "SH", VM: "1"},
+	"00209057": {Name: "In Stack Position Number", Keyword: "InStackPositionNumber", VR: "UL", VM: "1"},
+	"00209071": {Name: "Frame Anatomy Sequence", Keyword: "FrameAnatomySequence", VR: "SQ", VM: "1"},
+	"00209072": {Name: "Frame Laterality", Keyword: "FrameLaterality", VR: "CS", VM: "1"},
+	"00209111": {Name: "Frame Content Sequence", Keyword: "FrameContentSequence", VR: "SQ", VM: "1"},
+	"00209113": {Name: "Plane Position Sequence", Keyword: "PlanePositionSequence", VR: "SQ", VM: "1"},
+	"00209116": {Name: "Plane Orientation Sequence", Keyword: "PlaneOrientationSequence", VR: "SQ", VM: "1"},
+	"00209128": {Name: "Temporal Position Index", Keyword: "TemporalPositionIndex", VR: "UL", VM: "1"},
+	"00209153": {Name: "Trigger Delay Time", Keyword: "TriggerDelayTime", VR: "FD", VM: "1"},
+	"00209156": {Name: "Frame Acquisition Number", Keyword: "FrameAcquisitionNumber", VR: "US", VM: "1"},
+	"00209157": {Name: "Dimension Index Values", Keyword: "DimensionIndexValues", VR: "UL", VM: "1-n"},
+	"00209158": {Name: "Frame Comments", Keyword: "FrameComments", VR: "LT", VM: "1"},
+	"00209161": {Name: "Concatenation UID", Keyword: "ConcatenationUID", VR: "UI", VM: "1"},
+	"00209162": {Name: "In Concatenation Number", Keyword: "InConcatenationNumber", VR: "US", VM: "1"},
+	"00209163": {Name: "In Concatenation Total Number", Keyword: "InConcatenationTotalNumber", VR: "US", VM: "1"},
+	"00209164": {Name: "Dimension Organization UID", Keyword: "DimensionOrganizationUID", VR: "UI", VM: "1"},
+	"00209165": {Name: "Dimension Index Pointer", Keyword: "DimensionIndexPointer", VR: "AT", VM: "1"},
+	"00209167": {Name: "Functional Group Pointer", Keyword: "FunctionalGroupPointer", VR: "AT", VM: "1"},
+	"00209213": {Name: "Dimension Index Private Creator", Keyword: "DimensionIndexPrivateCreator", VR: "LO", VM: "1"},
+	"00209221": {Name: "Dimension Organization Sequence", Keyword: "DimensionOrganizationSequence", VR: "SQ", VM: "1"},
+	"00209222": {Name: "Dimension Index Sequence", Keyword: "DimensionIndexSequence", VR: "SQ", VM: "1"},
+	"00209228": {Name: "Concatenation Frame Offset Number", Keyword: "ConcatenationFrameOffsetNumber", VR: "UL", VM: "1"},
+	"00209238": {Name: "Functional Group Private Creator", Keyword: "FunctionalGroupPrivateCreator", VR: "LO", VM: "1"},
+	"00209241": {Name: "Nominal Percentage of Cardiac Phase", Keyword: "NominalPercentageOfCardiacPhase", VR: "FL", VM: "1"},
+	"00209245": {Name: "Nominal Percentage of Respiratory Phase", Keyword: "NominalPercentageOfRespiratoryPhase", VR: "FL", VM: "1"},
+	"00209246": {Name: "Starting Respiratory Amplitude", Keyword: "StartingRespiratoryAmplitude", VR: "FL", VM: "1"},
+	"00209247": {Name: "Starting Respiratory Phase", Keyword: "StartingRespiratoryPhase", VR: "CS", VM: "1"},
+	"00209248": {Name: "Ending Respiratory Amplitude", Keyword: "EndingRespiratoryAmplitude", VR: "FL", VM: "1"},
+	"00209249": {Name: "Ending Respiratory Phase", Keyword: "EndingRespiratoryPhase", VR: "CS", VM: "1"},
+	"00209250": {Name: "Respiratory Trigger Type", Keyword: "RespiratoryTriggerType", VR: "CS", VM: "1"},
+	"00209251": {Name: "RR Interval Time Nominal", Keyword: "RRIntervalTimeNominal", VR: "FD", VM: "1"},
+	"00209252": {Name: "Actual Cardiac Trigger Delay Time", Keyword: "ActualCardiacTriggerDelayTime", VR: "FD", VM: "1"},
+	"00209253": {Name: "Respiratory Synchronization Sequence", Keyword: "RespiratorySynchronizationSequence", VR: "SQ", VM: "1"},
+	"00209254": {Name: "Respiratory Interval Time", Keyword: "RespiratoryIntervalTime", VR: "FD", VM: "1"},
+	"00209255": {Name: "Nominal Respiratory Trigger Delay Time", Keyword: "NominalRespiratoryTriggerDelayTime", VR: "FD", VM: "1"},
+	"00209256": {Name: "Respiratory Trigger Delay Threshold", Keyword: "RespiratoryTriggerDelayThreshold", VR: "FD", VM: "1"},
+	"00209257": {Name: "Actual Respiratory Trigger Delay Time", Keyword: "ActualRespiratoryTriggerDelayTime", VR: "FD", VM: "1"},
+	"00209301": {Name: "Image Position Volume", Keyword: "ImagePositionVolume", VR: "FD", VM: "3"},
+	"00209302": {Name: "Image Orientation Volume", Keyword: "ImageOrientationVolume", VR: "FD", VM: "6"},
+	"00209308": {Name: "Apex Position", Keyword: "ApexPosition", VR: "FD", VM: "3"},
+	"00209421": {Name: "Dimension Description Label", Keyword: "DimensionDescriptionLabel", VR: "LO", VM: "1"},
+	"00209450": {Name: "Patient Orientation in Frame Sequence", Keyword: "PatientOrientationInFrameSequence", VR: "SQ", VM: "1"},
+	"00209453": {Name: "Frame Label", Keyword: "FrameLabel", VR: "LO", VM: "1"},
+	"00209518": {Name: "Acquisition Index", Keyword: "AcquisitionIndex", VR: "US", VM: "1-n"},
+	"00209529": {Name: "Contributing SOP Instances Reference Sequence", Keyword: "ContributingSOPInstancesReferenceSequence", VR: "SQ", VM: "1"},
+	"00209536": {Name: "Reconstruction Index", Keyword: "ReconstructionIndex", VR: "US", VM: "1"},
+	"00220001": {Name: "Light Path Filter Pass Through Wavelength", Keyword: "LightPathFilterPassThroughWavelength", VR: "US", VM: "1"},
+	"00220002": {Name: "Light Path Filter Pass Band", Keyword: "LightPathFilterPassBand", VR: "US", VM: "2"},
+	"00220003": {Name: "Image Path Filter Pass Through Wavelength", Keyword: "ImagePathFilterPassThroughWavelength", VR: "US", VM: "1"},
+	"00220004": {Name: "Image Path Filter Pass Band", Keyword: "ImagePathFilterPassBand", VR: "US", VM: "2"},
+	"00220005": {Name: "Patient Eye Movement Commanded", Keyword: "PatientEyeMovementCommanded", VR: "CS", VM: "1"},
+	"00220006": {Name: "Patient Eye Movement Command Code Sequence", Keyword: "PatientEyeMovementCommandCodeSequence", VR: "SQ", VM: "1"},
+	"00220007": {Name: "Spherical Lens Power", Keyword: "SphericalLensPower", VR: "FL", VM: "1"},
+	"00220008": {Name: "Cylinder Lens Power", Keyword: "CylinderLensPower", VR: "FL", VM: "1"},
+	"00220009": {Name: "Cylinder Axis", Keyword: "CylinderAxis", VR: "FL", VM: "1"},
+	"0022000A": {Name: "Emmetropic Magnification", Keyword: "EmmetropicMagnification", VR: "FL", VM: "1"},
+	"0022000B": {Name: "Intra Ocular Pressure", Keyword: "IntraOcularPressure", VR: "FL", VM: "1"},
+	"0022000C": {Name: "Horizontal Field of View", Keyword: "HorizontalFieldOfView", VR: "FL", VM: "1"},
+	"0022000D": {Name: "Pupil Dilated", Keyword: "PupilDilated", VR: "CS", VM: "1"},
+	"0022000E": {Name: "Degree of Dilation", Keyword: "DegreeOfDilation", VR: "FL", VM: "1"},
+	"00220010": {Name: "Stereo Baseline Angle", Keyword: "StereoBaselineAngle", VR: "FL", VM: "1"},
+	"00220011": {Name: "Stereo Baseline Displacement", Keyword: "StereoBaselineDisplacement", VR: "FL", VM: "1"},
+	"00220012": {Name: "Stereo Horizontal Pixel Offset", Keyword: "StereoHorizontalPixelOffset", VR: "FL", VM: "1"},
+	"00220013": {Name: "Stereo Vertical Pixel Offset", Keyword: "StereoVerticalPixelOffset", VR: "FL", VM: "1"},
+	"00220014": {Name: "Stereo Rotation", Keyword: "StereoRotation", VR: "FL", VM: "6"},
+	"00220015": {Name: "Acquisition Device Type Code Sequence", Keyword: "AcquisitionDeviceTypeCodeSequence", VR: "SQ", VM: "1"},
+	"00220016": {Name: "Illumination Type Code Sequence", Keyword: "IlluminationTypeCodeSequence", VR: "SQ", VM: "1"},
+	"00220017": {Name: "Light Path Filter Type Stack Code Sequence", Keyword: "LightPathFilterTypeStackCodeSequence", VR: "SQ", VM: "1"},
+	"00220018": {Name: "Image Path Filter Type Stack Code Sequence", Keyword: "ImagePathFilterTypeStackCodeSequence", VR: "SQ", VM: "1"},
+	"00220019": {Name: "Lenses Code Sequence", Keyword: "LensesCodeSequence", VR: "SQ", VM: "1"},
+	"0022001A": {Name: "Channel Description Code Sequence", Keyword: "ChannelDescriptionCodeSequence", VR: "SQ", VM: "1"},
+	"0022001B": {Name: "Refractive State Sequence", Keyword: "RefractiveStateSequence", VR: "SQ", VM: "1"},
+	"0022001C": {Name: "Mydriatic Agent Code Sequence", Keyword: "MydriaticAgentCodeSequence", VR: "SQ", VM: "1"},
+	"0022001D": {Name: "Relative Image Position Code Sequence", Keyword: "RelativeImagePositionCodeSequence", VR: "SQ", VM: "1"},
+	"00220020": {Name: "Stereo Pairs Sequence", Keyword: "StereoPairsSequence", VR: "SQ", VM: "1"},
+	"00220021": {Name: "Left Image Sequence", Keyword: "LeftImageSequence", VR: "SQ", VM: "1"},
+	"00220022": {Name: "Right Image Sequence", Keyword: "RightImageSequence", VR: "SQ", VM: "1"},
+	"00220030": {Name: "Axial Length of the Eye", Keyword: "AxialLengthOfTheEye", VR: "FL", VM: "1"},
+	"00220031": {Name: "Ophthalmic Frame Location Sequence", Keyword: "OphthalmicFrameLocationSequence", VR: "SQ", VM: "1"},
+	"00220032": {Name: "Reference Coordinates", Keyword: "ReferenceCoordinates", VR: "FL", VM: "2-2n"},
+	"00220035": {Name: "Depth Spatial Resolution", Keyword: "DepthSpatialResolution", VR: "FL", VM: "1"},
+	"00220036": {Name: "Maximum Depth Distortion", Keyword: "MaximumDepthDistortion", VR: "FL", VM: "1"},
+	"00220037": {Name: "Along Scan Spatial Resolution", Keyword: "AlongScanSpatialResolution", VR: "FL", VM: "1"},
+	"00220038": {Name: "Maximum Along Scan Distortion", Keyword: "MaximumAlongScanDistortion", VR: "FL", VM: "1"},
+	"00220039": {Name: "Ophthalmic Image Orientation", Keyword: "OphthalmicImageOrientation", VR: "CS", VM: "1"},
+	"00220041": {Name: "Depth of Transverse Image", Keyword: "DepthOfTransverseImage", VR: "FL", VM: "1"},
+	"00220042": {Name: "Mydriatic Agent Concentration Units Sequence", Keyword: "MydriaticAgentConcentrationUnitsSequence", VR: "SQ", VM: "1"},
+	"00220048": {Name: "Across Scan Spatial Resolution", Keyword: "AcrossScanSpatialResolution", VR: "FL", VM: "1"},
+	"00220049": {Name: "Maximum Across Scan Distortion", Keyword: "MaximumAcrossScanDistortion", VR: "FL", VM: "1"},
+	"0022004E": {Name: "Mydriatic Agent Concentration", Keyword: "MydriaticAgentConcentration", VR: "DS", VM: "1"},
+	"00220055": {Name: "Illumination Wave Length", Keyword: "IlluminationWaveLength", VR: "FL", VM: "1"},
+	"00220056": {Name: "Illumination Power", Keyword: "IlluminationPower", VR: "FL", VM: "1"},
+	"00220057": {Name: "Illumination Bandwidth", Keyword: "IlluminationBandwidth", VR: "FL", VM: "1"},
+	"00220058": {Name: "Mydriatic Agent Sequence", Keyword: "MydriaticAgentSequence", VR: "SQ", VM: "1"},
+	"00280000": {Name: "Image Presentation Group Length", Keyword: "ImagePresentationGroupLength", VR: "UL", VM: "1", Retired: true},
+	"00280002": {Name: "Samples per Pixel", Keyword: "SamplesPerPixel", VR: "US", VM: "1"},
+	"00280003": {Name: "Samples per Pixel Used", Keyword: "SamplesPerPixelUsed", VR: "US", VM: "1"},
+	"00280004": {Name: "Photometric Interpretation", Keyword: "PhotometricInterpretation", VR: "CS", VM: "1"},
+	"00280005": {Name: "Image Dimensions", Keyword: "ImageDimensions", VR: "US", VM: "1", Retired: true},
+	"00280006": {Name: "Planar Configuration", Keyword: "PlanarConfiguration", VR: "US", VM: "1"},
+	"00280008": {Name: "Number of Frames", Keyword: "NumberOfFrames", VR: "IS", VM: "1"},
+	"00280009": {Name: "Frame Increment Pointer", Keyword: "FrameIncrementPointer", VR: "AT", VM: "1-n"},
+	"0028000A": {Name: "Frame Dimension Pointer", Keyword: "FrameDimensionPointer", VR: "AT", VM: "1-n"},
+	"00280010": {Name: "Rows", Keyword: "Rows", VR: "US", VM: "1"},
+	"00280011": {Name: "Columns", Keyword: "Columns", VR: "US", VM: "1"},
+	"00280012": {Name: "Planes", Keyword: "Planes", VR: "US", VM: "1", Retired: true},
+	"00280014": {Name: "Ultrasound Color Data Present", Keyword: "UltrasoundColorDataPresent", VR: "US", VM: "1"},
+	"00280030": {Name: "Pixel Spacing", Keyword: "PixelSpacing", VR: "DS", VM: "2"},
+	"00280031": {Name: "Zoom Factor", Keyword: "ZoomFactor", VR: "DS", VM: "2"},
+	"00280032": {Name: "Zoom Center", Keyword: "ZoomCenter", VR: "DS", VM: "2"},
+	"00280034": {Name: "Pixel Aspect Ratio", Keyword: "PixelAspectRatio", VR: "IS", VM: "2"},
+	"00280040": {Name: "Image Format", Keyword: "ImageFormat", VR: "CS", VM: "1", Retired: true},
+	"00280050": {Name: "Manipulated Image", Keyword: "ManipulatedImage", VR: "LO", VM: "1-n", Retired: true},
+	"00280051": {Name: "Corrected Image", Keyword: "CorrectedImage", VR: "CS", VM: "1-n"},
+	"0028005F": {Name: "Compression Recognition Code", Keyword: "CompressionRecognitionCode", VR: "LO", VM: "1", Retired: true},
+	"00280060": {Name: "Compression Code", Keyword: "CompressionCode", VR: "CS", VM: "1", Retired: true},
+	"00280061": {Name: "Compression Originator", Keyword: "CompressionOriginator", VR: "SH", VM: "1", Retired: true},
+	"00280062": {Name: "Compression Label", Keyword: "CompressionLabel", VR: "LO", VM: "1", Retired: true},
+	"00280063": {Name: "Compression Description", Keyword: "CompressionDescription", VR: "SH", VM: "1", Retired: true},
+	"00280065": {Name: "Compression Sequence", Keyword: "CompressionSequence", VR: "CS", VM: "1-n", Retired: true},
+	"00280066": {Name: "Compression Step Pointers", Keyword: "CompressionStepPointers", VR: "AT", VM: "1-n", Retired: true},
+	"00280068": {Name: "Repeat Interval", Keyword: "RepeatInterval", VR: "US", VM: "1", Retired: true},
+	"00280069": {Name: "Bits Grouped", Keyword: "BitsGrouped", VR: "US", VM: "1", Retired: true},
+	"00280070": {Name: "Perimeter Table", Keyword: "PerimeterTable", VR: "US", VM: "1-n", Retired: true},
+	"00280071": {Name: "Perimeter Value", Keyword: "PerimeterValue", VR: "US or SS", VM: "1", Retired: true},
+	"00280080": {Name: "Predictor Rows", Keyword: "PredictorRows", VR: "US", VM: "1", Retired: true},
+	"00280081": {Name: "Predictor Columns", Keyword: "PredictorColumns", VR: "US", VM: "1", Retired: true},
+	"00280082": {Name: "Predictor Constants", Keyword: "PredictorConstants", VR: "US", VM: "1-n", Retired: true},
+	"00280090": {Name: "Blocked Pixels", Keyword: "BlockedPixels", VR: "CS", VM: "1", Retired: true},
+	"00280091": {Name: "Block Rows", Keyword: "BlockRows", VR: "US", VM: "1", Retired: true},
+	"00280092": {Name: "Block Columns", Keyword: "BlockColumns", VR: "US", VM: "1", Retired: true},
+	"00280093": {Name: "Row Overlap", Keyword: "RowOverlap", VR: "US", VM: "1", Retired: true},
+	"00280094": {Name: "Column Overlap", Keyword: "ColumnOverlap", VR: "US", VM: "1", Retired: true},
+	"00280100": {Name: "Bits Allocated", Keyword: "BitsAllocated", VR: "US", VM: "1"},
+	"00280101": {Name: "Bits Stored", Keyword: "BitsStored", VR: "US", VM: "1"},
+	"00280102": {Name: "High Bit", Keyword: "HighBit", VR: "US", VM: "1"},
+	"00280103": {Name: "Pixel Representation", Keyword: "PixelRepresentation", VR: "US", VM: "1"},
+	"00280104": {Name: "Smallest Valid Pixel Value", Keyword: "SmallestValidPixelValue", VR: "US or SS", VM: "1", Retired: true},
+	"00280105": {Name: "Largest Valid Pixel Value", Keyword: "LargestValidPixelValue", VR: "US or SS", VM: "1", Retired: true},
+	"00280106": {Name: "Smallest Image Pixel Value", Keyword: "SmallestImagePixelValue", VR: "US or SS", VM: "1"},
+	"00280107": {Name: "Largest Image Pixel Value", Keyword: "LargestImagePixelValue", VR: "US or SS", VM: "1"},
+	"00280108": {Name: "Smallest Pixel Value in Series", Keyword: "SmallestPixelValueInSeries", VR: "US or SS", VM: "1"},
+	"00280109": {Name: "Largest Pixel Value in Series", Keyword: "LargestPixelValueInSeries", VR: "US or SS", VM: "1"},
+	"00280110": {Name: "Smallest Image Pixel Value in Plane", Keyword: "SmallestImagePixelValueInPlane", VR: "US or SS", VM: "1", Retired: true},
+	"00280111": {Name: "Largest Image Pixel Value in Plane", Keyword: "LargestImagePixelValueInPlane", VR: "US or SS", VM: "1", Retired: true},
+	"00280120": {Name: "Pixel Padding Value", Keyword: "PixelPaddingValue", VR: "US or SS", VM: "1"},
+	"00280121": {Name: "Pixel Padding Range Limit", Keyword: "PixelPaddingRangeLimit", VR: "US or SS", VM: "1"},
+	"00280200": {Name: "Image Location", Keyword: "ImageLocation", VR: "US", VM: "1", Retired: true},
+	"00280300": {Name: "Quality Control Image", Keyword: "QualityControlImage", VR: "CS", VM: "1"},
+	"00280301": {Name: "Burned in Annotation", Keyword: "BurnedInAnnotation", VR: "CS", VM: "1"},
+	"00280400": {Name: "Transform Label", Keyword: "TransformLabel", VR: "LO", VM: "1", Retired: true},
+	"00280401": {Name: "Transform Version Number", Keyword: "TransformVersionNumber", VR: "LO", VM: "1", Retired: true},
+	"00280402": {Name: "Number of Transform Steps", Keyword: "NumberOfTransformSteps", VR: "US", VM: "1", Retired: true},
+	"00280403": {Name: "Sequence of Compressed Data", Keyword: "SequenceOfCompressedData", VR: "AT", VM: "1-n", Retired: true},
+	"00280404": {Name: "Details of Coefficients", Keyword: "DetailsOfCoefficients", VR: "AT", VM: "1-n", Retired: true},
+	"00280700": {Name: "DCT Label", Keyword: "DCTLabel", VR: "LO", VM: "1", Retired: true},
+	"00280701": {Name: "Data Block Description", Keyword: "DataBlockDescription", VR: "CS", VM: "1-n", Retired: true},
+	"00280702": {Name: "Data Block", Keyword: "DataBlock", VR: "AT", VM: "1-n", Retired: true},
+	"00280710": {Name: "Normalization Factor Format", Keyword: "NormalizationFactorFormat", VR: "US", VM: "1", Retired: true},
+	"00280720": {Name: "Zonal Map Number Format", Keyword: "ZonalMapNumberFormat", VR: "US", VM: "1", Retired: true},
+	"00280721": {Name: "Zonal Map Location", Keyword: "ZonalMapLocation", VR: "AT", VM: "1-n", Retired: true},
+	"00280722": {Name: "Zonal Map Format", Keyword: "ZonalMapFormat", VR: "US", VM: "1", Retired: true},
+	"00280730": {Name: "Adaptive Map Format", Keyword: "AdaptiveMapFormat", VR: "US", VM: "1", Retired: true},
+	"00280740": {Name: "Code Number Format", Keyword: "CodeNumberFormat", VR: "US", VM: "1", Retired: true},
+	"00280A02": {Name: "Pixel Spacing Calibration Type", Keyword: "PixelSpacingCalibrationType", VR: "CS", VM: "1"},
+	"00280A04": {Name: "Pixel Spacing Calibration Description", Keyword: "PixelSpacingCalibrationDescription", VR: "LO", VM: "1"},
+	"00281040": {Name: "Pixel Intensity Relationship", Keyword: "PixelIntensityRelationship", VR: "CS", VM: "1"},
+	"00281041": {Name: "Pixel Intensity Relationship Sign", Keyword: "PixelIntensityRelationshipSign", VR: "SS", VM: "1"},
+	"00281050": {Name: "Window Center", Keyword: "WindowCenter", VR: "DS", VM: "1-n"},
+	"00281051": {Name: "Window Width", Keyword: "WindowWidth", VR: "DS", VM: "1-n"},
+	"00281052": {Name: "Rescale Intercept", Keyword: "RescaleIntercept", VR: "DS", VM: "1"},
+	"00281053": {Name: "Rescale Slope", Keyword: "RescaleSlope", VR: "DS", VM: "1"},
+	"00281054": {Name: "Rescale Type", Keyword: "RescaleType", VR: "LO", VM: "1"},
+	"00281055": {Name: "Window Center and Width Explanation", Keyword: "WindowCenterAndWidthExplanation", VR: "LO", VM: "1-n"},
+	"00281056": {Name: "VOILUT Function", Keyword: "VOILUTFunction", VR: "CS", VM: "1"},
+	"00281080": {Name: "Gray Scale", Keyword: "GrayScale", VR: "CS", VM: "1", Retired: true},
+	"00281090": {Name: "Recommended Viewing Mode", Keyword: "RecommendedViewingMode", VR: "CS", VM: "1"},
+	"00281100": {Name: "Gray Lookup Table Descriptor", Keyword: "GrayLookupTableDescriptor", VR: "US or SS", VM: "3", Retired: true},
+	"00281101": {Name: "Red Palette Color Table Descriptor", Keyword: "RedPaletteColorTableDescriptor", VR: "US or SS", VM: "3"},
+	"00281102": {Name: "Green Palette Color Table Descriptor", Keyword: "GreenPaletteColorTableDescriptor", VR: "US or SS", VM: "3"},
+	"00281103": {Name: "Blue Palette Color Table Descriptor", Keyword: "BluePaletteColorTableDescriptor", VR: "US or SS", VM: "3"},
+	"00281111": {Name: "Large Red Palette Color Lookup Table Descriptor", Keyword: "LargeRedPaletteColorLookupTableDescriptor", VR: "US or SS", VM: "4", Retired: true},
+	"00281112": {Name: "Large Green Palette Color Lookup Table Descriptor", Keyword: "LargeGreenPaletteColorLookupTableDescriptor", VR: "US or SS", VM: "4", Retired: true},
+	"00281113": {Name: "Large Blue Palette Color Lookup Table Descriptor", Keyword: "LargeBluePaletteColorLookupTableDescriptor", VR: "US or SS", VM: "4", Retired: true},
+	"00281199": {Name: "Palette Color Lookup Table UID", Keyword: "PaletteColorLookupTableUID", VR: "UI", VM: "1"},
+	"00281200": {Name: "Gray Lookup Table Data", Keyword: "GrayLookupTableData", VR: "US or SS or OW", VM: "1-n", Retired: true},
+	"00281201": {Name: "Red Palette Color Lookup Table Data", Keyword: "RedPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	"00281202": {Name: "Green Palette Color Lookup Table Data", Keyword: "GreenPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	"00281203": {Name: "Blue Palette Color Lookup Table Data", Keyword: "BluePaletteColorLookupTableData", VR: "OW", VM: "1"},
+	"00281211": {Name: "Large Red Palette Color Lookup Table Data", Keyword: "LargeRedPaletteColorLookupTableData", VR: "OW", VM: "1", Retired: true},
+	"00281212": {Name: "Large Green Palette Color Lookup Table Data", Keyword: "LargeGreenPaletteColorLookupTableData", VR: "OW", VM: "1", Retired: true},
+	"00281213": {Name: "Large Blue Palette Color Lookup Table Data", Keyword: "LargeBluePaletteColorLookupTableData", VR: "OW", VM: "1", Retired: true},
+	"00281214": {Name: "Large Palette Color Lookup Table UID", Keyword: "LargePaletteColorLookupTableUID", VR: "UI", VM: "1", Retired: true},
+	"00281221": {Name: "Segmented Red Palette Color Lookup Table Data", Keyword: "SegmentedRedPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	"00281222": {Name: "Segmented Green Palette Color Lookup Table Data", Keyword: "SegmentedGreenPaletteColorLookupTableData", VR: "OW", VM: "1"},
+	"00281223": {Name: "Segmented Blue Palette Color Lookup Table Data", Keyword: "SegmentedBluePaletteColorLookupTableData", VR: "OW", VM: "1"},
+	"00281300": {Name: "Breast Implant Present", Keyword: "BreastImplantPresent", VR: "CS", VM: "1"},
+	"00281350": {Name: "Partial View", Keyword: "PartialView", VR: "CS", VM: "1"},
+	"00281351": {Name: "Partial View Description", Keyword: "PartialViewDescription", VR: "ST", VM: "1"},
+	"00281352": {Name: "Partial View Code Sequence", Keyword: "PartialViewCodeSequence", VR: "SQ", VM: "1"},
+	"0028135A": {Name: "Spatial Locations Preserved", Keyword: "SpatialLocationsPreserved", VR: "CS", VM: "1"},
+	"00281402": {Name: "Data Path Assignment", Keyword: "DataPathAssignment", VR: "CS", VM: "1"},
+	"00281404": {Name: "Blending LUT1 Sequence", Keyword: "BlendingLUT1Sequence", VR: "SQ", VM: "1"},
+	"00281406": {Name: "Blending Weight Constant", Keyword: "BlendingWeightConstant", VR: "FD", VM: "1"},
+	"00281408": {Name: "Blending Lookup Table Data", Keyword: "BlendingLookupTableData", VR: "OW", VM: "1"},
+	"0028140C": {Name: "Blending LUT2 Sequence", Keyword: "BlendingLUT2Sequence", VR: "SQ", VM: "1"},
+	"0028140E": {Name: "Data Path ID", Keyword: "DataPathID", VR: "CS", VM: "1"},
+	"0028140F": {Name: "RGBLUT Transfer Function", Keyword: "RGBLUTTransferFunction", VR: "CS", VM: "1"},
+	"00281410": {Name: "Alpha LUT Transfer Function", Keyword: "AlphaLUTTransferFunction", VR: "CS", VM: "1"},
+	"00282000": {Name: "ICC Profile", Keyword: "ICCProfile", VR: "OB", VM: "1"},
+	"00282110": {Name: "Lossy Image Compression", Keyword: "LossyImageCompression", VR: "CS", VM: "1"},
+	"00282112": {Name: "Lossy Image Compression Ratio", Keyword: "LossyImageCompressionRatio", VR: "DS", VM: "1-n"},
+	"00282114": {Name: "Lossy Image Compression Method", Keyword: "LossyImageCompressionMethod", VR: "CS", VM: "1-n"},
+	"00283000": {Name: "Modality LUT Sequence", Keyword: "ModalityLUTSequence", VR: "SQ", VM: "1"},
+	"00283002": {Name: "LUT Descriptor", Keyword: "LUTDescriptor", VR: "US or SS", VM: "3"},
+	"00283003": {Name: "LUT Explanation", Keyword: "LUTExplanation", VR: "LO", VM: "1"},
+	"00283004": {Name: "Modality LUT Type", Keyword: "ModalityLUTType", VR: "LO", VM: "1"},
+	"00283006": {Name: "LUT Data", Keyword: "LUTData", VR: "US or OW", VM: "1-n"},
+	"00283010": {Name: "VOILUT Sequence", Keyword: "VOILUTSequence", VR: "SQ", VM: "1"},
+	"00283110": {Name: "Softcopy VOILUT Sequence", Keyword: "SoftcopyVOILUTSequence", VR: "SQ", VM: "1"},
+	"00284000": {Name: "Image Presentation Comments", Keyword: "ImagePresentationComments", VR: "LT", VM: "1", Retired: true},
+	"00285000": {Name: "Bi Plane Acquisition Sequence", Keyword: "BiPlaneAcquisitionSequence", VR: "SQ", VM: "1", Retired: true},
+	"00286010": {Name: "Representative Frame Number", Keyword: "RepresentativeFrameNumber", VR: "US", VM: "1"},
+	"00286020": {Name: "Frame Numbers of Interest", Keyword: "FrameNumbersOfInterest", VR: "US", VM: "1-n"},
+	"00286022": {Name: "Frame of Interest Description", Keyword: "FrameOfInterestDescription", VR: "LO", VM: "1-n"},
+	"00286023": {Name: "Frame of Interest Type", Keyword: "FrameOfInterestType", VR: "CS", VM: "1-n"},
+	"00286030": {Name: "Mask Pointers", Keyword: "MaskPointers", VR: "US", VM: "1-n", Retired: true},
+	"00286040": {Name: "R Wave Pointer", Keyword: "RWavePointer", VR: "US", VM: "1-n"},
+	"00286100": {Name: "Mask Subtraction Sequence", Keyword: "MaskSubtractionSequence", VR: "SQ", VM: "1"},
+	"00286101": {Name: "Mask Operation", Keyword: "MaskOperation", VR: "CS", VM: "1"},
+	"00286102": {Name: "Applicable Frame Range", Keyword: "ApplicableFrameRange", VR: "US", VM: "2-2n"},
+	"00286110": {Name: "Mask Frame Numbers", Keyword: "MaskFrameNumbers", VR: "US", VM: "1-n"},
+	"00286112": {Name: "Contrast Frame Averaging", Keyword: "ContrastFrameAveraging", VR: "US", VM: "1"},
+	"00286114": {Name: "Mask Sub Pixel Shift", Keyword: "MaskSubPixelShift", VR: "FL", VM: "2"},
+	"00286120": {Name: "TID Offset", Keyword: "TIDOffset", VR: "SS", VM: "1"},
+	"00286190": {Name: "Mask Operation Explanation", Keyword: "MaskOperationExplanation", VR: "ST", VM: "1"},
+	"00287FE0": {Name: "Pixel Data Provider URL", Keyword: "PixelDataProviderURL", VR: "UR", VM: "1"},
+	"00289001": {Name: "Data Point Rows", Keyword: "DataPointRows", VR: "UL", VM: "1"},
+	"00289002": {Name: "Data Point Columns", Keyword: "DataPointColumns", VR: "UL", VM: "1"},
+	"00289003": {Name: "Signal Domain Columns", Keyword: "SignalDomainColumns", VR: "CS", VM: "1"},
+	"00289099": {Name: "Largest Monochrome Pixel Value", Keyword: "LargestMonochromePixelValue", VR: "US", VM: "1", Retired: true},
+	"00289108": {Name: "Data Representation", Keyword: "DataRepresentation", VR: "CS", VM: "1"},
+	"00289110": {Name: "Pixel Measures Sequence", Keyword: "PixelMeasuresSequence", VR: "SQ", VM: "1"},
+	"00289132": {Name: "Frame VOILUT Sequence", Keyword: "FrameVOILUTSequence", VR: "SQ", VM: "1"},
+	"00289145": {Name: "Pixel Value Transformation Sequence", Keyword: "PixelValueTransformationSequence", VR: "SQ", VM: "1"},
+	"00289235": {Name: "Signal Domain Rows", Keyword: "SignalDomainRows", VR: "CS", VM: "1-n"},
+	"00289411": {Name: "Display Filter Percentage", Keyword: "DisplayFilterPercentage", VR: "FL", VM: "1"},
+	"00289415": {Name: "Frame Pixel Shift Sequence", Keyword: "FramePixelShiftSequence", VR: "SQ", VM: "1"},
+	"00289416": {Name: "Subtraction Item ID", Keyword: "SubtractionItemID", VR: "US", VM: "1"},
+	"00289422": {Name: "Pixel Intensity Relationship LUT Sequence", Keyword: "PixelIntensityRelationshipLUTSequence", VR: "SQ", VM: "1"},
+	"00289443": {Name: "Frame Pixel Data Properties Sequence", Keyword: "FramePixelDataPropertiesSequence", VR: "SQ", VM: "1"},
+	"00289444": {Name: "Geometrical Properties", Keyword: "GeometricalProperties", VR: "CS", VM: "1"},
+	"00289445": {Name: "Geometric Maximum Distortion", Keyword: "GeometricMaximumDistortion", VR: "FL", VM: "1"},
+	"00289446": {Name: "Image Processing Applied", Keyword: "ImageProcessingApplied", VR: "CS", VM: "1-n"},
+	"00289454": {Name: "Mask Selection Mode", Keyword: "MaskSelectionMode", VR: "CS", VM: "1"},
+	"00289474": {Name: "LUT Function", Keyword: "LUTFunction", VR: "CS", VM: "1"},
+	"00289478": {Name: "Mask Visibility Percentage", Keyword: "MaskVisibilityPercentage", VR: "FL", VM: "1"},
+	"00289501": {Name: "Pixel Shift Sequence", Keyword: "PixelShiftSequence", VR: "SQ", VM: "1"},
+	"00289502": {Name: "Region Pixel Shift Sequence", Keyword: "RegionPixelShiftSequence", VR: "SQ", VM: "1"},
+	"00289503": {Name: "Vertices of the Region", Keyword: "VerticesOfTheRegion", VR: "SS", VM: "2-2n"},
+	"00289506": {Name: "Pixel Shift Frame Range", Keyword: "PixelShiftFrameRange", VR: "US", VM: "2-2n"},
+	"00289507": {Name: "LUT Frame Range", Keyword: "LUTFrameRange", VR: "US", VM: "2-2n"},
+	"00289520": {Name: "Image to Equipment Mapping Matrix", Keyword: "ImageToEquipmentMappingMatrix", VR: "DS", VM: "16"},
+	"00289537": {Name: "Equipment Coordinate System ID", Keyword: "EquipmentCoordinateSystemID", VR: "CS", VM: "1"},
+	"00320000": {Name: "Study Group Length", Keyword: "StudyGroupLength", VR: "UL", VM: "1", Retired: true},
+	"0032000A": {Name: "Study Status ID", Keyword: "StudyStatusID", VR: "CS", VM: "1", Retired: true},
+	"0032000C": {Name: "Study Priority ID", Keyword: "StudyPriorityID", VR: "CS", VM: "1", Retired: true},
+	"00320012": {Name: "Study ID Issuer", Keyword: "StudyIDIssuer", VR: "LO", VM: "1", Retired: true},
+	"00320032": {Name: "Study Verified Date", Keyword: "StudyVerifiedDate", VR: "DA", VM: "1", Retired: true},
+	"00320033": {Name: "Study Verified Time", Keyword: "StudyVerifiedTime", VR: "TM", VM: "1", Retired: true},
+	"00320034": {Name: "Study Read Date", Keyword: "StudyReadDate", VR: "DA", VM: "1", Retired: true},
+	"00320035": {Name: "Study Read Time", Keyword: "StudyReadTime", VR: "TM", VM: "1", Retired: true},
+	"00321000": {Name: "Scheduled Study Start Date", Keyword: "ScheduledStudyStartDate", VR: "DA", VM: "1", Retired: true},
+	"00321001": {Name: "Scheduled Study Start Time", Keyword: "ScheduledStudyStartTime", VR: "TM", VM: "1", Retired: true},
+	"00321010": {Name: "Scheduled Study Stop Date", Keyword: "ScheduledStudyStopDate", VR: "DA", VM: "1", Retired: true},
+	"00321011": {Name: "Scheduled Study Stop Time", Keyword: "ScheduledStudyStopTime", VR: "TM", VM: "1", Retired: true},
+	"00321020": {Name: "Scheduled Study Location", Keyword: "ScheduledStudyLocation", VR: "LO", VM: "1", Retired: true},
+	"00321021": {Name: "Scheduled Study Location AE Title", Keyword: "ScheduledStudyLocationAETitle", VR: "AE", VM: "1-n", Retired: true},
+	"00321030": {Name: "Reason for Study", Keyword: "ReasonForStudy", VR: "LO", VM: "1", Retired: true},
+	"00321031": {Name: "Requesting Physician ID Sequence", Keyword: "RequestingPhysicianIDSequence", VR: "SQ", VM: "1"},
+	"00321032": {Name: "Requesting Physician", Keyword: "RequestingPhysician", VR: "PN", VM: "1"},
+	"00321033": {Name: "Requesting Service", Keyword: "RequestingService", VR: "LO", VM: "1"},
+	"00321040": {Name: "Study Arrival Date", Keyword: "StudyArrivalDate", VR: "DA", VM: "1", Retired: true},
+	"00321041": {Name: "Study Arrival Time", Keyword: "StudyArrivalTime", VR: "TM", VM: "1", Retired: true},
+	"00321050": {Name: "Study Completion Date", Keyword: "StudyCompletionDate", VR: "DA", VM: "1", Retired: true},
+	"00321051": {Name: "Study Completion Time", Keyword: "StudyCompletionTime", VR: "TM", VM: "1", Retired: true},
+	"00321055": {Name: "Study Component Status ID", Keyword: "StudyComponentStatusID", VR: "CS", VM: "1", Retired: true},
+	"00321060": {Name: "Requested Procedure Description", Keyword: "RequestedProcedureDescription", VR: "LO", VM: "1"},
+	"00321064": {Name: "Requested Procedure Code Sequence", Keyword: "RequestedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	"00321070": {Name: "Requested Contrast Agent", Keyword: "RequestedContrastAgent", VR: "LO", VM: "1"},
+	"00324000": {Name: "Study Comments", Keyword: "StudyComments", VR: "LT", VM: "1", Retired: true},
+	"00380004": {Name: "Referenced Patient Alias Sequence", Keyword: "ReferencedPatientAliasSequence", VR: "SQ", VM: "1"},
+	"00380008": {Name: "Visit Status ID", Keyword: "VisitStatusID", VR: "CS", VM: "1"},
+	"00380010": {Name: "Admission ID", Keyword: "AdmissionID", VR: "LO", VM: "1"},
+	"00380011": {Name: "Issuer of Admission ID", Keyword: "IssuerOfAdmissionID", VR: "LO", VM: "1", Retired: true},
+	"00380016": {Name: "Route of Admissions", Keyword: "RouteOfAdmissions", VR: "LO", VM: "1"},
+	"0038001A": {Name: "Scheduled Admission Date", Keyword: "ScheduledAdmissionDate", VR: "DA", VM: "1", Retired: true},
+	"0038001B": {Name: "Scheduled Admission Time", Keyword: "ScheduledAdmissionTime", VR: "TM", VM: "1", Retired: true},
+	"0038001C": {Name: "Scheduled Discharge Date", Keyword: "ScheduledDischargeDate", VR: "DA", VM: "1", Retired: true},
+	"0038001D": {Name: "Scheduled Discharge Time", Keyword: "ScheduledDischargeTime", VR: "TM", VM: "1", Retired: true},
+	"0038001E": {Name: "Scheduled Patient Institution Residence", Keyword: "ScheduledPatientInstitutionResidence", VR: "LO", VM: "1", Retired: true},
+	"00380020": {Name: "Admitting Date", Keyword: "AdmittingDate", VR: "DA", VM: "1"},
+	"00380021": {Name: "Admitting Time", Keyword: "AdmittingTime", VR: "TM", VM: "1"},
+	"00380030": {Name: "Discharge Date", Keyword: "DischargeDate", VR: "DA", VM: "1", Retired: true},
+	"00380032": {Name: "Discharge Time", Keyword: "DischargeTime", VR: "TM", VM: "1", Retired: true},
+	"00380040": {Name: "Discharge Diagnosis Description", Keyword: "DischargeDiagnosisDescription", VR: "LO", VM: "1", Retired: true},
+	"00380044": {Name: "Discharge Diagnosis Code Sequence", Keyword: "DischargeDiagnosisCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	"00380050": {Name: "Special Needs", Keyword: "SpecialNeeds", VR: "LO", VM: "1"},
+	"00380060": {Name: "Service Episode ID", Keyword: "ServiceEpisodeID", VR: "LO", VM: "1"},
+	"00380061": {Name: "Issuer of Service Episode ID", Keyword: "IssuerOfServiceEpisodeID", VR: "LO", VM: "1", Retired: true},
+	"00380062": {Name: "Service Episode Description", Keyword: "ServiceEpisodeDescription", VR: "LO", VM: "1"},
+	"00380100": {Name: "Pertinent Documents Sequence", Keyword: "PertinentDocumentsSequence", VR: "SQ", VM: "1"},
+	"00380300": {Name: "Current Patient Location", Keyword: "CurrentPatientLocation", VR: "LO", VM: "1"},
+	"00380400": {Name: "Patient's Institution Residence", Keyword: "PatientInstitutionResidence", VR: "LO", VM: "1"},
+	"00380500": {Name: "Patient State", Keyword: "PatientState", VR: "LO", VM: "1"},
+	"00380502": {Name: "Patient Clinical Trial Participation Sequence", Keyword: "PatientClinicalTrialParticipationSequence", VR: "SQ", VM: "1"},
+	"00384000": {Name: "Visit Comments", Keyword: "VisitComments", VR: "LT", VM: "1"},
+	"003A0004": {Name: "Waveform Originality", Keyword: "WaveformOriginality", VR: "CS", VM: "1"},
+	"003A0005": {Name: "Number of Waveform Channels", Keyword: "NumberOfWaveformChannels", VR: "US", VM: "1"},
+	"003A0010": {Name: "Number of Waveform Samples", Keyword: "NumberOfWaveformSamples", VR: "UL", VM: "1"},
+	"003A001A": {Name: "Sampling Frequency", Keyword: "SamplingFrequency", VR: "DS", VM: "1"},
+	"003A0020": {Name: "Multiplex Group Label", Keyword: "MultiplexGroupLabel", VR: "SH", VM: "1"},
+	"003A0200": {Name: "Channel Definition Sequence", Keyword: "ChannelDefinitionSequence", VR: "SQ", VM: "1"},
+	"003A0202": {Name: "Waveform Channel Number", Keyword: "WaveformChannelNumber", VR: "IS", VM: "1"},
+	"003A0203": {Name: "Channel Label", Keyword: "ChannelLabel", VR: "SH", VM: "1"},
+	"003A0205": {Name: "Channel Status", Keyword: "ChannelStatus", VR: "CS", VM: "1-n"},
+	"003A0208": {Name: "Channel Source Sequence", Keyword: "ChannelSourceSequence", VR: "SQ", VM: "1"},
+	"003A0209": {Name: "Channel Source Modifiers Sequence", Keyword: "ChannelSourceModifiersSequence", VR: "SQ", VM: "1"},
+	"003A020A": {Name: "Source Waveform Sequence", Keyword: "SourceWaveformSequence", VR: "SQ", VM: "1"},
+	"003A020C": {Name: "Channel Derivation Description", Keyword: "ChannelDerivationDescription", VR: "LO", VM: "1"},
+	"003A0210": {Name: "Channel Sensitivity", Keyword: "ChannelSensitivity", VR: "DS", VM: "1"},
+	"003A0211": {Name: "Channel Sensitivity Units Sequence", Keyword: "ChannelSensitivityUnitsSequence", VR: "SQ", VM: "1"},
+	"003A0212": {Name: "Channel Sensitivity Correction Factor", Keyword: "ChannelSensitivityCorrectionFactor", VR: "DS", VM: "1"},
+	"003A0213": {Name: "Channel Baseline", Keyword: "ChannelBaseline", VR: "DS", VM: "1"},
+	"003A0214": {Name: "Channel Time Skew", Keyword: "ChannelTimeSkew", VR: "DS", VM: "1"},
+	"003A0215": {Name: "Channel Sample Skew", Keyword: "ChannelSampleSkew", VR: "DS", VM: "1"},
+	"003A0218": {Name: "Channel Offset", Keyword: "ChannelOffset", VR: "DS", VM: "1"},
+	"003A021A": {Name: "Waveform Bits Stored", Keyword: "WaveformBitsStored", VR: "US", VM: "1"},
+	"003A0220": {Name: "Filter Low Frequency", Keyword: "FilterLowFrequency", VR: "DS", VM: "1"},
+	"003A0221": {Name: "Filter High Frequency", Keyword: "FilterHighFrequency", VR: "DS", VM: "1"},
+	"003A0222": {Name: "Notch Filter Frequency", Keyword: "NotchFilterFrequency", VR: "DS", VM: "1"},
+	"003A0223": {Name: "Notch Filter Bandwidth", Keyword: "NotchFilterBandwidth", VR: "DS", VM: "1"},
+	"003A0230": {Name: "Waveform Data Display Scale", Keyword: "WaveformDataDisplayScale", VR: "FL", VM: "1"},
+	"003A0231": {Name: "Waveform Display Background CIE Lab Value", Keyword: "WaveformDisplayBackgroundCIELabValue", VR: "US", VM: "3"},
+	"003A0240": {Name: "Waveform Presentation Group Sequence", Keyword: "WaveformPresentationGroupSequence", VR: "SQ", VM: "1"},
+	"003A0241": {Name: "Presentation Group Number", Keyword: "PresentationGroupNumber", VR: "US", VM: "1"},
+	"003A0242": {Name: "Channel Display Sequence", Keyword: "ChannelDisplaySequence", VR: "SQ", VM: "1"},
+	"003A0244": {Name: "Channel Recommended Display CIE Lab Value", Keyword: "ChannelRecommendedDisplayCIELabValue", VR: "US", VM: "3"},
+	"003A0245": {Name: "Channel Position", Keyword: "ChannelPosition", VR: "FL", VM: "1"},
+	"003A0246": {Name: "Display Shading Flag", Keyword: "DisplayShadingFlag", VR: "CS", VM: "1"},
+	"003A0247": {Name: "Fractional Channel Display Scale", Keyword: "FractionalChannelDisplayScale", VR: "FL", VM: "1"},
+	"003A0248": {Name: "Absolute Channel Display Scale", Keyword: "AbsoluteChannelDisplayScale", VR: "FL", VM: "1"},
+	"003A0300": {Name: "Multiplexed Audio Channels Description Code Sequence", Keyword: "MultiplexedAudioChannelsDescriptionCodeSequence", VR: "SQ", VM: "1"},
+	"003A0301": {Name: "Channel Identification Code", Keyword: "ChannelIdentificationCode", VR: "IS", VM: "1"},
+	"003A0302": {Name: "Channel Mode", Keyword: "ChannelMode", VR: "CS", VM: "1"},
+	"00400001": {Name: "Scheduled Station AE Title", Keyword: "ScheduledStationAETitle", VR: "AE", VM: "1-n"},
+	"00400002": {Name: "Scheduled Procedure Step Start Date", Keyword: "ScheduledProcedureStepStartDate", VR: "DA", VM: "1"},
+	"00400003": {Name: "Scheduled Procedure Step Start Time", Keyword: "ScheduledProcedureStepStartTime", VR: "TM", VM: "1"},
+	"00400004": {Name: "Scheduled Procedure Step End Date", Keyword: "ScheduledProcedureStepEndDate", VR: "DA", VM: "1"},
+	"00400005": {Name: "Scheduled Procedure Step End Time", Keyword: "ScheduledProcedureStepEndTime", VR: "TM", VM: "1"},
+	"00400006": {Name: "Scheduled Performing Physician Name", Keyword: "ScheduledPerformingPhysicianName", VR: "PN", VM: "1"},
+	"00400007": {Name: "Scheduled Procedure Step Description", Keyword: "ScheduledProcedureStepDescription", VR: "LO", VM: "1"},
+	"00400008": {Name: "Scheduled Protocol Code Sequence", Keyword: "ScheduledProtocolCodeSequence", VR: "SQ", VM: "1"},
+	"00400009": {Name: "Scheduled Procedure Step ID", Keyword: "ScheduledProcedureStepID", VR: "SH", VM: "1"},
+	"0040000A": {Name: "Stage Code Sequence", Keyword: "StageCodeSequence", VR: "SQ", VM: "1"},
+	"0040000B": {Name: "Scheduled Performing Physician Identification Sequence", Keyword: "ScheduledPerformingPhysicianIdentificationSequence", VR: "SQ", VM: "1"},
+	"00400010": {Name: "Scheduled Station Name", Keyword: "ScheduledStationName", VR: "SH", VM: "1-n"},
+	"00400011": {Name: "Scheduled Procedure Step Location", Keyword: "ScheduledProcedureStepLocation", VR: "SH", VM: "1"},
+	"00400012": {Name: "Pre Medication", Keyword: "PreMedication", VR: "LO", VM: "1"},
+	"00400020": {Name: "Scheduled Procedure Step Status", Keyword: "ScheduledProcedureStepStatus", VR: "CS", VM: "1"},
+	"00400031": {Name: "Local Namespace Entity ID", Keyword: "LocalNamespaceEntityID", VR: "UT", VM: "1"},
+	"00400032": {Name: "Universal Entity ID", Keyword: "UniversalEntityID", VR: "UT", VM: "1"},
+	"00400033": {Name: "Universal Entity ID Type", Keyword: "UniversalEntityIDType", VR: "CS", VM: "1"},
+	"00400035": {Name: "Identifier Type Code", Keyword: "IdentifierTypeCode", VR: "CS", VM: "1"},
+	"00400036": {Name: "Assigning Facility Sequence", Keyword: "AssigningFacilitySequence", VR: "SQ", VM: "1"},
+	"00400100": {Name: "Scheduled Procedure Step Sequence", Keyword: "ScheduledProcedureStepSequence", VR: "SQ", VM: "1"},
+	"00400220": {Name: "Referenced Non Image Composite SOP Instance Sequence", Keyword: "ReferencedNonImageCompositeSOPInstanceSequence", VR: "SQ", VM: "1"},
+	"00400241": {Name: "Performed Station AE Title", Keyword: "PerformedStationAETitle", VR: "AE", VM: "1"},
+	"00400242": {Name: "Performed Station Name", Keyword: "PerformedStationName", VR: "SH", VM: "1"},
+	"00400243": {Name: "Performed Location", Keyword: "PerformedLocation", VR: "SH", VM: "1"},
+	"00400244": {Name: "Performed Procedure Step Start Date", Keyword: "PerformedProcedureStepStartDate", VR: "DA", VM: "1"},
+	"00400245": {Name: "Performed Procedure Step Start Time", Keyword: "PerformedProcedureStepStartTime", VR: "TM", VM: "1"},
+	"00400250": {Name: "Performed Procedure Step End Date", Keyword: "PerformedProcedureStepEndDate", VR: "DA", VM: "1"},
+	"00400251": {Name: "Performed Procedure Step End Time", Keyword: "PerformedProcedureStepEndTime", VR: "TM", VM: "1"},
+	"00400252": {Name: "Performed Procedure Step Status", Keyword: "PerformedProcedureStepStatus", VR: "CS", VM: "1"},
+	"00400253": {Name: "Performed Procedure Step ID", Keyword: "PerformedProcedureStepID", VR: "SH", VM: "1"},
+	"00400254": {Name: "Performed Procedure Step Description", Keyword: "PerformedProcedureStepDescription", VR: "LO", VM: "1"},
+	"00400255": {Name: "Performed Procedure Type Description", Keyword: "PerformedProcedureTypeDescription", VR: "LO", VM: "1"},
+	"00400260": {Name: "Performed Protocol Code Sequence", Keyword: "PerformedProtocolCodeSequence", VR: "SQ", VM: "1"},
+	"00400261": {Name: "Performed Protocol Type", Keyword: "PerformedProtocolType", VR: "CS", VM: "1-n"},
+	"00400270": {Name: "Scheduled Step Attributes Sequence", Keyword: "ScheduledStepAttributesSequence", VR: "SQ", VM: "1"},
+	"00400275": {Name: "Request Attributes Sequence", Keyword: "RequestAttributesSequence", VR: "SQ", VM: "1"},
+	"00400280": {Name: "Comments on Performed Procedure Step", Keyword: "CommentsOnPerformedProcedureStep", VR: "ST", VM: "1"},
+	"00400281": {Name: "Performed Procedure Step Discontinuation Reason Code Sequence", Keyword: "PerformedProcedureStepDiscontinuationReasonCodeSequence", VR: "SQ", VM: "1"},
+	"00400293": {Name: "Quantity Sequence", Keyword: "QuantitySequence", VR: "SQ", VM: "1"},
+	"00400294": {Name: "Quantity", Keyword: "Quantity", VR: "DS", VM: "1"},
+	"00400295": {Name: "Measuring Units Sequence", Keyword: "MeasuringUnitsSequence", VR: "SQ", VM: "1"},
+	"00400296": {Name: "Billing Item Sequence", Keyword: "BillingItemSequence", VR: "SQ", VM: "1"},
+	"00400300": {Name: "Total Time of Fluoroscopy", Keyword: "TotalTimeOfFluoroscopy", VR: "US", VM: "1", Retired: true},
+	"00400301": {Name: "Total Number of Exposures", Keyword: "TotalNumberOfExposures", VR: "US", VM: "1", Retired: true},
+	"00400302": {Name: "Entrance Dose", Keyword: "EntranceDose", VR: "US", VM: "1"},
+	"00400303": {Name: "Exposed Area", Keyword: "ExposedArea", VR: "US", VM: "1-2"},
+	"00400306": {Name: "Distance Source to Entrance", Keyword: "DistanceSourceToEntrance", VR: "DS", VM: "1"},
+	"00400307": {Name: "Distance Source to Support", Keyword: "DistanceSourceToSupport", VR: "DS", VM: "1", Retired: true},
+	"0040030E": {Name: "Exposure Dose Sequence", Keyword: "ExposureDoseSequence", VR: "SQ", VM: "1"},
+	"00400310": {Name: "Comments on Radiation Dose", Keyword: "CommentsOnRadiationDose", VR: "ST", VM: "1"},
+	"00400312": {Name: "X-Ray Output", Keyword: "XRayOutput", VR: "DS", VM: "1"},
+	"00400314": {Name: "Half Value Layer", Keyword: "HalfValueLayer", VR: "DS", VM: "1"},
+	"00400316": {Name: "Organ Dose", Keyword: "OrganDose", VR: "DS", VM: "1"},
+	"00400318": {Name: "Organ Exposed", Keyword: "OrganExposed", VR: "CS", VM: "1"},
+	"00400320": {Name: "Billing Procedure Step Sequence", Keyword: "BillingProcedureStepSequence", VR: "SQ", VM: "1"},
+	"00400321": {Name: "Film Consumption Sequence", Keyword: "FilmConsumptionSequence", VR: "SQ", VM: "1"},
+	"00400324": {Name: "Billing Supplies and Devices Sequence", Keyword: "BillingSuppliesAndDevicesSequence", VR: "SQ", VM: "1"},
+	"00400330": {Name: "Referenced Procedure Step Sequence", Keyword: "ReferencedProcedureStepSequence", VR: "SQ", VM: "1", Retired: true},
+	"00400340": {Name: "Performed Series Sequence", Keyword: "PerformedSeriesSequence", VR: "SQ", VM: "1"},
+	"00400400": {Name: "Comments on Scheduled Procedure Step", Keyword: "CommentsOnScheduledProcedureStep", VR: "LT", VM: "1"},
+	"00400440": {Name: "Protocol Context Sequence", Keyword: "ProtocolContextSequence", VR: "SQ", VM: "1"},
+	"00400441": {Name: "Content Item Modifier Sequence", Keyword: "ContentItemModifierSequence", VR: "SQ", VM: "1"},
+	"0040050A": {Name: "Specimen Accession Number", Keyword: "SpecimenAccessionNumber", VR: "LO", VM: "1", Retired: true},
+	"00400512": {Name: "Container Identifier", Keyword: "ContainerIdentifier", VR: "LO", VM: "1"},
+	"0040051A": {Name: "Container Description", Keyword: "ContainerDescription", VR: "LO", VM: "1"},
+	"00400550": {Name: "Specimen Sequence", Keyword: "SpecimenSequence", VR: "SQ", VM: "1", Retired: true},
+	"00400551": {Name: "Specimen Identifier", Keyword: "SpecimenIdentifier", VR: "LO", VM: "1"},
+	"00400552": {Name: "Specimen Description Sequence (Trial)", Keyword: "SpecimenDescriptionSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	"00400553": {Name: "Specimen Description (Trial)", Keyword: "SpecimenDescriptionTrial", VR: "ST", VM: "1", Retired: true},
+	"00400554": {Name: "Specimen UID", Keyword: "SpecimenUID", VR: "UI", VM: "1"},
+	"00400555": {Name: "Acquisition Context Sequence", Keyword: "AcquisitionContextSequence", VR: "SQ", VM: "1"},
+	"00400556": {Name: "Acquisition Context Description", Keyword: "AcquisitionContextDescription", VR: "ST", VM: "1"},
+	"0040059A": {Name: "Specimen Type Code Sequence", Keyword: "SpecimenTypeCodeSequence", VR: "SQ", VM: "1"},
+	"00400600": {Name: "Specimen Short Description", Keyword: "SpecimenShortDescription", VR: "LO", VM: "1"},
+	"004006FA": {Name: "Slide Identifier", Keyword: "SlideIdentifier", VR: "LO", VM: "1", Retired: true},
+	"0040071A": {Name: "Image Center Point Coordinates Sequence", Keyword: "ImageCenterPointCoordinatesSequence", VR: "SQ", VM: "1"},
+	"0040072A": {Name: "X Offset in Slide Coordinate System", Keyword: "XOffsetInSlideCoordinateSystem", VR: "DS", VM: "1"},
+	"0040073A": {Name: "Y Offset in Slide Coordinate System", Keyword: "YOffsetInSlideCoordinateSystem", VR: "DS", VM: "1"},
+	"0040074A": {Name: "Z Offset in Slide Coordinate System", Keyword: "ZOffsetInSlideCoordinateSystem", VR: "DS", VM: "1"},
+	"004008D8": {Name: "Pixel Spacing Sequence", Keyword: "PixelSpacingSequence", VR: "SQ", VM: "1", Retired: true},
+	"004008DA": {Name: "Coordinate System Axis Code Sequence", Keyword: "CoordinateSystemAxisCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	"004008EA": {Name: "Measurement Units Code Sequence", Keyword: "MeasurementUnitsCodeSequence", VR: "SQ", VM: "1"},
+	"004009F8": {Name: "Vital Stain Code Sequence (Trial)", Keyword: "VitalStainCodeSequenceTrial", VR: "SQ", VM: "1", Retired: true},
+	"00401001": {Name: "Requested Procedure ID", Keyword: "RequestedProcedureID", VR: "SH", VM: "1"},
+	"00401002": {Name: "Reason for Requested Procedure", Keyword: "ReasonForRequestedProcedure", VR: "LO", VM: "1"},
+	"00401003": {Name: "Requested Procedure Priority", Keyword: "RequestedProcedurePriority", VR: "SH", VM: "1"},
+	"00401004": {Name: "Patient Transport Arrangements", Keyword: "PatientTransportArrangements", VR: "LO", VM: "1"},
+	"00401005": {Name: "Requested Procedure Location", Keyword: "RequestedProcedureLocation", VR: "LO", VM: "1"},
+	"00401006": {Name: "Placer Order Number Procedure", Keyword: "PlacerOrderNumberProcedure", VR: "SH", VM: "1", Retired: true},
+	"00401007": {Name: "Filler Order Number Procedure", Keyword: "FillerOrderNumberProcedure", VR: "SH", VM: "1", Retired: true},
+	"00401008": {Name: "Confidentiality Code", Keyword: "ConfidentialityCode", VR: "LO", VM: "1"},
+	"00401009": {Name: "Reporting Priority", Keyword: "ReportingPriority", VR: "SH", VM: "1"},
+	"0040100A": {Name: "Reason for Requested Procedure Code Sequence", Keyword: "ReasonForRequestedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	"00401010": {Name: "Names of Intended Recipients of Results", Keyword: "NamesOfIntendedRecipientsOfResults", VR: "PN", VM: "1-n"},
+	"00401011": {Name: "Intended Recipients of Results Identification Sequence", Keyword: "IntendedRecipientsOfResultsIdentificationSequence", VR: "SQ", VM: "1"},
+	"00401101": {Name: "Person Identification Code Sequence", Keyword: "PersonIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	"00401102": {Name: "Person Address", Keyword: "PersonAddress", VR: "ST", VM: "1"},
+	"00401103": {Name: "Person Telephone Numbers", Keyword: "PersonTelephoneNumbers", VR: "LO", VM: "1-n"},
+	"00401400": {Name: "Requested Procedure Comments", Keyword: "RequestedProcedureComments", VR: "LT", VM: "1"},
+	"00402001": {Name: "Reason for Imaging Service Request", Keyword: "ReasonForImagingServiceRequest", VR: "LO", VM: "1", Retired: true},
+	"00402004": {Name: "Issue Date of Imaging Service Request", Keyword: "IssueDateOfImagingServiceRequest", VR: "DA", VM: "1"},
+	"00402005": {Name: "Issue Time of Imaging Service Request", Keyword: "IssueTimeOfImagingServiceRequest", VR: "TM", VM: "1"},
+	"00402006": {Name: "Placer Order Number Imaging Service Request (Retired)", Keyword: "PlacerOrderNumberImagingServiceRequestRetired", VR: "SH", VM: "1", Retired: true},
+	"00402007": {Name: "Filler Order Number Imaging Service Request (Retired)", Keyword: "FillerOrderNumberImagingServiceRequestRetired", VR: "SH", VM: "1", Retired: true},
+	"00402008": {Name: "Order Entered by", Keyword: "OrderEnteredBy", VR: "PN", VM: "1"},
+	"00402009": {Name: "Order Enterer Location", Keyword: "OrderEntererLocation", VR: "SH", VM: "1"},
+	"00402010": {Name: "Order Callback Phone Number", Keyword: "OrderCallbackPhoneNumber", VR: "SH", VM: "1"},
+	"00402016": {Name: "Placer Order Number Imaging Service Request", Keyword: "PlacerOrderNumberImagingServiceRequest", VR: "LO", VM: "1"},
+	"00402017": {Name: "Filler Order Number Imaging Service Request", Keyword: "FillerOrderNumberImagingServiceRequest", VR: "LO", VM: "1"},
+	"00402400": {Name: "Imaging Service Request Comments", Keyword: "ImagingServiceRequestComments", VR: "LT", VM: "1"},
+	"00403001": {Name: "Confidentiality Constraint on Patient Data Description", Keyword: "ConfidentialityConstraintOnPatientDataDescription", VR: "LO", VM: "1"},
+	"00404001": {Name: "General Purpose Scheduled Procedure Step Status", Keyword: "GeneralPurposeScheduledProcedureStepStatus", VR: "CS", VM: "1", Retired: true},
+	"00404002": {Name: "General Purpose Performed Procedure Step Status", Keyword: "GeneralPurposePerformedProcedureStepStatus", VR: "CS", VM: "1", Retired: true},
+	"00404003": {Name: "General Purpose Scheduled Procedure Step Priority", Keyword: "GeneralPurposeScheduledProcedureStepPriority", VR: "CS", VM: "1", Retired: true},
+	"00404004": {Name: "Scheduled Processing Applications Code Sequence", Keyword: "ScheduledProcessingApplicationsCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	"00404005": {Name: "Scheduled Procedure Step Start Date Time", Keyword: "ScheduledProcedureStepStartDateTime", VR: "DT", VM: "1"},
+	"00404006": {Name: "Multiple Copies Flag", Keyword: "MultipleCopiesFlag", VR: "CS", VM: "1", Retired: true},
+	"00404007": {Name: "Performed Processing Applications Code Sequence", Keyword: "PerformedProcessingApplicationsCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	"00404009": {Name: "Human Performer Code Sequence", Keyword: "HumanPerformerCodeSequence", VR: "SQ", VM: "1"},
+	"00404010": {Name: "Scheduled Procedure Step Modification Date Time", Keyword: "ScheduledProcedureStepModificationDateTime", VR: "DT", VM: "1"},
+	"00404011": {Name: "Expected Completion Date Time", Keyword: "ExpectedCompletionDateTime", VR: "DT", VM: "1"},
+	"00404015": {Name: "Resulting General Purpose Performed Procedure Steps Sequence", Keyword: "ResultingGeneralPurposePerformedProcedureStepsSequence", VR: "SQ", VM: "1", Retired: true},
+	"00404016": {Name: "Referenced General Purpose Scheduled Procedure Step Sequence", Keyword: "ReferencedGeneralPurposeScheduledProcedureStepSequence", VR: "SQ", VM: "1", Retired: true},
+	"00404018": {Name: "Scheduled Workitem Code Sequence", Keyword: "ScheduledWorkitemCodeSequence", VR: "SQ", VM: "1"},
+	"00404019": {Name: "Performed Workitem Code Sequence", Keyword: "PerformedWorkitemCodeSequence", VR: "SQ", VM: "1"},
+	"00404020": {Name: "Input Availability Flag", Keyword: "InputAvailabilityFlag", VR: "CS", VM: "1"},
+	"00404021": {Name: "Input Information Sequence", Keyword: "InputInformationSequence", VR: "SQ", VM: "1"},
+	"00404022": {Name: "Relevant Information Sequence", Keyword: "RelevantInformationSequence", VR: "SQ", VM: "1", Retired: true},
+	"00404023": {Name: "Referenced General Purpose Scheduled Procedure Step Transaction UID", Keyword: "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", VR: "UI", VM: "1", Retired: true},
+	"00404025": {Name: "Scheduled Station Name Code Sequence", Keyword: "ScheduledStationNameCodeSequence", VR: "SQ", VM: "1"},
+	"00404026": {Name: "Scheduled Station Class Code Sequence", Keyword: "ScheduledStationClassCodeSequence", VR: "SQ", VM: "1"},
+	"00404027": {Name: "Scheduled Station Geographic Location Code Sequence", Keyword: "ScheduledStationGeographicLocationCodeSequence", VR: "SQ", VM: "1"},
+	"00404028": {Name: "Performed Station Name Code Sequence", Keyword: "PerformedStationNameCodeSequence", VR: "SQ", VM: "1"},
+	"00404029": {Name: "Performed Station Class Code Sequence", Keyword: "PerformedStationClassCodeSequence", VR: "SQ", VM: "1"},
+	"00404030": {Name: "Performed Station Geographic Location Code Sequence", Keyword: "PerformedStationGeographicLocationCodeSequence", VR: "SQ", VM: "1"},
+	"00404031": {Name: "Requested Subsequent Workitem Code Sequence", Keyword: "RequestedSubsequentWorkitemCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	"00404032": {Name: "Non DICOM Output Code Sequence", Keyword: "NonDICOMOutputCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	"00404033": {Name: "Output Information Sequence", Keyword: "OutputInformationSequence", VR: "SQ", VM: "1"},
+	"00404034": {Name: "Scheduled Human Performers Sequence", Keyword: "ScheduledHumanPerformersSequence", VR: "SQ", VM: "1"},
+	"00404035": {Name: "Actual Human Performers Sequence", Keyword: "ActualHumanPerformersSequence", VR: "SQ", VM: "1"},
+	"00404036": {Name: "Human Performers Organization", Keyword: "HumanPerformersOrganization", VR: "LO", VM: "1"},
+	"00404037": {Name: "Human Performer Name", Keyword: "HumanPerformerName", VR: "PN", VM: "1"},
+	"00404040": {Name: "Raw Data Handling", Keyword: "RawDataHandling", VR: "CS", VM: "1"},
+	"00408302": {Name: "Entrance Dose Inm Gy", Keyword: "EntranceDoseInmGy", VR: "DS", VM: "1"},
+	"00409094": {Name: "Referenced Image Real World Value Mapping Sequence", Keyword: "ReferencedImageRealWorldValueMappingSequence", VR: "SQ", VM: "1"},
+	"00409096": {Name: "Real World Value Mapping Sequence", Keyword: "RealWorldValueMappingSequence", VR: "SQ", VM: "1"},
+	"00409098": {Name: "Pixel Value Mapping Code Sequence", Keyword: "PixelValueMappingCodeSequence", VR: "SQ", VM: "1"},
+	"00409210": {Name: "LUT Label", Keyword: "LUTLabel", VR: "SH", VM: "1"},
+	"00409211": {Name: "Real World Value Last Value Mapped", Keyword: "RealWorldValueLastValueMapped", VR: "US or SS", VM: "1"},
+	"00409212": {Name: "Real World Value LUT Data", Keyword: "RealWorldValueLUTData", VR: "FD", VM: "1-n"},
+	"00409216": {Name: "Real World Value First Value Mapped", Keyword: "RealWorldValueFirstValueMapped", VR: "US or SS", VM: "1"},
+	"00409224": {Name: "Real World Value Intercept", Keyword: "RealWorldValueIntercept", VR: "FD", VM: "1"},
+	"00409225": {Name: "Real World Value Slope", Keyword: "RealWorldValueSlope", VR: "FD", VM: "1"},
+	"0040A010": {Name: "Relationship Type", Keyword: "RelationshipType", VR: "CS", VM: "1"},
+	"0040A027": {Name: "Verifying Organization", Keyword: "VerifyingOrganization", VR: "LO", VM: "1"},
+	"0040A030": {Name: "Verification Date Time", Keyword: "VerificationDateTime", VR: "DT", VM: "1"},
+	"0040A032": {Name: "Observation Date Time", Keyword: "ObservationDateTime", VR: "DT", VM: "1"},
+	"0040A040": {Name: "Value Type", Keyword: "ValueType", VR: "CS", VM: "1"},
+	"0040A043": {Name: "Concept Name Code Sequence", Keyword: "ConceptNameCodeSequence", VR: "SQ", VM: "1"},
+	"0040A050": {Name: "Continuity of Content", Keyword: "ContinuityOfContent", VR: "CS", VM: "1"},
+	"0040A073": {Name: "Verifying Observer Sequence", Keyword: "VerifyingObserverSequence", VR: "SQ", VM: "1"},
+	"0040A075": {Name: "Verifying Observer Name", Keyword: "VerifyingObserverName", VR: "PN", VM: "1"},
+	"0040A078": {Name: "Author Observer Sequence", Keyword: "AuthorObserverSequence", VR: "SQ", VM: "1"},
+	"0040A07A": {Name: "Participant Sequence", Keyword: "ParticipantSequence", VR: "SQ", VM: "1"},
+	"0040A07C": {Name: "Custodial Organization Sequence", Keyword: "CustodialOrganizationSequence", VR: "SQ", VM: "1"},
+	"0040A080": {Name: "Participation Type", Keyword: "ParticipationType", VR: "CS", VM: "1"},
+	"0040A082": {Name: "Participation Date Time", Keyword: "ParticipationDateTime", VR: "DT", VM: "1"},
+	"0040A084": {Name: "Observer Type", Keyword: "ObserverType", VR: "CS", VM: "1"},
+	"0040A088": {Name: "Verifying Observer Identification Code Sequence", Keyword: "VerifyingObserverIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	"0040A090": {Name: "Equivalent CDA Document Sequence", Keyword: "EquivalentCDADocumentSequence", VR: "SQ", VM: "1", Retired: true},
+	"0040A0B0": {Name: "Referenced Waveform Channels", Keyword: "ReferencedWaveformChannels", VR: "US", VM: "2-2n"},
+	"0040A120": {Name: "Date Time", Keyword: "DateTime", VR: "DT", VM: "1"},
+	"0040A121": {Name: "Date", Keyword: "Date", VR: "DA", VM: "1"},
+	"0040A122": {Name: "Time", Keyword: "Time", VR: "TM", VM: "1"},
+	"0040A123": {Name: "Person Name", Keyword: "PersonName", VR: "PN", VM: "1"},
+	"0040A124": {Name: "UID", Keyword: "UID", VR: "UI", VM: "1"},
+	"0040A130": {Name: "Temporal Range Type", Keyword: "TemporalRangeType", VR: "CS", VM: "1"},
+	"0040A132": {Name: "Referenced Sample Positions", Keyword: "ReferencedSamplePositions", VR: "UL", VM: "1-n"},
+	"0040A136": {Name: "Referenced Frame Numbers", Keyword: "ReferencedFrameNumbers", VR: "US", VM: "1-n", Retired: true},
+	"0040A138": {Name: "Referenced Time Offsets", Keyword: "ReferencedTimeOffsets", VR: "DS", VM: "1-n"},
+	"0040A13A": {Name: "Referenced Date Time", Keyword: "ReferencedDateTime", VR: "DT", VM: "1-n"},
+	"0040A160": {Name: "Text Value", Keyword: "TextValue", VR: "UT", VM: "1"},
+	"0040A168": {Name: "Concept Code Sequence", Keyword: "ConceptCodeSequence", VR: "SQ", VM: "1"},
+	"0040A170": {Name: "Purpose of Reference Code Sequence", Keyword: "PurposeOfReferenceCodeSequence", VR: "SQ", VM: "1"},
+	"0040A180": {Name: "Annotation Group Number", Keyword: "AnnotationGroupNumber", VR: "US", VM: "1"},
+	"0040A195": {Name: "Modifier Code Sequence", Keyword: "ModifierCodeSequence", VR: "SQ", VM: "1"},
+	"0040A300": {Name: "Measured Value Sequence", Keyword: "MeasuredValueSequence", VR: "SQ", VM: "1"},
+	"0040A301": {Name: "Numeric Value Qualifier Code Sequence", Keyword: "NumericValueQualifierCodeSequence", VR: "SQ", VM: "1"},
+	"0040A30A": {Name: "Numeric Value", Keyword: "NumericValue", VR: "DS", VM: "1-n"},
+	"0040A353": {Name: "Address (Trial)", Keyword: "AddressTrial", VR: "ST", VM: "1", Retired: true},
+	"0040A354": {Name: "Telephone Number (Trial)", Keyword: "TelephoneNumberTrial", VR: "LO", VM: "1", Retired: true},
+	"0040A360": {Name: "Predecessor Documents Sequence", Keyword: "PredecessorDocumentsSequence", VR: "SQ", VM: "1"},
+	"0040A370": {Name: "Referenced Request Sequence", Keyword: "ReferencedRequestSequence", VR: "SQ", VM: "1"},
+	"0040A372": {Name: "Performed Procedure Code Sequence", Keyword: "PerformedProcedureCodeSequence", VR: "SQ", VM: "1"},
+	"0040A375": {Name: "Current Requested Procedure Evidence Sequence", Keyword: "CurrentRequestedProcedureEvidenceSequence", VR: "SQ", VM: "1"},
+	"0040A385": {Name: "Pertinent Other Evidence Sequence", Keyword: "PertinentOtherEvidenceSequence", VR: "SQ", VM: "1"},
+	"0040A390": {Name: "HL7 Structured Document Reference Sequence", Keyword: "HL7StructuredDocumentReferenceSequence", VR: "SQ", VM: "1"},
+	"0040A491": {Name: "Completion Flag", Keyword: "CompletionFlag", VR: "CS", VM: "1"},
+	"0040A492": {Name: "Completion Flag Description", Keyword: "CompletionFlagDescription", VR: "LO", VM: "1"},
+	"0040A493": {Name: "Verification Flag", Keyword: "VerificationFlag", VR: "CS", VM: "1"},
+	"0040A494": {Name: "Archive Requested", Keyword: "ArchiveRequested", VR: "CS", VM: "1"},
+	"0040A496": {Name: "Preliminary Flag", Keyword: "PreliminaryFlag", VR: "CS", VM: "1"},
+	"0040A504": {Name: "Content Template Sequence", Keyword: "ContentTemplateSequence", VR: "SQ", VM: "1"},
+	"0040A525": {Name: "Identical Documents Sequence", Keyword: "IdenticalDocumentsSequence", VR: "SQ", VM: "1"},
+	"0040A730": {Name: "Content Sequence", Keyword: "ContentSequence", VR: "SQ", VM: "1"},
+	"0040B020": {Name: "Annotation Sequence", Keyword: "AnnotationSequence", VR: "SQ", VM: "1"},
+	"0040DB00": {Name: "Template Identifier", Keyword: "TemplateIdentifier", VR: "CS", VM: "1"},
+	"0040DB06": {Name: "Template Version", Keyword: "TemplateVersion", VR: "DT", VM: "1", Retired: true},
+	"0040DB07": {Name: "Template Local Version", Keyword: "TemplateLocalVersion", VR: "DT", VM: "1", Retired: true},
+	"0040DB0B": {Name: "Template Extension Flag", Keyword: "TemplateExtensionFlag", VR: "CS", VM: "1", Retired: true},
+	"0040DB0C": {Name: "Template Extension Organization UID", Keyword: "TemplateExtensionOrganizationUID", VR: "UI", VM: "1", Retired: true},
+	"0040DB0D": {Name: "Template Extension Creator UID", Keyword: "TemplateExtensionCreatorUID", VR: "UI", VM: "1", Retired: true},
+	"0040DB73": {Name: "Referenced Content Item Identifier", Keyword: "ReferencedContentItemIdentifier", VR: "UL", VM: "1-n"},
+	"0040E001": {Name: "HL7 Instance Identifier", Keyword: "HL7InstanceIdentifier", VR: "ST", VM: "1"},
+	"0040E004": {Name: "HL7 Document Effective Time", Keyword: "HL7DocumentEffectiveTime", VR: "DT", VM: "1"},
+	"0040E006": {Name: "HL7 Document Type Code Sequence", Keyword: "HL7DocumentTypeCodeSequence", VR: "SQ", VM: "1"},
+	"0040E010": {Name: "Retrieve URI", Keyword: "RetrieveURI", VR: "UR", VM: "1"},
+	"0040E011": {Name: "Retrieve Location UID", Keyword: "RetrieveLocationUID", VR: "UI", VM: "1"},
+	"00420010": {Name: "Document Title", Keyword: "DocumentTitle", VR: "ST", VM: "1"},
+	"00420011": {Name: "Encapsulated Document", Keyword: "EncapsulatedDocument", VR: "OB", VM: "1"},
+	"00420012": {Name: "MIME Type of Encapsulated Document", Keyword: "MIMETypeOfEncapsulatedDocument", VR: "LO", VM: "1"},
+	"00420013": {Name: "Source Instance Sequence", Keyword: "SourceInstanceSequence", VR: "SQ", VM: "1"},
+	"00420014": {Name: "List of MIME Types", Keyword: "ListOfMIMETypes", VR: "LO", VM: "1-n"},
+	"00440001": {Name: "Product Package Identifier", Keyword: "ProductPackageIdentifier", VR: "ST", VM: "1"},
+	"00440002": {Name: "Substance Administration Approval", Keyword: "SubstanceAdministrationApproval", VR: "CS", VM: "1"},
+	"00440003": {Name: "Approval Status Further Description", Keyword: "ApprovalStatusFurtherDescription", VR: "LT", VM: "1"},
+	"00440004": {Name: "Approval Status Date Time", Keyword: "ApprovalStatusDateTime", VR: "DT", VM: "1"},
+	"00440007": {Name: "Product Type Code Sequence", Keyword: "ProductTypeCodeSequence", VR: "SQ", VM: "1"},
+	"00440008": {Name: "Product Name", Keyword: "ProductName", VR: "LO", VM: "1-n"},
+	"00440009": {Name: "Product Description", Keyword: "ProductDescription", VR: "LT", VM: "1"},
+	"0044000A": {Name: "Product Lot Identifier", Keyword: "ProductLotIdentifier", VR: "LO", VM: "1"},
+	"0044000B": {Name: "Product Expiration Date Time", Keyword: "ProductExpirationDateTime", VR: "DT", VM: "1"},
+	"00440010": {Name: "Substance Administration Date Time", Keyword: "SubstanceAdministrationDateTime", VR: "DT", VM: "1"},
+	"00440011": {Name: "Substance Administration Notes", Keyword: "SubstanceAdministrationNotes", VR: "LO", VM: "1"},
+	"00440012": {Name: "Substance Administration Device ID", Keyword: "SubstanceAdministrationDeviceID", VR: "LO", VM: "1"},
+	"00440013": {Name: "Product Parameter Sequence", Keyword: "ProductParameterSequence", VR: "SQ", VM: "1"},
+	"00440019": {Name: "Substance Administration Parameter Sequence", Keyword: "SubstanceAdministrationParameterSequence", VR: "SQ", VM: "1"},
+	"00460012": {Name: "Lens Description", Keyword: "LensDescription", VR: "LO", VM: "1"},
+	"00460014": {Name: "Right Lens Sequence", Keyword: "RightLensSequence", VR: "SQ", VM: "1"},
+	"00460015": {Name: "Left Lens Sequence", Keyword: "LeftLensSequence", VR: "SQ", VM: "1"},
+	"00460018": {Name: "Cylinder Sequence", Keyword: "CylinderSequence", VR: "SQ", VM: "1"},
+	"00460028": {Name: "Prism Sequence", Keyword: "PrismSequence", VR: "SQ", VM: "1"},
+	"00460030": {Name: "Horizontal Prism Power", Keyword: "HorizontalPrismPower", VR: "FD", VM: "1"},
+	"00460032": {Name: "Horizontal Prism Base", Keyword: "HorizontalPrismBase", VR: "CS", VM: "1"},
+	"00460034": {Name: "Vertical Prism Power", Keyword: "VerticalPrismPower", VR: "FD", VM: "1"},
+	"00460036": {Name: "Vertical Prism Base", Keyword: "VerticalPrismBase", VR: "CS", VM: "1"},
+	"00460038": {Name: "Lens Segment Type", Keyword: "LensSegmentType", VR: "CS", VM: "1"},
+	"00460040": {Name: "Optical Transmittance", Keyword: "OpticalTransmittance", VR: "FD", VM: "1"},
+	"00460042": {Name: "Channel Width", Keyword: "ChannelWidth", VR: "FD", VM: "1"},
+	"00460044": {Name: "Pupil Size", Keyword: "PupilSize", VR: "FD", VM: "1"},
+	"00460046": {Name: "Corneal Size", Keyword: "CornealSize", VR: "FD", VM: "1"},
+	"00460060": {Name: "Distance Pupillary Distance", Keyword: "DistancePupillaryDistance", VR: "FD", VM: "1"},
+	"00460062": {Name: "Near Pupillary Distance", Keyword: "NearPupillaryDistance", VR: "FD", VM: "1"},
+	"00460064": {Name: "Other Pupillary Distance", Keyword: "OtherPupillaryDistance", VR: "FD", VM: "1"},
+	"00460075": {Name: "Radius of Curvature", Keyword: "RadiusOfCurvature", VR: "FD", VM: "1"},
+	"00460076": {Name: "Keratometric Power", Keyword: "KeratometricPower", VR: "FD", VM: "1"},
+	"00460077": {Name: "Keratometric Axis", Keyword: "KeratometricAxis", VR: "FD", VM: "1"},
+	"00460092": {Name: "Background Color", Keyword: "BackgroundColor", VR: "CS", VM: "1"},
+	"00460094": {Name: "Optotype", Keyword: "Optotype", VR: "CS", VM: "1"},
+	"00460095": {Name: "Optotype Presentation", Keyword: "OptotypePresentation", VR: "CS", VM: "1"},
+	"00460100": {Name: "Add Near Sequence", Keyword: "AddNearSequence", VR: "SQ", VM: "1"},
+	"00460101": {Name: "Add Intermediate Sequence", Keyword: "AddIntermediateSequence", VR: "SQ", VM: "1"},
+	"00460102": {Name: "Add Other Sequence", Keyword: "AddOtherSequence", VR: "SQ", VM: "1"},
+	"00460104": {Name: "Add Power", Keyword: "AddPower", VR: "FD", VM: "1"},
+	"00460106": {Name: "Viewing Distance", Keyword: "ViewingDistance", VR: "FD", VM: "1"},
+	"00460125": {Name: "Viewing Distance Type", Keyword: "ViewingDistanceType", VR: "CS", VM: "1"},
+	"00460135": {Name: "Visual Acuity Modifiers", Keyword: "VisualAcuityModifiers", VR: "SS", VM: "2"},
+	"00460137": {Name: "Decimal Visual Acuity", Keyword: "DecimalVisualAcuity", VR: "FD", VM: "1"},
+	"00460139": {Name: "Optotype Detailed Definition", Keyword: "OptotypeDetailedDefinition", VR: "LO", VM: "1"},
+	"00460146": {Name: "Sphere Power", Keyword: "SpherePower", VR: "FD", VM: "1"},
+	"00460147": {Name: "Cylinder Power", Keyword: "CylinderPower", VR: "FD", VM: "1"},
+	"00500004": {Name: "Calibration Image", Keyword: "CalibrationImage", VR: "CS", VM: "1"},
+	"00500010": {Name: "Device Sequence", Keyword: "DeviceSequence", VR: "SQ", VM: "1"},
+	"00500014": {Name: "Device Length", Keyword: "DeviceLength", VR: "DS", VM: "1"},
+	"00500015": {Name: "Container Component Width", Keyword: "ContainerComponentWidth", VR: "FD", VM: "1"},
+	"00500016": {Name: "Device Diameter", Keyword: "DeviceDiameter", VR: "DS", VM: "1"},
+	"00500017": {Name: "Device Diameter Units", Keyword: "DeviceDiameterUnits", VR: "CS", VM: "1"},
+	"00500018": {Name: "Device Volume", Keyword: "DeviceVolume", VR: "DS", VM: "1"},
+	"00500019": {Name: "Inter Marker Distance", Keyword: "InterMarkerDistance", VR: "DS", VM: "1"},
+	"0050001B": {Name: "Container Component ID", Keyword: "ContainerComponentID", VR: "LO", VM: "1"},
+	"00500020": {Name: "Device Description", Keyword: "DeviceDescription", VR: "LO", VM: "1"},
+	"00540010": {Name: "Energy Window Vector", Keyword: "EnergyWindowVector", VR: "US", VM: "1-n"},
+	"00540011": {Name: "Number of Energy Windows", Keyword: "NumberOfEnergyWindows", VR: "US", VM: "1"},
+	"00540012": {Name: "Energy Window Information Sequence", Keyword: "EnergyWindowInformationSequence", VR: "SQ", VM: "1"},
+	"00540013": {Name: "Energy Window Range Sequence", Keyword: "EnergyWindowRangeSequence", VR: "SQ", VM: "1"},
+	"00540014": {Name: "Energy Window Lower Limit", Keyword: "EnergyWindowLowerLimit", VR: "DS", VM: "1"},
+	"00540015": {Name: "Energy Window Upper Limit", Keyword: "EnergyWindowUpperLimit", VR: "DS", VM: "1"},
+	"00540016": {Name: "Radiopharmaceutical Information Sequence", Keyword: "RadiopharmaceuticalInformationSequence", VR: "SQ", VM: "1"},
+	"00540017": {Name: "Residual Syringe Counts", Keyword: "ResidualSyringeCounts", VR: "IS", VM: "1"},
+	"00540018": {Name: "Energy Window Name", Keyword: "EnergyWindowName", VR: "SH", VM: "1"},
+	"00540020": {Name: "Detector Vector", Keyword: "DetectorVector", VR: "US", VM: "1-n"},
+	"00540021": {Name: "Number of Detectors", Keyword: "NumberOfDetectors", VR: "US", VM: "1"},
+	"00540022": {Name: "Detector Information Sequence", Keyword: "DetectorInformationSequence", VR: "SQ", VM: "1"},
+	"00540030": {Name: "Phase Vector", Keyword: "PhaseVector", VR: "US", VM: "1-n"},
+	"00540031": {Name: "Number of Phases", Keyword: "NumberOfPhases", VR: "US", VM: "1"},
+	"00540032": {Name: "Phase Information Sequence", Keyword: "PhaseInformationSequence", VR: "SQ", VM: "1"},
+	"00540033": {Name: "Number of Frames in Phase", Keyword: "NumberOfFramesInPhase", VR: "US", VM: "1"},
+	"00540036": {Name: "Phase Delay", Keyword: "PhaseDelay", VR: "IS", VM: "1"},
+	"00540038": {Name: "Pause Between Frames", Keyword: "PauseBetweenFrames", VR: "IS", VM: "1"},
+	"00540039": {Name: "Phase Description", Keyword: "PhaseDescription", VR: "CS", VM: "1"},
+	"00540050": {Name: "Rotation Vector", Keyword: "RotationVector", VR: "US", VM: "1-n"},
+	"00540051": {Name: "Number of Rotations", Keyword: "NumberOfRotations", VR: "US", VM: "1"},
+	"00540052": {Name: "Rotation Information Sequence", Keyword: "RotationInformationSequence", VR: "SQ", VM: "1"},
+	"00540053": {Name: "Number of Frames in Rotation", Keyword: "NumberOfFramesInRotation", VR: "US", VM: "1"},
+	"00540060": {Name: "RR Interval Vector", Keyword: "RRIntervalVector", VR: "US", VM: "1-n"},
+	"00540061": {Name: "Number of RR Intervals", Keyword: "NumberOfRRIntervals", VR: "US", VM: "1"},
+	"00540062": {Name: "Gated Information Sequence", Keyword: "GatedInformationSequence", VR: "SQ", VM: "1"},
+	"00540063": {Name: "Data Information Sequence", Keyword: "DataInformationSequence", VR: "SQ", VM: "1"},
+	"00540070": {Name: "Time Slot Vector", Keyword: "TimeSlotVector", VR: "US", VM: "1-n"},
+	"00540071": {Name: "Number of Time Slots", Keyword: "NumberOfTimeSlots", VR: "US", VM: "1"},
+	"00540072": {Name: "Time Slot Information Sequence", Keyword: "TimeSlotInformationSequence", VR: "SQ", VM: "1"},
+	"00540073": {Name: "Time Slot Time", Keyword: "TimeSlotTime", VR: "DS", VM: "1-n"},
+	"00540080": {Name: "Slice Vector", Keyword: "SliceVector", VR: "US", VM: "1-n"},
+	"00540081": {Name: "Number of Slices", Keyword: "NumberOfSlices", VR: "US", VM: "1"},
+	"00540090": {Name: "Angular View Vector", Keyword: "AngularViewVector", VR: "US", VM: "1-n"},
+	"00540100": {Name: "Time Slice Vector", Keyword: "TimeSliceVector", VR: "US", VM: "1-n"},
+	"00540101": {Name: "Number of Time Slices", Keyword: "NumberOfTimeSlices", VR: "US", VM: "1"},
+	"00540200": {Name: "Start Angle", Keyword: "StartAngle", VR: "DS", VM: "1"},
+	"00540202": {Name: "Type of Detector Motion", Keyword: "TypeOfDetectorMotion", VR: "CS", VM: "1"},
+	"00540210": {Name: "Trigger Vector", Keyword: "TriggerVector", VR: "IS", VM: "1-n"},
+	"00540211": {Name: "Number of Triggers in Phase", Keyword: "NumberOfTriggersInPhase", VR: "US", VM: "1"},
+	"00540220": {Name: "View Code Sequence", Keyword: "ViewCodeSequence", VR: "SQ", VM: "1"},
+	"00540222": {Name: "View Modifier Code Sequence", Keyword: "ViewModifierCodeSequence", VR: "SQ", VM: "1"},
+	"00540300": {Name: "Radionuclide Code Sequence", Keyword: "RadionuclideCodeSequence", VR: "SQ", VM: "1"},
+	"00540302": {Name: "Administration Route Code Sequence", Keyword: "AdministrationRouteCodeSequence", VR: "SQ", VM: "1"},
+	"00540304": {Name: "Radiopharmaceutical Code Sequence", Keyword: "RadiopharmaceuticalCodeSequence", VR: "SQ", VM: "1"},
+	"00540306": {Name: "Calibration Data Sequence", Keyword: "CalibrationDataSequence", VR: "SQ", VM: "1"},
+	"00540308": {Name: "Energy Window Number", Keyword: "EnergyWindowNumber", VR: "US", VM: "1"},
+	"00540400": {Name: "Image ID", Keyword: "ImageID", VR: "SH", VM: "1"},
+	"00540410": {Name: "Patient Orientation Code Sequence", Keyword: "PatientOrientationCodeSequence", VR: "SQ", VM: "1"},
+	"00540412": {Name: "Patient Orientation Modifier Code Sequence", Keyword: "PatientOrientationModifierCodeSequence", VR: "SQ", VM: "1"},
+	"00540414": {Name: "Patient Gantry Relationship Code Sequence", Keyword: "PatientGantryRelationshipCodeSequence", VR: "SQ", VM: "1"},
+	"00540500": {Name: "Slice Progression Direction", Keyword: "SliceProgressionDirection", VR: "CS", VM: "1"},
+	"00541000": {Name: "Series Type", Keyword: "SeriesType", VR: "CS", VM: "2"},
+	"00541001": {Name: "Units", Keyword: "Units", VR: "CS", VM: "1"},
+	"00541002": {Name: "Counts Source", Keyword: "CountsSource", VR: "CS", VM: "1"},
+	"00541004": {Name: "Reprojection Method", Keyword: "ReprojectionMethod", VR: "CS", VM: "1"},
+	"00541100": {Name: "Randoms Correction Method", Keyword: "RandomsCorrectionMethod", VR: "CS", VM: "1"},
+	"00541101": {Name: "Attenuation Correction Method", Keyword: "AttenuationCorrectionMethod", VR: "LO", VM: "1"},
+	"00541102": {Name: "Decay Correction", Keyword: "DecayCorrection", VR: "CS", VM: "1"},
+	"00541103": {Name: "Reconstruction Method", Keyword: "ReconstructionMethod", VR: "LO", VM: "1"},
+	"00541104": {Name: "Detector Lines of Response Used", Keyword: "DetectorLinesOfResponseUsed", VR: "LO", VM: "1"},
+	"00541105": {Name: "Scatter Correction Method", Keyword: "ScatterCorrectionMethod", VR: "LO", VM: "1"},
+	"00541200": {Name: "Axial Acceptance", Keyword: "AxialAcceptance", VR: "DS", VM: "1"},
+	"00541201": {Name: "Axial Mash", Keyword: "AxialMash", VR: "IS", VM: "1"},
+	"00541202": {Name: "Transverse Mash", Keyword: "TransverseMash", VR: "IS", VM: "1"},
+	"00541203": {Name: "Detector Element Size", Keyword: "DetectorElementSize", VR: "DS", VM: "2"},
+	"00541210": {Name: "Coincidence Window Width", Keyword: "CoincidenceWindowWidth", VR: "DS", VM: "1"},
+	"00541220": {Name: "Secondary Counts Type", Keyword: "SecondaryCountsType", VR: "CS", VM: "1-n"},
+	"00541300": {Name: "Frame Reference Time", Keyword: "FrameReferenceTime", VR: "DS", VM: "1"},
+	"00541310": {Name: "Primary Counts Accumulated", Keyword: "PrimaryCountsAccumulated", VR: "IS", VM: "1"},
+	"00541311": {Name: "Secondary Counts Accumulated", Keyword: "SecondaryCountsAccumulated", VR: "IS", VM: "1-n"},
+	"00541320": {Name: "Slice Sensitivity Factor", Keyword: "SliceSensitivityFactor", VR: "DS", VM: "1"},
+	"00541321": {Name: "Decay Factor", Keyword: "DecayFactor", VR: "DS", VM: "1"},
+	"00541322": {Name: "Dose Calibration Factor", Keyword: "DoseCalibrationFactor", VR: "DS", VM: "1"},
+	"00541323": {Name: "Scatter Fraction Factor", Keyword: "ScatterFractionFactor", VR: "DS", VM: "1"},
+	"00541324": {Name: "Dead Time Factor", Keyword: "DeadTimeFactor", VR: "DS", VM: "1"},
+	"00541330": {Name: "Image Index", Keyword: "ImageIndex", VR: "US", VM: "1"},
+	"00541400": {Name: "Counts Included", Keyword: "CountsIncluded", VR: "CS", VM: "1-n", Retired: true},
+	"00541401": {Name: "Dead Time Correction Flag", Keyword: "DeadTimeCorrectionFlag", VR: "CS", VM: "1", Retired: true},
+	"00603000": {Name: "Histogram Sequence", Keyword: "HistogramSequence", VR: "SQ", VM: "1"},
+	"00603002": {Name: "Histogram Number of Bins", Keyword: "HistogramNumberOfBins", VR: "US", VM: "1"},
+	"00603004": {Name: "Histogram First Bin Value", Keyword: "HistogramFirstBinValue", VR: "US or SS", VM: "1"},
+	"00603006": {Name: "Histogram Last Bin Value", Keyword: "HistogramLastBinValue", VR: "US or SS", VM: "1"},
+	"00603008": {Name: "Histogram Bin Width", Keyword: "HistogramBinWidth", VR: "US", VM: "1"},
+	"00603010": {Name: "Histogram Explanation", Keyword: "HistogramExplanation", VR: "LO", VM: "1"},
+	"00603020": {Name: "Histogram Data", Keyword: "HistogramData", VR: "UL", VM: "1-n"},
+	"00620001": {Name: "Segmentation Type", Keyword: "SegmentationType", VR: "CS", VM: "1"},
+	"00620002": {Name: "Segment Sequence", Keyword: "SegmentSequence", VR: "SQ", VM: "1"},
+	"00620003": {Name: "Segmented Property Category Code Sequence", Keyword: "SegmentedPropertyCategoryCodeSequence", VR: "SQ", VM: "1"},
+	"00620004": {Name: "Segment Number", Keyword: "SegmentNumber", VR: "US", VM: "1"},
+	"00620005": {Name: "Segment Label", Keyword: "SegmentLabel", VR: "LO", VM: "1"},
+	"00620006": {Name: "Segment Description", Keyword: "SegmentDescription", VR: "ST", VM: "1"},
+	"00620008": {Name: "Segment Algorithm Type", Keyword: "SegmentAlgorithmType", VR: "CS", VM: "1"},
+	"00620009": {Name: "Segment Algorithm Name", Keyword: "SegmentAlgorithmName", VR: "LO", VM: "1"},
+	"0062000A": {Name: "Segment Identification Sequence", Keyword: "SegmentIdentificationSequence", VR: "SQ", VM: "1"},
+	"0062000B": {Name: "Referenced Segment Number", Keyword: "ReferencedSegmentNumber", VR: "US", VM: "1-n"},
+	"0062000C": {Name: "Recommended Display Grayscale Value", Keyword: "RecommendedDisplayGrayscaleValue", VR: "US", VM: "1"},
+	"0062000D": {Name: "Recommended Display CIE Lab Value", Keyword: "RecommendedDisplayCIELabValue", VR: "US", VM: "3"},
+	"0062000E": {Name: "Maximum Fractional Value", Keyword: "MaximumFractionalValue", VR: "US", VM: "1"},
+	"0062000F": {Name: "Segmented Property Type Code Sequence", Keyword: "SegmentedPropertyTypeCodeSequence", VR: "SQ", VM: "1"},
+	"00620010": {Name: "Segmentation Fractional Type", Keyword: "SegmentationFractionalType", VR: "CS", VM: "1"},
+	"00640002": {Name: "Deformable Registration Sequence", Keyword: "DeformableRegistrationSequence", VR: "SQ", VM: "1"},
+	"00640003": {Name: "Source Frame of Reference UID", Keyword: "SourceFrameOfReferenceUID", VR: "UI", VM: "1"},
+	"00640005": {Name: "Deformable Registration Grid Sequence", Keyword: "DeformableRegistrationGridSequence", VR: "SQ", VM: "1"},
+	"00640007": {Name: "Grid Dimensions", Keyword: "GridDimensions", VR: "UL", VM: "3"},
+	"00640008": {Name: "Grid Resolution", Keyword: "GridResolution", VR: "FD", VM: "3"},
+	"00640009": {Name: "Vector Grid Data", Keyword: "VectorGridData", VR: "OF", VM: "1"},
+	"0064000F": {Name: "Pre Deformation Matrix Registration Sequence", Keyword: "PreDeformationMatrixRegistrationSequence", VR: "SQ", VM: "1"},
+	"00640010": {Name: "Post Deformation Matrix Registration Sequence", Keyword: "PostDeformationMatrixRegistrationSequence", VR: "SQ", VM: "1"},
+	"00660001": {Name: "Number of Surfaces", Keyword: "NumberOfSurfaces", VR: "UL", VM: "1"},
+	"00660002": {Name: "Surface Sequence", Keyword: "SurfaceSequence", VR: "SQ", VM: "1"},
+	"00660003": {Name: "Surface Number", Keyword: "SurfaceNumber", VR: "UL", VM: "1"},
+	"00660004": {Name: "Surface Comments", Keyword: "SurfaceComments", VR: "LT", VM: "1"},
+	"00660009": {Name: "Surface Processing", Keyword: "SurfaceProcessing", VR: "CS", VM: "1"},
+	"0066000A": {Name: "Surface Processing Ratio", Keyword: "SurfaceProcessingRatio", VR: "FL", VM: "1"},
+	"0066000E": {Name: "Finite Volume", Keyword: "FiniteVolume", VR: "CS", VM: "1"},
+	"00660010": {Name: "Manifold", Keyword: "Manifold", VR: "CS", VM: "1"},
+	"00660011": {Name: "Surface Points Sequence", Keyword: "SurfacePointsSequence", VR: "SQ", VM: "1"},
+	"00660015": {Name: "Number of Surface Points", Keyword: "NumberOfSurfacePoints", VR: "UL", VM: "1"},
+	"00660016": {Name: "Point Coordinates Data", Keyword: "PointCoordinatesData", VR: "OF", VM: "1"},
+	"00660017": {Name: "Point Position Accuracy", Keyword: "PointPositionAccuracy", VR: "FL", VM: "3"},
+	"00660018": {Name: "Mean Point Distance", Keyword: "MeanPointDistance", VR: "FL", VM: "1"},
+	"00660019": {Name: "Maximum Point Distance", Keyword: "MaximumPointDistance", VR: "FL", VM: "1"},
+	"0066001B": {Name: "Axis of Rotation", Keyword: "AxisOfRotation", VR: "FL", VM: "3", Retired: true},
+	"0066001C": {Name: "Center of Rotation", Keyword: "CenterOfRotation", VR: "FL", VM: "3", Retired: true},
+	"0066001E": {Name: "Number of Vectors", Keyword: "NumberOfVectors", VR: "UL", VM: "1"},
+	"0066001F": {Name: "Vector Dimensionality", Keyword: "VectorDimensionality", VR: "US", VM: "1"},
+	"00660020": {Name: "Vector Accuracy", Keyword: "VectorAccuracy", VR: "FL", VM: "1-n"},
+	"00660021": {Name: "Vector Coordinate Data", Keyword: "VectorCoordinateData", VR: "OF", VM: "1"},
+	"00660023": {Name: "Triangle Point Index List", Keyword: "TrianglePointIndexList", VR: "OW", VM: "1", Retired: true},
+	"00660024": {Name: "Edge Point Index List", Keyword: "EdgePointIndexList", VR: "OW", VM: "1", Retired: true},
+	"00660025": {Name: "Vertex Point Index List", Keyword: "VertexPointIndexList", VR: "OW", VM: "1", Retired: true},
+	"00660026": {Name: "Triangle Strip Sequence", Keyword: "TriangleStripSequence", VR: "SQ", VM: "1"},
+	"00660027": {Name: "Triangle Fan Sequence", Keyword: "TriangleFanSequence", VR: "SQ", VM: "1", Retired: true},
+	"00660028": {Name: "Line Sequence", Keyword: "LineSequence", VR: "SQ", VM: "1"},
+	"00660029": {Name: "Primitive Point Index List", Keyword: "PrimitivePointIndexList", VR: "OW", VM: "1", Retired: true},
+	"0066002A": {Name: "Surface Count", Keyword: "SurfaceCount", VR: "UL", VM: "1"},
+	"0066002F": {Name: "Algorithm Family Code Sequence", Keyword: "AlgorithmFamilyCodeSequence", VR: "SQ", VM: "1"},
+	"00660031": {Name: "Algorithm Version", Keyword: "AlgorithmVersion", VR: "LO", VM: "1"},
+	"00660032": {Name: "Algorithm Parameters", Keyword: "AlgorithmParameters", VR: "LO", VM: "1"},
+	"00660034": {Name: "Facet Sequence", Keyword: "FacetSequence", VR: "SQ", VM: "1"},
+	"00660036": {Name: "Algorithm Name", Keyword: "AlgorithmName", VR: "LO", VM: "1"},
+	"00700001": {Name: "Graphic Annotation Sequence", Keyword: "GraphicAnnotationSequence", VR: "SQ", VM: "1"},
+	"00700002": {Name: "Graphic Layer", Keyword: "GraphicLayer", VR: "CS", VM: "1"},
+	"00700003": {Name: "Bounding Box Annotation Units", Keyword: "BoundingBoxAnnotationUnits", VR: "CS", VM: "1"},
+	"00700004": {Name: "Anchor Point Annotation Units", Keyword: "AnchorPointAnnotationUnits", VR: "CS", VM: "1"},
+	"00700005": {Name: "Graphic Annotation Units", Keyword: "GraphicAnnotationUnits", VR: "CS", VM: "1"},
+	"00700006": {Name: "Unformatted Text Value", Keyword: "UnformattedTextValue", VR: "ST", VM: "1"},
+	"00700008": {Name: "Text Object Sequence", Keyword: "TextObjectSequence", VR: "SQ", VM: "1"},
+	"00700009": {Name: "Graphic Object Sequence", Keyword: "GraphicObjectSequence", VR: "SQ", VM: "1"},
+	"00700010": {Name: "Bounding Box Top Left Hand Corner", Keyword: "BoundingBoxTopLeftHandCorner", VR: "FL", VM: "2"},
+	"00700011": {Name: "Bounding Box Bottom Right Hand Corner", Keyword: "BoundingBoxBottomRightHandCorner", VR: "FL", VM: "2"},
+	"00700012": {Name: "Bounding Box Text Horizontal Justification", Keyword: "BoundingBoxTextHorizontalJustification", VR: "CS", VM: "1"},
+	"00700014": {Name: "Anchor Point", Keyword: "AnchorPoint", VR: "FL", VM: "2"},
+	"00700015": {Name: "Anchor Point Visibility", Keyword: "AnchorPointVisibility", VR: "CS", VM: "1"},
+	"00700020": {Name: "Graphic Dimensions", Keyword: "GraphicDimensions", VR: "US", VM: "1"},
+	"00700021": {Name: "Number of Graphic Points", Keyword: "NumberOfGraphicPoints", VR: "US", VM: "1"},
+	"00700022": {Name: "Graphic Data", Keyword: "GraphicData", VR: "FL", VM: "2-n"},
+	"00700023": {Name: "Graphic Type", Keyword: "GraphicType", VR: "CS", VM: "1"},
+	"00700024": {Name: "Graphic Filled", Keyword: "GraphicFilled", VR: "CS", VM: "1"},
+	"00700040": {Name: "Image Rotation (Retired)", Keyword: "ImageRotationRetired", VR: "IS", VM: "1", Retired: true},
+	"00700041": {Name: "Image Horizontal Flip", Keyword: "ImageHorizontalFlip", VR: "CS", VM: "1"},
+	"00700042": {Name: "Image Rotation", Keyword: "ImageRotation", VR: "US", VM: "1"},
+	"00700050": {Name: "Displayed Area Top Left Hand Corner (Trial)", Keyword: "DisplayedAreaTopLeftHandCornerTrial", VR: "US", VM: "2", Retired: true},
+	"00700051": {Name: "Displayed Area Bottom Right Hand Corner (Trial)", Keyword: "DisplayedAreaBottomRightHandCornerTrial", VR: "US", VM: "2", Retired: true},
+	"00700052": {Name: "Displayed Area Top Left Hand Corner", Keyword: "DisplayedAreaTopLeftHandCorner", VR: "SL", VM: "2"},
+	"00700053": {Name: "Displayed Area Bottom Right Hand Corner", Keyword: "DisplayedAreaBottomRightHandCorner", VR: "SL", VM: "2"},
+	"0070005A": {Name: "Displayed Area Selection Sequence", Keyword: "DisplayedAreaSelectionSequence", VR: "SQ", VM: "1"},
+	"00700060": {Name: "Graphic Layer Sequence", Keyword: "GraphicLayerSequence", VR: "SQ", VM: "1"},
+	"00700062": {Name: "Graphic Layer Order", Keyword: "GraphicLayerOrder", VR: "IS", VM: "1"},
+	"00700066": {Name: "Graphic Layer Recommended Display Grayscale Value", Keyword: "GraphicLayerRecommendedDisplayGrayscaleValue", VR: "US", VM: "1"},
+	"00700067": {Name: "Graphic Layer Recommended Display RGB Value", Keyword: "GraphicLayerRecommendedDisplayRGBValue", VR: "US", VM: "3", Retired: true},
+	"00700068": {Name: "Graphic Layer Description", Keyword: "GraphicLayerDescription", VR: "LO", VM: "1"},
+	"00700080": {Name: "Content Label", Keyword: "ContentLabel", VR: "CS", VM: "1"},
+	"00700081": {Name: "Content Description", Keyword: "ContentDescription", VR: "LO", VM: "1"},
+	"00700082": {Name: "Presentation Creation Date", Keyword: "PresentationCreationDate", VR: "DA", VM: "1"},
+	"00700083": {Name: "Presentation Creation Time", Keyword: "PresentationCreationTime", VR: "TM", VM: "1"},
+	"00700084": {Name: "Content Creator Name", Keyword: "ContentCreatorName", VR: "PN", VM: "1"},
+	"00700086": {Name: "Content Creator Identification Code Sequence", Keyword: "ContentCreatorIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	"00700100": {Name: "Presentation Size Mode", Keyword: "PresentationSizeMode", VR: "CS", VM: "1"},
+	"00700101": {Name: "Presentation Pixel Spacing", Keyword: "PresentationPixelSpacing", VR: "DS", VM: "2"},
+	"00700102": {Name: "Presentation Pixel Aspect Ratio", Keyword: "PresentationPixelAspectRatio", VR: "IS", VM: "2"},
+	"00700103": {Name: "Presentation Pixel Magnification Ratio", Keyword: "PresentationPixelMagnificationRatio", VR: "FL", VM: "1"},
+	"00700306": {Name: "Shape Type", Keyword: "ShapeType", VR: "CS", VM: "1"},
+	"00700308": {Name: "Registration Sequence", Keyword: "RegistrationSequence", VR: "SQ", VM: "1"},
+	"00700309": {Name: "Matrix Registration Sequence", Keyword: "MatrixRegistrationSequence", VR: "SQ", VM: "1"},
+	"0070030A": {Name: "Matrix Sequence", Keyword: "MatrixSequence", VR: "SQ", VM: "1"},
+	"0070030C": {Name: "Frame of Reference Transformation Matrix Type", Keyword: "FrameOfReferenceTransformationMatrixType", VR: "CS", VM: "1"},
+	"0070030D": {Name: "Registration Type Code Sequence", Keyword: "RegistrationTypeCodeSequence", VR: "SQ", VM: "1"},
+	"0070030F": {Name: "Fiducial Description", Keyword: "FiducialDescription", VR: "ST", VM: "1"},
+	"00700310": {Name: "Fiducial Identifier", Keyword: "FiducialIdentifier", VR: "SH", VM: "1"},
+	"00700311": {Name: "Fiducial Identifier Code Sequence", Keyword: "FiducialIdentifierCodeSequence", VR: "SQ", VM: "1"},
+	"00700312": {Name: "Contour Uncertainty Radius", Keyword: "ContourUncertaintyRadius", VR: "FD", VM: "1"},
+	"00700314": {Name: "Used Fiducials Sequence", Keyword: "UsedFiducialsSequence", VR: "SQ", VM: "1"},
+	"00700318": {Name: "Graphic Coordinates Data Sequence", Keyword: "GraphicCoordinatesDataSequence", VR: "SQ", VM: "1"},
+	"0070031A": {Name: "Fiducial UID", Keyword: "FiducialUID", VR: "UI", VM: "1"},
+	"0070031C": {Name: "Fiducial Set Sequence", Keyword: "FiducialSetSequence", VR: "SQ", VM: "1"},
+	"0070031E": {Name: "Fiducial Sequence", Keyword: "FiducialSequence", VR: "SQ", VM: "1"},
+	"00700401": {Name: "Graphic Layer Recommended Display CIE Lab Value", Keyword: "GraphicLayerRecommendedDisplayCIELabValue", VR: "US", VM: "3"},
+	"00700402": {Name: "Blending Sequence", Keyword: "BlendingSequence", VR: "SQ", VM: "1"},
+	"00700403": {Name: "Relative Opacity", Keyword: "RelativeOpacity", VR: "FL", VM: "1"},
+	"00700404": {Name: "Referenced Spatial Registration Sequence", Keyword: "ReferencedSpatialRegistrationSequence", VR: "SQ", VM: "1"},
+	"00700405": {Name: "Blending Position", Keyword: "BlendingPosition", VR: "CS", VM: "1"},
+	"00720002": {Name: "Hanging Protocol Name", Keyword: "HangingProtocolName", VR: "SH", VM: "1"},
+	"00720004": {Name: "Hanging Protocol Description", Keyword: "HangingProtocolDescription", VR: "LO", VM: "1"},
+	"00720006": {Name: "Hanging Protocol Level", Keyword: "HangingProtocolLevel", VR: "CS", VM: "1"},
+	"00720008": {Name: "Hanging Protocol Creator", Keyword: "HangingProtocolCreator", VR: "LO", VM: "1"},
+	"0072000A": {Name: "Hanging Protocol Creation Date Time", Keyword: "HangingProtocolCreationDateTime", VR: "DT", VM: "1"},
+	"0072000C": {Name: "Hanging Protocol Definition Sequence", Keyword: "HangingProtocolDefinitionSequence", VR: "SQ", VM: "1"},
+	"0072000E": {Name: "Hanging Protocol User ID Code Sequence", Keyword: "HangingProtocolUserIDCodeSequence", VR: "SQ", VM: "1"},
+	"00720010": {Name: "Hanging Protocol User Group Name", Keyword: "HangingProtocolUserGroupName", VR: "LO", VM: "1"},
+	"00720012": {Name: "Source Hanging Protocol Sequence", Keyword: "SourceHangingProtocolSequence", VR: "SQ", VM: "1"},
+	"00720014": {Name: "Number of Priors Referenced", Keyword: "NumberOfPriorsReferenced", VR: "US", VM: "1"},
+	"00720020": {Name: "Image Sets Sequence", Keyword: "ImageSetsSequence", VR: "SQ", VM: "1"},
+	"00720022": {Name: "Image Set Selector Sequence", Keyword: "ImageSetSelectorSequence", VR: "SQ", VM: "1"},
+	"00720024": {Name: "Image Set Selector Usage Flag", Keyword: "ImageSetSelectorUsageFlag", VR: "CS", VM: "1"},
+	"00720026": {Name: "Selector Attribute", Keyword: "SelectorAttribute", VR: "AT", VM: "1"},
+	"00720028": {Name: "Selector Value Number", Keyword: "SelectorValueNumber", VR: "US", VM: "1"},
+	"00720030": {Name: "Time Based Image Sets Sequence", Keyword: "TimeBasedImageSetsSequence", VR: "SQ", VM: "1"},
+	"00720032": {Name: "Image Set Number", Keyword: "ImageSetNumber", VR: "US", VM: "1"},
+	"00720034": {Name: "Image Set Selector Category", Keyword: "ImageSetSelectorCategory", VR: "CS", VM: "1"},
+	"00720038": {Name: "Relative Time", Keyword: "RelativeTime", VR: "US", VM: "2"},
+	"0072003A": {Name: "Relative Time Units", Keyword: "RelativeTimeUnits", VR: "CS", VM: "1"},
+	"0072003C": {Name: "Abstract Prior Value", Keyword: "AbstractPriorValue", VR: "SS", VM: "2"},
+	"0072003E": {Name: "Abstract Prior Code Sequence", Keyword: "AbstractPriorCodeSequence", VR: "SQ", VM: "1"},
+	"00720040": {Name: "Image Set Label", Keyword: "ImageSetLabel", VR: "LO", VM: "1"},
+	"00720050": {Name: "Selector Attribute VR", Keyword: "SelectorAttributeVR", VR: "CS", VM: "1"},
+	"00720052": {Name: "Selector Sequence Pointer", Keyword: "SelectorSequencePointer", VR: "AT", VM: "1-n"},
+	"00720054": {Name: "Selector Sequence Pointer Private Creator", Keyword: "SelectorSequencePointerPrivateCreator", VR: "LO", VM: "1-n"},
+	"00720056": {Name: "Selector Attribute Private Creator", Keyword: "SelectorAttributePrivateCreator", VR: "LO", VM: "1"},
+	"00720060": {Name: "Selector AT Value", Keyword: "SelectorATValue", VR: "AT", VM: "1-n"},
+	"00720062": {Name: "Selector CS Value", Keyword: "SelectorCSValue", VR: "CS", VM: "1-n"},
+	"00720064": {Name: "Selector IS Value", Keyword: "SelectorISValue", VR: "IS", VM: "1-n"},
+	"00720066": {Name: "Selector LO Value", Keyword: "SelectorLOValue", VR: "LO", VM: "1-n"},
+	"00720068": {Name: "Selector LT Value", Keyword: "SelectorLTValue", VR: "LT", VM: "1"},
+	"0072006A": {Name: "Selector PN Value", Keyword: "SelectorPNValue", VR: "PN", VM: "1-n"},
+	"0072006C": {Name: "Selector SH Value", Keyword: "SelectorSHValue", VR: "SH", VM: "1-n"},
+	"0072006E": {Name: "Selector ST Value", Keyword: "SelectorSTValue", VR: "ST", VM: "1"},
+	"00720070": {Name: "Selector UT Value", Keyword: "SelectorUTValue", VR: "UT", VM: "1"},
+	"00720072": {Name: "Selector DS Value", Keyword: "SelectorDSValue", VR: "DS", VM: "1-n"},
+	"00720074": {Name: "Selector FD Value", Keyword: "SelectorFDValue", VR: "FD", VM: "1-n"},
+	"00720076": {Name: "Selector FL Value", Keyword: "SelectorFLValue", VR: "FL", VM: "1-n"},
+	"00720078": {Name: "Selector UL Value", Keyword: "SelectorULValue", VR: "UL", VM: "1-n"},
+	"0072007A": {Name: "Selector US Value", Keyword: "SelectorUSValue", VR: "US", VM: "1-n"},
+	"0072007C": {Name: "Selector SL Value", Keyword: "SelectorSLValue", VR: "SL", VM: "1-n"},
+	"0072007E": {Name: "Selector SS Value", Keyword: "SelectorSSValue", VR: "SS", VM: "1-n"},
+	"00720080": {Name: "Selector Code Sequence Value", Keyword: "SelectorCodeSequenceValue", VR: "SQ", VM: "1"},
+	"00720100": {Name: "Number of Screens", Keyword: "NumberOfScreens", VR: "US", VM: "1"},
+	"00720102": {Name: "Nominal Screen Definition Sequence", Keyword: "NominalScreenDefinitionSequence", VR: "SQ", VM: "1"},
+	"00720104": {Name: "Number of Vertical Pixels", Keyword: "NumberOfVerticalPixels", VR: "US", VM: "1"},
+	"00720106": {Name: "Number of Horizontal Pixels", Keyword: "NumberOfHorizontalPixels", VR: "US", VM: "1"},
+	"00720108": {Name: "Display Environment Spatial Position", Keyword: "DisplayEnvironmentSpatialPosition", VR: "FD", VM: "4"},
+	"0072010A": {Name: "Screen Minimum Grayscale Bit Depth", Keyword: "ScreenMinimumGrayscaleBitDepth", VR: "US", VM: "1"},
+	"0072010C": {Name: "Screen Minimum Color Bit Depth", Keyword: "ScreenMinimumColorBitDepth", VR: "US", VM: "1"},
+	"0072010E": {Name: "Application Maximum Repaint Time", Keyword: "ApplicationMaximumRepaintTime", VR: "US", VM: "1"},
+	"00720200": {Name: "Display Sets Sequence", Keyword: "DisplaySetsSequence", VR: "SQ", VM: "1"},
+	"00720202": {Name: "Display Set Number", Keyword: "DisplaySetNumber", VR: "US", VM: "1"},
+	"00720203": {Name: "Display Set Label", Keyword: "DisplaySetLabel", VR: "LO", VM: "1"},
+	"00720204": {Name: "Display Set Presentation Group", Keyword: "DisplaySetPresentationGroup", VR: "US", VM: "1"},
+	"00720206": {Name: "Display Set Presentation Group Description", Keyword: "DisplaySetPresentationGroupDescription", VR: "LO", VM: "1"},
+	"00720208": {Name: "Partial Data Display Handling", Keyword: "PartialDataDisplayHandling", VR: "CS", VM: "1"},
+	"00720210": {Name: "Synchronized Scrolling Sequence", Keyword: "SynchronizedScrollingSequence", VR: "SQ", VM: "1"},
+	"00720212": {Name: "Display Set Scrolling Group", Keyword: "DisplaySetScrollingGroup", VR: "US", VM: "2-n"},
+	"00720214": {Name: "Navigation Indicator Sequence", Keyword: "NavigationIndicatorSequence", VR: "SQ", VM: "1"},
+	"00720216": {Name: "Navigation Display Set", Keyword: "NavigationDisplaySet", VR: "US", VM: "1"},
+	"00720218": {Name: "Reference Display Sets", Keyword: "ReferenceDisplaySets", VR: "US", VM: "1-n"},
+	"00720300": {Name: "Image Boxes Sequence", Keyword: "ImageBoxesSequence", VR: "SQ", VM: "1"},
+	"00720302": {Name: "Image Box Number", Keyword: "ImageBoxNumber", VR: "US", VM: "1"},
+	"00720304": {Name: "Image Box Layout Type", Keyword: "ImageBoxLayoutType", VR: "CS", VM: "1"},
+	"00720306": {Name: "Image Box Tile Horizontal Dimension", Keyword: "ImageBoxTileHorizontalDimension", VR: "US", VM: "1"},
+	"00720308": {Name: "Image Box Tile Vertical Dimension", Keyword: "ImageBoxTileVerticalDimension", VR: "US", VM: "1"},
+	"00720310": {Name: "Image Box Scroll Direction", Keyword: "ImageBoxScrollDirection", VR: "CS", VM: "1"},
+	"00720312": {Name: "Image Box Small Scroll Type", Keyword: "ImageBoxSmallScrollType", VR: "CS", VM: "1"},
+	"00720314": {Name: "Image Box Small Scroll Amount", Keyword: "ImageBoxSmallScrollAmount", VR: "US", VM: "1"},
+	"00720316": {Name: "Image Box Large Scroll Type", Keyword: "ImageBoxLargeScrollType", VR: "CS", VM: "1"},
+	"00720318": {Name: "Image Box Large Scroll Amount", Keyword: "ImageBoxLargeScrollAmount", VR: "US", VM: "1"},
+	"00720320": {Name: "Image Box Overlap Priority", Keyword: "ImageBoxOverlapPriority", VR: "US", VM: "1"},
+	"00720330": {Name: "Cine Relative to Real Time", Keyword: "CineRelativeToRealTime", VR: "FD", VM: "1"},
+	"00720400": {Name: "Filter Operations Sequence", Keyword: "FilterOperationsSequence", VR: "SQ", VM: "1"},
+	"00720402": {Name: "Filter by Category", Keyword: "FilterByCategory", VR: "CS", VM: "1"},
+	"00720404": {Name: "Filter by Attribute Presence", Keyword: "FilterByAttributePresence", VR: "CS", VM: "1"},
+	"00720406": {Name: "Filter by Operator", Keyword: "FilterByOperator", VR: "CS", VM: "1"},
+	"00720432": {Name: "Synchronized Image Box List", Keyword: "SynchronizedImageBoxList", VR: "US", VM: "2-n"},
+	"00720434": {Name: "Type of Synchronization", Keyword: "TypeOfSynchronization", VR: "CS", VM: "1"},
+	"00720500": {Name: "Blending Operation Type", Keyword: "BlendingOperationType", VR: "CS", VM: "1"},
+	"00720510": {Name: "Reformatting Operation Type", Keyword: "ReformattingOperationType", VR: "CS", VM: "1"},
+	"00720512": {Name: "Reformatting Thickness", Keyword: "ReformattingThickness", VR: "FD", VM: "1"},
+	"00720514": {Name: "Reformatting Interval", Keyword: "ReformattingInterval", VR: "FD", VM: "1"},
+	"00720516": {Name: "Reformatting Operation Initial View Direction", Keyword: "ReformattingOperationInitialViewDirection", VR: "CS", VM: "1"},
+	"00720520": {Name: "Three D Rendering Type", Keyword: "ThreeDRenderingType", VR: "CS", VM: "1-n"},
+	"00720600": {Name: "Sorting Operations Sequence", Keyword: "SortingOperationsSequence", VR: "SQ", VM: "1"},
+	"00720602": {Name: "Sort by Category", Keyword: "SortByCategory", VR: "CS", VM: "1"},
+	"00720604": {Name: "Sorting Direction", Keyword: "SortingDirection", VR: "CS", VM: "1"},
+	"00720700": {Name: "Display Set Patient Orientation", Keyword: "DisplaySetPatientOrientation", VR: "CS", VM: "2"},
+	"00720702": {Name: "VOI Type", Keyword: "VOIType", VR: "CS", VM: "1"},
+	"00720704": {Name: "Pseudo Color Type", Keyword: "PseudoColorType", VR: "CS", VM: "1"},
+	"00720706": {Name: "Show Grayscale Inverted", Keyword: "ShowGrayscaleInverted", VR: "CS", VM: "1"},
+	"00720710": {Name: "Show Image True Size Flag", Keyword: "ShowImageTrueSizeFlag", VR: "CS", VM: "1"},
+	"00720712": {Name: "Show Graphic Annotation Flag", Keyword: "ShowGraphicAnnotationFlag", VR: "CS", VM: "1"},
+	"00720714": {Name: "Show Patient Demographics Flag", Keyword: "ShowPatientDemographicsFlag", VR: "CS", VM: "1"},
+	"00720716": {Name: "Show Acquisition Techniques Flag", Keyword: "ShowAcquisitionTechniquesFlag", VR: "CS", VM: "1"},
+	"00720717": {Name: "Display Set Horizontal Justification", Keyword: "DisplaySetHorizontalJustification", VR: "CS", VM: "1"},
+	"00720718": {Name: "Display Set Vertical Justification", Keyword: "DisplaySetVerticalJustification", VR: "CS", VM: "1"},
+	"00741000": {Name: "Procedure Step State", Keyword: "ProcedureStepState", VR: "CS", VM: "1"},
+	"00741002": {Name: "Procedure Step Progress Information Sequence", Keyword: "ProcedureStepProgressInformationSequence", VR: "SQ", VM: "1"},
+	"00741004": {Name: "Procedure Step Progress", Keyword: "ProcedureStepProgress", VR: "DS", VM: "1"},
+	"00741006": {Name: "Procedure Step Progress Description", Keyword: "ProcedureStepProgressDescription", VR: "ST", VM: "1"},
+	"00741008": {Name: "Procedure Step Communications URI Sequence", Keyword: "ProcedureStepCommunicationsURISequence", VR: "SQ", VM: "1"},
+	"0074100A": {Name: "Contact URI", Keyword: "ContactURI", VR: "UR", VM: "1"},
+	"0074100C": {Name: "Contact Display Name", Keyword: "ContactDisplayName", VR: "LO", VM: "1-n"},
+	"00741020": {Name: "Beam Task Sequence", Keyword: "BeamTaskSequence", VR: "SQ", VM: "1"},
+	"00741022": {Name: "Beam Task Type", Keyword: "BeamTaskType", VR: "CS", VM: "1"},
+	"00741024": {Name: "Beam Order Index", Keyword: "BeamOrderIndex", VR: "IS", VM: "1"},
+	"00741030": {Name: "Delivery Verification Image Sequence", Keyword: "DeliveryVerificationImageSequence", VR: "SQ", VM: "1"},
+	"00741032": {Name: "Verification Image Timing", Keyword: "VerificationImageTiming", VR: "CS", VM: "1"},
+	"00741034": {Name: "Double Exposure Flag", Keyword: "DoubleExposureFlag", VR: "CS", VM: "1"},
+	"00741036": {Name: "Double Exposure Ordering", Keyword: "DoubleExposureOrdering", VR: "CS", VM: "1"},
+	"00741038": {Name: "Double Exposure Meterset", Keyword: "DoubleExposureMeterset", VR: "DS", VM: "1"},
+	"0074103A": {Name: "Double Exposure Field Delta", Keyword: "DoubleExposureFieldDelta", VR: "DS", VM: "4"},
+	"00741040": {Name: "Related Reference RT Image Sequence", Keyword: "RelatedReferenceRTImageSequence", VR: "SQ", VM: "1"},
+	"00741042": {Name: "General Machine Verification Sequence", Keyword: "GeneralMachineVerificationSequence", VR: "SQ", VM: "1"},
+	"00741044": {Name: "Conventional Machine Verification Sequence", Keyword: "ConventionalMachineVerificationSequence", VR: "SQ", VM: "1"},
+	"00741046": {Name: "Ion Machine Verification Sequence", Keyword: "IonMachineVerificationSequence", VR: "SQ", VM: "1"},
+	"00741048": {Name: "Failed Attributes Sequence", Keyword: "FailedAttributesSequence", VR: "SQ", VM: "1"},
+	"0074104A": {Name: "Overridden Attributes Sequence", Keyword: "OverriddenAttributesSequence", VR: "SQ", VM: "1"},
+	"0074104C": {Name: "Conventional Control Point Verification Sequence", Keyword: "ConventionalControlPointVerificationSequence", VR: "SQ", VM: "1"},
+	"0074104E": {Name: "Ion Control Point Verification Sequence", Keyword: "IonControlPointVerificationSequence", VR: "SQ", VM: "1"},
+	"00741050": {Name: "Attribute Occurrence Sequence", Keyword: "AttributeOccurrenceSequence", VR: "SQ", VM: "1"},
+	"00741052": {Name: "Attribute Occurrence Pointer", Keyword: "AttributeOccurrencePointer", VR: "AT", VM: "1"},
+	"00741054": {Name: "Attribute Item Selector", Keyword: "AttributeItemSelector", VR: "UL", VM: "1"},
+	"00741056": {Name: "Attribute Occurrence Private Creator", Keyword: "AttributeOccurrencePrivateCreator", VR: "LO", VM: "1"},
+	"00741200": {Name: "Scheduled Procedure Step Priority", Keyword: "ScheduledProcedureStepPriority", VR: "CS", VM: "1"},
+	"00741202": {Name: "Worklist Label", Keyword: "WorklistLabel", VR: "LO", VM: "1"},
+	"00741204": {Name: "Procedure Step Label", Keyword: "ProcedureStepLabel", VR: "LO", VM: "1"},
+	"00741210": {Name: "Scheduled Processing Parameters Sequence", Keyword: "ScheduledProcessingParametersSequence", VR: "SQ", VM: "1"},
+	"00741212": {Name: "Performed Processing Parameters Sequence", Keyword: "PerformedProcessingParametersSequence", VR: "SQ", VM: "1"},
+	"00741216": {Name: "Unified Procedure Step Performed Procedure Sequence", Keyword: "UnifiedProcedureStepPerformedProcedureSequence", VR: "SQ", VM: "1"},
+	"00741220": {Name: "Related Procedure Step Sequence", Keyword: "RelatedProcedureStepSequence", VR: "SQ", VM: "1", Retired: true},
+	"00741222": {Name: "Procedure Step Relationship Type", Keyword: "ProcedureStepRelationshipType", VR: "LO", VM: "1", Retired: true},
+	"00741230": {Name: "Deletion Lock", Keyword: "DeletionLock", VR: "LO", VM: "1"},
+	"00741234": {Name: "Receiving AE", Keyword: "ReceivingAE", VR: "AE", VM: "1"},
+	"00741236": {Name: "Requesting AE", Keyword: "RequestingAE", VR: "AE", VM: "1"},
+	"00741238": {Name: "Reason for Cancellation", Keyword: "ReasonForCancellation", VR: "LT", VM: "1"},
+	"00741242": {Name: "SCP Status", Keyword: "SCPStatus", VR: "CS", VM: "1"},
+	"00741244": {Name: "Subscription List Status", Keyword: "SubscriptionListStatus", VR: "CS", VM: "1"},
+	"00741246": {Name: "UPS List Status", Keyword: "UPSListStatus", VR: "CS", VM: "1"},
+	"00880130": {Name: "Storage Media File Set ID", Keyword: "StorageMediaFileSetID", VR: "SH", VM: "1"},
+	"00880140": {Name: "Storage Media File Set UID", Keyword: "StorageMediaFileSetUID", VR: "UI", VM: "1"},
+	"00880200": {Name: "Icon Image Sequence", Keyword: "IconImageSequence", VR: "SQ", VM: "1"},
+	"00880904": {Name: "Topic Title", Keyword: "TopicTitle", VR: "LO", VM: "1", Retired: true},
+	"00880906": {Name: "Topic Subject", Keyword: "TopicSubject", VR: "ST", VM: "1", Retired: true},
+	"00880910": {Name: "Topic Author", Keyword: "TopicAuthor", VR: "LO", VM: "1", Retired: true},
+	"00880912": {Name: "Topic Keywords", Keyword: "TopicKeywords", VR: "LO", VM: "1-32", Retired: true},
+	"01000410": {Name: "SOP Instance Status", Keyword: "SOPInstanceStatus", VR: "CS", VM: "1", Retired: true},
+	"01000420": {Name: "SOP Authorization Date and Time", Keyword: "SOPAuthorizationDateAndTime", VR: "DT", VM: "1", Retired: true},
+	"01000424": {Name: "SOP Authorization Comment", Keyword: "SOPAuthorizationComment", VR: "LT", VM: "1", Retired: true},
+	"01000426": {Name: "Authorization Equipment Certification Number", Keyword: "AuthorizationEquipmentCertificationNumber", VR: "LO", VM: "1", Retired: true},
+	"04000005": {Name: "MACID Number", Keyword: "MACIDNumber", VR: "US", VM: "1"},
+	"04000010": {Name: "MAC Calculation Transfer Syntax UID", Keyword: "MACCalculationTransferSyntaxUID", VR: "UI", VM: "1"},
+	"04000015": {Name: "MAC Algorithm", Keyword: "MACAlgorithm", VR: "CS", VM: "1"},
+	"04000020": {Name: "Data Elements Signed", Keyword: "DataElementsSigned", VR: "AT", VM: "1-n"},
+	"04000100": {Name: "Digital Signature UID", Keyword: "DigitalSignatureUID", VR: "UI", VM: "1"},
+	"04000105": {Name: "Digital Signature Date Time", Keyword: "DigitalSignatureDateTime", VR: "DT", VM: "1"},
+	"04000110": {Name: "Certificate Type", Keyword: "CertificateType", VR: "CS", VM: "1"},
+	"04000115": {Name: "Certificate of Signer", Keyword: "CertificateOfSigner", VR: "OB", VM: "1"},
+	"04000120": {Name: "Signature", Keyword: "Signature", VR: "OB", VM: "1"},
+	"04000305": {Name: "Certified Timestamp Type", Keyword: "CertifiedTimestampType", VR: "CS", VM: "1"},
+	"04000310": {Name: "Certified Timestamp", Keyword: "CertifiedTimestamp", VR: "OB", VM: "1"},
+	"04000401": {Name: "Digital Signature Purpose Code Sequence", Keyword: "DigitalSignaturePurposeCodeSequence", VR: "SQ", VM: "1"},
+	"04000402": {Name: "Referenced Digital Signature Sequence", Keyword: "ReferencedDigitalSignatureSequence", VR: "SQ", VM: "1"},
+	"04000403": {Name: "Referenced SOP Instance MAC Sequence", Keyword: "ReferencedSOPInstanceMACSequence", VR: "SQ", VM: "1"},
+	"04000404": {Name: "MAC", Keyword: "MAC", VR: "OB", VM: "1"},
+	"04000500": {Name: "Encrypted Attributes Sequence", Keyword: "EncryptedAttributesSequence", VR: "SQ", VM: "1"},
+	"04000510": {Name: "Encrypted Content Transfer Syntax UID", Keyword: "EncryptedContentTransferSyntaxUID", VR: "UI", VM: "1"},
+	"04000520": {Name: "Encrypted Content", Keyword: "EncryptedContent", VR: "OB", VM: "1"},
+	"04000550": {Name: "Modified Attributes Sequence", Keyword: "ModifiedAttributesSequence", VR: "SQ", VM: "1"},
+	"04000561": {Name: "Original Attributes Sequence", Keyword: "OriginalAttributesSequence", VR: "SQ", VM: "1"},
+	"04000562": {Name: "Attribute Modification Date Time", Keyword: "AttributeModificationDateTime", VR: "DT", VM: "1"},
+	"04000563": {Name: "Modifying System", Keyword: "ModifyingSystem", VR: "LO", VM: "1"},
+	"04000564": {Name: "Source of Previous Values", Keyword: "SourceOfPreviousValues", VR: "LO", VM: "1"},
+	"04000565": {Name: "Reason for the Attribute Modification", Keyword: "ReasonForTheAttributeModification", VR: "CS", VM: "1"},
+	"20000010": {Name: "Number of Copies", Keyword: "NumberOfCopies", VR: "IS", VM: "1"},
+	"2000001E": {Name: "Printer Configuration Sequence", Keyword: "PrinterConfigurationSequence", VR: "SQ", VM: "1"},
+	"20000020": {Name: "Print Priority", Keyword: "PrintPriority", VR: "CS", VM: "1"},
+	"20000030": {Name: "Medium Type", Keyword: "MediumType", VR: "CS", VM: "1"},
+	"20000040": {Name: "Film Destination", Keyword: "FilmDestination", VR: "CS", VM: "1"},
+	"20000050": {Name: "Film Session Label", Keyword: "FilmSessionLabel", VR: "LO", VM: "1"},
+	"20000060": {Name: "Memory Allocation", Keyword: "MemoryAllocation", VR: "IS", VM: "1"},
+	"20000061": {Name: "Maximum Memory Allocation", Keyword: "MaximumMemoryAllocation", VR: "IS", VM: "1"},
+	"20000062": {Name: "Color Image Printing Flag", Keyword: "ColorImagePrintingFlag", VR: "CS", VM: "1", Retired: true},
+	"20000063": {Name: "Collation Flag", Keyword: "CollationFlag", VR: "CS", VM: "1", Retired: true},
+	"20000065": {Name: "Annotation Flag", Keyword: "AnnotationFlag", VR: "CS", VM: "1", Retired: true},
+	"20000067": {Name: "Image Overlay Flag", Keyword: "ImageOverlayFlag", VR: "CS", VM: "1", Retired: true},
+	"20000069": {Name: "Presentation LUT Flag", Keyword: "PresentationLUTFlag", VR: "CS", VM: "1", Retired: true},
+	"2000006A": {Name: "Image Box Presentation LUT Flag", Keyword: "ImageBoxPresentationLUTFlag", VR: "CS", VM: "1", Retired: true},
+	"200000A0": {Name: "Memory Bit Depth", Keyword: "MemoryBitDepth", VR: "US", VM: "1", Retired: true},
+	"200000A1": {Name: "Printing Bit Depth", Keyword: "PrintingBitDepth", VR: "US", VM: "1", Retired: true},
+	"200000A2": {Name: "Media Installed Sequence", Keyword: "MediaInstalledSequence", VR: "SQ", VM: "1"},
+	"200000A4": {Name: "Other Media Available Sequence", Keyword: "OtherMediaAvailableSequence", VR: "SQ", VM: "1"},
+	"200000A8": {Name: "Supported Image Display Formats Sequence", Keyword: "SupportedImageDisplayFormatsSequence", VR: "SQ", VM: "1"},
+	"20000500": {Name: "Referenced Film Box Sequence", Keyword: "ReferencedFilmBoxSequence", VR: "SQ", VM: "1"},
+	"20000510": {Name: "Referenced Stored Print Sequence", Keyword: "ReferencedStoredPrintSequence", VR: "SQ", VM: "1", Retired: true},
+	"20100010": {Name: "Image Display Format", Keyword: "ImageDisplayFormat", VR: "ST", VM: "1"},
+	"20100030": {Name: "Annotation Display Format ID", Keyword: "AnnotationDisplayFormatID", VR: "CS", VM: "1"},
+	"20100040": {Name: "Film Orientation", Keyword: "FilmOrientation", VR: "CS", VM: "1"},
+	"20100050": {Name: "Film Size ID", Keyword: "FilmSizeID", VR: "CS", VM: "1"},
+	"20100052": {Name: "Printer Resolution ID", Keyword: "PrinterResolutionID", VR: "CS", VM: "1"},
+	"20100054": {Name: "Default Printer Resolution ID", Keyword: "DefaultPrinterResolutionID", VR: "CS", VM: "1"},
+	"20100060": {Name: "Magnification Type", Keyword: "MagnificationType", VR: "CS", VM: "1"},
+	"20100080": {Name: "Smoothing Type", Keyword: "SmoothingType", VR: "CS", VM: "1"},
+	"201000A6": {Name: "Default Magnification Type", Keyword: "DefaultMagnificationType", VR: "CS", VM: "1"},
+	"201000A7": {Name: "Other Magnification Types Available", Keyword: "OtherMagnificationTypesAvailable", VR: "CS", VM: "1-n"},
+	"201000A8": {Name: "Default Smoothing Type", Keyword: "DefaultSmoothingType", VR: "CS", VM: "1"},
+	"201000A9": {Name: "Other Smoothing Types Available", Keyword: "OtherSmoothingTypesAvailable", VR: "CS", VM: "1-n"},
+	"20100100": {Name: "Border Density", Keyword: "BorderDensity", VR: "CS", VM: "1"},
+	"20100110": {Name: "Empty Image Density", Keyword: "EmptyImageDensity", VR: "CS", VM: "1"},
+	"20100120": {Name: "Min Density", Keyword: "MinDensity", VR: "US", VM: "1"},
+	"20100130": {Name: "Max Density", Keyword: "MaxDensity", VR: "US", VM: "1"},
+	"20100140": {Name: "Trim", Keyword: "Trim", VR: "CS", VM: "1"},
+	"20100150": {Name: "Configuration Information", Keyword: "ConfigurationInformation", VR: "ST", VM: "1"},
+	"20100152": {Name: "Configuration Information Description", Keyword: "ConfigurationInformationDescription", VR: "LT", VM: "1"},
+	"20100154": {Name: "Maximum Collated Films", Keyword: "MaximumCollatedFilms", VR: "IS", VM: "1"},
+	"2010015E": {Name: "Illumination", Keyword: "Illumination", VR: "US", VM: "1"},
+	"20100160": {Name: "Reflected Ambient Light", Keyword: "ReflectedAmbientLight", VR: "US", VM: "1"},
+	"20100376": {Name: "Printer Pixel Spacing", Keyword: "PrinterPixelSpacing", VR: "DS", VM: "2"},
+	"20100500": {Name: "Referenced Film Session Sequence", Keyword: "ReferencedFilmSessionSequence", VR: "SQ", VM: "1"},
+	"20100510": {Name: "Referenced Image Box Sequence", Keyword: "ReferencedImageBoxSequence", VR: "SQ", VM: "1"},
+	"20100520": {Name: "Referenced Basic Annotation Box Sequence", Keyword: "ReferencedBasicAnnotationBoxSequence", VR: "SQ", VM: "1"},
+	"20200010": {Name: "Image Box Position", Keyword: "ImageBoxPosition", VR: "US", VM: "1"},
+	"20200020": {Name: "Polarity", Keyword: "Polarity", VR: "CS", VM: "1"},
+	"20200030": {Name: "Requested Image Size", Keyword: "RequestedImageSize", VR: "DS", VM: "1"},
+	"20200040": {Name: "Requested Decimate Crop Behavior", Keyword: "RequestedDecimateCropBehavior", VR: "CS", VM: "1"},
+	"20200050": {Name: "Requested Resolution ID", Keyword: "RequestedResolutionID", VR: "CS", VM: "1"},
+	"202000A0": {Name: "Requested Image Size Flag", Keyword: "RequestedImageSizeFlag", VR: "CS", VM: "1"},
+	"202000A2": {Name: "Decimate Crop Result", Keyword: "DecimateCropResult", VR: "CS", VM: "1"},
+	"20200110": {Name: "Basic Grayscale Image Sequence", Keyword: "BasicGrayscaleImageSequence", VR: "SQ", VM: "1"},
+	"20200111": {Name: "Basic Color Image Sequence", Keyword: "BasicColorImageSequence", VR: "SQ", VM: "1"},
+	"20200130": {Name: "Referenced Image Overlay Box Sequence", Keyword: "ReferencedImageOverlayBoxSequence", VR: "SQ", VM: "1", Retired: true},
+	"20200140": {Name: "Referenced VOILUT Box Sequence", Keyword: "ReferencedVOILUTBoxSequence", VR: "SQ", VM: "1", Retired: true},
+	"20300010": {Name: "Annotation Position", Keyword: "AnnotationPosition", VR: "US", VM: "1"},
+	"20300020": {Name: "Text String", Keyword: "TextString", VR: "LO", VM: "1"},
+	"20400010": {Name: "Referenced Overlay Plane Sequence", Keyword: "ReferencedOverlayPlaneSequence", VR: "SQ", VM: "1", Retired: true},
+	"20400011": {Name: "Referenced Overlay Plane Groups", Keyword: "ReferencedOverlayPlaneGroups", VR: "US", VM: "1-99", Retired: true},
+	"20400020": {Name: "Overlay Pixel Data Sequence", Keyword: "OverlayPixelDataSequence", VR: "SQ", VM: "1", Retired: true},
+	"20400060": {Name: "Overlay Magnification Type", Keyword: "OverlayMagnificationType", VR: "CS", VM: "1", Retired: true},
+	"20400070": {Name: "Overlay Smoothing Type", Keyword: "OverlaySmoothingType", VR: "CS", VM: "1", Retired: true},
+	"20400072": {Name: "Overlay or Image Magnification", Keyword: "OverlayOrImageMagnification", VR: "CS", VM: "1", Retired: true},
+	"20400074": {Name: "Magnify to Number of Columns", Keyword: "MagnifyToNumberOfColumns", VR: "US", VM: "1", Retired: true},
+	"20400080": {Name: "Overlay Foreground Density", Keyword: "OverlayForegroundDensity", VR: "CS", VM: "1", Retired: true},
+	"20400082": {Name: "Overlay Background Density", Keyword: "OverlayBackgroundDensity", VR: "CS", VM: "1", Retired: true},
+	"20400090": {Name: "Overlay Mode", Keyword: "OverlayMode", VR: "CS", VM: "1", Retired: true},
+	"20400100": {Name: "Threshold Density", Keyword: "ThresholdDensity", VR: "CS", VM: "1", Retired: true},
+	"20400500": {Name: "Referenced Image Box Sequence (Retired)", Keyword: "ReferencedImageBoxSequenceRetired", VR: "SQ", VM: "1", Retired: true},
+	"20500010": {Name: "Presentation LUT Sequence", Keyword: "PresentationLUTSequence", VR: "SQ", VM: "1"},
+	"20500020": {Name: "Presentation LUT Shape", Keyword: "PresentationLUTShape", VR: "CS", VM: "1"},
+	"20500500": {Name: "Referenced Presentation LUT Sequence", Keyword: "ReferencedPresentationLUTSequence", VR: "SQ", VM: "1"},
+	"21000010": {Name: "Print Job ID", Keyword: "PrintJobID", VR: "SH", VM: "1", Retired: true},
+	"21000020": {Name: "Execution Status", Keyword: "ExecutionStatus", VR: "CS", VM: "1"},
+	"21000030": {Name: "Execution Status Info", Keyword: "ExecutionStatusInfo", VR: "CS", VM: "1"},
+	"21000040": {Name: "Creation Date", Keyword: "CreationDate", VR: "DA", VM: "1"},
+	"21000050": {Name: "Creation Time", Keyword: "CreationTime", VR: "TM", VM: "1"},
+	"21000070": {Name: "Originator", Keyword: "Originator", VR: "AE", VM: "1"},
+	"21000140": {Name: "Destination AE", Keyword: "DestinationAE", VR: "AE", VM: "1", Retired: true},
+	"21000160": {Name: "Owner ID", Keyword: "OwnerID", VR: "SH", VM: "1"},
+	"21000170": {Name: "Number of Films", Keyword: "NumberOfFilms", VR: "IS", VM: "1"},
+	"21000500": {Name: "Referenced Print Job Sequence Pull Stored Print", Keyword: "ReferencedPrintJobSequencePullStoredPrint", VR: "SQ", VM: "1", Retired: true},
+	"21100010": {Name: "Printer Status", Keyword: "PrinterStatus", VR: "CS", VM: "1"},
+	"21100020": {Name: "Printer Status Info", Keyword: "PrinterStatusInfo", VR: "CS", VM: "1"},
+	"21100030": {Name: "Printer Name", Keyword: "PrinterName", VR: "LO", VM: "1"},
+	"21100099": {Name: "Print Queue ID", Keyword: "PrintQueueID", VR: "SH", VM: "1", Retired: true},
+	"21200010": {Name: "Queue Status", Keyword: "QueueStatus", VR: "CS", VM: "1", Retired: true},
+	"21200050": {Name: "Print Job Description Sequence", Keyword: "PrintJobDescriptionSequence", VR: "SQ", VM: "1", Retired: true},
+	"21200070": {Name: "Referenced Print Job Sequence", Keyword: "ReferencedPrintJobSequence", VR: "SQ", VM: "1", Retired: true},
+	"21300010": {Name: "Print Management Capabilities Sequence", Keyword: "PrintManagementCapabilitiesSequence", VR: "SQ", VM: "1", Retired: true},
+	"21300015": {Name: "Printer Characteristics Sequence", Keyword: "PrinterCharacteristicsSequence", VR: "SQ", VM: "1", Retired: true},
+	"21300030": {Name: "Film Box Content Sequence", Keyword: "FilmBoxContentSequence", VR: "SQ", VM: "1", Retired: true},
+	"21300040": {Name: "Image Box Content Sequence", Keyword: "ImageBoxContentSequence", VR: "SQ", VM: "1", Retired: true},
+	"21300050": {Name: "Annotation Content Sequence", Keyword: "AnnotationContentSequence", VR: "SQ", VM: "1", Retired: true},
+	"21300060": {Name: "Image Overlay Box Content Sequence", Keyword: "ImageOverlayBoxContentSequence", VR: "SQ", VM: "1", Retired: true},
+	"21300080": {Name: "Presentation LUT Content Sequence", Keyword: "PresentationLUTContentSequence", VR: "SQ", VM: "1", Retired: true},
+	"213000A0": {Name: "Proposed Study Sequence", Keyword: "ProposedStudySequence", VR: "SQ", VM: "1", Retired: true},
+	"213000C0": {Name: "Original Image Sequence", Keyword: "OriginalImageSequence", VR: "SQ", VM: "1", Retired: true},
+	"22000001": {Name: "Label Using Information Extracted from Instances", Keyword: "LabelUsingInformationExtractedFromInstances", VR: "CS", VM: "1"},
+	"22000002": {Name: "Label Text", Keyword: "LabelText", VR: "UT", VM: "1"},
+	"22000003": {Name: "Label Style Selection", Keyword: "LabelStyleSelection", VR: "CS", VM: "1"},
+	"22000004": {Name: "Media Disposition", Keyword: "MediaDisposition", VR: "LT", VM: "1"},
+	"22000005": {Name: "Barcode Value", Keyword: "BarcodeValue", VR: "LT", VM: "1"},
+	"22000006": {Name: "Barcode Symbology", Keyword: "BarcodeSymbology", VR: "CS", VM: "1"},
+	"22000007": {Name: "Allow Media Splitting", Keyword: "AllowMediaSplitting", VR: "CS", VM: "1"},
+	"22000008": {Name: "Include Non DICOM Objects", Keyword: "IncludeNonDICOMObjects", VR: "CS", VM: "1"},
+	"22000009": {Name: "Include Display Application", Keyword: "IncludeDisplayApplication", VR: "CS", VM: "1"},
+	"2200000A": {Name: "Preserve Composite Instances After Media Creation", Keyword: "PreserveCompositeInstancesAfterMediaCreation", VR: "CS", VM: "1"},
+	"2200000B": {Name: "Total Number of Pieces of Media Created", Keyword: "TotalNumberOfPiecesOfMediaCreated", VR: "US", VM: "1"},
+	"2200000C": {Name: "Requested Media Application Profile", Keyword: "RequestedMediaApplicationProfile", VR: "LO", VM: "1"},
+	"2200000D": {Name: "Referenced Storage Media Sequence", Keyword: "ReferencedStorageMediaSequence", VR: "SQ", VM: "1"},
+	"2200000E": {Name: "Failure Attributes", Keyword: "FailureAttributes", VR: "LO", VM: "1-n"},
+	"2200000F": {Name: "Allow Lossy Compression", Keyword: "AllowLossyCompression", VR: "CS", VM: "1"},
+	"22000020": {Name: "Request Priority", Keyword: "RequestPriority", VR: "CS", VM: "1"},
+	"30020002": {Name: "RT Image Label", Keyword: "RTImageLabel", VR: "SH", VM: "1"},
+	"30020003": {Name: "RT Image Name", Keyword: "RTImageName", VR: "LO", VM: "1"},
+	"30020004": {Name: "RT Image Description", Keyword: "RTImageDescription", VR: "ST", VM: "1"},
+	"3002000A": {Name: "Reported Values Origin", Keyword: "ReportedValuesOrigin", VR: "CS", VM: "1"},
+	"3002000C": {Name: "RT Image Plane", Keyword: "RTImagePlane", VR: "CS", VM: "1"},
+	"3002000D": {Name: "X-Ray Image Receptor Translation", Keyword: "XRayImageReceptorTranslation", VR: "DS", VM: "3"},
+	"3002000E": {Name: "X-Ray Image Receptor Angle", Keyword: "XRayImageReceptorAngle", VR: "DS", VM: "1"},
+	"30020010": {Name: "RT Image Orientation", Keyword: "RTImageOrientation", VR: "DS", VM: "6"},
+	"30020011": {Name: "Image Plane Pixel Spacing", Keyword: "ImagePlanePixelSpacing", VR: "DS", VM: "2"},
+	"30020012": {Name: "RT Image Position", Keyword: "RTImagePosition", VR: "DS", VM: "2"},
+	"30020020": {Name: "Radiation Machine Name", Keyword: "RadiationMachineName", VR: "SH", VM: "1"},
+	"30020022": {Name: "Radiation Machine SAD", Keyword: "RadiationMachineSAD", VR: "DS", VM: "1"},
+	"30020024": {Name: "Radiation Machine SSD", Keyword: "RadiationMachineSSD", VR: "DS", VM: "1"},
+	"30020026": {Name: "RT Image SID", Keyword: "RTImageSID", VR: "DS", VM: "1"},
+	"30020028": {Name: "Source to Reference Object Distance", Keyword: "SourceToReferenceObjectDistance", VR: "DS", VM: "1"},
+	"30020029": {Name: "Fraction Number", Keyword: "FractionNumber", VR: "IS", VM: "1"},
+	"30020030": {Name: "Exposure Sequence", Keyword: "ExposureSequence", VR: "SQ", VM: "1"},
+	"30020032": {Name: "Meterset Exposure", Keyword: "MetersetExposure", VR: "DS", VM: "1"},
+	"30020034": {Name: "Diaphragm Position", Keyword: "DiaphragmPosition", VR: "DS", VM: "4"},
+	"30020040": {Name: "Fluence Map Sequence", Keyword: "FluenceMapSequence", VR: "SQ", VM: "1"},
+	"30020041": {Name: "Fluence Data Source", Keyword: "FluenceDataSource", VR: "CS", VM: "1"},
+	"30020042": {Name: "Fluence Data Scale", Keyword: "FluenceDataScale", VR: "DS", VM: "1"},
+	"30020051": {Name: "Fluence Mode", Keyword: "FluenceMode", VR: "CS", VM: "1"},
+	"30020052": {Name: "Fluence Mode ID", Keyword: "FluenceModeID", VR: "SH", VM: "1"},
+	"30040001": {Name: "DVH Type", Keyword: "DVHType", VR: "CS", VM: "1"},
+	"30040002": {Name: "Dose Units", Keyword: "DoseUnits", VR: "CS", VM: "1"},
+	"30040004": {Name: "Dose Type", Keyword: "DoseType", VR: "CS", VM: "1"},
+	"30040006": {Name: "Dose Comment", Keyword: "DoseComment", VR: "LO", VM: "1"},
+	"30040008": {Name: "Normalization Point", Keyword: "NormalizationPoint", VR: "DS", VM: "3", Retired: true},
+	"3004000A": {Name: "Dose Summation Type", Keyword: "DoseSummationType", VR: "CS", VM: "1"},
+	"3004000C": {Name: "Grid Frame Offset Vector", Keyword: "GridFrameOffsetVector", VR: "DS", VM: "2-n"},
+	"3004000E": {Name: "Dose Grid Scaling", Keyword: "DoseGridScaling", VR: "DS", VM: "1"},
+	"30040010": {Name: "RT Dose ROI Sequence", Keyword: "RTDoseROISequence", VR: "SQ", VM: "1", Retired: true},
+	"30040012": {Name: "Dose Value", Keyword: "DoseValue", VR: "DS", VM: "1", Retired: true},
+	"30040014": {Name: "Tissue Heterogeneity Correction", Keyword: "TissueHeterogeneityCorrection", VR: "CS", VM: "1-3"},
+	"30040040": {Name: "DVH Normalization Point", Keyword: "DVHNormalizationPoint", VR: "DS", VM: "3", Retired: true},
+	"30040042": {Name: "DVH Normalization Dose Value", Keyword: "DVHNormalizationDoseValue", VR: "DS", VM: "1"},
+	"30040050": {Name: "DVH Sequence", Keyword: "DVHSequence", VR: "SQ", VM: "1"},
+	"30040052": {Name: "DVH Dose Scaling", Keyword: "DVHDoseScaling", VR: "DS", VM: "1"},
+	"30040054": {Name: "DVH Volume Units", Keyword: "DVHVolumeUnits", VR: "CS", VM: "1"},
+	"30040056": {Name: "DVH Number of Bins", Keyword: "DVHNumberOfBins", VR: "IS", VM: "1"},
+	"30040058": {Name: "DVH Data", Keyword: "DVHData", VR: "DS", VM: "2-2n"},
+	"30040060": {Name: "DVH Referenced ROI Sequence", Keyword: "DVHReferencedROISequence", VR: "SQ", VM: "1"},
+	"30040062": {Name: "DVHROI Contribution Type", Keyword: "DVHROIContributionType", VR: "CS", VM: "1"},
+	"30040070": {Name: "DVH Minimum Dose", Keyword: "DVHMinimumDose", VR: "DS", VM: "1"},
+	"30040072": {Name: "DVH Maximum Dose", Keyword: "DVHMaximumDose", VR: "DS", VM: "1"},
+	"30040074": {Name: "DVH Mean Dose", Keyword: "DVHMeanDose", VR: "DS", VM: "1"},
+	"30060002": {Name: "Structure Set Label", Keyword: "StructureSetLabel", VR: "SH", VM: "1"},
+	"30060004": {Name: "Structure Set Name", Keyword: "StructureSetName", VR: "LO", VM: "1"},
+	"30060006": {Name: "Structure Set Description", Keyword: "StructureSetDescription", VR: "ST", VM: "1"},
+	"30060008": {Name: "Structure Set Date", Keyword: "StructureSetDate", VR: "DA", VM: "1"},
+	"30060009": {Name: "Structure Set Time", Keyword: "StructureSetTime", VR: "TM", VM: "1"},
+	"30060010": {Name: "Referenced Frame of Reference Sequence", Keyword: "ReferencedFrameOfReferenceSequence", VR: "SQ", VM: "1"},
+	"30060012": {Name: "RT Referenced Study Sequence", Keyword: "RTReferencedStudySequence", VR: "SQ", VM: "1", Retired: true},
+	"30060014": {Name: "RT Referenced Series Sequence", Keyword: "RTReferencedSeriesSequence", VR: "SQ", VM: "1", Retired: true},
+	"30060016": {Name: "Contour Image Sequence", Keyword: "ContourImageSequence", VR: "SQ", VM: "1"},
+	"30060020": {Name: "Structure Set ROI Sequence", Keyword: "StructureSetROISequence", VR: "SQ", VM: "1"},
+	"30060022": {Name: "ROI Number", Keyword: "ROINumber", VR: "IS", VM: "1"},
+	"30060024": {Name: "Referenced Frame of Reference UID", Keyword: "ReferencedFrameOfReferenceUID", VR: "UI", VM: "1"},
+	"30060026": {Name: "ROI Name", Keyword: "ROIName", VR: "LO", VM: "1"},
+	"30060028": {Name: "ROI Description", Keyword: "ROIDescription", VR: "ST", VM: "1"},
+	"3006002A": {Name: "ROI Display Color", Keyword: "ROIDisplayColor", VR: "IS", VM: "3"},
+	"3006002C": {Name: "ROI Volume", Keyword: "ROIVolume", VR: "DS", VM: "1", Retired: true},
+	"30060030": {Name: "RT Related ROI Sequence", Keyword: "RTRelatedROISequence", VR: "SQ", VM: "1"},
+	"30060033": {Name: "RTROI Relationship", Keyword: "RTROIRelationship", VR: "CS", VM: "1"},
+	"30060036": {Name: "ROI Generation Algorithm", Keyword: "ROIGenerationAlgorithm", VR: "CS", VM: "1"},
+	"30060038": {Name: "ROI Generation Description", Keyword: "ROIGenerationDescription", VR: "LO", VM: "1"},
+	"30060039": {Name: "ROI Contour Sequence", Keyword: "ROIContourSequence", VR: "SQ", VM: "1"},
+	"30060040": {Name: "Contour Sequence", Keyword: "ContourSequence", VR: "SQ", VM: "1"},
+	"30060042": {Name: "Contour Geometric Type", Keyword: "ContourGeometricType", VR: "CS", VM: "1"},
+	"30060044": {Name: "Contour Slab Thickness", Keyword: "ContourSlabThickness", VR: "DS", VM: "1"},
+	"30060045": {Name: "Contour Offset Vector", Keyword: "ContourOffsetVector", VR: "DS", VM: "3"},
+	"30060046": {Name: "Number of Contour Points", Keyword: "NumberOfContourPoints", VR: "IS", VM: "1"},
+	"30060048": {Name: "Contour Number", Keyword: "ContourNumber", VR: "IS", VM: "1"},
+	"30060049": {Name: "Attached Contours", Keyword: "AttachedContours", VR: "IS", VM: "1-n", Retired: true},
+	"30060050": {Name: "Contour Data", Keyword: "ContourData", VR: "DS", VM: "3-3n"},
+	"30060080": {Name: "RTROI Observations Sequence", Keyword: "RTROIObservationsSequence", VR: "SQ", VM: "1"},
+	"30060082": {Name: "Observation Number", Keyword: "ObservationNumber", VR: "IS", VM: "1"},
+	"30060084": {Name: "Referenced ROI Number", Keyword: "ReferencedROINumber", VR: "IS", VM: "1"},
+	"30060085": {Name: "ROI Observation Label", Keyword: "ROIObservationLabel", VR: "SH", VM: "1"},
+	"30060086": {Name: "RTROI Identification Code Sequence", Keyword: "RTROIIdentificationCodeSequence", VR: "SQ", VM: "1"},
+	"30060088": {Name: "ROI Observation Description", Keyword: "ROIObservationDescription", VR: "ST", VM: "1"},
+	"300600A0": {Name: "Related RTROI Observations Sequence", Keyword: "RelatedRTROIObservationsSequence", VR: "SQ", VM: "1"},
+	"300600A4": {Name: "RTROI Interpreted Type", Keyword: "RTROIInterpretedType", VR: "CS", VM: "1"},
+	"300600A6": {Name: "ROI Interpreter", Keyword: "ROIInterpreter", VR: "PN", VM: "1"},
+	"300600B0": {Name: "ROI Physical Properties Sequence", Keyword: "ROIPhysicalPropertiesSequence", VR: "SQ", VM: "1"},
+	"300600B2": {Name: "ROI Physical Property", Keyword: "ROIPhysicalProperty", VR: "CS", VM: "1"},
+	"300600B4": {Name: "ROI Physical Property Value", Keyword: "ROIPhysicalPropertyValue", VR: "DS", VM: "1"},
+	"300600B6": {Name: "ROI Elemental Composition Sequence", Keyword: "ROIElementalCompositionSequence", VR: "SQ", VM: "1"},
+	"300600B7": {Name: "ROI Elemental Composition Atomic Number", Keyword: "ROIElementalCompositionAtomicNumber", VR: "US", VM: "1"},
+	"300600B8": {Name: "ROI Elemental Comp Atomic Mass Fraction", Keyword: "ROIElementalCompAtomicMassFraction", VR: "FL", VM: "1"},
+	"300600C0": {Name: "Frame of Reference Relationship Sequence", Keyword: "FrameOfReferenceRelationshipSequence", VR: "SQ", VM: "1", Retired: true},
+	"300600C2": {Name: "Related Frame of Reference UID", Keyword: "RelatedFrameOfReferenceUID", VR: "UI", VM: "1", Retired: true},
+	"300600C4": {Name: "Frame of Reference Transformation Type", Keyword: "FrameOfReferenceTransformationType", VR: "CS", VM: "1", Retired: true},
+	"300600C6": {Name: "Frame of Reference Transformation Matrix", Keyword: "FrameOfReferenceTransformationMatrix", VR: "DS", VM: "16", Retired: true},
+	"300600C8": {Name: "Frame of Reference Transformation Comment", Keyword: "FrameOfReferenceTransformationComment", VR: "LO", VM: "1", Retired: true},
+	"30080010": {Name: "Measured Dose Reference Sequence", Keyword: "MeasuredDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"30080012": {Name: "Measured Dose Description", Keyword: "MeasuredDoseDescription", VR: "ST", VM: "1"},
+	"30080014": {Name: "Measured Dose Type", Keyword: "MeasuredDoseType", VR: "CS", VM: "1"},
+	"30080016": {Name: "Measured Dose Value", Keyword: "MeasuredDoseValue", VR: "DS", VM: "1"},
+	"30080020": {Name: "Treatment Session Beam Sequence", Keyword: "TreatmentSessionBeamSequence", VR: "SQ", VM: "1"},
+	"30080021": {Name: "Treatment Session Ion Beam Sequence", Keyword: "TreatmentSessionIonBeamSequence", VR: "SQ", VM: "1"},
+	"30080022": {Name: "Current Fraction Number", Keyword: "CurrentFractionNumber", VR: "IS", VM: "1"},
+	"30080024": {Name: "Treatment Control Point Date", Keyword: "TreatmentControlPointDate", VR: "DA", VM: "1"},
+	"30080025": {Name: "Treatment Control Point Time", Keyword: "TreatmentControlPointTime", VR: "TM", VM: "1"},
+	"3008002A": {Name: "Treatment Termination Status", Keyword: "TreatmentTerminationStatus", VR: "CS", VM: "1"},
+	"3008002B": {Name: "Treatment Termination Code", Keyword: "TreatmentTerminationCode", VR: "SH", VM: "1"},
+	"3008002C": {Name: "Treatment Verification Status", Keyword: "TreatmentVerificationStatus", VR: "CS", VM: "1"},
+	"30080030": {Name: "Referenced Treatment Record Sequence", Keyword: "ReferencedTreatmentRecordSequence", VR: "SQ", VM: "1"},
+	"30080032": {Name: "Specified Primary Meterset", Keyword: "SpecifiedPrimaryMeterset", VR: "DS", VM: "1"},
+	"30080033": {Name: "Specified Secondary Meterset", Keyword: "SpecifiedSecondaryMeterset", VR: "DS", VM: "1"},
+	"30080036": {Name: "Delivered Primary Meterset", Keyword: "DeliveredPrimaryMeterset", VR: "DS", VM: "1"},
+	"30080037": {Name: "Delivered Secondary Meterset", Keyword: "DeliveredSecondaryMeterset", VR: "DS", VM: "1"},
+	"3008003A": {Name: "Specified Treatment Time", Keyword: "SpecifiedTreatmentTime", VR: "DS", VM: "1"},
+	"3008003B": {Name: "Delivered Treatment Time", Keyword: "DeliveredTreatmentTime", VR: "DS", VM: "1"},
+	"30080040": {Name: "Control Point Delivery Sequence", Keyword: "ControlPointDeliverySequence", VR: "SQ", VM: "1"},
+	"30080041": {Name: "Ion Control Point Delivery Sequence", Keyword: "IonControlPointDeliverySequence", VR: "SQ", VM: "1"},
+	"30080042": {Name: "Specified Meterset", Keyword: "SpecifiedMeterset", VR: "DS", VM: "1"},
+	"30080044": {Name: "Delivered Meterset", Keyword: "DeliveredMeterset", VR: "DS", VM: "1"},
+	"30080045": {Name: "Meterset Rate Set", Keyword: "MetersetRateSet", VR: "FL", VM: "1"},
+	"30080046": {Name: "Meterset Rate Delivered", Keyword: "MetersetRateDelivered", VR: "FL", VM: "1"},
+	"30080047": {Name: "Scan Spot Metersets Delivered", Keyword: "ScanSpotMetersetsDelivered", VR: "FL", VM: "1-n"},
+	"30080048": {Name: "Dose Rate Delivered", Keyword: "DoseRateDelivered", VR: "DS", VM: "1"},
+	"30080050": {Name: "Treatment Summary Calculated Dose Reference Sequence", Keyword: "TreatmentSummaryCalculatedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"30080052": {Name: "Cumulative Dose to Dose Reference", Keyword: "CumulativeDoseToDoseReference", VR: "DS", VM: "1"},
+	"30080054": {Name: "First Treatment Date", Keyword: "FirstTreatmentDate", VR: "DA", VM: "1"},
+	"30080056": {Name: "Most Recent Treatment Date", Keyword: "MostRecentTreatmentDate", VR: "DA", VM: "1"},
+	"3008005A": {Name: "Number of Fractions Delivered", Keyword: "NumberOfFractionsDelivered", VR: "IS", VM: "1"},
+	"30080060": {Name: "Override Sequence", Keyword: "OverrideSequence", VR: "SQ", VM: "1"},
+	"30080061": {Name: "Parameter Sequence Pointer", Keyword: "ParameterSequencePointer", VR: "AT", VM: "1"},
+	"30080062": {Name: "Override Parameter Pointer", Keyword: "OverrideParameterPointer", VR: "AT", VM: "1"},
+	"30080063": {Name: "Parameter Item Index", Keyword: "ParameterItemIndex", VR: "IS", VM: "1"},
+	"30080064": {Name: "Measured Dose Reference Number", Keyword: "MeasuredDoseReferenceNumber", VR: "IS", VM: "1"},
+	"30080065": {Name: "Parameter Pointer", Keyword: "ParameterPointer", VR: "AT", VM: "1"},
+	"30080066": {Name: "Override Reason", Keyword: "OverrideReason", VR: "ST", VM: "1"},
+	"30080068": {Name: "Corrected Parameter Sequence", Keyword: "CorrectedParameterSequence", VR: "SQ", VM: "1"},
+	"3008006A": {Name: "Correction Value", Keyword: "CorrectionValue", VR: "FL", VM: "1-n"},
+	"30080070": {Name: "Calculated Dose Reference Sequence", Keyword: "CalculatedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"30080072": {Name: "Calculated Dose Reference Number", Keyword: "CalculatedDoseReferenceNumber", VR: "IS", VM: "1"},
+	"30080074": {Name: "Calculated Dose Reference Description", Keyword: "CalculatedDoseReferenceDescription", VR: "ST", VM: "1"},
+	"30080076": {Name: "Calculated Dose Reference Dose Value", Keyword: "CalculatedDoseReferenceDoseValue", VR: "DS", VM: "1"},
+	"30080078": {Name: "Start Meterset", Keyword: "StartMeterset", VR: "DS", VM: "1"},
+	"3008007A": {Name: "End Meterset", Keyword: "EndMeterset", VR: "DS", VM: "1"},
+	"30080080": {Name: "Referenced Measured Dose Reference Sequence", Keyword: "ReferencedMeasuredDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"30080082": {Name: "Referenced Measured Dose Reference Number", Keyword: "ReferencedMeasuredDoseReferenceNumber", VR: "IS", VM: "1"},
+	"30080090": {Name: "Referenced Calculated Dose Reference Sequence", Keyword: "ReferencedCalculatedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"30080092": {Name: "Referenced Calculated Dose Reference Number", Keyword: "ReferencedCalculatedDoseReferenceNumber", VR: "IS", VM: "1"},
+	"300800A0": {Name: "Beam Limiting Device Leaf Pairs Sequence", Keyword: "BeamLimitingDeviceLeafPairsSequence", VR: "SQ", VM: "1"},
+	"300800B0": {Name: "Recorded Wedge Sequence", Keyword: "RecordedWedgeSequence", VR: "SQ", VM: "1"},
+	"300800C0": {Name: "Recorded Compensator Sequence", Keyword: "RecordedCompensatorSequence", VR: "SQ", VM: "1"},
+	"300800D0": {Name: "Recorded Block Sequence", Keyword: "RecordedBlockSequence", VR: "SQ", VM: "1"},
+	"300800E0": {Name: "Treatment Summary Measured Dose Reference Sequence", Keyword: "TreatmentSummaryMeasuredDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"300800F0": {Name: "Recorded Snout Sequence", Keyword: "RecordedSnoutSequence", VR: "SQ", VM: "1"},
+	"300800F2": {Name: "Recorded Range Shifter Sequence", Keyword: "RecordedRangeShifterSequence", VR: "SQ", VM: "1"},
+	"300800F4": {Name: "Recorded Lateral Spreading Device Sequence", Keyword: "RecordedLateralSpreadingDeviceSequence", VR: "SQ", VM: "1"},
+	"300800F6": {Name: "Recorded Range Modulator Sequence", Keyword: "RecordedRangeModulatorSequence", VR: "SQ", VM: "1"},
+	"30080100": {Name: "Recorded Source Sequence", Keyword: "RecordedSourceSequence", VR: "SQ", VM: "1"},
+	"30080105": {Name: "Source Serial Number", Keyword: "SourceSerialNumber", VR: "LO", VM: "1"},
+	"30080110": {Name: "Treatment Session Application Setup Sequence", Keyword: "TreatmentSessionApplicationSetupSequence", VR: "SQ", VM: "1"},
+	"30080116": {Name: "Application Setup Check", Keyword: "ApplicationSetupCheck", VR: "CS", VM: "1"},
+	"30080120": {Name: "Recorded Brachy Accessory Device Sequence", Keyword: "RecordedBrachyAccessoryDeviceSequence", VR: "SQ", VM: "1"},
+	"30080122": {Name: "Referenced Brachy Accessory Device Number", Keyword: "ReferencedBrachyAccessoryDeviceNumber", VR: "IS", VM: "1"},
+	"30080130": {Name: "Recorded Channel Sequence", Keyword: "RecordedChannelSequence", VR: "SQ", VM: "1"},
+	"30080132": {Name: "Specified Channel Total Time", Keyword: "SpecifiedChannelTotalTime", VR: "DS", VM: "1"},
+	"30080134": {Name: "Delivered Channel Total Time", Keyword: "DeliveredChannelTotalTime", VR: "DS", VM: "1"},
+	"30080136": {Name: "Specified Number of Pulses", Keyword: "SpecifiedNumberOfPulses", VR: "IS", VM: "1"},
+	"30080138": {Name: "Delivered Number of Pulses", Keyword: "DeliveredNumberOfPulses", VR: "IS", VM: "1"},
+	"3008013A": {Name: "Specified Pulse Repetition Interval", Keyword: "SpecifiedPulseRepetitionInterval", VR: "DS", VM: "1"},
+	"3008013C": {Name: "Delivered Pulse Repetition Interval", Keyword: "DeliveredPulseRepetitionInterval", VR: "DS", VM: "1"},
+	"30080140": {Name: "Recorded Source Applicator Sequence", Keyword: "RecordedSourceApplicatorSequence", VR: "SQ", VM: "1"},
+	"30080142": {Name: "Referenced Source Applicator Number", Keyword: "ReferencedSourceApplicatorNumber", VR: "IS", VM: "1"},
+	"30080150": {Name: "Recorded Channel Shield Sequence", Keyword: "RecordedChannelShieldSequence", VR: "SQ", VM: "1"},
+	"30080152": {Name: "Referenced Channel Shield Number", Keyword: "ReferencedChannelShieldNumber", VR: "IS", VM: "1"},
+	"30080160": {Name: "Brachy Control Point Delivered Sequence", Keyword: "BrachyControlPointDeliveredSequence", VR: "SQ", VM: "1"},
+	"30080162": {Name: "Safe Position Exit Date", Keyword: "SafePositionExitDate", VR: "DA", VM: "1"},
+	"30080164": {Name: "Safe Position Exit Time", Keyword: "SafePositionExitTime", VR: "TM", VM: "1"},
+	"30080166": {Name: "Safe Position Return Date", Keyword: "SafePositionReturnDate", VR: "DA", VM: "1"},
+	"30080168": {Name: "Safe Position Return Time", Keyword: "SafePositionReturnTime", VR: "TM", VM: "1"},
+	"30080200": {Name: "Current Treatment Status", Keyword: "CurrentTreatmentStatus", VR: "CS", VM: "1"},
+	"30080202": {Name: "Treatment Status Comment", Keyword: "TreatmentStatusComment", VR: "ST", VM: "1"},
+	"30080220": {Name: "Fraction Group Summary Sequence", Keyword: "FractionGroupSummarySequence", VR: "SQ", VM: "1"},
+	"30080223": {Name: "Referenced Fraction Number", Keyword: "ReferencedFractionNumber", VR: "IS", VM: "1"},
+	"30080224": {Name: "Fraction Group Type", Keyword: "FractionGroupType", VR: "CS", VM: "1"},
+	"30080230": {Name: "Beam Stopper Position", Keyword: "BeamStopperPosition", VR: "CS", VM: "1"},
+	"30080240": {Name: "Fraction Status Summary Sequence", Keyword: "FractionStatusSummarySequence", VR: "SQ", VM: "1"},
+	"30080250": {Name: "Treatment Date", Keyword: "TreatmentDate", VR: "DA", VM: "1"},
+	"30080251": {Name: "Treatment Time", Keyword: "TreatmentTime", VR: "TM", VM: "1"},
+	"300A0002": {Name: "RT Plan Label", Keyword: "RTPlanLabel", VR: "SH", VM: "1"},
+	"300A0003": {Name: "RT Plan Name", Keyword: "RTPlanName", VR: "LO", VM: "1"},
+	"300A0004": {Name: "RT Plan Description", Keyword: "RTPlanDescription", VR: "ST", VM: "1"},
+	"300A0006": {Name: "RT Plan Date", Keyword: "RTPlanDate", VR: "DA", VM: "1"},
+	"300A0007": {Name: "RT Plan Time", Keyword: "RTPlanTime", VR: "TM", VM: "1"},
+	"300A0009": {Name: "Treatment Protocols", Keyword: "TreatmentProtocols", VR: "LO", VM: "1-n"},
+	"300A000A": {Name: "Plan Intent", Keyword: "PlanIntent", VR: "CS", VM: "1"},
+	"300A000B": {Name: "Treatment Sites", Keyword: "TreatmentSites", VR: "LO", VM: "1-n"},
+	"300A000C": {Name: "RT Plan Geometry", Keyword: "RTPlanGeometry", VR: "CS", VM: "1"},
+	"300A000E": {Name: "Prescription Description", Keyword: "PrescriptionDescription", VR: "ST", VM: "1"},
+	"300A0010": {Name: "Dose Reference Sequence", Keyword: "DoseReferenceSequence", VR: "SQ", VM: "1"},
+	"300A0012": {Name: "Dose Reference Number", Keyword: "DoseReferenceNumber", VR: "IS", VM: "1"},
+	"300A0013": {Name: "Dose Reference UID", Keyword: "DoseReferenceUID", VR: "UI", VM: "1"},
+	"300A0014": {Name: "Dose Reference Structure Type", Keyword: "DoseReferenceStructureType", VR: "CS", VM: "1"},
+	"300A0015": {Name: "Nominal Beam Energy Unit", Keyword: "NominalBeamEnergyUnit", VR: "CS", VM: "1"},
+	"300A0016": {Name: "Dose Reference Description", Keyword: "DoseReferenceDescription", VR: "LO", VM: "1"},
+	"300A0018": {Name: "Dose Reference Point Coordinates", Keyword: "DoseReferencePointCoordinates", VR: "DS", VM: "3"},
+	"300A001A": {Name: "Nominal Prior Dose", Keyword: "NominalPriorDose", VR: "DS", VM: "1"},
+	"300A0020": {Name: "Dose Reference Type", Keyword: "DoseReferenceType", VR: "CS", VM: "1"},
+	"300A0021": {Name: "Constraint Weight", Keyword: "ConstraintWeight", VR: "DS", VM: "1"},
+	"300A0022": {Name: "Delivery Warning Dose", Keyword: "DeliveryWarningDose", VR: "DS", VM: "1"},
+	"300A0023": {Name: "Delivery Maximum Dose", Keyword: "DeliveryMaximumDose", VR: "DS", VM: "1"},
+	"300A0025": {Name: "Target Minimum Dose", Keyword: "TargetMinimumDose", VR: "DS", VM: "1"},
+	"300A0026": {Name: "Target Prescription Dose", Keyword: "TargetPrescriptionDose", VR: "DS", VM: "1"},
+	"300A0027": {Name: "Target Maximum Dose", Keyword: "TargetMaximumDose", VR: "DS", VM: "1"},
+	"300A0028": {Name: "Target Underdose Volume Fraction", Keyword: "TargetUnderdoseVolumeFraction", VR: "DS", VM: "1"},
+	"300A002A": {Name: "Organ at Risk Full Volume Dose", Keyword: "OrganAtRiskFullVolumeDose", VR: "DS", VM: "1"},
+	"300A002B": {Name: "Organ at Risk Limit Dose", Keyword: "OrganAtRiskLimitDose", VR: "DS", VM: "1"},
+	"300A002C": {Name: "Organ at Risk Maximum Dose", Keyword: "OrganAtRiskMaximumDose", VR: "DS", VM: "1"},
+	"300A002D": {Name: "Organ at Risk Overdose Volume Fraction", Keyword: "OrganAtRiskOverdoseVolumeFraction", VR: "DS", VM: "1"},
+	"300A0040": {Name: "Tolerance Table Sequence", Keyword: "ToleranceTableSequence", VR: "SQ", VM: "1"},
+	"300A0042": {Name: "Tolerance Table Number", Keyword: "ToleranceTableNumber", VR: "IS", VM: "1"},
+	"300A0043": {Name: "Tolerance Table Label", Keyword: "ToleranceTableLabel", VR: "SH", VM: "1"},
+	"300A0044": {Name: "Gantry Angle Tolerance", Keyword: "GantryAngleTolerance", VR: "DS", VM: "1"},
+	"300A0046": {Name: "Beam Limiting Device Angle Tolerance", Keyword: "BeamLimitingDeviceAngleTolerance", VR: "DS", VM: "1"},
+	"300A0048": {Name: "Beam Limiting Device Tolerance Sequence", Keyword: "BeamLimitingDeviceToleranceSequence", VR: "SQ", VM: "1"},
+	"300A004A": {Name: "Beam Limiting Device Position Tolerance", Keyword: "BeamLimitingDevicePositionTolerance", VR: "DS", VM: "1"},
+	"300A004B": {Name: "Snout Position Tolerance", Keyword: "SnoutPositionTolerance", VR: "FL", VM: "1"},
+	"300A004C": {Name: "Patient Support Angle Tolerance", Keyword: "PatientSupportAngleTolerance", VR: "DS", VM: "1"},
+	"300A004E": {Name: "Table Top Eccentric Angle Tolerance", Keyword: "TableTopEccentricAngleTolerance", VR: "DS", VM: "1"},
+	"300A004F": {Name: "Table Top Pitch Angle Tolerance", Keyword: "TableTopPitchAngleTolerance", VR: "FL", VM: "1"},
+	"300A0050": {Name: "Table Top Roll Angle Tolerance", Keyword: "TableTopRollAngleTolerance", VR: "FL", VM: "1"},
+	"300A0051": {Name: "Table Top Vertical Position Tolerance", Keyword: "TableTopVerticalPositionTolerance", VR: "DS", VM: "1"},
+	"300A0052": {Name: "Table Top Longitudinal Position Tolerance", Keyword: "TableTopLongitudinalPositionTolerance", VR: "DS", VM: "1"},
+	"300A0053": {Name: "Table Top Lateral Position Tolerance", Keyword: "TableTopLateralPositionTolerance", VR: "DS", VM: "1"},
+	"300A0055": {Name: "RT Plan Relationship", Keyword: "RTPlanRelationship", VR: "CS", VM: "1"},
+	"300A0070": {Name: "Fraction Group Sequence", Keyword: "FractionGroupSequence", VR: "SQ", VM: "1"},
+	"300A0071": {Name: "Fraction Group Number", Keyword: "FractionGroupNumber", VR: "IS", VM: "1"},
+	"300A0072": {Name: "Fraction Group Description", Keyword: "FractionGroupDescription", VR: "LO", VM: "1"},
+	"300A0078": {Name: "Number of Fractions Planned", Keyword: "NumberOfFractionsPlanned", VR: "IS", VM: "1"},
+	"300A0079": {Name: "Number of Fraction Pattern Digits per Day", Keyword: "NumberOfFractionPatternDigitsPerDay", VR: "IS", VM: "1"},
+	"300A007A": {Name: "Repeat Fraction Cycle Length", Keyword: "RepeatFractionCycleLength", VR: "IS", VM: "1"},
+	"300A007B": {Name: "Fraction Pattern", Keyword: "FractionPattern", VR: "LT", VM: "1"},
+	"300A0080": {Name: "Number of Beams", Keyword: "NumberOfBeams", VR: "IS", VM: "1"},
+	"300A0082": {Name: "Beam Dose Specification Point", Keyword: "BeamDoseSpecificationPoint", VR: "DS", VM: "3", Retired: true},
+	"300A0084": {Name: "Beam Dose", Keyword: "BeamDose", VR: "DS", VM: "1", Retired: true},
+	"300A0086": {Name: "Beam Meterset", Keyword: "BeamMeterset", VR: "DS", VM: "1"},
+	"300A0088": {Name: "Beam Dose Point Depth", Keyword: "BeamDosePointDepth", VR: "FL", VM: "1", Retired: true},
+	"300A0089": {Name: "Beam Dose Point Equivalent Depth", Keyword: "BeamDosePointEquivalentDepth", VR: "FL", VM: "1", Retired: true},
+	"300A008A": {Name: "Beam Dose Point SSD", Keyword: "BeamDosePointSSD", VR: "FL", VM: "1", Retired: true},
+	"300A00A0": {Name: "Number of Brachy Application Setups", Keyword: "NumberOfBrachyApplicationSetups", VR: "IS", VM: "1"},
+	"300A00A2": {Name: "Brachy Application Setup Dose Specification Point", Keyword: "BrachyApplicationSetupDoseSpecificationPoint", VR: "DS", VM: "3"},
+	"300A00A4": {Name: "Brachy Application Setup Dose", Keyword: "BrachyApplicationSetupDose", VR: "DS", VM: "1"},
+	"300A00B0": {Name: "Beam Sequence", Keyword: "BeamSequence", VR: "SQ", VM: "1"},
+	"300A00B2": {Name: "Treatment Machine Name", Keyword: "TreatmentMachineName", VR: "SH", VM: "1"},
+	"300A00B3": {Name: "Primary Dosimeter Unit", Keyword: "PrimaryDosimeterUnit", VR: "CS", VM: "1"},
+	"300A00B4": {Name: "Source Axis Distance", Keyword: "SourceAxisDistance", VR: "DS", VM: "1"},
+	"300A00B6": {Name: "Beam Limiting Device Sequence", Keyword: "BeamLimitingDeviceSequence", VR: "SQ", VM: "1"},
+	"300A00B8": {Name: "RT Beam Limiting Device Type", Keyword: "RTBeamLimitingDeviceType", VR: "CS", VM: "1"},
+	"300A00BA": {Name: "Source to Beam Limiting Device Distance", Keyword: "SourceToBeamLimitingDeviceDistance", VR: "DS", VM: "1"},
+	"300A00BB": {Name: "Isocenter to Beam Limiting Device Distance", Keyword: "IsocenterToBeamLimitingDeviceDistance", VR: "FL", VM: "1"},
+	"300A00BC": {Name: "Number of Leaf Jaw Pairs", Keyword: "NumberOfLeafJawPairs", VR: "IS", VM: "1"},
+	"300A00BE": {Name: "Leaf Position Boundaries", Keyword: "LeafPositionBoundaries", VR: "DS", VM: "3-n"},
+	"300A00C0": {Name: "Beam Number", Keyword: "BeamNumber", VR: "IS", VM: "1"},
+	"300A00C2": {Name: "Beam Name", Keyword: "BeamName", VR: "LO", VM: "1"},
+	"300A00C3": {Name: "Beam Description", Keyword: "BeamDescription", VR: "ST", VM: "1"},
+	"300A00C4": {Name: "Beam Type", Keyword: "BeamType", VR: "CS", VM: "1"},
+	"300A00C6": {Name: "Radiation Type", Keyword: "RadiationType", VR: "CS", VM: "1"},
+	"300A00C7": {Name: "High Dose Technique Type", Keyword: "HighDoseTechniqueType", VR: "CS", VM: "1"},
+	"300A00C8": {Name: "Reference Image Number", Keyword: "ReferenceImageNumber", VR: "IS", VM: "1"},
+	"300A00CA": {Name: "Planned Verification Image Sequence", Keyword: "PlannedVerificationImageSequence", VR: "SQ", VM: "1"},
+	"300A00CC": {Name: "Imaging Device Specific Acquisition Parameters", Keyword: "ImagingDeviceSpecificAcquisitionParameters", VR: "LO", VM: "1-n"},
+	"300A00CE": {Name: "Treatment Delivery Type", Keyword: "TreatmentDeliveryType", VR: "CS", VM: "1"},
+	"300A00D0": {Name: "Number of Wedges", Keyword: "NumberOfWedges", VR: "IS", VM: "1"},
+	"300A00D1": {Name: "Wedge Sequence", Keyword: "WedgeSequence", VR: "SQ", VM: "1"},
+	"300A00D2": {Name: "Wedge Number", Keyword: "WedgeNumber", VR: "IS", VM: "1"},
+	"300A00D3": {Name: "Wedge Type", Keyword: "WedgeType", VR: "CS", VM: "1"},
+	"300A00D4": {Name: "Wedge ID", Keyword: "WedgeID", VR: "SH", VM: "1"},
+	"300A00D5": {Name: "Wedge Angle", Keyword: "WedgeAngle", VR: "IS", VM: "1"},
+	"300A00D6": {Name: "Wedge Factor", Keyword: "WedgeFactor", VR: "DS", VM: "1"},
+	"300A00D7": {Name: "Total Wedge Tray Water Equivalent Thickness", Keyword: "TotalWedgeTrayWaterEquivalentThickness", VR: "FL", VM: "1"},
+	"300A00D8": {Name: "Wedge Orientation", Keyword: "WedgeOrientation", VR: "DS", VM: "1"},
+	"300A00D9": {Name: "Isocenter to Wedge Tray Distance", Keyword: "IsocenterToWedgeTrayDistance", VR: "FL", VM: "1"},
+	"300A00DA": {Name: "Source to Wedge Tray Distance", Keyword: "SourceToWedgeTrayDistance", VR: "DS", VM: "1"},
+	"300A00DB": {Name: "Wedge Thin Edge Position", Keyword: "WedgeThinEdgePosition", VR: "FL", VM: "1"},
+	"300A00DC": {Name: "Bolus ID", Keyword: "BolusID", VR: "SH", VM: "1"},
+	"300A00DD": {Name: "Bolus Description", Keyword: "BolusDescription", VR: "ST", VM: "1"},
+	"300A00E0": {Name: "Number of Compensators", Keyword: "NumberOfCompensators", VR: "IS", VM: "1"},
+	"300A00E1": {Name: "Material ID", Keyword: "MaterialID", VR: "SH", VM: "1"},
+	"300A00E2": {Name: "Total Compensator Tray Factor", Keyword: "TotalCompensatorTrayFactor", VR: "DS", VM: "1"},
+	"300A00E3": {Name: "Compensator Sequence", Keyword: "CompensatorSequence", VR: "SQ", VM: "1"},
+	"300A00E4": {Name: "Compensator Number", Keyword: "CompensatorNumber", VR: "IS", VM: "1"},
+	"300A00E5": {Name: "Compensator ID", Keyword: "CompensatorID", VR: "SH", VM: "1"},
+	"300A00E6": {Name: "Source to Compensator Tray Distance", Keyword: "SourceToCompensatorTrayDistance", VR: "DS", VM: "1"},
+	"300A00E7": {Name: "Compensator Rows", Keyword: "CompensatorRows", VR: "IS", VM: "1"},
+	"300A00E8": {Name: "Compensator Columns", Keyword: "CompensatorColumns", VR: "IS", VM: "1"},
+	"300A00E9": {Name: "Compensator Pixel Spacing", Keyword: "CompensatorPixelSpacing", VR: "DS", VM: "2"},
+	"300A00EA": {Name: "Compensator Position", Keyword: "CompensatorPosition", VR: "DS", VM: "2"},
+	"300A00EB": {Name: "Compensator Transmission Data", Keyword: "CompensatorTransmissionData", VR: "DS", VM: "1-n"},
+	"300A00EC": {Name: "Compensator Thickness Data", Keyword: "CompensatorThicknessData", VR: "DS", VM: "1-n"},
+	"300A00ED": {Name: "Number of Boli", Keyword: "NumberOfBoli", VR: "IS", VM: "1"},
+	"300A00EE": {Name: "Compensator Type", Keyword: "CompensatorType", VR: "CS", VM: "1"},
+	"300A00F0": {Name: "Number of Blocks", Keyword: "NumberOfBlocks", VR: "IS", VM: "1"},
+	"300A00F2": {Name: "Total Block Tray Factor", Keyword: "TotalBlockTrayFactor", VR: "DS", VM: "1"},
+	"300A00F3": {Name: "Total Block Tray Water Equivalent Thickness", Keyword: "TotalBlockTrayWaterEquivalentThickness", VR: "FL", VM: "1"},
+	"300A00F4": {Name: "Block Sequence", Keyword: "BlockSequence", VR: "SQ", VM: "1"},
+	"300A00F5": {Name: "Block Tray ID", Keyword: "BlockTrayID", VR: "SH", VM: "1"},
+	"300A00F6": {Name: "Source to Block Tray Distance", Keyword: "SourceToBlockTrayDistance", VR: "DS", VM: "1"},
+	"300A00F7": {Name: "Isocenter to Block Tray Distance", Keyword: "IsocenterToBlockTrayDistance", VR: "FL", VM: "1"},
+	"300A00F8": {Name: "Block Type", Keyword: "BlockType", VR: "CS", VM: "1"},
+	"300A00F9": {Name: "Accessory Code", Keyword: "AccessoryCode", VR: "LO", VM: "1"},
+	"300A00FA": {Name: "Block Divergence", Keyword: "BlockDivergence", VR: "CS", VM: "1"},
+	"300A00FB": {Name: "Block Mounting Position", Keyword: "BlockMountingPosition", VR: "CS", VM: "1"},
+	"300A00FC": {Name: "Block Number", Keyword: "BlockNumber", VR: "IS", VM: "1"},
+	"300A00FE": {Name: "Block Name", Keyword: "BlockName", VR: "LO", VM: "1"},
+	"300A0100": {Name: "Block Thickness", Keyword: "BlockThickness", VR: "DS", VM: "1"},
+	"300A0102": {Name: "Block Transmission", Keyword: "BlockTransmission", VR: "DS", VM: "1"},
+	"300A0104": {Name: "Block Number of Points", Keyword: "BlockNumberOfPoints", VR: "IS", VM: "1"},
+	"300A0106": {Name: "Block Data", Keyword: "BlockData", VR: "DS", VM: "2-2n"},
+	"300A0107": {Name: "Applicator Sequence", Keyword: "ApplicatorSequence", VR: "SQ", VM: "1"},
+	"300A0108": {Name: "Applicator ID", Keyword: "ApplicatorID", VR: "SH", VM: "1"},
+	"300A0109": {Name: "Applicator Type", Keyword: "ApplicatorType", VR: "CS", VM: "1"},
+	"300A010A": {Name: "Applicator Description", Keyword: "ApplicatorDescription", VR: "LO", VM: "1"},
+	"300A010C": {Name: "Cumulative Dose Reference Coefficient", Keyword: "CumulativeDoseReferenceCoefficient", VR: "DS", VM: "1"},
+	"300A010E": {Name: "Final Cumulative Meterset Weight", Keyword: "FinalCumulativeMetersetWeight", VR: "DS", VM: "1"},
+	"300A0110": {Name: "Number of Control Points", Keyword: "NumberOfControlPoints", VR: "IS", VM: "1"},
+	"300A0111": {Name: "Control Point Sequence", Keyword: "ControlPointSequence", VR: "SQ", VM: "1"},
+	"300A0112": {Name: "Control Point Index", Keyword: "ControlPointIndex", VR: "IS", VM: "1"},
+	"300A0114": {Name: "Nominal Beam Energy", Keyword: "NominalBeamEnergy", VR: "DS", VM: "1"},
+	"300A0115": {Name: "Dose Rate Set", Keyword: "DoseRateSet", VR: "DS", VM: "1"},
+	"300A0116": {Name: "Wedge Position Sequence", Keyword: "WedgePositionSequence", VR: "SQ", VM: "1"},
+	"300A0118": {Name: "Wedge Position", Keyword: "WedgePosition", VR: "CS", VM: "1"},
+	"300A011A": {Name: "Beam Limiting Device Position Sequence", Keyword: "BeamLimitingDevicePositionSequence", VR: "SQ", VM: "1"},
+	"300A011C": {Name: "Leaf Jaw Positions", Keyword: "LeafJawPositions", VR: "DS", VM: "2-2n"},
+	"300A011E": {Name: "Gantry Angle", Keyword: "GantryAngle", VR: "DS", VM: "1"},
+	"300A011F": {Name: "Gantry Rotation Direction", Keyword: "GantryRotationDirection", VR: "CS", VM: "1"},
+	"300A0120": {Name: "Beam Limiting Device Angle", Keyword: "BeamLimitingDeviceAngle", VR: "DS", VM: "1"},
+	"300A0121": {Name: "Beam Limiting Device Rotation Direction", Keyword: "BeamLimitingDeviceRotationDirection", VR: "CS", VM: "1"},
+	"300A0122": {Name: "Patient Support Angle", Keyword: "PatientSupportAngle", VR: "DS", VM: "1"},
+	"300A0123": {Name: "Patient Support Rotation Direction", Keyword: "PatientSupportRotationDirection", VR: "CS", VM: "1"},
+	"300A0124": {Name: "Table Top Eccentric Axis Distance", Keyword: "TableTopEccentricAxisDistance", VR: "DS", VM: "1"},
+	"300A0125": {Name: "Table Top Eccentric Angle", Keyword: "TableTopEccentricAngle", VR: "DS", VM: "1"},
+	"300A0126": {Name: "Table Top Eccentric Rotation Direction", Keyword: "TableTopEccentricRotationDirection", VR: "CS", VM: "1"},
+	"300A0128": {Name: "Table Top Vertical Position", Keyword: "TableTopVerticalPosition", VR: "DS", VM: "1"},
+	"300A0129": {Name: "Table Top Longitudinal Position", Keyword: "TableTopLongitudinalPosition", VR: "DS", VM: "1"},
+	"300A012A": {Name: "Table Top Lateral Position", Keyword: "TableTopLateralPosition", VR: "DS", VM: "1"},
+	"300A012C": {Name: "Isocenter Position", Keyword: "IsocenterPosition", VR: "DS", VM: "3"},
+	"300A012E": {Name: "Surface Entry Point", Keyword: "SurfaceEntryPoint", VR: "DS", VM: "3"},
+	"300A0130": {Name: "Source to Surface Distance", Keyword: "SourceToSurfaceDistance", VR: "DS", VM: "1"},
+	"300A0134": {Name: "Cumulative Meterset Weight", Keyword: "CumulativeMetersetWeight", VR: "DS", VM: "1"},
+	"300A0140": {Name: "Table Top Pitch Angle", Keyword: "TableTopPitchAngle", VR: "FL", VM: "1"},
+	"300A0142": {Name: "Table Top Pitch Rotation Direction", Keyword: "TableTopPitchRotationDirection", VR: "CS", VM: "1"},
+	"300A0144": {Name: "Table Top Roll Angle", Keyword: "TableTopRollAngle", VR: "FL", VM: "1"},
+	"300A0146": {Name: "Table Top Roll Rotation Direction", Keyword: "TableTopRollRotationDirection", VR: "CS", VM: "1"},
+	"300A0148": {Name: "Head Fixation Angle", Keyword: "HeadFixationAngle", VR: "FL", VM: "1"},
+	"300A014A": {Name: "Gantry Pitch Angle", Keyword: "GantryPitchAngle", VR: "FL", VM: "1"},
+	"300A014C": {Name: "Gantry Pitch Rotation Direction", Keyword: "GantryPitchRotationDirection", VR: "CS", VM: "1"},
+	"300A014E": {Name: "Gantry Pitch Angle Tolerance", Keyword: "GantryPitchAngleTolerance", VR: "FL", VM: "1"},
+	"300A0180": {Name: "Patient Setup Sequence", Keyword: "PatientSetupSequence", VR: "SQ", VM: "1"},
+	"300A0182": {Name: "Patient Setup Number", Keyword: "PatientSetupNumber", VR: "IS", VM: "1"},
+	"300A0183": {Name: "Patient Setup Label", Keyword: "PatientSetupLabel", VR: "LO", VM: "1"},
+	"300A0184": {Name: "Patient Additional Position", Keyword: "PatientAdditionalPosition", VR: "LO", VM: "1"},
+	"300A0190": {Name: "Fixation Device Sequence", Keyword: "FixationDeviceSequence", VR: "SQ", VM: "1"},
+	"300A0192": {Name: "Fixation Device Type", Keyword: "FixationDeviceType", VR: "CS", VM: "1"},
+	"300A0194": {Name: "Fixation Device Label", Keyword: "FixationDeviceLabel", VR: "SH", VM: "1"},
+	"300A0196": {Name: "Fixation Device Description", Keyword: "FixationDeviceDescription", VR: "ST", VM: "1"},
+	"300A0198": {Name: "Fixation Device Position", Keyword: "FixationDevicePosition", VR: "SH", VM: "1"},
+	"300A0199": {Name: "Fixation Device Pitch Angle", Keyword: "FixationDevicePitchAngle", VR: "FL", VM: "1"},
+	"300A019A": {Name: "Fixation Device Roll Angle", Keyword: "FixationDeviceRollAngle", VR: "FL", VM: "1"},
+	"300A01A0": {Name: "Shielding Device Sequence", Keyword: "ShieldingDeviceSequence", VR: "SQ", VM: "1"},
+	"300A01A2": {Name: "Shielding Device Type", Keyword: "ShieldingDeviceType", VR: "CS", VM: "1"},
+	"300A01A4": {Name: "Shielding Device Label", Keyword: "ShieldingDeviceLabel", VR: "SH", VM: "1"},
+	"300A01A6": {Name: "Shielding Device Description", Keyword: "ShieldingDeviceDescription", VR: "ST", VM: "1"},
+	"300A01A8": {Name: "Shielding Device Position", Keyword: "ShieldingDevicePosition", VR: "SH", VM: "1"},
+	"300A01B0": {Name: "Setup Technique", Keyword: "SetupTechnique", VR: "CS", VM: "1"},
+	"300A01B2": {Name: "Setup Technique Description", Keyword: "SetupTechniqueDescription", VR: "ST", VM: "1"},
+	"300A01B4": {Name: "Setup Device Sequence", Keyword: "SetupDeviceSequence", VR: "SQ", VM: "1"},
+	"300A01B6": {Name: "Setup Device Type", Keyword: "SetupDeviceType", VR: "CS", VM: "1"},
+	"300A01B8": {Name: "Setup Device Label", Keyword: "SetupDeviceLabel", VR: "SH", VM: "1"},
+	"300A01BA": {Name: "Setup Device Description", Keyword: "SetupDeviceDescription", VR: "ST", VM: "1"},
+	"300A01BC": {Name: "Setup Device Parameter", Keyword: "SetupDeviceParameter", VR: "DS", VM: "1"},
+	"300A01D0": {Name: "Setup Reference Description", Keyword: "SetupReferenceDescription", VR: "ST", VM: "1"},
+	"300A01D2": {Name: "Table Top Vertical Setup Displacement", Keyword: "TableTopVerticalSetupDisplacement", VR: "DS", VM: "1"},
+	"300A01D4": {Name: "Table Top Longitudinal Setup Displacement", Keyword: "TableTopLongitudinalSetupDisplacement", VR: "DS", VM: "1"},
+	"300A01D6": {Name: "Table Top Lateral Setup Displacement", Keyword: "TableTopLateralSetupDisplacement", VR: "DS", VM: "1"},
+	"300A0200": {Name: "Brachy Treatment Technique", Keyword: "BrachyTreatmentTechnique", VR: "CS", VM: "1"},
+	"300A0202": {Name: "Brachy Treatment Type", Keyword: "BrachyTreatmentType", VR: "CS", VM: "1"},
+	"300A0206": {Name: "Treatment Machine Sequence", Keyword: "TreatmentMachineSequence", VR: "SQ", VM: "1"},
+	"300A0210": {Name: "Source Sequence", Keyword: "SourceSequence", VR: "SQ", VM: "1"},
+	"300A0212": {Name: "Source Number", Keyword: "SourceNumber", VR: "IS", VM: "1"},
+	"300A0214": {Name: "Source Type", Keyword: "SourceType", VR: "CS", VM: "1"},
+	"300A0216": {Name: "Source Manufacturer", Keyword: "SourceManufacturer", VR: "LO", VM: "1"},
+	"300A0218": {Name: "Active Source Diameter", Keyword: "ActiveSourceDiameter", VR: "DS", VM: "1"},
+	"300A021A": {Name: "Active Source Length", Keyword: "ActiveSourceLength", VR: "DS", VM: "1"},
+	"300A0222": {Name: "Source Encapsulation Nominal Thickness", Keyword: "SourceEncapsulationNominalThickness", VR: "DS", VM: "1"},
+	"300A0224": {Name: "Source Encapsulation Nominal Transmission", Keyword: "SourceEncapsulationNominalTransmission", VR: "DS", VM: "1"},
+	"300A0226": {Name: "Source Isotope Name", Keyword: "SourceIsotopeName", VR: "LO", VM: "1"},
+	"300A0228": {Name: "Source Isotope Half Life", Keyword: "SourceIsotopeHalfLife", VR: "DS", VM: "1"},
+	"300A0229": {Name: "Source Strength Units", Keyword: "SourceStrengthUnits", VR: "CS", VM: "1"},
+	"300A022A": {Name: "Reference Air Kerma Rate", Keyword: "ReferenceAirKermaRate", VR: "DS", VM: "1"},
+	"300A022B": {Name: "Source Strength", Keyword: "SourceStrength", VR: "DS", VM: "1"},
+	"300A022C": {Name: "Source Strength Reference Date", Keyword: "SourceStrengthReferenceDate", VR: "DA", VM: "1"},
+	"300A022E": {Name: "Source Strength Reference Time", Keyword: "SourceStrengthReferenceTime", VR: "TM", VM: "1"},
+	"300A0230": {Name: "Application Setup Sequence", Keyword: "ApplicationSetupSequence", VR: "SQ", VM: "1"},
+	"300A0232": {Name: "Application Setup Type", Keyword: "ApplicationSetupType", VR: "CS", VM: "1"},
+	"300A0234": {Name: "Application Setup Number", Keyword: "ApplicationSetupNumber", VR: "IS", VM: "1"},
+	"300A0236": {Name: "Application Setup Name", Keyword: "ApplicationSetupName", VR: "LO", VM: "1"},
+	"300A0238": {Name: "Application Setup Manufacturer", Keyword: "ApplicationSetupManufacturer", VR: "LO", VM: "1"},
+	"300A0240": {Name: "Template Number", Keyword: "TemplateNumber", VR: "IS", VM: "1"},
+	"300A0242": {Name: "Template Type", Keyword: "TemplateType", VR: "SH", VM: "1"},
+	"300A0244": {Name: "Template Name", Keyword: "TemplateName", VR: "LO", VM: "1"},
+	"300A0250": {Name: "Total Reference Air Kerma", Keyword: "TotalReferenceAirKerma", VR: "DS", VM: "1"},
+	"300A0260": {Name: "Brachy Accessory Device Sequence", Keyword: "BrachyAccessoryDeviceSequence", VR: "SQ", VM: "1"},
+	"300A0262": {Name: "Brachy Accessory Device Number", Keyword: "BrachyAccessoryDeviceNumber", VR: "IS", VM: "1"},
+	"300A0263": {Name: "Brachy Accessory Device ID", Keyword: "BrachyAccessoryDeviceID", VR: "SH", VM: "1"},
+	"300A0264": {Name: "Brachy Accessory Device Type", Keyword: "BrachyAccessoryDeviceType", VR: "CS", VM: "1"},
+	"300A0266": {Name: "Brachy Accessory Device Name", Keyword: "BrachyAccessoryDeviceName", VR: "LO", VM: "1"},
+	"300A026A": {Name: "Brachy Accessory Device Nominal Thickness", Keyword: "BrachyAccessoryDeviceNominalThickness", VR: "DS", VM: "1"},
+	"300A026C": {Name: "Brachy Accessory Device Nominal Transmission", Keyword: "BrachyAccessoryDeviceNominalTransmission", VR: "DS", VM: "1"},
+	"300A0280": {Name: "Channel Sequence", Keyword: "ChannelSequence", VR: "SQ", VM: "1"},
+	"300A0282": {Name: "Channel Number", Keyword: "ChannelNumber", VR: "IS", VM: "1"},
+	"300A0284": {Name: "Channel Length", Keyword: "ChannelLength", VR: "DS", VM: "1"},
+	"300A0286": {Name: "Channel Total Time", Keyword: "ChannelTotalTime", VR: "DS", VM: "1"},
+	"300A0288": {Name: "Source Movement Type", Keyword: "SourceMovementType", VR: "CS", VM: "1"},
+	"300A028A": {Name: "Number of Pulses", Keyword: "NumberOfPulses", VR: "IS", VM: "1"},
+	"300A028C": {Name: "Pulse Repetition Interval", Keyword: "PulseRepetitionInterval", VR: "DS", VM: "1"},
+	"300A0290": {Name: "Source Applicator Number", Keyword: "SourceApplicatorNumber", VR: "IS", VM: "1"},
+	"300A0291": {Name: "Source Applicator ID", Keyword: "SourceApplicatorID", VR: "SH", VM: "1"},
+	"300A0292": {Name: "Source Applicator Type", Keyword: "SourceApplicatorType", VR: "CS", VM: "1"},
+	"300A0294": {Name: "Source Applicator Name", Keyword: "SourceApplicatorName", VR: "LO", VM: "1"},
+	"300A0296": {Name: "Source Applicator Length", Keyword: "SourceApplicatorLength", VR: "DS", VM: "1"},
+	"300A0298": {Name: "Source Applicator Manufacturer", Keyword: "SourceApplicatorManufacturer", VR: "LO", VM: "1"},
+	"300A029C": {Name: "Source Applicator Wall Nominal Thickness", Keyword: "SourceApplicatorWallNominalThickness", VR: "DS", VM: "1"},
+	"300A029E": {Name: "Source Applicator Wall Nominal Transmission", Keyword: "SourceApplicatorWallNominalTransmission", VR: "DS", VM: "1"},
+	"300A02A0": {Name: "Source Applicator Step Size", Keyword: "SourceApplicatorStepSize", VR: "DS", VM: "1"},
+	"300A02A2": {Name: "Transfer Tube Number", Keyword: "TransferTubeNumber", VR: "IS", VM: "1"},
+	"300A02A4": {Name: "Transfer Tube Length", Keyword: "TransferTubeLength", VR: "DS", VM: "1"},
+	"300A02B0": {Name: "Channel Shield Sequence", Keyword: "ChannelShieldSequence", VR: "SQ", VM: "1"},
+	"300A02B2": {Name: "Channel Shield Number", Keyword: "ChannelShieldNumber", VR: "IS", VM: "1"},
+	"300A02B3": {Name: "Channel Shield ID", Keyword: "ChannelShieldID", VR: "SH", VM: "1"},
+	"300A02B4": {Name: "Channel Shield Name", Keyword: "ChannelShieldName", VR: "LO", VM: "1"},
+	"300A02B8": {Name: "Channel Shield Nominal Thickness", Keyword: "ChannelShieldNominalThickness", VR: "DS", VM: "1"},
+	"300A02BA": {Name: "Channel Shield Nominal Transmission", Keyword: "ChannelShieldNominalTransmission", VR: "DS", VM: "1"},
+	"300A02C8": {Name: "Final Cumulative Time Weight", Keyword: "FinalCumulativeTimeWeight", VR: "DS", VM: "1"},
+	"300A02D0": {Name: "Brachy Control Point Sequence", Keyword: "BrachyControlPointSequence", VR: "SQ", VM: "1"},
+	"300A02D2": {Name: "Control Point Relative Position", Keyword: "ControlPointRelativePosition", VR: "DS", VM: "1"},
+	"300A02D4": {Name: "Control Point 3 D Position", Keyword: "ControlPoint3DPosition", VR: "DS", VM: "3"},
+	"300A02D6": {Name: "Cumulative Time Weight", Keyword: "CumulativeTimeWeight", VR: "DS", VM: "1"},
+	"300A02E0": {Name: "Compensator Divergence", Keyword: "CompensatorDivergence", VR: "CS", VM: "1"},
+	"300A02E1": {Name: "Compensator Mounting Position", Keyword: "CompensatorMountingPosition", VR: "CS", VM: "1"},
+	"300A02E2": {Name: "Source to Compensator Distance", Keyword: "SourceToCompensatorDistance", VR: "DS", VM: "1-n"},
+	"300A02E3": {Name: "Total Compensator Tray Water Equivalent Thickness", Keyword: "TotalCompensatorTrayWaterEquivalentThickness", VR: "FL", VM: "1"},
+	"300A02E4": {Name: "Isocenter to Compensator Tray Distance", Keyword: "IsocenterToCompensatorTrayDistance", VR: "FL", VM: "1"},
+	"300A02E5": {Name: "Compensator Column Offset", Keyword: "CompensatorColumnOffset", VR: "FL", VM: "1"},
+	"300A02E6": {Name: "Isocenter to Compensator Distances", Keyword: "IsocenterToCompensatorDistances", VR: "FL", VM: "1-n"},
+	"300A02E7": {Name: "Compensator Relative Stopping Power Ratio", Keyword: "CompensatorRelativeStoppingPowerRatio", VR: "FL", VM: "1-n"},
+	"300A02E8": {Name: "Compensator Milling Tool Diameter", Keyword: "CompensatorMillingToolDiameter", VR: "FL", VM: "1"},
+	"300A02EA": {Name: "Ion Range Compensator Sequence", Keyword: "IonRangeCompensatorSequence", VR: "SQ", VM: "1"},
+	"300A02EB": {Name: "Compensator Description", Keyword: "CompensatorDescription", VR: "LT", VM: "1"},
+	"300A0302": {Name: "Radiation Mass Number", Keyword: "RadiationMassNumber", VR: "IS", VM: "1"},
+	"300A0304": {Name: "Radiation Atomic Number", Keyword: "RadiationAtomicNumber", VR: "IS", VM: "1"},
+	"300A0306": {Name: "Radiation Charge State", Keyword: "RadiationChargeState", VR: "SS", VM: "1"},
+	"300A0308": {Name: "Scan Mode", Keyword: "ScanMode", VR: "CS", VM: "1"},
+	"300A030A": {Name: "Virtual Source Axis Distances", Keyword: "VirtualSourceAxisDistances", VR: "FL", VM: "2"},
+	"300A030C": {Name: "Snout Sequence", Keyword: "SnoutSequence", VR: "SQ", VM: "1"},
+	"300A030D": {Name: "Snout Position", Keyword: "SnoutPosition", VR: "FL", VM: "1"},
+	"300A030F": {Name: "Snout ID", Keyword: "SnoutID", VR: "SH", VM: "1"},
+	"300A0312": {Name: "Number of Range Shifters", Keyword: "NumberOfRangeShifters", VR: "IS", VM: "1"},
+	"300A0314": {Name: "Range Shifter Sequence", Keyword: "RangeShifterSequence", VR: "SQ", VM: "1"},
+	"300A0316": {Name: "Range Shifter Number", Keyword: "RangeShifterNumber", VR: "IS", VM: "1"},
+	"300A0318": {Name: "Range Shifter ID", Keyword: "RangeShifterID", VR: "SH", VM: "1"},
+	"300A0320": {Name: "Range Shifter Type", Keyword: "RangeShifterType", VR: "CS", VM: "1"},
+	"300A0322": {Name: "Range Shifter Description", Keyword: "RangeShifterDescription", VR: "LO", VM: "1"},
+	"300A0330": {Name: "Number of Lateral Spreading Devices", Keyword: "NumberOfLateralSpreadingDevices", VR: "IS", VM: "1"},
+	"300A0332": {Name: "Lateral Spreading Device Sequence", Keyword: "LateralSpreadingDeviceSequence", VR: "SQ", VM: "1"},
+	"300A0334": {Name: "Lateral Spreading Device Number", Keyword: "LateralSpreadingDeviceNumber", VR: "IS", VM: "1"},
+	"300A0336": {Name: "Lateral Spreading Device ID", Keyword: "LateralSpreadingDeviceID", VR: "SH", VM: "1"},
+	"300A0338": {Name: "Lateral Spreading Device Type", Keyword: "LateralSpreadingDeviceType", VR: "CS", VM: "1"},
+	"300A033A": {Name: "Lateral Spreading Device Description", Keyword: "LateralSpreadingDeviceDescription", VR: "LO", VM: "1"},
+	"300A033C": {Name: "Lateral Spreading Device Water Equivalent Thickness", Keyword: "LateralSpreadingDeviceWaterEquivalentThickness", VR: "FL", VM: "1"},
+	"300A0340": {Name: "Number of Range Modulators", Keyword: "NumberOfRangeModulators", VR: "IS", VM: "1"},
+	"300A0342": {Name: "Range Modulator Sequence", Keyword: "RangeModulatorSequence", VR: "SQ", VM: "1"},
+	"300A0344": {Name: "Range Modulator Number", Keyword: "RangeModulatorNumber", VR: "IS", VM: "1"},
+	"300A0346": {Name: "Range Modulator ID", Keyword: "RangeModulatorID", VR: "SH", VM: "1"},
+	"300A0348": {Name: "Range Modulator Type", Keyword: "RangeModulatorType", VR: "CS", VM: "1"},
+	"300A034A": {Name: "Range Modulator Description", Keyword: "RangeModulatorDescription", VR: "LO", VM: "1"},
+	"300A034C": {Name: "Beam Current Modulation ID", Keyword: "BeamCurrentModulationID", VR: "SH", VM: "1"},
+	"300A0350": {Name: "Patient Support Type", Keyword: "PatientSupportType", VR: "CS", VM: "1"},
+	"300A0352": {Name: "Patient Support ID", Keyword: "PatientSupportID", VR: "SH", VM: "1"},
+	"300A0354": {Name: "Patient Support Accessory Code", Keyword: "PatientSupportAccessoryCode", VR: "LO", VM: "1"},
+	"300A0356": {Name: "Fixation Light Azimuthal Angle", Keyword: "FixationLightAzimuthalAngle", VR: "FL", VM: "1"},
+	"300A0358": {Name: "Fixation Light Polar Angle", Keyword: "FixationLightPolarAngle", VR: "FL", VM: "1"},
+	"300A035A": {Name: "Meterset Rate", Keyword: "MetersetRate", VR: "FL", VM: "1"},
+	"300A0360": {Name: "Range Shifter Settings Sequence", Keyword: "RangeShifterSettingsSequence", VR: "SQ", VM: "1"},
+	"300A0362": {Name: "Range Shifter Setting", Keyword: "RangeShifterSetting", VR: "LO", VM: "1"},
+	"300A0364": {Name: "Isocenter to Range Shifter Distance", Keyword: "IsocenterToRangeShifterDistance", VR: "FL", VM: "1"},
+	"300A0366": {Name: "Range Shifter Water Equivalent Thickness", Keyword: "RangeShifterWaterEquivalentThickness", VR: "FL", VM: "1"},
+	"300A0370": {Name: "Lateral Spreading Device Settings Sequence", Keyword: "LateralSpreadingDeviceSettingsSequence", VR: "SQ", VM: "1"},
+	"300A0372": {Name: "Lateral Spreading Device Setting", Keyword: "LateralSpreadingDeviceSetting", VR: "LO", VM: "1"},
+	"300A0374": {Name: "Isocenter to Lateral Spreading Device Distance", Keyword: "IsocenterToLateralSpreadingDeviceDistance", VR: "FL", VM: "1"},
+	"300A0380": {Name: "Range Modulator Settings Sequence", Keyword: "RangeModulatorSettingsSequence", VR: "SQ", VM: "1"},
+	"300A0382": {Name: "Range Modulator Gating Start Value", Keyword: "RangeModulatorGatingStartValue", VR: "FL", VM: "1"},
+	"300A0384": {Name: "Range Modulator Gating Stop Value", Keyword: "RangeModulatorGatingStopValue", VR: "FL", VM: "1"},
+	"300A038A": {Name: "Isocenter to Range Modulator Distance", Keyword: "IsocenterToRangeModulatorDistance", VR: "FL", VM: "1"},
+	"300A0390": {Name: "Scan Spot Tune ID", Keyword: "ScanSpotTuneID", VR: "SH", VM: "1"},
+	"300A0392": {Name: "Number of Scan Spot Positions", Keyword: "NumberOfScanSpotPositions", VR: "IS", VM: "1"},
+	"300A0394": {Name: "Scan Spot Position Map", Keyword: "ScanSpotPositionMap", VR: "FL", VM: "1-n"},
+	"300A0396": {Name: "Scan Spot Meterset Weights", Keyword: "ScanSpotMetersetWeights", VR: "FL", VM: "1-n"},
+	"300A0398": {Name: "Scanning Spot Size", Keyword: "ScanningSpotSize", VR: "FL", VM: "2"},
+	"300A039A": {Name: "Number of Paintings", Keyword: "NumberOfPaintings", VR: "IS", VM: "1"},
+	"300A03A0": {Name: "Ion Tolerance Table Sequence", Keyword: "IonToleranceTableSequence", VR: "SQ", VM: "1"},
+	"300A03A2": {Name: "Ion Beam Sequence", Keyword: "IonBeamSequence", VR: "SQ", VM: "1"},
+	"300A03A4": {Name: "Ion Beam Limiting Device Sequence", Keyword: "IonBeamLimitingDeviceSequence", VR: "SQ", VM: "1"},
+	"300A03A6": {Name: "Ion Block Sequence", Keyword: "IonBlockSequence", VR: "SQ", VM: "1"},
+	"300A03A8": {Name: "Ion Control Point Sequence", Keyword: "IonControlPointSequence", VR: "SQ", VM: "1"},
+	"300A03AA": {Name: "Ion Wedge Sequence", Keyword: "IonWedgeSequence", VR: "SQ", VM: "1"},
+	"300A03AC": {Name: "Ion Wedge Position Sequence", Keyword: "IonWedgePositionSequence", VR: "SQ", VM: "1"},
+	"300A0401": {Name: "Referenced Setup Image Sequence", Keyword: "ReferencedSetupImageSequence", VR: "SQ", VM: "1"},
+	"300A0402": {Name: "Setup Image Comment", Keyword: "SetupImageComment", VR: "ST", VM: "1"},
+	"300A0410": {Name: "Motion Synchronization Sequence", Keyword: "MotionSynchronizationSequence", VR: "SQ", VM: "1"},
+	"300A0412": {Name: "Control Point Orientation", Keyword: "ControlPointOrientation", VR: "FL", VM: "3"},
+	"300A0420": {Name: "General Accessory Sequence", Keyword: "GeneralAccessorySequence", VR: "SQ", VM: "1"},
+	"300A0421": {Name: "General Accessory ID", Keyword: "GeneralAccessoryID", VR: "SH", VM: "1"},
+	"300A0422": {Name: "General Accessory Description", Keyword: "GeneralAccessoryDescription", VR: "ST", VM: "1"},
+	"300A0423": {Name: "General Accessory Type", Keyword: "GeneralAccessoryType", VR: "CS", VM: "1"},
+	"300A0424": {Name: "General Accessory Number", Keyword: "GeneralAccessoryNumber", VR: "IS", VM: "1"},
+	"300C0002": {Name: "Referenced RT Plan Sequence", Keyword: "ReferencedRTPlanSequence", VR: "SQ", VM: "1"},
+	"300C0004": {Name: "Referenced Beam Sequence", Keyword: "ReferencedBeamSequence", VR: "SQ", VM: "1"},
+	"300C0006": {Name: "Referenced Beam Number", Keyword: "ReferencedBeamNumber", VR: "IS", VM: "1"},
+	"300C0007": {Name: "Referenced Reference Image Number", Keyword: "ReferencedReferenceImageNumber", VR: "IS", VM: "1"},
+	"300C0008": {Name: "Start Cumulative Meterset Weight", Keyword: "StartCumulativeMetersetWeight", VR: "DS", VM: "1"},
+	"300C0009": {Name: "End Cumulative Meterset Weight", Keyword: "EndCumulativeMetersetWeight", VR: "DS", VM: "1"},
+	"300C000A": {Name: "Referenced Brachy Application Setup Sequence", Keyword: "ReferencedBrachyApplicationSetupSequence", VR: "SQ", VM: "1"},
+	"300C000C": {Name: "Referenced Brachy Application Setup Number", Keyword: "ReferencedBrachyApplicationSetupNumber", VR: "IS", VM: "1"},
+	"300C000E": {Name: "Referenced Source Number", Keyword: "ReferencedSourceNumber", VR: "IS", VM: "1"},
+	"300C0020": {Name: "Referenced Fraction Group Sequence", Keyword: "ReferencedFractionGroupSequence", VR: "SQ", VM: "1"},
+	"300C0022": {Name: "Referenced Fraction Group Number", Keyword: "ReferencedFractionGroupNumber", VR: "IS", VM: "1"},
+	"300C0040": {Name: "Referenced Verification Image Sequence", Keyword: "ReferencedVerificationImageSequence", VR: "SQ", VM: "1"},
+	"300C0042": {Name: "Referenced Reference Image Sequence", Keyword: "ReferencedReferenceImageSequence", VR: "SQ", VM: "1"},
+	"300C0050": {Name: "Referenced Dose Reference Sequence", Keyword: "ReferencedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"300C0051": {Name: "Referenced Dose Reference Number", Keyword: "ReferencedDoseReferenceNumber", VR: "IS", VM: "1"},
+	"300C0055": {Name: "Brachy Referenced Dose Reference Sequence", Keyword: "BrachyReferencedDoseReferenceSequence", VR: "SQ", VM: "1"},
+	"300C0060": {Name: "Referenced Structure Set Sequence", Keyword: "ReferencedStructureSetSequence", VR: "SQ", VM: "1"},
+	"300C006A": {Name: "Referenced Patient Setup Number", Keyword: "ReferencedPatientSetupNumber", VR: "IS", VM: "1"},
+	"300C0080": {Name: "Referenced Dose Sequence", Keyword: "ReferencedDoseSequence", VR: "SQ", VM: "1"},
+	"300C00A0": {Name: "Referenced Tolerance Table Number", Keyword: "ReferencedToleranceTableNumber", VR: "IS", VM: "1"},
+	"300C00B0": {Name: "Referenced Bolus Sequence", Keyword: "ReferencedBolusSequence", VR: "SQ", VM: "1"},
+	"300C00C0": {Name: "Referenced Wedge Number", Keyword: "ReferencedWedgeNumber", VR: "IS", VM: "1"},
+	"300C00D0": {Name: "Referenced Compensator Number", Keyword: "ReferencedCompensatorNumber", VR: "IS", VM: "1"},
+	"300C00E0": {Name: "Referenced Block Number", Keyword: "ReferencedBlockNumber", VR: "IS", VM: "1"},
+	"300C00F0": {Name: "Referenced Control Point Index", Keyword: "ReferencedControlPointIndex", VR: "IS", VM: "1"},
+	"300C00F2": {Name: "Referenced Control Point Sequence", Keyword: "ReferencedControlPointSequence", VR: "SQ", VM: "1"},
+	"300C00F4": {Name: "Referenced Start Control Point Index", Keyword: "ReferencedStartControlPointIndex", VR: "IS", VM: "1"},
+	"300C00F6": {Name: "Referenced Stop Control Point Index", Keyword: "ReferencedStopControlPointIndex", VR: "IS", VM: "1"},
+	"300C0100": {Name: "Referenced Range Shifter Number", Keyword: "ReferencedRangeShifterNumber", VR: "IS", VM: "1"},
+	"300C0102": {Name: "Referenced Lateral Spreading Device Number", Keyword: "ReferencedLateralSpreadingDeviceNumber", VR: "IS", VM: "1"},
+	"300C0104": {Name: "Referenced Range Modulator Number", Keyword: "ReferencedRangeModulatorNumber", VR: "IS", VM: "1"},
+	"300E0002": {Name: "Approval Status", Keyword: "ApprovalStatus", VR: "CS", VM: "1"},
+	"300E0004": {Name: "Review Date", Keyword: "ReviewDate", VR: "DA", VM: "1"},
+	"300E0005": {Name: "Review Time", Keyword: "ReviewTime", VR: "TM", VM: "1"},
+	"300E0008": {Name: "Reviewer Name", Keyword: "ReviewerName", VR: "PN", VM: "1"},
+	"40000000": {Name: "Text Group Length", Keyword: "TextGroupLength", VR: "UL", VM: "1", Retired: true},
+	"40000010": {Name: "Arbitrary", Keyword: "Arbitrary", VR: "LT", VM: "1", Retired: true},
+	"40004000": {Name: "Text Comments", Keyword: "TextComments", VR: "LT", VM: "1", Retired: true},
+	"40080040": {Name: "Results ID", Keyword: "ResultsID", VR: "SH", VM: "1", Retired: true},
+	"40080042": {Name: "Results ID Issuer", Keyword: "ResultsIDIssuer", VR: "LO", VM: "1", Retired: true},
+	"40080050": {Name: "Referenced Interpretation Sequence", Keyword: "ReferencedInterpretationSequence", VR: "SQ", VM: "1", Retired: true},
+	"40080100": {Name: "Interpretation Recorded Date", Keyword: "InterpretationRecordedDate", VR: "DA", VM: "1", Retired: true},
+	"40080101": {Name: "Interpretation Recorded Time", Keyword: "InterpretationRecordedTime", VR: "TM", VM: "1", Retired: true},
+	"40080102": {Name: "Interpretation Recorder", Keyword: "InterpretationRecorder", VR: "PN", VM: "1", Retired: true},
+	"40080103": {Name: "Reference to Recorded Sound", Keyword: "ReferenceToRecordedSound", VR: "ST", VM: "1", Retired: true},
+	"40080108": {Name: "Interpretation Transcription Date", Keyword: "InterpretationTranscriptionDate", VR: "DA", VM: "1", Retired: true},
+	"40080109": {Name: "Interpretation Transcription Time", Keyword: "InterpretationTranscriptionTime", VR: "TM", VM: "1", Retired: true},
+	"4008010A": {Name: "Interpretation Transcriber", Keyword: "InterpretationTranscriber", VR: "PN", VM: "1", Retired: true},
+	"4008010B": {Name: "Interpretation Text", Keyword: "InterpretationText", VR: "ST", VM: "1", Retired: true},
+	"4008010C": {Name: "Interpretation Author", Keyword: "InterpretationAuthor", VR: "SQ", VM: "1", Retired: true},
+	"40080111": {Name: "Interpretation Approver Sequence", Keyword: "InterpretationApproverSequence", VR: "SQ", VM: "1", Retired: true},
+	"40080112": {Name: "Interpretation Approval Date", Keyword: "InterpretationApprovalDate", VR: "DA", VM: "1", Retired: true},
+	"40080113": {Name: "Interpretation Approval Time", Keyword: "InterpretationApprovalTime", VR: "TM", VM: "1", Retired: true},
+	"40080114": {Name: "Physician Approving Interpretation", Keyword: "PhysicianApprovingInterpretation", VR: "PN", VM: "1", Retired: true},
+	"40080115": {Name: "Interpretation Diagnosis Description", Keyword: "InterpretationDiagnosisDescription", VR: "LT", VM: "1", Retired: true},
+	"40080117": {Name: "Interpretation Diagnosis Code Sequence", Keyword: "InterpretationDiagnosisCodeSequence", VR: "SQ", VM: "1", Retired: true},
+	"40080118": {Name: "Results Distribution List Sequence", Keyword: "ResultsDistributionListSequence", VR: "SQ", VM: "1", Retired: true},
+	"40080119": {Name: "Distribution Name", Keyword: "DistributionName", VR: "PN", VM: "1", Retired: true},
+	"4008011A": {Name: "Distribution Address", Keyword: "DistributionAddress", VR: "LO", VM: "1", Retired: true},
+	"40080200": {Name: "Interpretation ID", Keyword: "InterpretationID", VR: "SH", VM: "1", Retired: true},
+	"40080202": {Name: "Interpretation ID Issuer", Keyword: "InterpretationIDIssuer", VR: "LO", VM: "1", Retired: true},
+	"40080210": {Name: "Interpretation Type ID", Keyword: "InterpretationTypeID", VR: "CS", VM: "1", Retired: true},
+	"40080212": {Name: "Interpretation Status ID", Keyword: "InterpretationStatusID", VR: "CS", VM: "1", Retired: true},
+	"40080300": {Name: "Impressions", Keyword: "Impressions", VR: "ST", VM: "1", Retired: true},
+	"40084000": {Name: "Results Comments", Keyword: "ResultsComments", VR: "ST", VM: "1", Retired: true},
+	"4FFE0001": {Name: "MAC Parameters Sequence", Keyword: "MACParametersSequence", VR: "SQ", VM: "1"},
+	"52009229": {Name: "Shared Functional Groups Sequence", Keyword: "SharedFunctionalGroupsSequence", VR: "SQ", VM: "1"},
+	"52009230": {Name: "Per Frame Functional Groups Sequence", Keyword: "PerFrameFunctionalGroupsSequence", VR: "SQ", VM: "1"},
+	"54000100": {Name: "Waveform Sequence", Keyword: "WaveformSequence", VR: "SQ", VM: "1"},
+	"54000110": {Name: "Channel Minimum Value", Keyword: "ChannelMinimumValue", VR: "OB or OW", VM: "1"},
+	"54000112": {Name: "Channel Maximum Value", Keyword: "ChannelMaximumValue", VR: "OB or OW", VM: "1"},
+	"54001004": {Name: "Waveform Bits Allocated", Keyword: "WaveformBitsAllocated", VR: "US", VM: "1"},
+	"54001006": {Name: "Waveform Sample Interpretation", Keyword: "WaveformSampleInterpretation", VR: "CS", VM: "1"},
+	"5400100A": {Name: "Waveform Padding Value", Keyword: "WaveformPaddingValue", VR: "OB or OW", VM: "1"},
+	"54001010": {Name: "Waveform Data", Keyword: "WaveformData", VR: "OB or OW", VM: "1"},
+	"56000010": {Name: "First Order Phase Correction Angle", Keyword: "FirstOrderPhaseCorrectionAngle", VR: "OF", VM: "1"},
+	"56000020": {Name: "Spectroscopy Data", Keyword: "SpectroscopyData", VR: "OF", VM: "1"},
+	"60000000": {Name: "Overlay Group Length", Keyword: "OverlayGroupLength", VR: "UL", VM: "1", Retired: true},
+	"7FE00008": {Name: "Float Pixel Data", Keyword: "FloatPixelData", VR: "OF", VM: "1"},
+	"7FE00009": {Name: "Double Float Pixel Data", Keyword: "DoubleFloatPixelData", VR: "OD", VM: "1"},
+	"7FE00010": {Name: "Pixel Data", Keyword: "PixelData", VR: "OB or OW", VM: "1"},
+	"FFFAFFFA": {Name: "Digital Signatures Sequence", Keyword: "DigitalSignaturesSequence", VR: "SQ", VM: "1"},
+	"FFFCFFFC": {Name: "Data Set Trailing Padding", Keyword: "DataSetTrailingPadding", VR: "OB", VM: "1"},
+	"FFFEE000": {Name: "Item", Keyword: "Item"},
+	"FFFEE00D": {Name: "Item Delimitation Item", Keyword: "ItemDelimitationItem"},
+	"FFFEE0DD": {Name: "Sequence Delimitation Item", Keyword: "SequenceDelimitationItem"},
+}
+
+// TagRange - Data Elements of repeating groups keyed by "GGGGEEEE" where
+// X matches any hexadecimal digit.
+var TagRange = map[string]Entry{
+	"002031XX": {Name: "Source Image I Ds", Keyword: "SourceImageIDs", VR: "CS", VM: "1-n", Retired: true},
+	"002804X2": {Name: "Coefficient Coding", Keyword: "CoefficientCoding", VR: "US", VM: "1-n", Retired: true},
+	"002804X3": {Name: "Coefficient Coding Pointers", Keyword: "CoefficientCodingPointers", VR: "AT", VM: "1-n", Retired: true},
+	"002808X0": {Name: "Code Label", Keyword: "CodeLabel", VR: "CS", VM: "1-n", Retired: true},
+	"002808X2": {Name: "Number of Tables", Keyword: "NumberOfTables", VR: "US", VM: "1", Retired: true},
+	"002808X3": {Name: "Code Table Location", Keyword: "CodeTableLocation", VR: "AT", VM: "1-n", Retired: true},
+	"002808X4": {Name: "Bits for Code Word", Keyword: "BitsForCodeWord", VR: "US", VM: "1-n", Retired: true},
+	"002808X8": {Name: "Image Data Location", Keyword: "ImageDataLocation", VR: "AT", VM: "1-n", Retired: true},
+	"1000XXX0": {Name: "Escape Triplet", Keyword: "EscapeTriplet", VR: "US", VM: "3", Retired: true},
+	"1000XXX1": {Name: "Run Length Triplet", Keyword: "RunLengthTriplet", VR: "US", VM: "3", Retired: true},
+	"1000XXX2": {Name: "Huffman Table Size", Keyword: "HuffmanTableSize", VR: "US", VM: "1", Retired: true},
+	"1000XXX3": {Name: "Huffman Table Triplet", Keyword: "HuffmanTableTriplet", VR: "US", VM: "3", Retired: true},
+	"1000XXX4": {Name: "Shift Table Size", Keyword: "ShiftTableSize", VR: "US", VM: "1", Retired: true},
+	"1000XXX5": {Name: "Shift Table Triplet", Keyword: "ShiftTableTriplet", VR: "US", VM: "3", Retired: true},
+	"1010XXXX": {Name: "Zonal Map", Keyword: "ZonalMap", VR: "US", VM: "1-n", Retired: true},
+	"50XX0005": {Name: "Curve Dimensions", Keyword: "CurveDimensions", VR: "US", VM: "1", Retired: true},
+	"50XX0010": {Name: "Number of Points", Keyword: "NumberOfPoints", VR: "US", VM: "1", Retired: true},
+	"50XX0020": {Name: "Type of Data", Keyword: "TypeOfData", VR: "CS", VM: "1", Retired: true},
+	"50XX0022": {Name: "Curve Description", Keyword: "CurveDescription", VR: "LO", VM: "1", Retired: true},
+	"50XX0030": {Name: "Axis Units", Keyword: "AxisUnits", VR: "SH", VM: "1-n", Retired: true},
+	"50XX0040": {Name: "Axis Labels", Keyword: "AxisLabels", VR: "SH", VM: "1-n", Retired: true},
+	"50XX0103": {Name: "Data Value Representation", Keyword: "DataValueRepresentation", VR: "US", VM: "1", Retired: true},
+	"50XX0104": {Name: "Minimum Coordinate Value", Keyword: "MinimumCoordinateValue", VR: "US", VM: "1-n", Retired: true},
+	"50XX0105": {Name: "Maximum Coordinate Value", Keyword: "MaximumCoordinateValue", VR: "US", VM: "1-n", Retired: true},
+	"50XX0106": {Name: "Curve Range", Keyword: "CurveRange", VR: "SH", VM: "1-n", Retired: true},
+	"50XX0110": {Name: "Curve Data Descriptor", Keyword: "CurveDataDescriptor", VR: "US", VM: "1-n", Retired: true},
+	"50XX0112": {Name: "Coordinate Start Value", Keyword: "CoordinateStartValue", VR: "US", VM: "1-n", Retired: true},
+	"50XX0114": {Name: "Coordinate Step Value", Keyword: "CoordinateStepValue", VR: "US", VM: "1-n", Retired: true},
+	"50XX1001": {Name: "Curve Activation Layer", Keyword: "CurveActivationLayer", VR: "CS", VM: "1", Retired: true},
+	"50XX2000": {Name: "Audio Type", Keyword: "AudioType", VR: "US", VM: "1", Retired: true},
+	"50XX2002": {Name: "Audio Sample Format", Keyword: "AudioSampleFormat", VR: "US", VM: "1", Retired: true},
+	"50XX2004": {Name: "Number of Channels", Keyword: "NumberOfChannels", VR: "US", VM: "1", Retired: true},
+	"50XX2006": {Name: "Number of Samples", Keyword: "NumberOfSamples", VR: "UL", VM: "1", Retired: true},
+	"50XX2008": {Name: "Sample Rate", Keyword: "SampleRate", VR: "US", VM: "1", Retired: true},
+	"50XX200A": {Name: "Total Time", Keyword: "TotalTime", VR: "UL", VM: "1", Retired: true},
+	"50XX200C": {Name: "Audio Sample Data", Keyword: "AudioSampleData", VR: "OB or OW", VM: "1", Retired: true},
+	"50XX200E": {Name: "Audio Comments", Keyword: "AudioComments", VR: "LT", VM: "1", Retired: true},
+	"50XX2500": {Name: "Curve Label", Keyword: "CurveLabel", VR: "LO", VM: "1", Retired: true},
+	"50XX2600": {Name: "Curve Referenced Overlay Sequence", Keyword: "CurveReferencedOverlaySequence", VR: "SQ", VM: "1", Retired: true},
+	"50XX2610": {Name: "Referenced Overlay Group", Keyword: "ReferencedOverlayGroup", VR: "US", VM: "1", Retired: true},
+	"50XX3000": {Name: "Curve Data", Keyword: "CurveData", VR: "OB or OW", VM: "1", Retired: true},
+	"60XX0010": {Name: "Overlay Rows", Keyword: "OverlayRows", VR: "US", VM: "1"},
+	"60XX0011": {Name: "Overlay Columns", Keyword: "OverlayColumns", VR: "US", VM: "1"},
+	"60XX0012": {Name: "Overlay Planes", Keyword: "OverlayPlanes", VR: "US", VM: "1", Retired: true},
+	"60XX0015": {Name: "Number of Frames in Overlay", Keyword: "NumberOfFramesInOverlay", VR: "IS", VM: "1"},
+	"60XX0022": {Name: "Overlay Description", Keyword: "OverlayDescription", VR: "LO", VM: "1"},
+	"60XX0040": {Name: "Overlay Type", Keyword: "OverlayType", VR: "CS", VM: "1"},
+	"60XX0045": {Name: "Overlay Subtype", Keyword: "OverlaySubtype", VR: "LO", VM: "1"},
+	"60XX0050": {Name: "Overlay Origin", Keyword: "OverlayOrigin", VR: "SS", VM: "2"},
+	"60XX0051": {Name: "Image Frame Origin", Keyword: "ImageFrameOrigin", VR: "US", VM: "1"},
+	"60XX0052": {Name: "Overlay Plane Origin", Keyword: "OverlayPlaneOrigin", VR: "US", VM: "1", Retired: true},
+	"60XX0060": {Name: "Overlay Compression Code", Keyword: "OverlayCompressionCode", VR: "CS", VM: "1", Retired: true},
+	"60XX0061": {Name: "Overlay Compression Originator", Keyword: "OverlayCompressionOriginator", VR: "SH", VM: "1", Retired: true},
+	"60XX0062": {Name: "Overlay Compression Label", Keyword: "OverlayCompressionLabel", VR: "SH", VM: "1", Retired: true},
+	"60XX0063": {Name: "Overlay Compression Description", Keyword: "OverlayCompressionDescription", VR: "SH", VM: "1", Retired: true},
+	"60XX0066": {Name: "Overlay Compression Step Pointers", Keyword: "OverlayCompressionStepPointers", VR: "AT", VM: "1-n", Retired: true},
+	"60XX0068": {Name: "Overlay Repeat Interval", Keyword: "OverlayRepeatInterval", VR: "US", VM: "1", Retired: true},
+	"60XX0069": {Name: "Overlay Bits Grouped", Keyword: "OverlayBitsGrouped", VR: "US", VM: "1", Retired: true},
+	"60XX0100": {Name: "Overlay Bits Allocated", Keyword: "OverlayBitsAllocated", VR: "US", VM: "1"},
+	"60XX0102": {Name: "Overlay Bit Position", Keyword: "OverlayBitPosition", VR: "US", VM: "1"},
+	"60XX0110": {Name: "Overlay Format", Keyword: "OverlayFormat", VR: "CS", VM: "1", Retired: true},
+	"60XX0200": {Name: "Overlay Location", Keyword: "OverlayLocation", VR: "US", VM: "1", Retired: true},
+	"60XX0800": {Name: "Overlay Code Label", Keyword: "OverlayCodeLabel", VR: "CS", VM: "1-n", Retired: true},
+	"60XX0802": {Name: "Overlay Number of Tables", Keyword: "OverlayNumberOfTables", VR: "US", VM: "1", Retired: true},
+	"60XX0803": {Name: "Overlay Code Table Location", Keyword: "OverlayCodeTableLocation", VR: "AT", VM: "1-n", Retired: true},
+	"60XX0804": {Name: "Overlay Bits for Code Word", Keyword: "OverlayBitsForCodeWord", VR: "US", VM: "1", Retired: true},
+	"60XX1001": {Name: "Overlay Activation Layer", Keyword: "OverlayActivationLayer", VR: "CS", VM: "1"},
+	"60XX1100": {Name: "Overlay Descriptor Gray", Keyword: "OverlayDescriptorGray", VR: "US", VM: "1", Retired: true},
+	"60XX1101": {Name: "Overlay Descriptor Red", Keyword: "OverlayDescriptorRed", VR: "US", VM: "1", Retired: true},
+	"60XX1102": {Name: "Overlay Descriptor Green", Keyword: "OverlayDescriptorGreen", VR: "US", VM: "1", Retired: true},
+	"60XX1103": {Name: "Overlay Descriptor Blue", Keyword: "OverlayDescriptorBlue", VR: "US", VM: "1", Retired: true},
+	"60XX1200": {Name: "Overlays Gray", Keyword: "OverlaysGray", VR: "US", VM: "1-n", Retired: true},
+	"60XX1201": {Name: "Overlays Red", Keyword: "OverlaysRed", VR: "US", VM: "1-n", Retired: true},
+	"60XX1202": {Name: "Overlays Green", Keyword: "OverlaysGreen", VR: "US", VM: "1-n", Retired: true},
+	"60XX1203": {Name: "Overlays Blue", Keyword: "OverlaysBlue", VR: "US", VM: "1-n", Retired: true},
+	"60XX1301": {Name: "ROI Area", Keyword: "ROIArea", VR: "IS", VM: "1"},
+	"60XX1302": {Name: "ROI Mean", Keyword: "ROIMean", VR: "DS", VM: "1"},
+	"60XX1303": {Name: "ROI Standard Deviation", Keyword: "ROIStandardDeviation", VR: "DS", VM: "1"},
+	"60XX1500": {Name: "Overlay Label", Keyword: "OverlayLabel", VR: "LO", VM: "1"},
+	"60XX3000": {Name: "Overlay Data", Keyword: "OverlayData", VR: "OB or OW", VM: "1"},
+	"60XX4000": {Name: "Overlay Comments", Keyword: "OverlayComments", VR: "LT", VM: "1", Retired: true},
+	"7FXX0000": {Name: "Pixel Data Group Length", Keyword: "PixelDataGroupLength", VR: "UL", VM: "1", Retired: true},
+	"7FXX0010": {Name: "Variable Pixel Data", Keyword: "VariablePixelData", VR: "OB or OW", VM: "1", Retired: true},
+	"7FXX0011": {Name: "Variable Next Data Group", Keyword: "VariableNextDataGroup", VR: "US", VM: "1", Retired: true},
+	"7FXX0020": {Name: "Variable Coefficients SDVN", Keyword: "VariableCoefficientsSDVN", VR: "OW", VM: "1", Retired: true},
+	"7FXX0030": {Name: "Variable Coefficients SDHN", Keyword: "VariableCoefficientsSDHN", VR: "OW", VM: "1", Retired: true},
+	"7FXX0040": {Name: "Variable Coefficients SDDN", Keyword: "VariableCoefficientsSDDN", VR: "OW", VM: "1", Retired: true},
+}
+
+// Keyword - Tag and TagRange keys by keyword.
+var Keyword = map[string]string{
+	"FileMetaInformationGroupLength":                          "00020000",
+	"FileMetaInformationVersion":                              "00020001",
+	"MediaStorageSOPClassUID":                                 "00020002",
+	"MediaStorageSOPInstanceUID":                              "00020003",
+	"TransferSyntaxUID":                                       "00020010",
+	"ImplementationClassUID":                                  "00020012",
+	"ImplementationVersionName":                               "00020013",
+	"SourceApplicationEntityTitle":                            "00020016",
+	"PrivateInformationCreatorUID":                            "00020100",
+	"PrivateInformation":                                      "00020102",
+	"FileSetID":                                               "00041130",
+	"FileSetDescriptorFileID":                                 "00041141",
+	"SpecificCharacterSetOfFile":                              "00041142",
+	"OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity": "00041200",
+	"OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity":  "00041202",
+	"FileSetConsistencyFlag":                                  "00041212",
+	"DirectoryRecordSequence":                                 "00041220",
+	"OffsetOfTheNextDirectoryRecord":                          "00041400",
+	"RecordInUseFlag":                                         "00041410",
+	"OffsetOfReferencedLowerLevelDirectoryEntity":             "00041420",
+	"DirectoryRecordType":                                     "00041430",
+	"PrivateRecordUID":                                        "00041432",
+	"ReferencedFileID":                                        "00041500",
+	"MRDRDirectoryRecordOffset":                               "00041504",
+	"ReferencedSOPClassUIDInFile":                             "00041510",
+	"ReferencedSOPInstanceUIDInFile":                          "00041511",
+	"ReferencedTransferSyntaxUIDInFile":                       "00041512",
+	"ReferencedRelatedSOPClassUIDInFile":                      "0004151A",
+	"NumberOfReferences":                                      "00041600",
+	"IdentifyingGroupLength":                                  "00080000",
+	"LengthToEnd":                                             "00080001",
+	"SpecificCharacterSet":                                    "00080005",
+	"LanguageCodeSequence":                                    "00080006",
+	"ImageType":                                               "00080008",
+	"RecognitionCode":                                         "00080010",
+	"InstanceCreationDate":                                    "00080012",
+	"InstanceCreationTime":                                    "00080013",
+	"InstanceCreatorUID":                                      "00080014",
+	"SOPClassUID":                                             "00080016",
+	"SOPInstanceUID":                                          "00080018",
+	"RelatedGeneralSOPClassUID":                               "0008001A",
+	"OriginalSpecializedSOPClassUID":                          "0008001B",
+	"StudyDate":                                               "00080020",
+	"SeriesDate":                                              "00080021",
+	"AcquisitionDate":                                         "00080022",
+	"ContentDate":                                             "00080023",
+	"OverlayDate":                                             "00080024",
+	"CurveDate":                                               "00080025",
+	"AcquisitionDateTime":                                     "0008002A",
+	"StudyTime":                                               "00080030",
+	"SeriesTime":                                              "00080031",
+	"AcquisitionTime":                                         "00080032",
+	"ContentTime":                                             "00080033",
+	"OverlayTime":                                             "00080034",
+	"CurveTime":                                               "00080035",
+	"DataSetType":                                             "00080040",
+	"DataSetSubtype":                                          "00080041",
+	"NuclearMedicineSeriesType":                               "00080042",
+	"AccessionNumber":                                         "00080050",
+	"QueryRetrieveLevel":                                      "00080052",
+	"RetrieveAETitle":                                         "00080054",
+	"InstanceAvailability":                                    "00080056",
+	"FailedSOPInstanceUIDList":                                "00080058",
+	"Modality":                                                "00080060",
+	"ModalitiesInStudy":                                       "00080061",
+	"SOPClassesInStudy":                                       "00080062",
+	"ConversionType":                                          "00080064",
+	"PresentationIntentType":                                  "00080068",
+	"Manufacturer":                                            "00080070",
+	"InstitutionName":                                         "00080080",
+	"InstitutionAddress":                                      "00080081",
+	"InstitutionCodeSequence":                                 "00080082",
+	"ReferringPhysicianName":                                  "00080090",
+	"ReferringPhysicianAddress":                               "00080092",
+	"ReferringPhysicianTelephoneNumber":                       "00080094",
+	"ReferringPhysicianIDSequence":                            "00080096",
+	"CodeValue":                                               "00080100",
+	"CodingSchemeDesignator":                                  "00080102",
+	"CodingSchemeVersion":                                     "00080103",
+	"CodeMeaning":                                             "00080104",
+	"MappingResource":                                         "00080105",
+	"ContextGroupVersion":                                     "00080106",
+	"ContextGroupLocalVersion":                                "00080107",
+	"ContextGroupExtensionFlag":                               "0008010B",
+	"CodingSchemeUID":                                         "0008010C",
+	"ContextGroupExtensionCreatorUID":                         "0008010D",
+	"ContextIdentifier":                                       "0008010F",
+	"CodingSchemeIDSequence":                                  "00080110",
+	"CodingSchemeRegistry":                                    "00080112",
+	"CodingSchemeExternalID":                                  "00080114",
+	"CodingSchemeName":                                        "00080115",
+	"CodingSchemeResponsibleOrganization":                     "00080116",
+	"ContextUID":                                              "00080117",
+	"TimezoneOffsetFromUTC":                                   "00080201",
+	"NetworkID":                                               "00081000",
+	"StationName":                                             "00081010",
+	"StudyDescription":                                        "00081030",
+	"ProcedureCodeSequence":                                   "00081032",
+	"SeriesDescription":                                       "0008103E",
+	"InstitutionalDepartmentName":                             "00081040",
+	"PhysiciansOfRecord":                                      "00081048",
+	"PhysiciansOfRecordIDSequence":                            "00081049",
+	"PerformingPhysicianName":                                 "00081050",
+	"PerformingPhysicianIDSequence":                           "00081052",
+	"NameOfPhysicianReadingStudy":                             "00081060",
+	"PhysicianReadingStudyIDSequence":                         "00081062",
+	"OperatorsName":                                           "00081070",
+	"OperatorIDSequence":                                      "00081072",
+	"AdmittingDiagnosesDescription":                           "00081080",
+	"AdmittingDiagnosesCodeSequence":                          "00081084",
+	"ManufacturerModelName":                                   "00081090",
+	"ReferencedResultsSequence":                               "00081100",
+	"ReferencedStudySequence":                                 "00081110",
+	"ReferencedPerformedProcedureStepSequence":                "00081111",
+	"ReferencedSeriesSequence":                                "00081115",
+	"ReferencedPatientSequence":                               "00081120",
+	"ReferencedVisitSequence":                                 "00081125",
+	"ReferencedOverlaySequence":                               "00081130",
+	"ReferencedWaveformSequence":                              "0008113A",
+	"ReferencedImageSequence":                                 "00081140",
+	"ReferencedCurveSequence":                                 "00081145",
+	"ReferencedInstanceSequence":                              "0008114A",
+	"ReferencedSOPClassUID":                                   "00081150",
+	"ReferencedSOPInstanceUID":                                "00081155",
+	"SOPClassesSupported":                                     "0008115A",
+	"ReferencedFrameNumber":                                   "00081160",
+	"SimpleFrameList":                                         "00081161",
+	"CalculatedFrameList":                                     "00081162",
+	"TimeRange":                                               "00081163",
+	"FrameExtractionSequence":                                 "00081164",
+	"TransactionUID":                                          "00081195",
+	"FailureReason":                                           "00081197",
+	"FailedSOPSequence":                                       "00081198",
+	"ReferencedSOPSequence":                                   "00081199",
+	"StudiesContainingOtherReferencedInstancesSequence":       "00081200",
+	"RelatedSeriesSequence":                                   "00081250",
+	"LossyImageCompressionRetired":                            "00082110",
+	"DerivationDescription":                                   "00082111",
+	"SourceImageSequence":                                     "00082112",
+	"StageName":                                               "00082120",
+	"StageNumber":                                             "00082122",
+	"NumberOfStages":                                          "00082124",
+	"ViewName":                                                "00082127",
+	"ViewNumber":                                              "00082128",
+	"NumberOfEventTimers":                                     "00082129",
+	"NumberOfViewsInStage":                                    "0008212A",
+	"EventElapsedTimes":                                       "00082130",
+	"EventTimerNames":                                         "00082132",
+	"EventTimerSequence":                                      "00082133",
+	"EventTimeOffset":                                         "00082134",
+	"EventCodeSequence":                                       "00082135",
+	"StartTrim":                                               "00082142",
+	"StopTrim":                                                "00082143",
+	"RecommendedDisplayFrameRate":                             "00082144",
+	"TransducerPosition":                                      "00082200",
+	"TransducerOrientation":                                   "00082204",
+	"AnatomicStructure":                                       "00082208",
+	"AnatomicRegionSequence":                                  "00082218",
+	"AnatomicRegionModifierSequence":                          "00082220",
+	"PrimaryAnatomicStructureSequence":                        "00082228",
+	"AnatomicStructureOrRegionSequence":                       "00082229",
+	"AnatomicStructureModifierSequence":                       "00082230",
+	"TransducerPositionSequence":                              "00082240",
+	"TransducerPositionModifierSequence":                      "00082242",
+	"TransducerOrientationSequence":                           "00082244",
+	"TransducerOrientationModifierSequence":                   "00082246",
+	"AnatomicEntrancePortalCodeSequenceTrial":                 "00082253",
+	"AnatomicApproachDirectionCodeSequenceTrial":              "00082255",
+	"AnatomicPerspectiveDescriptionTrial":                     "00082256",
+	"AnatomicPerspectiveCodeSequenceTrial":                    "00082257",
+	"AlternateRepresentationSequence":                         "00083001",
+	"IrradiationEventUID":                                     "00083010",
+	"IdentifyingComments":                                     "00084000",
+	"FrameType":                                               "00089007",
+	"ReferencedImageEvidenceSequence":                         "00089092",
+	"ReferencedRawDataSequence":                               "00089121",
+	"CreatorVersionUID":                                       "00089123",
+	"DerivationImageSequence":                                 "00089124",
+	"SourceImageEvidenceSequence":                             "00089154",
+	"PixelPresentation":                                       "00089205",
+	"VolumetricProperties":                                    "00089206",
+	"VolumeBasedCalculationTechnique":                         "00089207",
+	"ComplexImageComponent":                                   "00089208",
+	"AcquisitionContrast":                                     "00089209",
+	"DerivationCodeSequence":                                  "00089215",
+	"GrayscalePresentationStateSequence":                      "00089237",
+	"ReferencedOtherPlaneSequence":                            "00089410",
+	"FrameDisplaySequence":                                    "00089458",
+	"RecommendedDisplayFrameRateInFloat":                      "00089459",
+	"SkipFrameRangeFlag":                                      "00089460",
+	"PatientGroupLength":                                      "00100000",
+	"PatientName":                                             "00100010",
+	"PatientID":                                               "00100020",
+	"IssuerOfPatientID":                                       "00100021",
+	"TypeOfPatientID":                                         "00100022",
+	"PatientBirthDate":                                        "00100030",
+	"PatientBirthTime":                                        "00100032",
+	"PatientSex":                                              "00100040",
+	"PatientInsurancePlanCodeSequence":                        "00100050",
+	"PatientPrimaryLanguageCodeSequence":                      "00100101",
+	"PatientPrimaryLanguageModifierCodeSequence":              "00100102",
+	"OtherPatientIDs":                                         "00101000",
+	"OtherPatientNames":                                       "00101001",
+	"OtherPatientIDsSequence":                                 "00101002",
+	"PatientBirthName":                                        "00101005",
+	"PatientAge":                                              "00101010",
+	"PatientSize":                                             "00101020",
+	"PatientWeight":                                           "00101030",
+	"PatientAddress":                                          "00101040",
+	"InsurancePlanIdentification":                             "00101050",
+	"PatientMotherBirthName":                                  "00101060",
+	"MilitaryRank":                                            "00101080",
+	"BranchOfService":                                         "00101081",
+	"MedicalRecordLocator":                                    "00101090",
+	"MedicalAlerts":                                           "00102000",
+	"Allergies":                                               "00102110",
+	"CountryOfResidence":                                      "00102150",
+	"RegionOfResidence":                                       "00102152",
+	"PatientTelephoneNumbers":                                 "00102154",
+	"EthnicGroup":                                             "00102160",
+	"Occupation":                                              "00102180",
+	"SmokingStatus":                                           "001021A0",
+	"AdditionalPatientHistory":                                "001021B0",
+	"PregnancyStatus":                                         "001021C0",
+	"LastMenstrualDate":                                       "001021D0",
+	"PatientReligiousPreference":                              "001021F0",
+	"PatientSpeciesDescription":                               "00102201",
+	"PatientSpeciesCodeSequence":                              "00102202",
+	"PatientSexNeutered":                                      "00102203",
+	"AnatomicalOrientationType":                               "00102210",
+	"PatientBreedDescription":                                 "00102292",
+	"PatientBreedCodeSequence":                                "00102293",
+	"BreedRegistrationSequence":                               "00102294",
+	"BreedRegistrationNumber":                                 "00102295",
+	"BreedRegistryCodeSequence":                               "00102296",
+	"ResponsiblePerson":                                       "00102297",
+	"ResponsiblePersonRole":                                   "00102298",
+	"ResponsibleOrganization":                                 "00102299",
+	"PatientComments":                                         "00104000",
+	"ExaminedBodyThickness":                                   "00109431",
+	"ClinicalTrialSponsorName":                                "00120010",
+	"ClinicalTrialProtocolID":                                 "00120020",
+	"ClinicalTrialProtocolName":                               "00120021",
+	"ClinicalTrialSiteID":                                     "00120030",
+	"ClinicalTrialSiteName":                                   "00120031",
+	"ClinicalTrialSubjectID":                                  "00120040",
+	"ClinicalTrialSubjectReadingID":                           "00120042",
+	"ClinicalTrialTimePointID":                                "00120050",
+	"ClinicalTrialTimePointDescription":                       "00120051",
+	"ClinicalTrialCoordinatingCenter":                         "00120060",
+	"PatientIdentityRemoved":                                  "00120062",
+	"DeidentificationMethod":                                  "00120063",
+	"DeidentificationMethodCodeSequence":                      "00120064",
+	"ClinicalTrialSeriesID":                                   "00120071",
+	"ClinicalTrialSeriesDescription":                          "00120072",
+	"DistributionType":                                        "00120084",
+	"ConsentForDistributionFlag":                              "00120085",
+	"AcquisitionGroupLength":                                  "00180000",
+	"ContrastBolusAgent":                                      "00180010",
+	"ContrastBolusAgentSequence":                              "00180012",
+	"ContrastBolusAdministrationRouteSequence":                "00180014",
+	"BodyPartExamined":                                        "00180015",
+	"ScanningSequence":                                        "00180020",
+	"SequenceVariant":                                         "00180021",
+	"ScanOptions":                                             "00180022",
+	"MRAcquisitionType":                                       "00180023",
+	"SequenceName":                                            "00180024",
+	"AngioFlag":                                               "00180025",
+	"InterventionDrugInformationSequence":                     "00180026",
+	"InterventionDrugStopTime":                                "00180027",
+	"InterventionDrugDose":                                    "00180028",
+	"InterventionDrugCodeSequence":                            "00180029",
+	"AdditionalDrugSequence":                                  "0018002A",
+	"Radionuclide":                                            "00180030",
+	"Radiopharmaceutical":                                     "00180031",
+	"EnergyWindowCenterline":                                  "00180032",
+	"EnergyWindowTotalWidth":                                  "00180033",
+	"InterventionDrugName":                                    "00180034",
+	"InterventionDrugStartTime":                               "00180035",
+	"InterventionSequence":                                    "00180036",
+	"TherapyType":                                             "00180037",
+	"InterventionStatus":                                      "00180038",
+	"TherapyDescription":                                      "00180039",
+	"InterventionDescription":                                 "0018003A",
+	"CineRate":                                                "00180040",
+	"InitialCineRunState":                                     "00180042",
+	"SliceThickness":                                          "00180050",
+	"KVP":                                                     "00180060",
+	"CountsAccumulated":                                       "00180070",
+	"AcquisitionTerminationCondition":                         "00180071",
+	"EffectiveDuration":                                       "00180072",
+	"AcquisitionStartCondition":                               "00180073",
+	"AcquisitionStartConditionData":                           "00180074",
+	"AcquisitionEndConditionData":                             "00180075",
+	"RepetitionTime":                                          "00180080",
+	"EchoTime":                                                "00180081",
+	"InversionTime":                                           "00180082",
+	"NumberOfAverages":                                        "00180083",
+	"ImagingFrequency":                                        "00180084",
+	"ImagedNucleus":                                           "00180085",
+	"EchoNumber":                                              "00180086",
+	"MagneticFieldStrength":                                   "00180087",
+	"SpacingBetweenSlices":                                    "00180088",
+	"NumberOfPhaseEncodingSteps":                              "00180089",
+	"DataCollectionDiameter":                                  "00180090",
+	"EchoTrainLength":                                         "00180091",
+	"PercentSampling":                                         "00180093",
+	"PercentPhaseFieldOfView":                                 "00180094",
+	"PixelBandwidth":                                          "00180095",
+	"DeviceSerialNumber":                                      "00181000",
+	"DeviceUID":                                               "00181002",
+	"DeviceID":                                                "00181003",
+	"PlateID":                                                 "00181004",
+	"GeneratorID":                                             "00181005",
+	"GridID":                                                  "00181006",
+	"CassetteID":                                              "00181007",
+	"GantryID":                                                "00181008",
+	"SecondaryCaptureDeviceID":                                "00181010",
+	"HardcopyCreationDeviceID":                                "00181011",
+	"DateOfSecondaryCapture":                                  "00181012",
+	"TimeOfSecondaryCapture":                                  "00181014",
+	"SecondaryCaptureDeviceManufacturer":                      "00181016",
+	"HardcopyDeviceManufacturer":                              "00181017",
+	"SecondaryCaptureDeviceModelName":                         "00181018",
+	"SecondaryCaptureDeviceSoftwareVersions":                  "00181019",
+	"HardcopyDeviceSoftwareVersion":                           "0018101A",
+	"HardcopyDeviceModelName":                                 "0018101B",
+	"SoftwareVersions":                                        "00181020",
+	"VideoImageFormatAcquired":                                "00181022",
+	"DigitalImageFormatAcquired":                              "00181023",
+	"ProtocolName":                                            "00181030",
+	"ContrastBolusRoute":                                      "00181040",
+	"ContrastBolusVolume":                                     "00181041",
+	"ContrastBolusStartTime":                                  "00181042",
+	"ContrastBolusStopTime":                                   "00181043",
+	"ContrastBolusTotalDose":                                  "00181044",
+	"SyringeCounts":                                           "00181045",
+	"ContrastFlowRate":                                        "00181046",
+	"ContrastFlowDuration":                                    "00181047",
+	"ContrastBolusIngredient":                                 "00181048",
+	"ContrastBolusConcentration":                              "00181049",
+	"SpatialResolution":                                       "00181050",
+	"TriggerTime":                                             "00181060",
+	"TriggerSourceOrType":                                     "00181061",
+	"NominalInterval":                                         "00181062",
+	"FrameTime":                                               "00181063",
+	"CardiacFramingType":                                      "00181064",
+	"FrameTimeVector":                                         "00181065",
+	"FrameDelay":                                              "00181066",
+	"ImageTriggerDelay":                                       "00181067",
+	"MultiplexGroupTimeOffset":                                "00181068",
+	"TriggerTimeOffset":                                       "00181069",
+	"SynchronizationTrigger":                                  "0018106A",
+	"SynchronizationChannel":                                  "0018106C",
+	"TriggerSamplePosition":                                   "0018106E",
+	"RadiopharmaceuticalRoute":                                "00181070",
+	"RadiopharmaceuticalVolume":                               "00181071",
+	"RadiopharmaceuticalStartTime":                            "00181072",
+	"RadiopharmaceuticalStopTime":                             "00181073",
+	"RadionuclideTotalDose":                                   "00181074",
+	"RadionuclideHalfLife":                                    "00181075",
+	"RadionuclidePositronFraction":                            "00181076",
+	"RadiopharmaceuticalSpecificActivity":                     "00181077",
+	"RadiopharmaceuticalStartDateTime":                        "00181078",
+	"RadiopharmaceuticalStopDateTime":                         "00181079",
+	"BeatRejectionFlag":                                       "00181080",
+	"LowRRValue":                                              "00181081",
+	"HighRRValue":                                             "00181082",
+	"IntervalsAcquired":                                       "00181083",
+	"IntervalsRejected":                                       "00181084",
+	"PVCRejection":                                            "00181085",
+	"SkipBeats":                                               "00181086",
+	"HeartRate":                                               "00181088",
+	"CardiacNumberOfImages":                                   "00181090",
+	"TriggerWindow":                                           "00181094",
+	"ReconstructionDiameter":                                  "00181100",
+	"DistanceSourceToDetector":                                "00181110",
+	"DistanceSourceToPatient":                                 "00181111",
+	"EstimatedRadiographicMagnification":                      "00181114",
+	"GantryDetectorTilt":                                      "00181120",
+	"GantryDetectorSlew":                                      "00181121",
+	"TableHeight":                                             "00181130",
+	"TableTraverse":                                           "00181131",
+	"TableMotion":                                             "00181134",
+	"TableVerticalIncrement":                                  "00181135",
+	"TableLateralIncrement":                                   "00181136",
+	"TableLongitudinalIncrement":                              "00181137",
+	"TableAngle":                                              "00181138",
+	"TableType":                                               "0018113A",
+	"RotationDirection":                                       "00181140",
+	"AngularPosition":                                         "00181141",
+	"RadialPosition":                                          "00181142",
+	"ScanArc":                                                 "00181143",
+	"AngularStep":                                             "00181144",
+	"CenterOfRotationOffset":                                  "00181145",
+	"RotationOffset":                                          "00181146",
+	"FieldOfViewShape":                                        "00181147",
+	"FieldOfViewDimensions":                                   "00181149",
+	"ExposureTime":                                            "00181150",
+	"XRayTubeCurrent":                                         "00181151",
+	"Exposure":                                                "00181152",
+	"ExposureInuAs":                                           "00181153",
+	"AveragePulseWidth":                                       "00181154",
+	"RadiationSetting":                                        "00181155",
+	"RectificationType":                                       "00181156",
+	"RadiationMode":                                           "0018115A",
+	"ImageAreaDoseProduct":                                    "0018115E",
+	"FilterType":                                              "00181160",
+	"TypeOfFilters":                                           "00181161",
+	"IntensifierSize":                                         "00181162",
+	"ImagerPixelSpacing":                                      "00181164",
+	"Grid":                                                    "00181166",
+	"GeneratorPower":                                          "00181170",
+	"CollimatorGridName":                                      "00181180",
+	"CollimatorType":                                          "00181181",
+	"FocalDistance":                                           "00181182",
+	"XFocusCenter":                                            "00181183",
+	"YFocusCenter":                                            "00181184",
+	"FocalSpots":                                              "00181190",
+	"AnodeTargetMaterial":                                     "00181191",
+	"BodyPartThickness":                                       "001811A0",
+	"CompressionForce":                                        "001811A2",
+	"DateOfLastCalibration":                                   "00181200",
+	"TimeOfLastCalibration":                                   "00181201",
+	"ConvolutionKernel":                                       "00181210",
+	"UpperLowerPixelValues":                                   "00181240",
+	"ActualFrameDuration":                                     "00181242",
+	"CountRate":                                               "00181243",
+	"PreferredPlaybackSequencing":                             "00181244",
+	"ReceiveCoilName":                                         "00181250",
+	"TransmitCoilName":                                        "00181251",
+	"PlateType":                                               "00181260",
+	"PhosphorType":                                            "00181261",
+	"ScanVelocity":                                            "00181300",
+	"WholeBodyTechnique":                                      "00181301",
+	"ScanLength":                                              "00181302",
+	"AcquisitionMatrix":                                       "00181310",
+	"InPlanePhaseEncodingDirection":                           "00181312",
+	"FlipAngle":                                               "00181314",
+	"VariableFlipAngleFlag":                                   "00181315",
+	"SAR":                                                     "00181316",
+	"dBdt":                                                    "00181318",
+	"AcquisitionDeviceProcessingDescription":                  "00181400",
+	"AcquisitionDeviceProcessingCode":                         "00181401",
+	"CassetteOrientation":                                     "00181402",
+	"CassetteSize":                                            "00181403",
+	"ExposuresOnPlate":                                        "00181404",
+	"RelativeXRayExposure":                                    "00181405",
+	"ColumnAngulation":                                        "00181450",
+	"TomoLayerHeight":                                         "00181460",
+	"TomoAngle":                                               "00181470",
+	"TomoTime":                                                "00181480",
+	"TomoType":                                                "00181490",
+	"TomoClass":                                               "00181491",
+	"NumberOfTomosynthesisSourceImages":                       "00181495",
+	"PositionerMotion":                                        "00181500",
+	"PositionerType":                                          "00181508",
+	"PositionerPrimaryAngle":                                  "00181510",
+	"PositionerSecondaryAngle":                                "00181511",
+	"PositionerPrimaryAngleIncrement":                         "00181520",
+	"PositionerSecondaryAngleIncrement":                       "00181521",
+	"DetectorPrimaryAngle":                                    "00181530",
+	"DetectorSecondaryAngle":                                  "00181531",
+	"ShutterShape":                                            "00181600",
+	"ShutterLeftVerticalEdge":                                 "00181602",
+	"ShutterRightVerticalEdge":                                "00181604",
+	"ShutterUpperHorizontalEdge":                              "00181606",
+	"ShutterLowerHorizontalEdge":                              "00181608",
+	"CenterOfCircularShutter":                                 "00181610",
+	"RadiusOfCircularShutter":                                 "00181612",
+	"VerticesOfPolygonalShutter":                              "00181620",
+	"ShutterPresentationValue":                                "00181622",
+	"ShutterOverlayGroup":                                     "00181623",
+	"ShutterPresentationColorCIELabValue":                     "00181624",
+	"CollimatorShape":                                         "00181700",
+	"CollimatorLeftVerticalEdge":                              "00181702",
+	"CollimatorRightVerticalEdge":                             "00181704",
+	"CollimatorUpperHorizontalEdge":                           "00181706",
+	"CollimatorLowerHorizontalEdge":                           "00181708",
+	"CenterOfCircularCollimator":                              "00181710",
+	"RadiusOfCircularCollimator":                              "00181712",
+	"VerticesOfPolygonalCollimator":                           "00181720",
+	"AcquisitionTimeSynchronized":                             "00181800",
+	"TimeSource":                                              "00181801",
+	"TimeDistributionProtocol":                                "00181802",
+	"NTPSourceAddress":                                        "00181803",
+	"PageNumberVector":                                        "00182001",
+	"FrameLabelVector":                                        "00182002",
+	"FramePrimaryAngleVector":                                 "00182003",
+	"FrameSecondaryAngleVector":                               "00182004",
+	"SliceLocationVector":                                     "00182005",
+	"DisplayWindowLabelVector":                                "00182006",
+	"NominalScannedPixelSpacing":                              "00182010",
+	"DigitizingDeviceTransportDirection":                      "00182020",
+	"RotationOfScannedFilm":                                   "00182030",
+	"IVUSAcquisition":                                         "00183100",
+	"IVUSPullbackRate":                                        "00183101",
+	"IVUSGatedRate":                                           "00183102",
+	"IVUSPullbackStartFrameNumber":                            "00183103",
+	"IVUSPullbackStopFrameNumber":                             "00183104",
+	"LesionNumber":                                            "00183105",
+	"AcquisitionComments":                                     "00184000",
+	"OutputPower":                                             "00185000",
+	"TransducerData":                                          "00185010",
+	"FocusDepth":                                              "00185012",
+	"ProcessingFunction":                                      "00185020",
+	"PostprocessingFunction":                                  "00185021",
+	"MechanicalIndex":                                         "00185022",
+	"BoneThermalIndex":                                        "00185024",
+	"CranialThermalIndex":                                     "00185026",
+	"SoftTissueThermalIndex":                                  "00185027",
+	"SoftTissueFocusThermalIndex":                             "00185028",
+	"SoftTissueSurfaceThermalIndex":                           "00185029",
+	"DynamicRange":                                            "00185030",
+	"TotalGain":                                               "00185040",
+	"DepthOfScanField":                                        "00185050",
+	"PatientPosition":                                         "00185100",
+	"ViewPosition":                                            "00185101",
+	"ProjectionEponymousNameCodeSequence":                     "00185104",
+	"ImageTransformationMatrix":                               "00185210",
+	"ImageTranslationVector":                                  "00185212",
+	"Sensitivity":                                             "00186000",
+	"SequenceOfUltrasoundRegions":                             "00186011",
+	"RegionSpatialFormat":                                     "00186012",
+	"RegionDataType":                                          "00186014",
+	"RegionFlags":                                             "00186016",
+	"RegionLocationMinX0":                                     "00186018",
+	"RegionLocationMinY0":                                     "0018601A",
+	"RegionLocationMaxX1":                                     "0018601C",
+	"RegionLocationMaxY1":                                     "0018601E",
+	"ReferencePixelX0":                                        "00186020",
+	"ReferencePixelY0":                                        "00186022",
+	"PhysicalUnitsXDirection":                                 "00186024",
+	"PhysicalUnitsYDirection":                                 "00186026",
+	"ReferencePixelPhysicalValueX":                            "00186028",
+	"ReferencePixelPhysicalValueY":                            "0018602A",
+	"PhysicalDeltaX":                                          "0018602C",
+	"PhysicalDeltaY":                                          "0018602E",
+	"TransducerFrequency":                                     "00186030",
+	"TransducerType":                                          "00186031",
+	"PulseRepetitionFrequency":                                "00186032",
+	"DopplerCorrectionAngle":                                  "00186034",
+	"SteeringAngle":                                           "00186036",
+	"DopplerSampleVolumeXPositionRetired":                     "00186038",
+	"DopplerSampleVolumeXPosition":                            "00186039",
+	"DopplerSampleVolumeYPositionRetired":                     "0018603A",
+	"DopplerSampleVolumeYPosition":                            "0018603B",
+	"TMLinePositionX0Retired":                                 "0018603C",
+	"TMLinePositionX0":                                        "0018603D",
+	"TMLinePositionY0Retired":                                 "0018603E",
+	"TMLinePositionY0":                                        "0018603F",
+	"TMLinePositionX1Retired":                                 "00186040",
+	"TMLinePositionX1":                                        "00186041",
+	"TMLinePositionY1Retired":                                 "00186042",
+	"TMLinePositionY1":                                        "00186043",
+	"PixelComponentOrganization":                              "00186044",
+	"PixelComponentMask":                                      "00186046",
+	"PixelComponentRangeStart":                                "00186048",
+	"PixelComponentRangeStop":                                 "0018604A",
+	"PixelComponentPhysicalUnits":                             "0018604C",
+	"PixelComponentDataType":                                  "0018604E",
+	"NumberOfTableBreakPoints":                                "00186050",
+	"TableOfXBreakPoints":                                     "00186052",
+	"TableOfYBreakPoints":                                     "00186054",
+	"NumberOfTableEntries":                                    "00186056",
+	"TableOfPixelValues":                                      "00186058",
+	"TableOfParameterValues":                                  "0018605A",
+	"RWaveTimeVector":                                         "00186060",
+	"DetectorConditionsNominalFlag":                           "00187000",
+	"DetectorTemperature":                                     "00187001",
+	"DetectorType":                                            "00187004",
+	"DetectorConfiguration":                                   "00187005",
+	"DetectorDescription":                                     "00187006",
+	"DetectorMode":                                            "00187008",
+	"DetectorID":                                              "0018700A",
+	"DateOfLastDetectorCalibration":                           "0018700C",
+	"TimeOfLastDetectorCalibration":                           "0018700E",
+	"DetectorExposuresSinceCalibration":                       "00187010",
+	"DetectorExposuresSinceManufactured":                      "00187011",
+	"DetectorTimeSinceLastExposure":                           "00187012",
+	"DetectorActiveTime":                                      "00187014",
+	"DetectorActiveOffsetFromExposure":                        "00187016",
+	"DetectorBinning":                                         "0018701A",
+	"DetectorElementPhysicalSize":                             "00187020",
+	"DetectorElementSpacing":                                  "00187022",
+	"DetectorActiveShape":                                     "00187024",
+	"DetectorActiveDimensions":                                "00187026",
+	"DetectorActiveOrigin":                                    "00187028",
+	"DetectorManufacturerName":                                "0018702A",
+	"DetectorManufacturerModelName":                           "0018702B",
+	"FieldOfViewOrigin":                                       "00187030",
+	"FieldOfViewRotation":                                     "00187032",
+	"FieldOfViewHorizontalFlip":                               "00187034",
+	"GridAbsorbingMaterial":                                   "00187040",
+	"GridSpacingMaterial":                                     "00187041",
+	"GridThickness":                                           "00187042",
+	"GridPitch":                                               "00187044",
+	"GridAspectRatio":                                         "00187046",
+	"GridPeriod":                                              "00187048",
+	"GridFocalDistance":                                       "0018704C",
+	"FilterMaterial":                                          "00187050",
+	"FilterThicknessMinimum":                                  "00187052",
+	"FilterThicknessMaximum":                                  "00187054",
+	"ExposureControlMode":                                     "00187060",
+	"ExposureControlModeDescription":                          "00187062",
+	"ExposureStatus":                                          "00187064",
+	"PhototimerSetting":                                       "00187065",
+	"ExposureTimeInuS":                                        "00188150",
+	"XRayTubeCurrentInuA":                                     "00188151",
+	"ContentQualification":                                    "00189004",
+	"PulseSequenceName":                                       "00189005",
+	"MRImagingModifierSequence":                               "00189006",
+	"EchoPulseSequence":                                       "00189008",
+	"InversionRecovery":                                       "00189009",
+	"FlowCompensation":                                        "00189010",
+	"MultipleSpinEcho":                                        "00189011",
+	"MultiPlanarExcitation":                                   "00189012",
+	"PhaseContrast":                                           "00189014",
+	"TimeOfFlightContrast":                                    "00189015",
+	"Spoiling":                                                "00189016",
+	"SteadyStatePulseSequence":                                "00189017",
+	"EchoPlanarPulseSequence":                                 "00189018",
+	"TagAngleFirstAxis":                                       "00189019",
+	"MagnetizationTransfer":                                   "00189020",
+	"T2Preparation":                                           "00189021",
+	"BloodSignalNulling":                                      "00189022",
+	"SaturationRecovery":                                      "00189024",
+	"SpectrallySelectedSuppression":                           "00189025",
+	"SpectrallySelectedExcitation":                            "00189026",
+	"SpatialPresaturation":                                    "00189027",
+	"Tagging":                                                 "00189028",
+	"OversamplingPhase":                                       "00189029",
+	"TagSpacingFirstDimension":                                "00189030",
+	"GeometryOfKSpaceTraversal":                               "00189032",
+	"SegmentedKSpaceTraversal":                                "00189033",
+	"RectilinearPhaseEncodeReordering":                        "00189034",
+	"TagThickness":                                            "00189035",
+	"PartialFourierDirection":                                 "00189036",
+	"CardiacSynchronizationTechnique":                         "00189037",
+	"ReceiveCoilManufacturerName":                             "00189041",
+	"MRReceiveCoilSequence":                                   "00189042",
+	"ReceiveCoilType":                                         "00189043",
+	"QuadratureReceiveCoil":                                   "00189044",
+	"MultiCoilDefinitionSequence":                             "00189045",
+	"MultiCoilConfiguration":                                  "00189046",
+	"MultiCoilElementName":                                    "00189047",
+	"MultiCoilElementUsed":                                    "00189048",
+	"MRTransmitCoilSequence":                                  "00189049",
+	"TransmitCoilManufacturerName":                            "00189050",
+	"TransmitCoilType":                                        "00189051",
+	"SpectralWidth":                                           "00189052",
+	"ChemicalShiftReference":                                  "00189053",
+	"VolumeLocalizationTechnique":                             "00189054",
+	"MRAcquisitionFrequencyEncodeSteps":                       "00189058",
+	"Decoupling":                                              "00189059",
+	"DecoupledNucleus":                                        "00189060",
+	"DecouplingFrequency":                                     "00189061",
+	"DecouplingMethod":                                        "00189062",
+	"DecouplingChemicalShiftReference":                        "00189063",
+	"KSpaceFiltering":                                         "00189064",
+	"TimeDomainFiltering":                                     "00189065",
+	"NumberOfZeroFills":                                       "00189066",
+	"BaselineCorrection":                                      "00189067",
+	"ParallelReductionFactorInPlane":                          "00189069",
+	"CardiacRRIntervalSpecified":                              "00189070",
+	"AcquisitionDuration":                                     "00189073",
+	"FrameAcquisitionDateTime":                                "00189074",
+	"DiffusionDirectionality":                                 "00189075",
+	"DiffusionGradientDirectionSequence":                      "00189076",
+	"ParallelAcquisition":                                     "00189077",
+	"ParallelAcquisitionTechnique":                            "00189078",
+	"InversionTimes":                                          "00189079",
+	"MetaboliteMapDescription":                                "00189080",
+	"PartialFourier":                                          "00189081",
+	"EffectiveEchoTime":                                       "00189082",
+	"MetaboliteMapCodeSequence":                               "00189083",
+	"ChemicalShiftSequence":                                   "00189084",
+	"CardiacSignalSource":                                     "00189085",
+	"DiffusionBValue":                                         "00189087",
+	"DiffusionGradientOrientation":                            "00189089",
+	"VelocityEncodingDirection":                               "00189090",
+	"VelocityEncodingMinimumValue":                            "00189091",
+	"NumberOfKSpaceTrajectories":                              "00189093",
+	"CoverageOfKSpace":                                        "00189094",
+	"SpectroscopyAcquisitionPhaseRows":                        "00189095",
+	"ParallelReductionFactorInPlaneRetired":                   "00189096",
+	"TransmitterFrequency":                                    "00189098",
+	"ResonantNucleus":                                         "00189100",
+	"FrequencyCorrection":                                     "00189101",
+	"MRSpectroscopyFOVGeometrySequence":                       "00189103",
+	"SlabThickness":                                           "00189104",
+	"SlabOrientation":                                         "00189105",
+	"MidSlabPosition":                                         "00189106",
+	"MRSpatialSaturationSequence":                             "00189107",
+	"MRTimingAndRelatedParametersSequence":                    "00189112",
+	"MREchoSequence":                                          "00189114",
+	"MRModifierSequence":                                      "00189115",
+	"MRDiffusionSequence":                                     "00189117",
+	"CardiacTriggerSequence":                                  "00189118",
+	"MRAveragesSequence":                                      "00189119",
+	"MRFOVGeometrySequence":                                   "00189125",
+	"VolumeLocalizationSequence":                              "00189126",
+	"SpectroscopyAcquisitionDataColumns":                      "00189127",
+	"DiffusionAnisotropyType":                                 "00189147",
+	"FrameReferenceDateTime":                                  "00189151",
+	"MRMetaboliteMapSequence":                                 "00189152",
+	"ParallelReductionFactorOutOfPlane":                       "00189155",
+	"SpectroscopyOutOfPlanePhaseSteps":                        "00189159",
+	"BulkMotionStatus":                                        "00189166",
+	"ParallelReductionFactorSecondInPlane":                    "00189168",
+	"CardiacBeatRejectionTechnique":                           "00189169",
+	"RespiratoryMotionCompensationTechnique":                  "00189170",
+	"RespiratorySignalSource":                                 "00189171",
+	"BulkMotionCompensationTechnique":                         "00189172",
+	"BulkMotionSignalSource":                                  "00189173",
+	"ApplicableSafetyStandardAgency":                          "00189174",
+	"ApplicableSafetyStandardDescription":                     "00189175",
+	"OperatingModeSequence":                                   "00189176",
+	"OperatingModeType":                                       "00189177",
+	"OperatingMode":                                           "00189178",
+	"SpecificAbsorptionRateDefinition":                        "00189179",
+	"GradientOutputType":                                      "00189180",
+	"SpecificAbsorptionRateValue":                             "00189181",
+	"GradientOutput":                                          "00189182",
+	"FlowCompensationDirection":                               "00189183",
+	"TaggingDelay":                                            "00189184",
+	"RespiratoryMotionCompensationTechniqueDescription":       "00189185",
+	"RespiratorySignalSourceID":                               "00189186",
+	"ChemicalShiftMinimumIntegrationLimitInHz":                "00189195",
+	"ChemicalShiftMaximumIntegrationLimitInHz":                "00189196",
+	"MRVelocityEncodingSequence":                              "00189197",
+	"FirstOrderPhaseCorrection":                               "00189198",
+	"WaterReferencedPhaseCorrection":                          "00189199",
+	"MRSpectroscopyAcquisitionType":                           "00189200",
+	"RespiratoryCyclePosition":                                "00189214",
+	"VelocityEncodingMaximumValue":                            "00189217",
+	"TagSpacingSecondDimension":                               "00189218",
+	"TagAngleSecondAxis":                                      "00189219",
+	"FrameAcquisitionDuration":                                "00189220",
+	"MRImageFrameTypeSequence":                                "00189226",
+	"MRSpectroscopyFrameTypeSequence":                         "00189227",
+	"MRAcquisitionPhaseEncodingStepsInPlane":                  "00189231",
+	"MRAcquisitionPhaseEncodingStepsOutOfPlane":               "00189232",
+	"SpectroscopyAcquisitionPhaseColumns":                     "00189234",
+	"CardiacCyclePosition":                                    "00189236",
+	"SpecificAbsorptionRateSequence":                          "00189239",
+	"RFEchoTrainLength":                                       "00189240",
+	"GradientEchoTrainLength":                                 "00189241",
+	"ChemicalShiftMinimumIntegrationLimitInppm":               "00189295",
+	"ChemicalShiftMaximumIntegrationLimitInppm":               "00189296",
+	"CTAcquisitionTypeSequence":                               "00189301",
+	"AcquisitionType":                                         "00189302",
+	"TubeAngle":                                               "00189303",
+	"CTAcquisitionDetailsSequence":                            "00189304",
+	"RevolutionTime":                                          "00189305",
+	"SingleCollimationWidth":                                  "00189306",
+	"TotalCollimationWidth":                                   "00189307",
+	"CTTableDynamicsSequence":                                 "00189308",
+	"TableSpeed":                                              "00189309",
+	"TableFeedPerRotation":                                    "00189310",
+	"SpiralPitchFactor":                                       "00189311",
+	"CTGeometrySequence":                                      "00189312",
+	"DataCollectionCenterPatient":                             "00189313",
+	"CTReconstructionSequence":                                "00189314",
+	"ReconstructionAlgorithm":                                 "00189315",
+	"ConvolutionKernelGroup":                                  "00189316",
+	"ReconstructionFieldOfView":                               "00189317",
+	"ReconstructionTargetCenterPatient":                       "00189318",
+	"ReconstructionAngle":                                     "00189319",
+	"ImageFilter":                                             "00189320",
+	"CTExposureSequence":                                      "00189321",
+	"ReconstructionPixelSpacing":                              "00189322",
+	"ExposureModulationType":                                  "00189323",
+	"EstimatedDoseSaving":                                     "00189324",
+	"CTXRayDetailsSequence":                                   "00189325",
+	"CTPositionSequence":                                      "00189326",
+	"TablePosition":                                           "00189327",
+	"ExposureTimeInms":                                        "00189328",
+	"CTImageFrameTypeSequence":                                "00189329",
+	"XRayTubeCurrentInmA":                                     "00189330",
+	"ExposureInmAs":                                           "00189332",
+	"ConstantVolumeFlag":                                      "00189333",
+	"FluoroscopyFlag":                                         "00189334",
+	"DistanceSourceToDataCollectionCenter":                    "00189335",
+	"ContrastBolusAgentNumber":                                "00189337",
+	"ContrastBolusIngredientCodeSequence":                     "00189338",
+	"ContrastAdministrationProfileSequence":                   "00189340",
+	"ContrastBolusUsageSequence":                              "00189341",
+	"ContrastBolusAgentAdministered":                          "00189342",
+	"ContrastBolusAgentDetected":                              "00189343",
+	"ContrastBolusAgentPhase":                                 "00189344",
+	"CTDIvol":                                                 "00189345",
+	"CTDIPhantomTypeCodeSequence":                             "00189346",
+	"CalciumScoringMassFactorPatient":                         "00189351",
+	"CalciumScoringMassFactorDevice":                          "00189352",
+	"EnergyWeightingFactor":                                   "00189353",
+	"CTAdditionalXRaySourceSequence":                          "00189360",
+	"ProjectionPixelCalibrationSequence":                      "00189401",
+	"DistanceSourceToIsocenter":                               "00189402",
+	"DistanceObjectToTableTop":                                "00189403",
+	"ObjectPixelSpacingInCenterOfBeam":                        "00189404",
+	"PositionerPositionSequence":                              "00189405",
+	"TablePositionSequence":                                   "00189406",
+	"CollimatorShapeSequence":                                 "00189407",
+	"XAXRFFrameCharacteristicsSequence":                       "00189412",
+	"FrameAcquisitionSequence":                                "00189417",
+	"XRayReceptorType":                                        "00189420",
+	"AcquisitionProtocolName":                                 "00189423",
+	"AcquisitionProtocolDescription":                          "00189424",
+	"ContrastBolusIngredientOpaque":                           "00189425",
+	"DistanceReceptorPlaneToDetectorHousing":                  "00189426",
+	"IntensifierActiveShape":                                  "00189427",
+	"IntensifierActiveDimensions":                             "00189428",
+	"PhysicalDetectorSize":                                    "00189429",
+	"PositionOfIsocenterProjection":                           "00189430",
+	"FieldOfViewSequence":                                     "00189432",
+	"FieldOfViewDescription":                                  "00189433",
+	"ExposureControlSensingRegionsSequence":                   "00189434",
+	"ExposureControlSensingRegionShape":                       "00189435",
+	"ExposureControlSensingRegionLeftVerticalEdge":            "00189436",
+	"ExposureControlSensingRegionRightVerticalEdge":           "00189437",
+	"CenterOfCircularExposureControlSensingRegion":            "00189440",
+	"RadiusOfCircularExposureControlSensingRegion":            "00189441",
+	"ColumnAngulationPatient":                                 "00189447",
+	"BeamAngle":                                               "00189449",
+	"FrameDetectorParametersSequence":                         "00189451",
+	"CalculatedAnatomyThickness":                              "00189452",
+	"CalibrationSequence":                                     "00189455",
+	"ObjectThicknessSequence":                                 "00189456",
+	"PlaneIdentification":                                     "00189457",
+	"FieldOfViewDimensionsInFloat":                            "00189461",
+	"IsocenterReferenceSystemSequence":                        "00189462",
+	"PositionerIsocenterPrimaryAngle":                         "00189463",
+	"PositionerIsocenterSecondaryAngle":                       "00189464",
+	"PositionerIsocenterDetectorRotationAngle":                "00189465",
+	"TableXPositionToIsocenter":                               "00189466",
+	"TableYPositionToIsocenter":                               "00189467",
+	"TableZPositionToIsocenter":                               "00189468",
+	"TableHorizontalRotationAngle":                            "00189469",
+	"TableHeadTiltAngle":                                      "00189470",
+	"TableCradleTiltAngle":                                    "00189471",
+	"FrameDisplayShutterSequence":                             "00189472",
+	"AcquiredImageAreaDoseProduct":                            "00189473",
+	"CArmPositionerTabletopRelationship":                      "00189474",
+	"XRayGeometrySequence":                                    "00189476",
+	"IrradiationEventIDSequence":                              "00189477",
+	"XRay3DFrameTypeSequence":                                 "00189504",
+	"ContributingSourcesSequence":                             "00189506",
+	"XRay3DAcquisitionSequence":                               "00189507",
+	"PrimaryPositionerScanArc":                                "00189508",
+	"SecondaryPositionerScanArc":                              "00189509",
+	"PrimaryPositionerScanStartAngle":                         "00189510",
+	"SecondaryPositionerScanStartAngle":                       "00189511",
+	"PrimaryPositionerIncrement":                              "00189514",
+	"SecondaryPositionerIncrement":                            "00189515",
+	"StartAcquisitionDateTime":                                "00189516",
+	"EndAcquisitionDateTime":                                  "00189517",
+	"ApplicationName":                                         "00189524",
+	"ApplicationVersion":                                      "00189525",
+	"ApplicationManufacturer":                                 "00189526",
+	"AlgorithmType":                                           "00189527",
+	"AlgorithmDescription":                                    "00189528",
+	"XRay3DReconstructionSequence":                            "00189530",
+	"ReconstructionDescription":                               "00189531",
+	"PerProjectionAcquisitionSequence":                        "00189538",
+	"DiffusionBMatrixSequence":                                "00189601",
+	"DiffusionBValueXX":                                       "00189602",
+	"DiffusionBValueXY":                                       "00189603",
+	"DiffusionBValueXZ":                                       "00189604",
+	"DiffusionBValueYY":                                       "00189605",
+	"DiffusionBValueYZ":                                       "00189606",
+	"DiffusionBValueZZ":                                       "00189607",
+	"DecayCorrectionDateTime":                                 "00189701",
+	"StartDensityThreshold":                                   "00189715",
+	"TerminationTimeThreshold":                                "00189722",
+	"DetectorGeometry":                                        "00189725",
+	"AxialDetectorDimension":                                  "00189727",
+	"PETPositionSequence":                                     "00189735",
+	"NumberOfIterations":                                      "00189739",
+	"NumberOfSubsets":                                         "00189740",
+	"PETFrameTypeSequence":                                    "00189751",
+	"ReconstructionType":                                      "00189756",
+	"DecayCorrected":                                          "00189758",
+	"AttenuationCorrected":                                    "00189759",
+	"ScatterCorrected":                                        "00189760",
+	"DeadTimeCorrected":                                       "00189761",
+	"GantryMotionCorrected":                                   "00189762",
+	"PatientMotionCorrected":                                  "00189763",
+	"RandomsCorrected":                                        "00189765",
+	"SensitivityCalibrated":                                   "00189767",
+	"DepthsOfFocus":                                           "00189801",
+	"ExclusionStartDateTime":                                  "00189804",
+	"ExclusionDuration":                                       "00189805",
+	"ImageDataTypeSequence":                                   "00189807",
+	"DataType":                                                "00189808",
+	"AliasedDataType":                                         "0018980B",
+	"ContributingEquipmentSequence":                           "0018A001",
+	"ContributionDateTime":                                    "0018A002",
+	"ContributionDescription":                                 "0018A003",
+	"RelationshipGroupLength":                                 "00200000",
+	"StudyInstanceUID":                                        "0020000D",
+	"SeriesInstanceUID":                                       "0020000E",
+	"StudyID":                                                 "00200010",
+	"SeriesNumber":                                            "00200011",
+	"AcquisitionNumber":                                       "00200012",
+	"InstanceNumber":                                          "00200013",
+	"IsotopeNumber":                                           "00200014",
+	"PhaseNumber":                                             "00200015",
+	"IntervalNumber":                                          "00200016",
+	"TimeSlotNumber":                                          "00200017",
+	"AngleNumber":                                             "00200018",
+	"ItemNumber":                                              "00200019",
+	"PatientOrientation":                                      "00200020",
+	"OverlayNumber":                                           "00200022",
+	"CurveNumber":                                             "00200024",
+	"LookupTableNumber":                                       "00200026",
+	"ImagePosition":                                           "00200030",
+	"ImagePositionPatient":                                    "00200032",
+	"ImageOrientation":                                        "00200035",
+	"ImageOrientationPatient":                                 "00200037",
+	"Location":                                                "00200050",
+	"FrameOfReferenceUID":                                     "00200052",
+	"Laterality":                                              "00200060",
+	"ImageLaterality":                                         "00200062",
+	"ImageGeometryType":                                       "00200070",
+	"MaskingImage":                                            "00200080",
+	"TemporalPositionIdentifier":                              "00200100",
+	"NumberOfTemporalPositions":                               "00200105",
+	"TemporalResolution":                                      "00200110",
+	"SynchronizationFrameOfReferenceUID":                      "00200200",
+	"SeriesInStudy":                                           "00201000",
+	"AcquisitionsInSeries":                                    "00201001",
+	"ImagesInAcquisition":                                     "00201002",
+	"ImagesInSeries":                                          "00201003",
+	"AcquisitionsInStudy":                                     "00201004",
+	"ImagesInStudy":                                           "00201005",
+	"Reference":                                               "00201020",
+	"PositionReferenceIndicator":                              "00201040",
+	"SliceLocation":                                           "00201041",
+	"OtherStudyNumbers":                                       "00201070",
+	"NumberOfPatientRelatedStudies":                           "00201200",
+	"NumberOfPatientRelatedSeries":                            "00201202",
+	"NumberOfPatientRelatedInstances":                         "00201204",
+	"NumberOfStudyRelatedSeries":                              "00201206",
+	"NumberOfStudyRelatedInstances":                           "00201208",
+	"NumberOfSeriesRelatedInstances":                          "00201209",
+	"SourceImageIDs":                                          "002031XX",
+	"ModifyingDeviceID":                                       "00203401",
+	"ModifiedImageID":                                         "00203402",
+	"ModifiedImageDate":                                       "00203403",
+	"ModifyingDeviceManufacturer":                             "00203404",
+	"ModifiedImageTime":                                       "00203405",
+	"ModifiedImageDescription":                                "00203406",
+	"ImageComments":                                           "00204000",
+	"OriginalImageIdentification":                             "00205000",
+	"OriginalImageIdentificationNomenclature":                 "00205002",
+	"StackID":                                                 "00209056",
+	"InStackPositionNumber":                                   "00209057",
+	"FrameAnatomySequence":                                    "00209071",
+	"FrameLaterality":                                         "00209072",
+	"FrameContentSequence":                                    "00209111",
+	"PlanePositionSequence":                                   "00209113",
+	"PlaneOrientationSequence":                                "00209116",
+	"TemporalPositionIndex":                                   "00209128",
+	"TriggerDelayTime":                                        "00209153",
+	"FrameAcquisitionNumber":                                  "00209156",
+	"DimensionIndexValues":                                    "00209157",
+	"FrameComments":                                           "00209158",
+	"ConcatenationUID":                                        "00209161",
+	"InConcatenationNumber":                                   "00209162",
+	"InConcatenationTotalNumber":                              "00209163",
+	"DimensionOrganizationUID":                                "00209164",
+	"DimensionIndexPointer":                                   "00209165",
+	"FunctionalGroupPointer":                                  "00209167",
+	"DimensionIndexPrivateCreator":                            "00209213",
+	"DimensionOrganizationSequence":                           "00209221",
+	"DimensionIndexSequence":                                  "00209222",
+	"ConcatenationFrameOffsetNumber":                          "00209228",
+	"FunctionalGroupPrivateCreator":                           "00209238",
+	"NominalPercentageOfCardiacPhase":                         "00209241",
+	"NominalPercentageOfRespiratoryPhase":                     "00209245",
+	"StartingRespiratoryAmplitude":                            "00209246",
+	"StartingRespiratoryPhase":                                "00209247",
+	"EndingRespiratoryAmplitude":                              "00209248",
+	"EndingRespiratoryPhase":                                  "00209249",
+	"RespiratoryTriggerType":                                  "00209250",
+	"RRIntervalTimeNominal":                                   "00209251",
+	"ActualCardiacTriggerDelayTime":                           "00209252",
+	"RespiratorySynchronizationSequence":                      "00209253",
+	"RespiratoryIntervalTime":                                 "00209254",
+	"NominalRespiratoryTriggerDelayTime":                      "00209255",
+	"RespiratoryTriggerDelayThreshold":                        "00209256",
+	"ActualRespiratoryTriggerDelayTime":                       "00209257",
+	"ImagePositionVolume":                                     "00209301",
+	"ImageOrientationVolume":                                  "00209302",
+	"ApexPosition":                                            "00209308",
+	"DimensionDescriptionLabel":                               "00209421",
+	"PatientOrientationInFrameSequence":                       "00209450",
+	"FrameLabel":                                              "00209453",
+	"AcquisitionIndex":                                        "00209518",
+	"ContributingSOPInstancesReferenceSequence":               "00209529",
+	"ReconstructionIndex":                                     "00209536",
+	"LightPathFilterPassThroughWavelength":                    "00220001",
+	"LightPathFilterPassBand":                                 "00220002",
+	"ImagePathFilterPassThroughWavelength":                    "00220003",
+	"ImagePathFilterPassBand":                                 "00220004",
+	"PatientEyeMovementCommanded":                             "00220005",
+	"PatientEyeMovementCommandCodeSequence":                   "00220006",
+	"SphericalLensPower":                                      "00220007",
+	"CylinderLensPower":                                       "00220008",
+	"CylinderAxis":                                            "00220009",
+	"EmmetropicMagnification":                                 "0022000A",
+	"IntraOcularPressure":                                     "0022000B",
+	"HorizontalFieldOfView":                                   "0022000C",
+	"PupilDilated":                                            "0022000D",
+	"DegreeOfDilation":                                        "0022000E",
+	"StereoBaselineAngle":                                     "00220010",
+	"StereoBaselineDisplacement":                              "00220011",
+	"StereoHorizontalPixelOffset":                             "00220012",
+	"StereoVerticalPixelOffset":                               "00220013",
+	"StereoRotation":                                          "00220014",
+	"AcquisitionDeviceTypeCodeSequence":                       "00220015",
+	"IlluminationTypeCodeSequence":                            "00220016",
+	"LightPathFilterTypeStackCodeSequence":                    "00220017",
+	"ImagePathFilterTypeStackCodeSequence":                    "00220018",
+	"LensesCodeSequence":                                      "00220019",
+	"ChannelDescriptionCodeSequence":                          "0022001A",
+	"RefractiveStateSequence":                                 "0022001B",
+	"MydriaticAgentCodeSequence":                              "0022001C",
+	"RelativeImagePositionCodeSequence":                       "0022001D",
+	"StereoPairsSequence":                                     "00220020",
+	"LeftImageSequence":                                       "00220021",
+	"RightImageSequence":                                      "00220022",
+	"AxialLengthOfTheEye":                                     "00220030",
+	"OphthalmicFrameLocationSequence":                         "00220031",
+	"ReferenceCoordinates":                                    "00220032",
+	"DepthSpatialResolution":                                  "00220035",
+	"MaximumDepthDistortion":                                  "00220036",
+	"AlongScanSpatialResolution":                              "00220037",
+	"MaximumAlongScanDistortion":                              "00220038",
+	"OphthalmicImageOrientation":                              "00220039",
+	"DepthOfTransverseImage":                                  "00220041",
+	"MydriaticAgentConcentrationUnitsSequence":                "00220042",
+	"AcrossScanSpatialResolution":                             "00220048",
+	"MaximumAcrossScanDistortion":                             "00220049",
+	"MydriaticAgentConcentration":                             "0022004E",
+	"IlluminationWaveLength":                                  "00220055",
+	"IlluminationPower":                                       "00220056",
+	"IlluminationBandwidth":                                   "00220057",
+	"MydriaticAgentSequence":                                  "00220058",
+	"ImagePresentationGroupLength":                            "00280000",
+	"SamplesPerPixel":                                         "00280002",
+	"SamplesPerPixelUsed":                                     "00280003",
+	"PhotometricInterpretation":                               "00280004",
+	"ImageDimensions":                                         "00280005",
+	"PlanarConfiguration":                                     "00280006",
+	"NumberOfFrames":                                          "00280008",
+	"FrameIncrementPointer":                                   "00280009",
+	"FrameDimensionPointer":                                   "0028000A",
+	"Rows":                                                    "00280010",
+	"Columns":                                                 "00280011",
+	"Planes":                                                  "00280012",
+	"UltrasoundColorDataPresent":                              "00280014",
+	"PixelSpacing":                                            "00280030",
+	"ZoomFactor":                                              "00280031",
+	"ZoomCenter":                                              "00280032",
+	"PixelAspectRatio":                                        "00280034",
+	"ImageFormat":                                             "00280040",
+	"ManipulatedImage":                                        "00280050",
+	"CorrectedImage":                                          "00280051",
+	"CompressionRecognitionCode":                              "0028005F",
+	"CompressionCode":                                         "00280060",
+	"CompressionOriginator":                                   "00280061",
+	"CompressionLabel":                                        "00280062",
+	"CompressionDescription":                                  "00280063",
+	"CompressionSequence":                                     "00280065",
+	"CompressionStepPointers":                                 "00280066",
+	"RepeatInterval":                                          "00280068",
+	"BitsGrouped":                                             "00280069",
+	"PerimeterTable":                                          "00280070",
+	"PerimeterValue":                                          "00280071",
+	"PredictorRows":                                           "00280080",
+	"PredictorColumns":                                        "00280081",
+	"PredictorConstants":                                      "00280082",
+	"BlockedPixels":                                           "00280090",
+	"BlockRows":                                               "00280091",
+	"BlockColumns":                                            "00280092",
+	"RowOverlap":                                              "00280093",
+	"ColumnOverlap":                                           "00280094",
+	"BitsAllocated":                                           "00280100",
+	"BitsStored":                                              "00280101",
+	"HighBit":                                                 "00280102",
+	"PixelRepresentation":                                     "00280103",
+	"SmallestValidPixelValue":                                 "00280104",
+	"LargestValidPixelValue":                                  "00280105",
+	"SmallestImagePixelValue":                                 "00280106",
+	"LargestImagePixelValue":                                  "00280107",
+	"SmallestPixelValueInSeries":                              "00280108",
+	"LargestPixelValueInSeries":                               "00280109",
+	"SmallestImagePixelValueInPlane":                          "00280110",
+	"LargestImagePixelValueInPlane":                           "00280111",
+	"PixelPaddingValue":                                       "00280120",
+	"PixelPaddingRangeLimit":                                  "00280121",
+	"ImageLocation":                                           "00280200",
+	"QualityControlImage":                                     "00280300",
+	"BurnedInAnnotation":                                      "00280301",
+	"TransformLabel":                                          "00280400",
+	"TransformVersionNumber":                                  "00280401",
+	"NumberOfTransformSteps":                                  "00280402",
+	"SequenceOfCompressedData":                                "00280403",
+	"DetailsOfCoefficients":                                   "00280404",
+	"CoefficientCoding":                                       "002804X2",
+	"CoefficientCodingPointers":                               "002804X3",
+	"DCTLabel":                                                "00280700",
+	"DataBlockDescription":                                    "00280701",
+	"DataBlock":                                               "00280702",
+	"NormalizationFactorFormat":                               "00280710",
+	"ZonalMapNumberFormat":                                    "00280720",
+	"ZonalMapLocation":                                        "00280721",
+	"ZonalMapFormat":                                          "00280722",
+	"AdaptiveMapFormat":                                       "00280730",
+	"CodeNumberFormat":                                        "00280740",
+	"CodeLabel":                                               "002808X0",
+	"NumberOfTables":                                          "002808X2",
+	"CodeTableLocation":                                       "002808X3",
+	"BitsForCodeWord":                                         "002808X4",
+	"ImageDataLocation":                                       "002808X8",
+	"PixelSpacingCalibrationType":                             "00280A02",
+	"PixelSpacingCalibrationDescription":                      "00280A04",
+	"PixelIntensityRelationship":                              "00281040",
+	"PixelIntensityRelationshipSign":                          "00281041",
+	"WindowCenter":                                            "00281050",
+	"WindowWidth":                                             "00281051",
+	"RescaleIntercept":                                        "00281052",
+	"RescaleSlope":                                            "00281053",
+	"RescaleType":                                             "00281054",
+	"WindowCenterAndWidthExplanation":                         "00281055",
+	"VOILUTFunction":                                          "00281056",
+	"GrayScale":                                               "00281080",
+	"RecommendedViewingMode":                                  "00281090",
+	"GrayLookupTableDescriptor":                               "00281100",
+	"RedPaletteColorTableDescriptor":                          "00281101",
+	"GreenPaletteColorTableDescriptor":                        "00281102",
+	"BluePaletteColorTableDescriptor":                         "00281103",
+	"LargeRedPaletteColorLookupTableDescriptor":               "00281111",
+	"LargeGreenPaletteColorLookupTableDescriptor":             "00281112",
+	"LargeBluePaletteColorLookupTableDescriptor":              "00281113",
+	"PaletteColorLookupTableUID":                              "00281199",
+	"GrayLookupTableData":                                     "00281200",
+	"RedPaletteColorLookupTableData":                          "00281201",
+	"GreenPaletteColorLookupTableData":                        "00281202",
+	"BluePaletteColorLookupTableData":                         "00281203",
+	"LargeRedPaletteColorLookupTableData":                     "00281211",
+	"LargeGreenPaletteColorLookupTableData":                   "00281212",
+	"LargeBluePaletteColorLookupTableData":                    "00281213",
+	"LargePaletteColorLookupTableUID":                         "00281214",
+	"SegmentedRedPaletteColorLookupTableData":                 "00281221",
+	"SegmentedGreenPaletteColorLookupTableData":               "00281222",
+	"SegmentedBluePaletteColorLookupTableData":                "00281223",
+	"BreastImplantPresent":                                    "00281300",
+	"PartialView":                                             "00281350",
+	"PartialViewDescription":                                  "00281351",
+	"PartialViewCodeSequence":                                 "00281352",
+	"SpatialLocationsPreserved":                               "0028135A",
+	"DataPathAssignment":                                      "00281402",
+	"BlendingLUT1Sequence":                                    "00281404",
+	"BlendingWeightConstant":                                  "00281406",
+	"BlendingLookupTableData":                                 "00281408",
+	"BlendingLUT2Sequence":                                    "0028140C",
+	"DataPathID":                                              "0028140E",
+	"RGBLUTTransferFunction":                                  "0028140F",
+	"AlphaLUTTransferFunction":                                "00281410",
+	"ICCProfile":                                              "00282000",
+	"LossyImageCompression":                                   "00282110",
+	"LossyImageCompressionRatio":                              "00282112",
+	"LossyImageCompressionMethod":                             "00282114",
+	"ModalityLUTSequence":                                     "00283000",
+	"LUTDescriptor":                                           "00283002",
+	"LUTExplanation":                                          "00283003",
+	"ModalityLUTType":                                         "00283004",
+	"LUTData":                                                 "00283006",
+	"VOILUTSequence":                                          "00283010",
+	"SoftcopyVOILUTSequence":                                  "00283110",
+	"ImagePresentationComments":                               "00284000",
+	"BiPlaneAcquisitionSequence":                              "00285000",
+	"RepresentativeFrameNumber":                               "00286010",
+	"FrameNumbersOfInterest":                                  "00286020",
+	"FrameOfInterestDescription":                              "00286022",
+	"FrameOfInterestType":                                     "00286023",
+	"MaskPointers":                                            "00286030",
+	"RWavePointer":                                            "00286040",
+	"MaskSubtractionSequence":                                 "00286100",
+	"MaskOperation":                                           "00286101",
+	"ApplicableFrameRange":                                    "00286102",
+	"MaskFrameNumbers":                                        "00286110",
+	"ContrastFrameAveraging":                                  "00286112",
+	"MaskSubPixelShift":                                       "00286114",
+	"TIDOffset":                                               "00286120",
+	"MaskOperationExplanation":                                "00286190",
+	"PixelDataProviderURL":                                    "00287FE0",
+	"DataPointRows":                                           "00289001",
+	"DataPointColumns":                                        "00289002",
+	"SignalDomainColumns":                                     "00289003",
+	"LargestMonochromePixelValue":                             "00289099",
+	"DataRepresentation":                                      "00289108",
+	"PixelMeasuresSequence":                                   "00289110",
+	"FrameVOILUTSequence":                                     "00289132",
+	"PixelValueTransformationSequence":                        "00289145",
+	"SignalDomainRows":                                        "00289235",
+	"DisplayFilterPercentage":                                 "00289411",
+	"FramePixelShiftSequence":                                 "00289415",
+	"SubtractionItemID":                                       "00289416",
+	"PixelIntensityRelationshipLUTSequence":                   "00289422",
+	"FramePixelDataPropertiesSequence":                        "00289443",
+	"GeometricalProperties":                                   "00289444",
+	"GeometricMaximumDistortion":                              "00289445",
+	"ImageProcessingApplied":                                  "00289446",
+	"MaskSelectionMode":                                       "00289454",
+	"LUTFunction":                                             "00289474",
+	"MaskVisibilityPercentage":                                "00289478",
+	"PixelShiftSequence":                                      "00289501",
+	"RegionPixelShiftSequence":                                "00289502",
+	"VerticesOfTheRegion":                                     "00289503",
+	"PixelShiftFrameRange":                                    "00289506",
+	"LUTFrameRange":                                           "00289507",
+	"ImageToEquipmentMappingMatrix":                           "00289520",
+	"EquipmentCoordinateSystemID":                             "00289537",
+	"StudyGroupLength":                                        "00320000",
+	"StudyStatusID":                                           "0032000A",
+	"StudyPriorityID":                                         "0032000C",
+	"StudyIDIssuer":                                           "00320012",
+	"StudyVerifiedDate":                                       "00320032",
+	"StudyVerifiedTime":                                       "00320033",
+	"StudyReadDate":                                           "00320034",
+	"StudyReadTime":                                           "00320035",
+	"ScheduledStudyStartDate":                                 "00321000",
+	"ScheduledStudyStartTime":                                 "00321001",
+	"ScheduledStudyStopDate":                                  "00321010",
+	"ScheduledStudyStopTime":                                  "00321011",
+	"ScheduledStudyLocation":                                  "00321020",
+	"ScheduledStudyLocationAETitle":                           "00321021",
+	"ReasonForStudy":                                          "00321030",
+	"RequestingPhysicianIDSequence":                           "00321031",
+	"RequestingPhysician":                                     "00321032",
+	"RequestingService":                                       "00321033",
+	"StudyArrivalDate":                                        "00321040",
+	"StudyArrivalTime":                                        "00321041",
+	"StudyCompletionDate":                                     "00321050",
+	"StudyCompletionTime":                                     "00321051",
+	"StudyComponentStatusID":                                  "00321055",
+	"RequestedProcedureDescription":                           "00321060",
+	"RequestedProcedureCodeSequence":                          "00321064",
+	"RequestedContrastAgent":                                  "00321070",
+	"StudyComments":                                           "00324000",
+	"ReferencedPatientAliasSequence":                          "00380004",
+	"VisitStatusID":                                           "00380008",
+	"AdmissionID":                                             "00380010",
+	"IssuerOfAdmissionID":                                     "00380011",
+	"RouteOfAdmissions":                                       "00380016",
+	"ScheduledAdmissionDate":                                  "0038001A",
+	"ScheduledAdmissionTime":                                  "0038001B",
+	"ScheduledDischargeDate":                                  "0038001C",
+	"ScheduledDischargeTime":                                  "0038001D",
+	"ScheduledPatientInstitutionResidence":                    "0038001E",
+	"AdmittingDate":                                           "00380020",
+	"AdmittingTime":                                           "00380021",
+	"DischargeDate":                                           "00380030",
+	"DischargeTime":                                           "00380032",
+	"DischargeDiagnosisDescription":                           "00380040",
+	"DischargeDiagnosisCodeSequence":                          "00380044",
+	"SpecialNeeds":                                            "00380050",
+	"ServiceEpisodeID":                                        "00380060",
+	"IssuerOfServiceEpisodeID":                                "00380061",
+	"ServiceEpisodeDescription":                               "00380062",
+	"PertinentDocumentsSequence":                              "00380100",
+	"CurrentPatientLocation":                                  "00380300",
+	"PatientInstitutionResidence":                             "00380400",
+	"PatientState":                                            "00380500",
+	"PatientClinicalTrialParticipationSequence":               "00380502",
+	"VisitComments":                                           "00384000",
+	"WaveformOriginality":                                     "003A0004",
+	"NumberOfWaveformChannels":                                "003A0005",
+	"NumberOfWaveformSamples":                                 "003A0010",
+	"SamplingFrequency":                                       "003A001A",
+	"MultiplexGroupLabel":                                     "003A0020",
+	"ChannelDefinitionSequence":                               "003A0200",
+	"WaveformChannelNumber":                                   "003A0202",
+	"ChannelLabel":                                            "003A0203",
+	"ChannelStatus":                                           "003A0205",
+	"ChannelSourceSequence":                                   "003A0208",
+	"ChannelSourceModifiersSequence":                          "003A0209",
+	"SourceWaveformSequence":                                  "003A020A",
+	"ChannelDerivationDescription":                            "003A020C",
+	"ChannelSensitivity":                                      "003A0210",
+	"ChannelSensitivityUnitsSequence":                         "003A0211",
+	"ChannelSensitivityCorrectionFactor":                      "003A0212",
+	"ChannelBaseline":                                         "003A0213",
+	"ChannelTimeSkew":                                         "003A0214",
+	"ChannelSampleSkew":                                       "003A0215",
+	"ChannelOffset":                                           "003A0218",
+	"WaveformBitsStored":                                      "003A021A",
+	"FilterLowFrequency":                                      "003A0220",
+	"FilterHighFrequency":                                     "003A0221",
+	"NotchFilterFrequency":                                    "003A0222",
+	"NotchFilterBandwidth":                                    "003A0223",
+	"WaveformDataDisplayScale":                                "003A0230",
+	"WaveformDisplayBackgroundCIELabValue":                    "003A0231",
+	"WaveformPresentationGroupSequence":                       "003A0240",
+	"PresentationGroupNumber":                                 "003A0241",
+	"ChannelDisplaySequence":                                  "003A0242",
+	"ChannelRecommendedDisplayCIELabValue":                    "003A0244",
+	"ChannelPosition":                                         "003A0245",
+	"DisplayShadingFlag":                                      "003A0246",
+	"FractionalChannelDisplayScale":                           "003A0247",
+	"AbsoluteChannelDisplayScale":                             "003A0248",
+	"MultiplexedAudioChannelsDescriptionCodeSequence":         "003A0300",
+	"ChannelIdentificationCode":                               "003A0301",
+	"ChannelMode":                                             "003A0302",
+	"ScheduledStationAETitle":                                 "00400001",
+	"ScheduledProcedureStepStartDate":                         "00400002",
+	"ScheduledProcedureStepStartTime":                         "00400003",
+	"ScheduledProcedureStepEndDate":                           "00400004",
+	"ScheduledProcedureStepEndTime":                           "00400005",
+	"ScheduledPerformingPhysicianName":                        "00400006",
+	"ScheduledProcedureStepDescription":                       "00400007",
+	"ScheduledProtocolCodeSequence":                           "00400008",
+	"ScheduledProcedureStepID":                                "00400009",
+	"StageCodeSequence":                                       "0040000A",
+	"ScheduledPerformingPhysicianIdentificationSequence":      "0040000B",
+	"ScheduledStationName":                                    "00400010",
+	"ScheduledProcedureStepLocation":                          "00400011",
+	"PreMedication":                                           "00400012",
+	"ScheduledProcedureStepStatus":                            "00400020",
+	"LocalNamespaceEntityID":                                  "00400031",
+	"UniversalEntityID":                                       "00400032",
+	"UniversalEntityIDType":                                   "00400033",
+	"IdentifierTypeCode":                                      "00400035",
+	"AssigningFacilitySequence":                               "00400036",
+	"ScheduledProcedureStepSequence":                          "00400100",
+	"ReferencedNonImageCompositeSOPInstanceSequence":          "00400220",
+	"PerformedStationAETitle":                                 "00400241",
+	"PerformedStationName":                                    "00400242",
+	"PerformedLocation":                                       "00400243",
+	"PerformedProcedureStepStartDate":                         "00400244",
+	"PerformedProcedureStepStartTime":                         "00400245",
+	"PerformedProcedureStepEndDate":                           "00400250",
+	"PerformedProcedureStepEndTime":                           "00400251",
+	"PerformedProcedureStepStatus":                            "00400252",
+	"PerformedProcedureStepID":                                "00400253",
+	"PerformedProcedureStepDescription":                       "00400254",
+	"PerformedProcedureTypeDescription":                       "00400255",
+	"PerformedProtocolCodeSequence":                           "00400260",
+	"PerformedProtocolType":                                   "00400261",
+	"ScheduledStepAttributesSequence":                         "00400270",
+	"RequestAttributesSequence":                               "00400275",
+	"CommentsOnPerformedProcedureStep":                        "00400280",
+	"PerformedProcedureStepDiscontinuationReasonCodeSequence": "00400281",
+	"QuantitySequence":                                             "00400293",
+	"Quantity":                                                     "00400294",
+	"MeasuringUnitsSequence":                                       "00400295",
+	"BillingItemSequence":                                          "00400296",
+	"TotalTimeOfFluoroscopy":                                       "00400300",
+	"TotalNumberOfExposures":                                       "00400301",
+	"EntranceDose":                                                 "00400302",
+	"ExposedArea":                                                  "00400303",
+	"DistanceSourceToEntrance":                                     "00400306",
+	"DistanceSourceToSupport":                                      "00400307",
+	"ExposureDoseSequence":                                         "0040030E",
+	"CommentsOnRadiationDose":                                      "00400310",
+	"XRayOutput":                                                   "00400312",
+	"HalfValueLayer":                                               "00400314",
+	"OrganDose":                                                    "00400316",
+	"OrganExposed":                                                 "00400318",
+	"BillingProcedureStepSequence":                                 "00400320",
+	"FilmConsumptionSequence":                                      "00400321",
+	"BillingSuppliesAndDevicesSequence":                            "00400324",
+	"ReferencedProcedureStepSequence":                              "00400330",
+	"PerformedSeriesSequence":                                      "00400340",
+	"CommentsOnScheduledProcedureStep":                             "00400400",
+	"ProtocolContextSequence":                                      "00400440",
+	"ContentItemModifierSequence":                                  "00400441",
+	"SpecimenAccessionNumber":                                      "0040050A",
+	"ContainerIdentifier":                                          "00400512",
+	"ContainerDescription":                                         "0040051A",
+	"SpecimenSequence":                                             "00400550",
+	"SpecimenIdentifier":                                           "00400551",
+	"SpecimenDescriptionSequenceTrial":                             "00400552",
+	"SpecimenDescriptionTrial":                                     "00400553",
+	"SpecimenUID":                                                  "00400554",
+	"AcquisitionContextSequence":                                   "00400555",
+	"AcquisitionContextDescription":                                "00400556",
+	"SpecimenTypeCodeSequence":                                     "0040059A",
+	"SpecimenShortDescription":                                     "00400600",
+	"SlideIdentifier":                                              "004006FA",
+	"ImageCenterPointCoordinatesSequence":                          "0040071A",
+	"XOffsetInSlideCoordinateSystem":                               "0040072A",
+	"YOffsetInSlideCoordinateSystem":                               "0040073A",
+	"ZOffsetInSlideCoordinateSystem":                               "0040074A",
+	"PixelSpacingSequence":                                         "004008D8",
+	"CoordinateSystemAxisCodeSequence":                             "004008DA",
+	"MeasurementUnitsCodeSequence":                                 "004008EA",
+	"VitalStainCodeSequenceTrial":                                  "004009F8",
+	"RequestedProcedureID":                                         "00401001",
+	"ReasonForRequestedProcedure":                                  "00401002",
+	"RequestedProcedurePriority":                                   "00401003",
+	"PatientTransportArrangements":                                 "00401004",
+	"RequestedProcedureLocation":                                   "00401005",
+	"PlacerOrderNumberProcedure":                                   "00401006",
+	"FillerOrderNumberProcedure":                                   "00401007",
+	"ConfidentialityCode":                                          "00401008",
+	"ReportingPriority":                                            "00401009",
+	"ReasonForRequestedProcedureCodeSequence":                      "0040100A",
+	"NamesOfIntendedRecipientsOfResults":                           "00401010",
+	"IntendedRecipientsOfResultsIdentificationSequence":            "00401011",
+	"PersonIdentificationCodeSequence":                             "00401101",
+	"PersonAddress":                                                "00401102",
+	"PersonTelephoneNumbers":                                       "00401103",
+	"RequestedProcedureComments":                                   "00401400",
+	"ReasonForImagingServiceRequest":                               "00402001",
+	"IssueDateOfImagingServiceRequest":                             "00402004",
+	"IssueTimeOfImagingServiceRequest":                             "00402005",
+	"PlacerOrderNumberImagingServiceRequestRetired":                "00402006",
+	"FillerOrderNumberImagingServiceRequestRetired":                "00402007",
+	"OrderEnteredBy":                                               "00402008",
+	"OrderEntererLocation":                                         "00402009",
+	"OrderCallbackPhoneNumber":                                     "00402010",
+	"PlacerOrderNumberImagingServiceRequest":                       "00402016",
+	"FillerOrderNumberImagingServiceRequest":                       "00402017",
+	"ImagingServiceRequestComments":                                "00402400",
+	"ConfidentialityConstraintOnPatientDataDescription":            "00403001",
+	"GeneralPurposeScheduledProcedureStepStatus":                   "00404001",
+	"GeneralPurposePerformedProcedureStepStatus":                   "00404002",
+	"GeneralPurposeScheduledProcedureStepPriority":                 "00404003",
+	"ScheduledProcessingApplicationsCodeSequence":                  "00404004",
+	"ScheduledProcedureStepStartDateTime":                          "00404005",
+	"MultipleCopiesFlag":                                           "00404006",
+	"PerformedProcessingApplicationsCodeSequence":                  "00404007",
+	"HumanPerformerCodeSequence":                                   "00404009",
+	"ScheduledProcedureStepModificationDateTime":                   "00404010",
+	"ExpectedCompletionDateTime":                                   "00404011",
+	"ResultingGeneralPurposePerformedProcedureStepsSequence":       "00404015",
+	"ReferencedGeneralPurposeScheduledProcedureStepSequence":       "00404016",
+	"ScheduledWorkitemCodeSequence":                                "00404018",
+	"PerformedWorkitemCodeSequence":                                "00404019",
+	"InputAvailabilityFlag":                                        "00404020",
+	"InputInformationSequence":                                     "00404021",
+	"RelevantInformationSequence":                                  "00404022",
+	"ReferencedGeneralPurposeScheduledProcedureStepTransactionUID": "00404023",
+	"ScheduledStationNameCodeSequence":                             "00404025",
+	"ScheduledStationClassCodeSequence":                            "00404026",
+	"ScheduledStationGeographicLocationCodeSequence":               "00404027",
+	"PerformedStationNameCodeSequence":                             "00404028",
+	"PerformedStationClassCodeSequence":                            "00404029",
+	"PerformedStationGeographicLocationCodeSequence":               "00404030",
+	"RequestedSubsequentWorkitemCodeSequence":                      "00404031",
+	"NonDICOMOutputCodeSequence":                                   "00404032",
+	"OutputInformationSequence":                                    "00404033",
+	"ScheduledHumanPerformersSequence":                             "00404034",
+	"ActualHumanPerformersSequence":                                "00404035",
+	"HumanPerformersOrganization":                                  "00404036",
+	"HumanPerformerName":                                           "00404037",
+	"RawDataHandling":                                              "00404040",
+	"EntranceDoseInmGy":                                            "00408302",
+	"ReferencedImageRealWorldValueMappingSequence":                 "00409094",
+	"RealWorldValueMappingSequence":                                "00409096",
+	"PixelValueMappingCodeSequence":                                "00409098",
+	"LUTLabel":                                                     "00409210",
+	"RealWorldValueLastValueMapped":                                "00409211",
+	"RealWorldValueLUTData":                                        "00409212",
+	"RealWorldValueFirstValueMapped":                               "00409216",
+	"RealWorldValueIntercept":                                      "00409224",
+	"RealWorldValueSlope":                                          "00409225",
+	"RelationshipType":                                             "0040A010",
+	"VerifyingOrganization":                                        "0040A027",
+	"VerificationDateTime":                                         "0040A030",
+	"ObservationDateTime":                                          "0040A032",
+	"ValueType":                                                    "0040A040",
+	"ConceptNameCodeSequence":                                      "0040A043",
+	"ContinuityOfContent":                                          "0040A050",
+	"VerifyingObserverSequence":                                    "0040A073",
+	"VerifyingObserverName":                                        "0040A075",
+	"AuthorObserverSequence":                                       "0040A078",
+	"ParticipantSequence":                                          "0040A07A",
+	"CustodialOrganizationSequence":                                "0040A07C",
+	"ParticipationType":                                            "0040A080",
+	"ParticipationDateTime":                                        "0040A082",
+	"ObserverType":                                                 "0040A084",
+	"VerifyingObserverIdentificationCodeSequence":                  "0040A088",
+	"EquivalentCDADocumentSequence":                                "0040A090",
+	"ReferencedWaveformChannels":                                   "0040A0B0",
+	"DateTime":                                                     "0040A120",
+	"Date":                                                         "0040A121",
+	"Time":                                                         "0040A122",
+	"PersonName":                                                   "0040A123",
+	"UID":                                                          "0040A124",
+	"TemporalRangeType":                                            "0040A130",
+	"ReferencedSamplePositions":                                    "0040A132",
+	"ReferencedFrameNumbers":                                       "0040A136",
+	"ReferencedTimeOffsets":                                        "0040A138",
+	"ReferencedDateTime":                                           "0040A13A",
+	"TextValue":                                                    "0040A160",
+	"ConceptCodeSequence":                                          "0040A168",
+	"PurposeOfReferenceCodeSequence":                               "0040A170",
+	"AnnotationGroupNumber":                                        "0040A180",
+	"ModifierCodeSequence":                                         "0040A195",
+	"MeasuredValueSequence":                                        "0040A300",
+	"NumericValueQualifierCodeSequence":                            "0040A301",
+	"NumericValue":                                                 "0040A30A",
+	"AddressTrial":                                                 "0040A353",
+	"TelephoneNumberTrial":                                         "0040A354",
+	"PredecessorDocumentsSequence":                                 "0040A360",
+	"ReferencedRequestSequence":                                    "0040A370",
+	"PerformedProcedureCodeSequence":                               "0040A372",
+	"CurrentRequestedProcedureEvidenceSequence":                    "0040A375",
+	"PertinentOtherEvidenceSequence":                               "0040A385",
+	"HL7StructuredDocumentReferenceSequence":                       "0040A390",
+	"CompletionFlag":                                               "0040A491",
+	"CompletionFlagDescription":                                    "0040A492",
+	"VerificationFlag":                                             "0040A493",
+	"ArchiveRequested":                                             "0040A494",
+	"PreliminaryFlag":                                              "0040A496",
+	"ContentTemplateSequence":                                      "0040A504",
+	"IdenticalDocumentsSequence":                                   "0040A525",
+	"ContentSequence":                                              "0040A730",
+	"AnnotationSequence":                                           "0040B020",
+	"TemplateIdentifier":                                           "0040DB00",
+	"TemplateVersion":                                              "0040DB06",
+	"TemplateLocalVersion":                                         "0040DB07",
+	"TemplateExtensionFlag":                                        "0040DB0B",
+	"TemplateExtensionOrganizationUID":                             "0040DB0C",
+	"TemplateExtensionCreatorUID":                                  "0040DB0D",
+	"ReferencedContentItemIdentifier":                              "0040DB73",
+	"HL7InstanceIdentifier":                                        "0040E001",
+	"HL7DocumentEffectiveTime":                                     "0040E004",
+	"HL7DocumentTypeCodeSequence":                                  "0040E006",
+	"RetrieveURI":                                                  "0040E010",
+	"RetrieveLocationUID":                                          "0040E011",
+	"DocumentTitle":                                                "00420010",
+	"EncapsulatedDocument":                                         "00420011",
+	"MIMETypeOfEncapsulatedDocument":                               "00420012",
+	"SourceInstanceSequence":                                       "00420013",
+	"ListOfMIMETypes":                                              "00420014",
+	"ProductPackageIdentifier":                                     "00440001",
+	"SubstanceAdministrationApproval":                              "00440002",
+	"ApprovalStatusFurtherDescription":                             "00440003",
+	"ApprovalStatusDateTime":                                       "00440004",
+	"ProductTypeCodeSequence":                                      "00440007",
+	"ProductName":                                                  "00440008",
+	"ProductDescription":                                           "00440009",
+	"ProductLotIdentifier":                                         "0044000A",
+	"ProductExpirationDateTime":                                    "0044000B",
+	"SubstanceAdministrationDateTime":                              "00440010",
+	"SubstanceAdministrationNotes":                                 "00440011",
+	"SubstanceAdministrationDeviceID":                              "00440012",
+	"ProductParameterSequence":                                     "00440013",
+	"SubstanceAdministrationParameterSequence":                     "00440019",
+	"LensDescription":                                              "00460012",
+	"RightLensSequence":                                            "00460014",
+	"LeftLensSequence":                                             "00460015",
+	"CylinderSequence":                                             "00460018",
+	"PrismSequence":                                                "00460028",
+	"HorizontalPrismPower":                                         "00460030",
+	"HorizontalPrismBase":                                          "00460032",
+	"VerticalPrismPower":                                           "00460034",
+	"VerticalPrismBase":                                            "00460036",
+	"LensSegmentType":                                              "00460038",
+	"OpticalTransmittance":                                         "00460040",
+	"ChannelWidth":                                                 "00460042",
+	"PupilSize":                                                    "00460044",
+	"CornealSize":                                                  "00460046",
+	"DistancePupillaryDistance":                                    "00460060",
+	"NearPupillaryDistance":                                        "00460062",
+	"OtherPupillaryDistance":                                       "00460064",
+	"RadiusOfCurvature":                                            "00460075",
+	"KeratometricPower":                                            "00460076",
+	"KeratometricAxis":                                             "00460077",
+	"BackgroundColor":                                              "00460092",
+	"Optotype":                                                     "00460094",
+	"OptotypePresentation":                                         "00460095",
+	"AddNearSequence":                                              "00460100",
+	"AddIntermediateSequence":                                      "00460101",
+	"AddOtherSequence":                                             "00460102",
+	"AddPower":                                                     "00460104",
+	"ViewingDistance":                                              "00460106",
+	"ViewingDistanceType":                                          "00460125",
+	"VisualAcuityModifiers":                                        "00460135",
+	"DecimalVisualAcuity":                                          "00460137",
+	"OptotypeDetailedDefinition":                                   "00460139",
+	"SpherePower":                                                  "00460146",
+	"CylinderPower":                                                "00460147",
+	"CalibrationImage":                                             "00500004",
+	"DeviceSequence":                                               "00500010",
+	"DeviceLength":                                                 "00500014",
+	"ContainerComponentWidth":                                      "00500015",
+	"DeviceDiameter":                                               "00500016",
+	"DeviceDiameterUnits":                                          "00500017",
+	"DeviceVolume":                                                 "00500018",
+	"InterMarkerDistance":                                          "00500019",
+	"ContainerComponentID":                                         "0050001B",
+	"DeviceDescription":                                            "00500020",
+	"EnergyWindowVector":                                           "00540010",
+	"NumberOfEnergyWindows":                                        "00540011",
+	"EnergyWindowInformationSequence":                              "00540012",
+	"EnergyWindowRangeSequence":                                    "00540013",
+	"EnergyWindowLowerLimit":                                       "00540014",
+	"EnergyWindowUpperLimit":                                       "00540015",
+	"RadiopharmaceuticalInformationSequence":                       "00540016",
+	"ResidualSyringeCounts":                                        "00540017",
+	"EnergyWindowName":                                             "00540018",
+	"DetectorVector":                                               "00540020",
+	"NumberOfDetectors":                                            "00540021",
+	"DetectorInformationSequence":                                  "00540022",
+	"PhaseVector":                                                  "00540030",
+	"NumberOfPhases":                                               "00540031",
+	"PhaseInformationSequence":                                     "00540032",
+	"NumberOfFramesInPhase":                                        "00540033",
+	"PhaseDelay":                                                   "00540036",
+	"PauseBetweenFrames":                                           "00540038",
+	"PhaseDescription":                                             "00540039",
+	"RotationVector":                                               "00540050",
+	"NumberOfRotations":                                            "00540051",
+	"RotationInformationSequence":                                  "00540052",
+	"NumberOfFramesInRotation":                                     "00540053",
+	"RRIntervalVector":                                             "00540060",
+	"NumberOfRRIntervals":                                          "00540061",
+	"GatedInformationSequence":                                     "00540062",
+	"DataInformationSequence":                                      "00540063",
+	"TimeSlotVector":                                               "00540070",
+	"NumberOfTimeSlots":                                            "00540071",
+	"TimeSlotInformationSequence":                                  "00540072",
+	"TimeSlotTime":                                                 "00540073",
+	"SliceVector":                                                  "00540080",
+	"NumberOfSlices":                                               "00540081",
+	"AngularViewVector":                                            "00540090",
+	"TimeSliceVector":                                              "00540100",
+	"NumberOfTimeSlices":                                           "00540101",
+	"StartAngle":                                                   "00540200",
+	"TypeOfDetectorMotion":                                         "00540202",
+	"TriggerVector":                                                "00540210",
+	"NumberOfTriggersInPhase":                                      "00540211",
+	"ViewCodeSequence":                                             "00540220",
+	"ViewModifierCodeSequence":                                     "00540222",
+	"RadionuclideCodeSequence":                                     "00540300",
+	"AdministrationRouteCodeSequence":                              "00540302",
+	"RadiopharmaceuticalCodeSequence":                              "00540304",
+	"CalibrationDataSequence":                                      "00540306",
+	"EnergyWindowNumber":                                           "00540308",
+	"ImageID":                                                      "00540400",
+	"PatientOrientationCodeSequence":                               "00540410",
+	"PatientOrientationModifierCodeSequence":                       "00540412",
+	"PatientGantryRelationshipCodeSequence":                        "00540414",
+	"SliceProgressionDirection":                                    "00540500",
+	"SeriesType":                                                   "00541000",
+	"Units":                                                        "00541001",
+	"CountsSource":                                                 "00541002",
+	"ReprojectionMethod":                                           "00541004",
+	"RandomsCorrectionMethod":                                      "00541100",
+	"AttenuationCorrectionMethod":                                  "00541101",
+	"DecayCorrection":                                              "00541102",
+	"ReconstructionMethod":                                         "00541103",
+	"DetectorLinesOfResponseUsed":                                  "00541104",
+	"ScatterCorrectionMethod":                                      "00541105",
+	"AxialAcceptance":                                              "00541200",
+	"AxialMash":                                                    "00541201",
+	"TransverseMash":                                               "00541202",
+	"DetectorElementSize":                                          "00541203",
+	"CoincidenceWindowWidth":                                       "00541210",
+	"SecondaryCountsType":                                          "00541220",
+	"FrameReferenceTime":                                           "00541300",
+	"PrimaryCountsAccumulated":                                     "00541310",
+	"SecondaryCountsAccumulated":                                   "00541311",
+	"SliceSensitivityFactor":                                       "00541320",
+	"DecayFactor":                                                  "00541321",
+	"DoseCalibrationFactor":                                        "00541322",
+	"ScatterFractionFactor":                                        "00541323",
+	"DeadTimeFactor":                                               "00541324",
+	"ImageIndex":                                                   "00541330",
+	"CountsIncluded":                                               "00541400",
+	"DeadTimeCorrectionFlag":                                       "00541401",
+	"HistogramSequence":                                            "00603000",
+	"HistogramNumberOfBins":                                        "00603002",
+	"HistogramFirstBinValue":                                       "00603004",
+	"HistogramLastBinValue":                                        "00603006",
+	"HistogramBinWidth":                                            "00603008",
+	"HistogramExplanation":                                         "00603010",
+	"HistogramData":                                                "00603020",
+	"SegmentationType":                                             "00620001",
+	"SegmentSequence":                                              "00620002",
+	"SegmentedPropertyCategoryCodeSequence":                        "00620003",
+	"SegmentNumber":                                                "00620004",
+	"SegmentLabel":                                                 "00620005",
+	"SegmentDescription":                                           "00620006",
+	"SegmentAlgorithmType":                                         "00620008",
+	"SegmentAlgorithmName":                                         "00620009",
+	"SegmentIdentificationSequence":                                "0062000A",
+	"ReferencedSegmentNumber":                                      "0062000B",
+	"RecommendedDisplayGrayscaleValue":                             "0062000C",
+	"RecommendedDisplayCIELabValue":                                "0062000D",
+	"MaximumFractionalValue":                                       "0062000E",
+	"SegmentedPropertyTypeCodeSequence":                            "0062000F",
+	"SegmentationFractionalType":                                   "00620010",
+	"DeformableRegistrationSequence":                               "00640002",
+	"SourceFrameOfReferenceUID":                                    "00640003",
+	"DeformableRegistrationGridSequence":                           "00640005",
+	"GridDimensions":                                               "00640007",
+	"GridResolution":                                               "00640008",
+	"VectorGridData":                                               "00640009",
+	"PreDeformationMatrixRegistrationSequence":                     "0064000F",
+	"PostDeformationMatrixRegistrationSequence":                    "00640010",
+	"NumberOfSurfaces":                                             "00660001",
+	"SurfaceSequence":                                              "00660002",
+	"SurfaceNumber":                                                "00660003",
+	"SurfaceComments":                                              "00660004",
+	"SurfaceProcessing":                                            "00660009",
+	"SurfaceProcessingRatio":                                       "0066000A",
+	"FiniteVolume":                                                 "0066000E",
+	"Manifold":                                                     "00660010",
+	"SurfacePointsSequence":                                        "00660011",
+	"NumberOfSurfacePoints":                                        "00660015",
+	"PointCoordinatesData":                                         "00660016",
+	"PointPositionAccuracy":                                        "00660017",
+	"MeanPointDistance":                                            "00660018",
+	"MaximumPointDistance":                                         "00660019",
+	"AxisOfRotation":                                               "0066001B",
+	"CenterOfRotation":                                             "0066001C",
+	"NumberOfVectors":                                              "0066001E",
+	"VectorDimensionality":                                         "0066001F",
+	"VectorAccuracy":                                               "00660020",
+	"VectorCoordinateData":                                         "00660021",
+	"TrianglePointIndexList":                                       "00660023",
+	"EdgePointIndexList":                                           "00660024",
+	"VertexPointIndexList":                                         "00660025",
+	"TriangleStripSequence":                                        "00660026",
+	"TriangleFanSequence":                                          "00660027",
+	"LineSequence":                                                 "00660028",
+	"PrimitivePointIndexList":                                      "00660029",
+	"SurfaceCount":                                                 "0066002A",
+	"AlgorithmFamilyCodeSequence":                                  "0066002F",
+	"AlgorithmVersion":                                             "00660031",
+	"AlgorithmParameters":                                          "00660032",
+	"FacetSequence":                                                "00660034",
+	"AlgorithmName":                                                "00660036",
+	"GraphicAnnotationSequence":                                    "00700001",
+	"GraphicLayer":                                                 "00700002",
+	"BoundingBoxAnnotationUnits":                                   "00700003",
+	"AnchorPointAnnotationUnits":                                   "00700004",
+	"GraphicAnnotationUnits":                                       "00700005",
+	"UnformattedTextValue":                                         "00700006",
+	"TextObjectSequence":                                           "00700008",
+	"GraphicObjectSequence":                                        "00700009",
+	"BoundingBoxTopLeftHandCorner":                                 "00700010",
+	"BoundingBoxBottomRightHandCorner":                             "00700011",
+	"BoundingBoxTextHorizontalJustification":                       "00700012",
+	"AnchorPoint":                                                  "00700014",
+	"AnchorPointVisibility":                                        "00700015",
+	"GraphicDimensions":                                            "00700020",
+	"NumberOfGraphicPoints":                                        "00700021",
+	"GraphicData":                                                  "00700022",
+	"GraphicType":                                                  "00700023",
+	"GraphicFilled":                                                "00700024",
+	"ImageRotationRetired":                                         "00700040",
+	"ImageHorizontalFlip":                                          "00700041",
+	"ImageRotation":                                                "00700042",
+	"DisplayedAreaTopLeftHandCornerTrial":                          "00700050",
+	"DisplayedAreaBottomRightHandCornerTrial":                      "00700051",
+	"DisplayedAreaTopLeftHandCorner":                               "00700052",
+	"DisplayedAreaBottomRightHandCorner":                           "00700053",
+	"DisplayedAreaSelectionSequence":                               "0070005A",
+	"GraphicLayerSequence":                                         "00700060",
+	"GraphicLayerOrder":                                            "00700062",
+	"GraphicLayerRecommendedDisplayGrayscaleValue":                 "00700066",
+	"GraphicLayerRecommendedDisplayRGBValue":                       "00700067",
+	"GraphicLayerDescription":                                      "00700068",
+	"ContentLabel":                                                 "00700080",
+	"ContentDescription":                                           "00700081",
+	"PresentationCreationDate":                                     "00700082",
+	"PresentationCreationTime":                                     "00700083",
+	"ContentCreatorName":                                           "00700084",
+	"ContentCreatorIdentificationCodeSequence":                     "00700086",
+	"PresentationSizeMode":                                         "00700100",
+	"PresentationPixelSpacing":                                     "00700101",
+	"PresentationPixelAspectRatio":                                 "00700102",
+	"PresentationPixelMagnificationRatio":                          "00700103",
+	"ShapeType":                                                    "00700306",
+	"RegistrationSequence":                                         "00700308",
+	"MatrixRegistrationSequence":                                   "00700309",
+	"MatrixSequence":                                               "0070030A",
+	"FrameOfReferenceTransformationMatrixType":                     "0070030C",
+	"RegistrationTypeCodeSequence":                                 "0070030D",
+	"FiducialDescription":                                          "0070030F",
+	"FiducialIdentifier":                                           "00700310",
+	"FiducialIdentifierCodeSequence":                               "00700311",
+	"ContourUncertaintyRadius":                                     "00700312",
+	"UsedFiducialsSequence":                                        "00700314",
+	"GraphicCoordinatesDataSequence":                               "00700318",
+	"FiducialUID":                                                  "0070031A",
+	"FiducialSetSequence":                                          "0070031C",
+	"FiducialSequence":                                             "0070031E",
+	"GraphicLayerRecommendedDisplayCIELabValue":                    "00700401",
+	"BlendingSequence":                                             "00700402",
+	"RelativeOpacity":                                              "00700403",
+	"ReferencedSpatialRegistrationSequence":                        "00700404",
+	"BlendingPosition":                                             "00700405",
+	"HangingProtocolName":                                          "00720002",
+	"HangingProtocolDescription":                                   "00720004",
+	"HangingProtocolLevel":                                         "00720006",
+	"HangingProtocolCreator":                                       "00720008",
+	"HangingProtocolCreationDateTime":                              "0072000A",
+	"HangingProtocolDefinitionSequence":                            "0072000C",
+	"HangingProtocolUserIDCodeSequence":                            "0072000E",
+	"HangingProtocolUserGroupName":                                 "00720010",
+	"SourceHangingProtocolSequence":                                "00720012",
+	"NumberOfPriorsReferenced":                                     "00720014",
+	"ImageSetsSequence":                                            "00720020",
+	"ImageSetSelectorSequence":                                     "00720022",
+	"ImageSetSelectorUsageFlag":                                    "00720024",
+	"SelectorAttribute":                                            "00720026",
+	"SelectorValueNumber":                                          "00720028",
+	"TimeBasedImageSetsSequence":                                   "00720030",
+	"ImageSetNumber":                                               "00720032",
+	"ImageSetSelectorCategory":                                     "00720034",
+	"RelativeTime":                                                 "00720038",
+	"RelativeTimeUnits":                                            "0072003A",
+	"AbstractPriorValue":                                           "0072003C",
+	"AbstractPriorCodeSequence":                                    "0072003E",
+	"ImageSetLabel":                                                "00720040",
+	"SelectorAttributeVR":                                          "00720050",
+	"SelectorSequencePointer":                                      "00720052",
+	"SelectorSequencePointerPrivateCreator":                        "00720054",
+	"SelectorAttributePrivateCreator":                              "00720056",
+	"SelectorATValue":                                              "00720060",
+	"SelectorCSValue":                                              "00720062",
+	"SelectorISValue":                                              "00720064",
+	"SelectorLOValue":                                              "00720066",
+	"SelectorLTValue":                                              "00720068",
+	"SelectorPNValue":                                              "0072006A",
+	"SelectorSHValue":                                              "0072006C",
+	"SelectorSTValue":                                              "0072006E",
+	"SelectorUTValue":                                              "00720070",
+	"SelectorDSValue":                                              "00720072",
+	"SelectorFDValue":                                              "00720074",
+	"SelectorFLValue":                                              "00720076",
+	"SelectorULValue":                                              "00720078",
+	"SelectorUSValue":                                              "0072007A",
+	"SelectorSLValue":                                              "0072007C",
+	"SelectorSSValue":                                              "0072007E",
+	"SelectorCodeSequenceValue":                                    "00720080",
+	"NumberOfScreens":                                              "00720100",
+	"NominalScreenDefinitionSequence":                              "00720102",
+	"NumberOfVerticalPixels":                                       "00720104",
+	"NumberOfHorizontalPixels":                                     "00720106",
+	"DisplayEnvironmentSpatialPosition":                            "00720108",
+	"ScreenMinimumGrayscaleBitDepth":                               "0072010A",
+	"ScreenMinimumColorBitDepth":                                   "0072010C",
+	"ApplicationMaximumRepaintTime":                                "0072010E",
+	"DisplaySetsSequence":                                          "00720200",
+	"DisplaySetNumber":                                             "00720202",
+	"DisplaySetLabel":                                              "00720203",
+	"DisplaySetPresentationGroup":                                  "00720204",
+	"DisplaySetPresentationGroupDescription":                       "00720206",
+	"PartialDataDisplayHandling":                                   "00720208",
+	"SynchronizedScrollingSequence":                                "00720210",
+	"DisplaySetScrollingGroup":                                     "00720212",
+	"NavigationIndicatorSequence":                                  "00720214",
+	"NavigationDisplaySet":                                         "00720216",
+	"ReferenceDisplaySets":                                         "00720218",
+	"ImageBoxesSequence":                                           "00720300",
+	"ImageBoxNumber":                                               "00720302",
+	"ImageBoxLayoutType":                                           "00720304",
+	"ImageBoxTileHorizontalDimension":                              "00720306",
+	"ImageBoxTileVerticalDimension":                                "00720308",
+	"ImageBoxScrollDirection":                                      "00720310",
+	"ImageBoxSmallScrollType":                                      "00720312",
+	"ImageBoxSmallScrollAmount":                                    "00720314",
+	"ImageBoxLargeScrollType":                                      "00720316",
+	"ImageBoxLargeScrollAmount":                                    "00720318",
+	"ImageBoxOverlapPriority":                                      "00720320",
+	"CineRelativeToRealTime":                                       "00720330",
+	"FilterOperationsSequence":                                     "00720400",
+	"FilterByCategory":                                             "00720402",
+	"FilterByAttributePresence":                                    "00720404",
+	"FilterByOperator":                                             "00720406",
+	"SynchronizedImageBoxList":                                     "00720432",
+	"TypeOfSynchronization":                                        "00720434",
+	"BlendingOperationType":                                        "00720500",
+	"ReformattingOperationType":                                    "00720510",
+	"ReformattingThickness":                                        "00720512",
+	"ReformattingInterval":                                         "00720514",
+	"ReformattingOperationInitialViewDirection":                    "00720516",
+	"ThreeDRenderingType":                                          "00720520",
+	"SortingOperationsSequence":                                    "00720600",
+	"SortByCategory":                                               "00720602",
+	"SortingDirection":                                             "00720604",
+	"DisplaySetPatientOrientation":                                 "00720700",
+	"VOIType":                                                      "00720702",
+	"PseudoColorType":                                              "00720704",
+	"ShowGrayscaleInverted":                                        "00720706",
+	"ShowImageTrueSizeFlag":                                        "00720710",
+	"ShowGraphicAnnotationFlag":                                    "00720712",
+	"ShowPatientDemographicsFlag":                                  "00720714",
+	"ShowAcquisitionTechniquesFlag":                                "00720716",
+	"DisplaySetHorizontalJustification":                            "00720717",
+	"DisplaySetVerticalJustification":                              "00720718",
+	"ProcedureStepState":                                           "00741000",
+	"ProcedureStepProgressInformationSequence":                     "00741002",
+	"ProcedureStepProgress":                                        "00741004",
+	"ProcedureStepProgressDescription":                             "00741006",
+	"ProcedureStepCommunicationsURISequence":                       "00741008",
+	"ContactURI":                                                   "0074100A",
+	"ContactDisplayName":                                           "0074100C",
+	"BeamTaskSequence":                                             "00741020",
+	"BeamTaskType":                                                 "00741022",
+	"BeamOrderIndex":                                               "00741024",
+	"DeliveryVerificationImageSequence":                            "00741030",
+	"VerificationImageTiming":                                      "00741032",
+	"DoubleExposureFlag":                                           "00741034",
+	"DoubleExposureOrdering":                                       "00741036",
+	"DoubleExposureMeterset":                                       "00741038",
+	"DoubleExposureFieldDelta":                                     "0074103A",
+	"RelatedReferenceRTImageSequence":                              "00741040",
+	"GeneralMachineVerificationSequence":                           "00741042",
+	"ConventionalMachineVerificationSequence":                      "00741044",
+	"IonMachineVerificationSequence":                               "00741046",
+	"FailedAttributesSequence":                                     "00741048",
+	"OverriddenAttributesSequence":                                 "0074104A",
+	"ConventionalControlPointVerificationSequence":                 "0074104C",
+	"IonControlPointVerificationSequence":                          "0074104E",
+	"AttributeOccurrenceSequence":                                  "00741050",
+	"AttributeOccurrencePointer":                                   "00741052",
+	"AttributeItemSelector":                                        "00741054",
+	"AttributeOccurrencePrivateCreator":                            "00741056",
+	"ScheduledProcedureStepPriority":                               "00741200",
+	"WorklistLabel":                                                "00741202",
+	"ProcedureStepLabel":                                           "00741204",
+	"ScheduledProcessingParametersSequence":                        "00741210",
+	"PerformedProcessingParametersSequence":                        "00741212",
+	"UnifiedProcedureStepPerformedProcedureSequence":               "00741216",
+	"RelatedProcedureStepSequence":                                 "00741220",
+	"ProcedureStepRelationshipType":                                "00741222",
+	"DeletionLock":                                                 "00741230",
+	"ReceivingAE":                                                  "00741234",
+	"RequestingAE":                                                 "00741236",
+	"ReasonForCancellation":                                        "00741238",
+	"SCPStatus":                                                    "00741242",
+	"SubscriptionListStatus":                                       "00741244",
+	"UPSListStatus":                                                "00741246",
+	"StorageMediaFileSetID":                                        "00880130",
+	"StorageMediaFileSetUID":                                       "00880140",
+	"IconImageSequence":                                            "00880200",
+	"TopicTitle":                                                   "00880904",
+	"TopicSubject":                                                 "00880906",
+	"TopicAuthor":                                                  "00880910",
+	"TopicKeywords":                                                "00880912",
+	"SOPInstanceStatus":                                            "01000410",
+	"SOPAuthorizationDateAndTime":                                  "01000420",
+	"SOPAuthorizationComment":                                      "01000424",
+	"AuthorizationEquipmentCertificationNumber":                    "01000426",
+	"MACIDNumber":                                                  "04000005",
+	"MACCalculationTransferSyntaxUID":                              "04000010",
+	"MACAlgorithm":                                                 "04000015",
+	"DataElementsSigned":                                           "04000020",
+	"DigitalSignatureUID":                                          "04000100",
+	"DigitalSignatureDateTime":                                     "04000105",
+	"CertificateType":                                              "04000110",
+	"CertificateOfSigner":                                          "04000115",
+	"Signature":                                                    "04000120",
+	"CertifiedTimestampType":                                       "04000305",
+	"CertifiedTimestamp":                                           "04000310",
+	"DigitalSignaturePurposeCodeSequence":                          "04000401",
+	"ReferencedDigitalSignatureSequence":                           "04000402",
+	"ReferencedSOPInstanceMACSequence":                             "04000403",
+	"MAC":                                                          "04000404",
+	"EncryptedAttributesSequence":                                  "04000500",
+	"EncryptedContentTransferSyntaxUID":                            "04000510",
+	"EncryptedContent":                                             "04000520",
+	"ModifiedAttributesSequence":                                   "04000550",
+	"OriginalAttributesSequence":                                   "04000561",
+	"AttributeModificationDateTime":                                "04000562",
+	"ModifyingSystem":                                              "04000563",
+	"SourceOfPreviousValues":                                       "04000564",
+	"ReasonForTheAttributeModification":                            "04000565",
+	"EscapeTriplet":                                                "1000XXX0",
+	"RunLengthTriplet":                                             "1000XXX1",
+	"HuffmanTableSize":                                             "1000XXX2",
+	"HuffmanTableTriplet":                                          "1000XXX3",
+	"ShiftTableSize":                                               "1000XXX4",
+	"ShiftTableTriplet":                                            "1000XXX5",
+	"ZonalMap":                                                     "1010XXXX",
+	"NumberOfCopies":                                               "20000010",
+	"PrinterConfigurationSequence":                                 "2000001E",
+	"PrintPriority":                                                "20000020",
+	"MediumType":                                                   "20000030",
+	"FilmDestination":                                              "20000040",
+	"FilmSessionLabel":                                             "20000050",
+	"MemoryAllocation":                                             "20000060",
+	"MaximumMemoryAllocation":                                      "20000061",
+	"ColorImagePrintingFlag":                                       "20000062",
+	"CollationFlag":                                                "20000063",
+	"AnnotationFlag":                                               "20000065",
+	"ImageOverlayFlag":                                             "20000067",
+	"PresentationLUTFlag":                                          "20000069",
+	"ImageBoxPresentationLUTFlag":                                  "2000006A",
+	"MemoryBitDepth":                                               "200000A0",
+	"PrintingBitDepth":                                             "200000A1",
+	"MediaInstalledSequence":                                       "200000A2",
+	"OtherMediaAvailableSequence":                                  "200000A4",
+	"SupportedImageDisplayFormatsSequence":                         "200000A8",
+	"ReferencedFilmBoxSequence":                                    "20000500",
+	"ReferencedStoredPrintSequence":                                "20000510",
+	"ImageDisplayFormat":                                           "20100010",
+	"AnnotationDisplayFormatID":                                    "20100030",
+	"FilmOrientation":                                              "20100040",
+	"FilmSizeID":                                                   "20100050",
+	"PrinterResolutionID":                                          "20100052",
+	"DefaultPrinterResolutionID":                                   "20100054",
+	"MagnificationType":                                            "20100060",
+	"SmoothingType":                                                "20100080",
+	"DefaultMagnificationType":                                     "201000A6",
+	"OtherMagnificationTypesAvailable":                             "201000A7",
+	"DefaultSmoothingType":                                         "201000A8",
+	"OtherSmoothingTypesAvailable":                                 "201000A9",
+	"BorderDensity":                                                "20100100",
+	"EmptyImageDensity":                                            "20100110",
+	"MinDensity":                                                   "20100120",
+	"MaxDensity":                                                   "20100130",
+	"Trim":                                                         "20100140",
+	"ConfigurationInformation":                                     "20100150",
+	"ConfigurationInformationDescription":                          "20100152",
+	"MaximumCollatedFilms":                                         "20100154",
+	"Illumination":                                                 "2010015E",
+	"ReflectedAmbientLight":                                        "20100160",
+	"PrinterPixelSpacing":                                          "20100376",
+	"ReferencedFilmSessionSequence":                                "20100500",
+	"ReferencedImageBoxSequence":                                   "20100510",
+	"ReferencedBasicAnnotationBoxSequence":                         "20100520",
+	"ImageBoxPosition":                                             "20200010",
+	"Polarity":                                                     "20200020",
+	"RequestedImageSize":                                           "20200030",
+	"RequestedDecimateCropBehavior":                                "20200040",
+	"RequestedResolutionID":                                        "20200050",
+	"RequestedImageSizeFlag":                                       "202000A0",
+	"DecimateCropResult":                                           "202000A2",
+	"BasicGrayscaleImageSequence":                                  "20200110",
+	"BasicColorImageSequence":                                      "20200111",
+	"ReferencedImageOverlayBoxSequence":                            "20200130",
+	"ReferencedVOILUTBoxSequence":                                  "20200140",
+	"AnnotationPosition":                                           "20300010",
+	"TextString":                                                   "20300020",
+	"ReferencedOverlayPlaneSequence":                               "20400010",
+	"ReferencedOverlayPlaneGroups":                                 "20400011",
+	"OverlayPixelDataSequence":                                     "20400020",
+	"OverlayMagnificationType":                                     "20400060",
+	"OverlaySmoothingType":                                         "20400070",
+	"OverlayOrImageMagnification":                                  "20400072",
+	"MagnifyToNumberOfColumns":                                     "20400074",
+	"OverlayForegroundDensity":                                     "20400080",
+	"OverlayBackgroundDensity":                                     "20400082",
+	"OverlayMode":                                                  "20400090",
+	"ThresholdDensity":                                             "20400100",
+	"ReferencedImageBoxSequenceRetired":                            "20400500",
+	"PresentationLUTSequence":                                      "20500010",
+	"PresentationLUTShape":                                         "20500020",
+	"ReferencedPresentationLUTSequence":                            "20500500",
+	"PrintJobID":                                                   "21000010",
+	"ExecutionStatus":                                              "21000020",
+	"ExecutionStatusInfo":                                          "21000030",
+	"CreationDate":                                                 "21000040",
+	"CreationTime":                                                 "21000050",
+	"Originator":                                                   "21000070",
+	"DestinationAE":                                                "21000140",
+	"OwnerID":                                                      "21000160",
+	"NumberOfFilms":                                                "21000170",
+	"ReferencedPrintJobSequencePullStoredPrint":                    "21000500",
+	"PrinterStatus":                                                "21100010",
+	"PrinterStatusInfo":                                            "21100020",
+	"PrinterName":                                                  "21100030",
+	"PrintQueueID":                                                 "21100099",
+	"QueueStatus":                                                  "21200010",
+	"PrintJobDescriptionSequence":                                  "21200050",
+	"ReferencedPrintJobSequence":                                   "21200070",
+	"PrintManagementCapabilitiesSequence":                          "21300010",
+	"PrinterCharacteristicsSequence":                               "21300015",
+	"FilmBoxContentSequence":                                       "21300030",
+	"ImageBoxContentSequence":                                      "21300040",
+	"AnnotationContentSequence":                                    "21300050",
+	"ImageOverlayBoxContentSequence":                               "21300060",
+	"PresentationLUTContentSequence":                               "21300080",
+	"ProposedStudySequence":                                        "213000A0",
+	"OriginalImageSequence":                                        "213000C0",
+	"LabelUsingInformationExtractedFromInstances":                  "22000001",
+	"LabelText":                                                    "22000002",
+	"LabelStyleSelection":                                          "22000003",
+	"MediaDisposition":                                             "22000004",
+	"BarcodeValue":                                                 "22000005",
+	"BarcodeSymbology":                                             "22000006",
+	"AllowMediaSplitting":                                          "22000007",
+	"IncludeNonDICOMObjects":                                       "22000008",
+	"IncludeDisplayApplication":                                    "22000009",
+	"PreserveCompositeInstancesAfterMediaCreation":                 "2200000A",
+	"TotalNumberOfPiecesOfMediaCreated":                            "2200000B",
+	"RequestedMediaApplicationProfile":                             "2200000C",
+	"ReferencedStorageMediaSequence":                               "2200000D",
+	"FailureAttributes":                                            "2200000E",
+	"AllowLossyCompression":                                        "2200000F",
+	"RequestPriority":                                              "22000020",
+	"RTImageLabel":                                                 "30020002",
+	"RTImageName":                                                  "30020003",
+	"RTImageDescription":                                           "30020004",
+	"ReportedValuesOrigin":                                         "3002000A",
+	"RTImagePlane":                                                 "3002000C",
+	"XRayImageReceptorTranslation":                                 "3002000D",
+	"XRayImageReceptorAngle":                                       "3002000E",
+	"RTImageOrientation":                                           "30020010",
+	"ImagePlanePixelSpacing":                                       "30020011",
+	"RTImagePosition":                                              "30020012",
+	"RadiationMachineName":                                         "30020020",
+	"RadiationMachineSAD":                                          "30020022",
+	"RadiationMachineSSD":                                          "30020024",
+	"RTImageSID":                                                   "30020026",
+	"SourceToReferenceObjectDistance":                              "30020028",
+	"FractionNumber":                                               "30020029",
+	"ExposureSequence":                                             "30020030",
+	"MetersetExposure":                                             "30020032",
+	"DiaphragmPosition":                                            "30020034",
+	"FluenceMapSequence":                                           "30020040",
+	"FluenceDataSource":                                            "30020041",
+	"FluenceDataScale":                                             "30020042",
+	"FluenceMode":                                                  "30020051",
+	"FluenceModeID":                                                "30020052",
+	"DVHType":                                                      "30040001",
+	"DoseUnits":                                                    "30040002",
+	"DoseType":                                                     "30040004",
+	"DoseComment":                                                  "30040006",
+	"NormalizationPoint":                                           "30040008",
+	"DoseSummationType":                                            "3004000A",
+	"GridFrameOffsetVector":                                        "3004000C",
+	"DoseGridScaling":                                              "3004000E",
+	"RTDoseROISequence":                                            "30040010",
+	"DoseValue":                                                    "30040012",
+	"TissueHeterogeneityCorrection":                                "30040014",
+	"DVHNormalizationPoint":                                        "30040040",
+	"DVHNormalizationDoseValue":                                    "30040042",
+	"DVHSequence":                                                  "30040050",
+	"DVHDoseScaling":                                               "30040052",
+	"DVHVolumeUnits":                                               "30040054",
+	"DVHNumberOfBins":                                              "30040056",
+	"DVHData":                                                      "30040058",
+	"DVHReferencedROISequence":                                     "30040060",
+	"DVHROIContributionType":                                       "30040062",
+	"DVHMinimumDose":                                               "30040070",
+	"DVHMaximumDose":                                               "30040072",
+	"DVHMeanDose":                                                  "30040074",
+	"StructureSetLabel":                                            "30060002",
+	"StructureSetName":                                             "30060004",
+	"StructureSetDescription":                                      "30060006",
+	"StructureSetDate":                                             "30060008",
+	"StructureSetTime":                                             "30060009",
+	"ReferencedFrameOfReferenceSequence":                           "30060010",
+	"RTReferencedStudySequence":                                    "30060012",
+	"RTReferencedSeriesSequence":                                   "30060014",
+	"ContourImageSequence":                                         "30060016",
+	"StructureSetROISequence":                                      "30060020",
+	"ROINumber":                                                    "30060022",
+	"ReferencedFrameOfReferenceUID":                                "30060024",
+	"ROIName":                                                      "30060026",
+	"ROIDescription":                                               "30060028",
+	"ROIDisplayColor":                                              "3006002A",
+	"ROIVolume":                                                    "3006002C",
+	"RTRelatedROISequence":                                         "30060030",
+	"RTROIRelationship":                                            "30060033",
+	"ROIGenerationAlgorithm":                                       "30060036",
+	"ROIGenerationDescription":                                     "30060038",
+	"ROIContourSequence":                                           "30060039",
+	"ContourSequence":                                              "30060040",
+	"ContourGeometricType":                                         "30060042",
+	"ContourSlabThickness":                                         "30060044",
+	"ContourOffsetVector":                                          "30060045",
+	"NumberOfContourPoints":                                        "30060046",
+	"ContourNumber":                                                "30060048",
+	"AttachedContours":                                             "30060049",
+	"ContourData":                                                  "30060050",
+	"RTROIObservationsSequence":                                    "30060080",
+	"ObservationNumber":                                            "30060082",
+	"ReferencedROINumber":                                          "30060084",
+	"ROIObservationLabel":                                          "30060085",
+	"RTROIIdentificationCodeSequence":                              "30060086",
+	"ROIObservationDescription":                                    "30060088",
+	"RelatedRTROIObservationsSequence":                             "300600A0",
+	"RTROIInterpretedType":                                         "300600A4",
+	"ROIInterpreter":                                               "300600A6",
+	"ROIPhysicalPropertiesSequence":                                "300600B0",
+	"ROIPhysicalProperty":                                          "300600B2",
+	"ROIPhysicalPropertyValue":                                     "300600B4",
+	"ROIElementalCompositionSequence":                              "300600B6",
+	"ROIElementalCompositionAtomicNumber":                          "300600B7",
+	"ROIElementalCompAtomicMassFraction":                           "300600B8",
+	"FrameOfReferenceRelationshipSequence":                         "300600C0",
+	"RelatedFrameOfReferenceUID":                                   "300600C2",
+	"FrameOfReferenceTransformationType":                           "300600C4",
+	"FrameOfReferenceTransformationMatrix":                         "300600C6",
+	"FrameOfReferenceTransformationComment":                        "300600C8",
+	"MeasuredDoseReferenceSequence":                                "30080010",
+	"MeasuredDoseDescription":                                      "30080012",
+	"MeasuredDoseType":                                             "30080014",
+	"MeasuredDoseValue":                                            "30080016",
+	"TreatmentSessionBeamSequence":                                 "30080020",
+	"TreatmentSessionIonBeamSequence":                              "30080021",
+	"CurrentFractionNumber":                                        "30080022",
+	"TreatmentControlPointDate":                                    "30080024",
+	"TreatmentControlPointTime":                                    "30080025",
+	"TreatmentTerminationStatus":                                   "3008002A",
+	"TreatmentTerminationCode":                                     "3008002B",
+	"TreatmentVerificationStatus":                                  "3008002C",
+	"ReferencedTreatmentRecordSequence":                            "30080030",
+	"SpecifiedPrimaryMeterset":                                     "30080032",
+	"SpecifiedSecondaryMeterset":                                   "30080033",
+	"DeliveredPrimaryMeterset":                                     "30080036",
+	"DeliveredSecondaryMeterset":                                   "30080037",
+	"SpecifiedTreatmentTime":                                       "3008003A",
+	"DeliveredTreatmentTime":                                       "3008003B",
+	"ControlPointDeliverySequence":                                 "30080040",
+	"IonControlPointDeliverySequence":                              "30080041",
+	"SpecifiedMeterset":                                            "30080042",
+	"DeliveredMeterset":                                            "30080044",
+	"MetersetRateSet":                                              "30080045",
+	"MetersetRateDelivered":                                        "30080046",
+	"ScanSpotMetersetsDelivered":                                   "30080047",
+	"DoseRateDelivered":                                            "30080048",
+	"TreatmentSummaryCalculatedDoseReferenceSequence":              "30080050",
+	"CumulativeDoseToDoseReference":                                "30080052",
+	"FirstTreatmentDate":                                           "30080054",
+	"MostRecentTreatmentDate":                                      "30080056",
+	"NumberOfFractionsDelivered":                                   "3008005A",
+	"OverrideSequence":                                             "30080060",
+	"ParameterSequencePointer":                                     "30080061",
+	"OverrideParameterPointer":                                     "30080062",
+	"ParameterItemIndex":                                           "30080063",
+	"MeasuredDoseReferenceNumber":                                  "30080064",
+	"ParameterPointer":                                             "30080065",
+	"OverrideReason":                                               "30080066",
+	"CorrectedParameterSequence":                                   "30080068",
+	"CorrectionValue":                                              "3008006A",
+	"CalculatedDoseReferenceSequence":                              "30080070",
+	"CalculatedDoseReferenceNumber":                                "30080072",
+	"CalculatedDoseReferenceDescription":                           "30080074",
+	"CalculatedDoseReferenceDoseValue":                             "30080076",
+	"StartMeterset":                                                "30080078",
+	"EndMeterset":                                                  "3008007A",
+	"ReferencedMeasuredDoseReferenceSequence":                      "30080080",
+	"ReferencedMeasuredDoseReferenceNumber":                        "30080082",
+	"ReferencedCalculatedDoseReferenceSequence":                    "30080090",
+	"ReferencedCalculatedDoseReferenceNumber":                      "30080092",
+	"BeamLimitingDeviceLeafPairsSequence":                          "300800A0",
+	"RecordedWedgeSequence":                                        "300800B0",
+	"RecordedCompensatorSequence":                                  "300800C0",
+	"RecordedBlockSequence":                                        "300800D0",
+	"TreatmentSummaryMeasuredDoseReferenceSequence":                "300800E0",
+	"RecordedSnoutSequence":                                        "300800F0",
+	"RecordedRangeShifterSequence":                                 "300800F2",
+	"RecordedLateralSpreadingDeviceSequence":                       "300800F4",
+	"RecordedRangeModulatorSequence":                               "300800F6",
+	"RecordedSourceSequence":                                       "30080100",
+	"SourceSerialNumber":                                           "30080105",
+	"TreatmentSessionApplicationSetupSequence":                     "30080110",
+	"ApplicationSetupCheck":                                        "30080116",
+	"RecordedBrachyAccessoryDeviceSequence":                        "30080120",
+	"ReferencedBrachyAccessoryDeviceNumber":                        "30080122",
+	"RecordedChannelSequence":                                      "30080130",
+	"SpecifiedChannelTotalTime":                                    "30080132",
+	"DeliveredChannelTotalTime":                                    "30080134",
+	"SpecifiedNumberOfPulses":                                      "30080136",
+	"DeliveredNumberOfPulses":                                      "30080138",
+	"SpecifiedPulseRepetitionInterval":                             "3008013A",
+	"DeliveredPulseRepetitionInterval":                             "3008013C",
+	"RecordedSourceApplicatorSequence":                             "30080140",
+	"ReferencedSourceApplicatorNumber":                             "30080142",
+	"RecordedChannelShieldSequence":                                "30080150",
+	"ReferencedChannelShieldNumber":                                "30080152",
+	"BrachyControlPointDeliveredSequence":                          "30080160",
+	"SafePositionExitDate":                                         "30080162",
+	"SafePositionExitTime":                                         "30080164",
+	"SafePositionReturnDate":                                       "30080166",
+	"SafePositionReturnTime":                                       "30080168",
+	"CurrentTreatmentStatus":                                       "30080200",
+	"TreatmentStatusComment":                                       "30080202",
+	"FractionGroupSummarySequence":                                 "30080220",
+	"ReferencedFractionNumber":                                     "30080223",
+	"FractionGroupType":                                            "30080224",
+	"BeamStopperPosition":                                          "30080230",
+	"FractionStatusSummarySequence":                                "30080240",
+	"TreatmentDate":                                                "30080250",
+	"TreatmentTime":                                                "30080251",
+	"RTPlanLabel":                                                  "300A0002",
+	"RTPlanName":                                                   "300A0003",
+	"RTPlanDescription":                                            "300A0004",
+	"RTPlanDate":                                                   "300A0006",
+	"RTPlanTime":                                                   "300A0007",
+	"TreatmentProtocols":                                           "300A0009",
+	"PlanIntent":                                                   "300A000A",
+	"TreatmentSites":                                               "300A000B",
+	"RTPlanGeometry":                                               "300A000C",
+	"PrescriptionDescription":                                      "300A000E",
+	"DoseReferenceSequence":                                        "300A0010",
+	"DoseReferenceNumber":                                          "300A0012",
+	"DoseReferenceUID":                                             "300A0013",
+	"DoseReferenceStructureType":                                   "300A0014",
+	"NominalBeamEnergyUnit":                                        "300A0015",
+	"DoseReferenceDescription":                                     "300A0016",
+	"DoseReferencePointCoordinates":                                "300A0018",
+	"NominalPriorDose":                                             "300A001A",
+	"DoseReferenceType":                                            "300A0020",
+	"ConstraintWeight":                                             "300A0021",
+	"DeliveryWarningDose":                                          "300A0022",
+	"DeliveryMaximumDose":                                          "300A0023",
+	"TargetMinimumDose":                                            "300A0025",
+	"TargetPrescriptionDose":                                       "300A0026",
+	"TargetMaximumDose":                                            "300A0027",
+	"TargetUnderdoseVolumeFraction":                                "300A0028",
+	"OrganAtRiskFullVolumeDose":                                    "300A002A",
+	"OrganAtRiskLimitDose":                                         "300A002B",
+	"OrganAtRiskMaximumDose":                                       "300A002C",
+	"OrganAtRiskOverdoseVolumeFraction":                            "300A002D",
+	"ToleranceTableSequence":                                       "300A0040",
+	"ToleranceTableNumber":                                         "300A0042",
+	"ToleranceTableLabel":                                          "300A0043",
+	"GantryAngleTolerance":                                         "300A0044",
+	"BeamLimitingDeviceAngleTolerance":                             "300A0046",
+	"BeamLimitingDeviceToleranceSequence":                          "300A0048",
+	"BeamLimitingDevicePositionTolerance":                          "300A004A",
+	"SnoutPositionTolerance":                                       "300A004B",
+	"PatientSupportAngleTolerance":                                 "300A004C",
+	"TableTopEccentricAngleTolerance":                              "300A004E",
+	"TableTopPitchAngleTolerance":                                  "300A004F",
+	"TableTopRollAngleTolerance":                                   "300A0050",
+	"TableTopVerticalPositionTolerance":                            "300A0051",
+	"TableTopLongitudinalPositionTolerance":                        "300A0052",
+	"TableTopLateralPositionTolerance":                             "300A0053",
+	"RTPlanRelationship":                                           "300A0055",
+	"FractionGroupSequence":                                        "300A0070",
+	"FractionGroupNumber":                                          "300A0071",
+	"FractionGroupDescription":                                     "300A0072",
+	"NumberOfFractionsPlanned":                                     "300A0078",
+	"NumberOfFractionPatternDigitsPerDay":                          "300A0079",
+	"RepeatFractionCycleLength":                                    "300A007A",
+	"FractionPattern":                                              "300A007B",
+	"NumberOfBeams":                                                "300A0080",
+	"BeamDoseSpecificationPoint":                                   "300A0082",
+	"BeamDose":                                                     "300A0084",
+	"BeamMeterset":                                                 "300A0086",
+	"BeamDosePointDepth":                                           "300A0088",
+	"BeamDosePointEquivalentDepth":                                 "300A0089",
+	"BeamDosePointSSD":                                             "300A008A",
+	"NumberOfBrachyApplicationSetups":                              "300A00A0",
+	"BrachyApplicationSetupDoseSpecificationPoint":                 "300A00A2",
+	"BrachyApplicationSetupDose":                                   "300A00A4",
+	"BeamSequence":                                                 "300A00B0",
+	"TreatmentMachineName":                                         "300A00B2",
+	"PrimaryDosimeterUnit":                                         "300A00B3",
+	"SourceAxisDistance":                                           "300A00B4",
+	"BeamLimitingDeviceSequence":                                   "300A00B6",
+	"RTBeamLimitingDeviceType":                                     "300A00B8",
+	"SourceToBeamLimitingDeviceDistance":                           "300A00BA",
+	"IsocenterToBeamLimitingDeviceDistance":                        "300A00BB",
+	"NumberOfLeafJawPairs":                                         "300A00BC",
+	"LeafPositionBoundaries":                                       "300A00BE",
+	"BeamNumber":                                                   "300A00C0",
+	"BeamName":                                                     "300A00C2",
+	"BeamDescription":                                              "300A00C3",
+	"BeamType":                                                     "300A00C4",
+	"RadiationType":                                                "300A00C6",
+	"HighDoseTechniqueType":                                        "300A00C7",
+	"ReferenceImageNumber":                                         "300A00C8",
+	"PlannedVerificationImageSequence":                             "300A00CA",
+	"ImagingDeviceSpecificAcquisitionParameters":                   "300A00CC",
+	"TreatmentDeliveryType":                                        "300A00CE",
+	"NumberOfWedges":                                               "300A00D0",
+	"WedgeSequence":                                                "300A00D1",
+	"WedgeNumber":                                                  "300A00D2",
+	"WedgeType":                                                    "300A00D3",
+	"WedgeID":                                                      "300A00D4",
+	"WedgeAngle":                                                   "300A00D5",
+	"WedgeFactor":                                                  "300A00D6",
+	"TotalWedgeTrayWaterEquivalentThickness":                       "300A00D7",
+	"WedgeOrientation":                                             "300A00D8",
+	"IsocenterToWedgeTrayDistance":                                 "300A00D9",
+	"SourceToWedgeTrayDistance":                                    "300A00DA",
+	"WedgeThinEdgePosition":                                        "300A00DB",
+	"BolusID":                                                      "300A00DC",
+	"BolusDescription":                                             "300A00DD",
+	"NumberOfCompensators":                                         "300A00E0",
+	"MaterialID":                                                   "300A00E1",
+	"TotalCompensatorTrayFactor":                                   "300A00E2",
+	"CompensatorSequence":                                          "300A00E3",
+	"CompensatorNumber":                                            "300A00E4",
+	"CompensatorID":                                                "300A00E5",
+	"SourceToCompensatorTrayDistance":                              "300A00E6",
+	"CompensatorRows":                                              "300A00E7",
+	"CompensatorColumns":                                           "300A00E8",
+	"CompensatorPixelSpacing":                                      "300A00E9",
+	"CompensatorPosition":                                          "300A00EA",
+	"CompensatorTransmissionData":                                  "300A00EB",
+	"CompensatorThicknessData":                                     "300A00EC",
+	"NumberOfBoli":                                                 "300A00ED",
+	"CompensatorType":                                              "300A00EE",
+	"NumberOfBlocks":                                               "300A00F0",
+	"TotalBlockTrayFactor":                                         "300A00F2",
+	"TotalBlockTrayWaterEquivalentThickness":                       "300A00F3",
+	"BlockSequence":                                                "300A00F4",
+	"BlockTrayID":                                                  "300A00F5",
+	"SourceToBlockTrayDistance":                                    "300A00F6",
+	"IsocenterToBlockTrayDistance":                                 "300A00F7",
+	"BlockType":                                                    "300A00F8",
+	"AccessoryCode":                                                "300A00F9",
+	"BlockDivergence":                                              "300A00FA",
+	"BlockMountingPosition":                                        "300A00FB",
+	"BlockNumber":                                                  "300A00FC",
+	"BlockName":                                                    "300A00FE",
+	"BlockThickness":                                               "300A0100",
+	"BlockTransmission":                                            "300A0102",
+	"BlockNumberOfPoints":                                          "300A0104",
+	"BlockData":                                                    "300A0106",
+	"ApplicatorSequence":                                           "300A0107",
+	"ApplicatorID":                                                 "300A0108",
+	"ApplicatorType":                                               "300A0109",
+	"ApplicatorDescription":                                        "300A010A",
+	"CumulativeDoseReferenceCoefficient":                           "300A010C",
+	"FinalCumulativeMetersetWeight":                                "300A010E",
+	"NumberOfControlPoints":                                        "300A0110",
+	"ControlPointSequence":                                         "300A0111",
+	"ControlPointIndex":                                            "300A0112",
+	"NominalBeamEnergy":                                            "300A0114",
+	"DoseRateSet":                                                  "300A0115",
+	"WedgePositionSequence":                                        "300A0116",
+	"WedgePosition":                                                "300A0118",
+	"BeamLimitingDevicePositionSequence":                           "300A011A",
+	"LeafJawPositions":                                             "300A011C",
+	"GantryAngle":                                                  "300A011E",
+	"GantryRotationDirection":                                      "300A011F",
+	"BeamLimitingDeviceAngle":                                      "300A0120",
+	"BeamLimitingDeviceRotationDirection":                          "300A0121",
+	"PatientSupportAngle":                                          "300A0122",
+	"PatientSupportRotationDirection":                              "300A0123",
+	"TableTopEccentricAxisDistance":                                "300A0124",
+	"TableTopEccentricAngle":                                       "300A0125",
+	"TableTopEccentricRotationDirection":                           "300A0126",
+	"TableTopVerticalPosition":                                     "300A0128",
+	"TableTopLongitudinalPosition":                                 "300A0129",
+	"TableTopLateralPosition":                                      "300A012A",
+	"IsocenterPosition":                                            "300A012C",
+	"SurfaceEntryPoint":                                            "300A012E",
+	"SourceToSurfaceDistance":                                      "300A0130",
+	"CumulativeMetersetWeight":                                     "300A0134",
+	"TableTopPitchAngle":                                           "300A0140",
+	"TableTopPitchRotationDirection":                               "300A0142",
+	"TableTopRollAngle":                                            "300A0144",
+	"TableTopRollRotationDirection":                                "300A0146",
+	"HeadFixationAngle":                                            "300A0148",
+	"GantryPitchAngle":                                             "300A014A",
+	"GantryPitchRotationDirection":                                 "300A014C",
+	"GantryPitchAngleTolerance":                                    "300A014E",
+	"PatientSetupSequence":                                         "300A0180",
+	"PatientSetupNumber":                                           "300A0182",
+	"PatientSetupLabel":                                            "300A0183",
+	"PatientAdditionalPosition":                                    "300A0184",
+	"FixationDeviceSequence":                                       "300A0190",
+	"FixationDeviceType":                                           "300A0192",
+	"FixationDeviceLabel":                                          "300A0194",
+	"FixationDeviceDescription":                                    "300A0196",
+	"FixationDevicePosition":                                       "300A0198",
+	"FixationDevicePitchAngle":                                     "300A0199",
+	"FixationDeviceRollAngle":                                      "300A019A",
+	"ShieldingDeviceSequence":                                      "300A01A0",
+	"ShieldingDeviceType":                                          "300A01A2",
+	"ShieldingDeviceLabel":                                         "300A01A4",
+	"ShieldingDeviceDescription":                                   "300A01A6",
+	"ShieldingDevicePosition":                                      "300A01A8",
+	"SetupTechnique":                                               "300A01B0",
+	"SetupTechniqueDescription":                                    "300A01B2",
+	"SetupDeviceSequence":                                          "300A01B4",
+	"SetupDeviceType":                                              "300A01B6",
+	"SetupDeviceLabel":                                             "300A01B8",
+	"SetupDeviceDescription":                                       "300A01BA",
+	"SetupDeviceParameter":                                         "300A01BC",
+	"SetupReferenceDescription":                                    "300A01D0",
+	"TableTopVerticalSetupDisplacement":                            "300A01D2",
+	"TableTopLongitudinalSetupDisplacement":                        "300A01D4",
+	"TableTopLateralSetupDisplacement":                             "300A01D6",
+	"BrachyTreatmentTechnique":                                     "300A0200",
+	"BrachyTreatmentType":                                          "300A0202",
+	"TreatmentMachineSequence":                                     "300A0206",
+	"SourceSequence":                                               "300A0210",
+	"SourceNumber":                                                 "300A0212",
+	"SourceType":                                                   "300A0214",
+	"SourceManufacturer":                                           "300A0216",
+	"ActiveSourceDiameter":                                         "300A0218",
+	"ActiveSourceLength":                                           "300A021A",
+	"SourceEncapsulationNominalThickness":                          "300A0222",
+	"SourceEncapsulationNominalTransmission":                       "300A0224",
+	"SourceIsotopeName":                                            "300A0226",
+	"SourceIsotopeHalfLife":                                        "300A0228",
+	"SourceStrengthUnits":                                          "300A0229",
+	"ReferenceAirKermaRate":                                        "300A022A",
+	"SourceStrength":                                               "300A022B",
+	"SourceStrengthReferenceDate":                                  "300A022C",
+	"SourceStrengthReferenceTime":                                  "300A022E",
+	"ApplicationSetupSequence":                                     "300A0230",
+	"ApplicationSetupType":                                         "300A0232",
+	"ApplicationSetupNumber":                                       "300A0234",
+	"ApplicationSetupName":                                         "300A0236",
+	"ApplicationSetupManufacturer":                                 "300A0238",
+	"TemplateNumber":                                               "300A0240",
+	"TemplateType":                                                 "300A0242",
+	"TemplateName":                                                 "300A0244",
+	"TotalReferenceAirKerma":                                       "300A0250",
+	"BrachyAccessoryDeviceSequence":                                "300A0260",
+	"BrachyAccessoryDeviceNumber":                                  "300A0262",
+	"BrachyAccessoryDeviceID":                                      "300A0263",
+	"BrachyAccessoryDeviceType":                                    "300A0264",
+	"BrachyAccessoryDeviceName":                                    "300A0266",
+	"BrachyAccessoryDeviceNominalThickness":                        "300A026A",
+	"BrachyAccessoryDeviceNominalTransmission":                     "300A026C",
+	"ChannelSequence":                                              "300A0280",
+	"ChannelNumber":                                                "300A0282",
+	"ChannelLength":                                                "300A0284",
+	"ChannelTotalTime":                                             "300A0286",
+	"SourceMovementType":                                           "300A0288",
+	"NumberOfPulses":                                               "300A028A",
+	"PulseRepetitionInterval":                                      "300A028C",
+	"SourceApplicatorNumber":                                       "300A0290",
+	"SourceApplicatorID":                                           "300A0291",
+	"SourceApplicatorType":                                         "300A0292",
+	"SourceApplicatorName":                                         "300A0294",
+	"SourceApplicatorLength":                                       "300A0296",
+	"SourceApplicatorManufacturer":                                 "300A0298",
+	"SourceApplicatorWallNominalThickness":                         "300A029C",
+	"SourceApplicatorWallNominalTransmission":                      "300A029E",
+	"SourceApplicatorStepSize":                                     "300A02A0",
+	"TransferTubeNumber":                                           "300A02A2",
+	"TransferTubeLength":                                           "300A02A4",
+	"ChannelShieldSequence":                                        "300A02B0",
+	"ChannelShieldNumber":                                          "300A02B2",
+	"ChannelShieldID":                                              "300A02B3",
+	"ChannelShieldName":                                            "300A02B4",
+	"ChannelShieldNominalThickness":                                "300A02B8",
+	"ChannelShieldNominalTransmission":                             "300A02BA",
+	"FinalCumulativeTimeWeight":                                    "300A02C8",
+	"BrachyControlPointSequence":                                   "300A02D0",
+	"ControlPointRelativePosition":                                 "300A02D2",
+	"ControlPoint3DPosition":                                       "300A02D4",
+	"CumulativeTimeWeight":                                         "300A02D6",
+	"CompensatorDivergence":                                        "300A02E0",
+	"CompensatorMountingPosition":                                  "300A02E1",
+	"SourceToCompensatorDistance":                                  "300A02E2",
+	"TotalCompensatorTrayWaterEquivalentThickness":                 "300A02E3",
+	"IsocenterToCompensatorTrayDistance":                           "300A02E4",
+	"CompensatorColumnOffset":                                      "300A02E5",
+	"IsocenterToCompensatorDistances":                              "300A02E6",
+	"CompensatorRelativeStoppingPowerRatio":                        "300A02E7",
+	"CompensatorMillingToolDiameter":                               "300A02E8",
+	"IonRangeCompensatorSequence":                                  "300A02EA",
+	"CompensatorDescription":                                       "300A02EB",
+	"RadiationMassNumber":                                          "300A0302",
+	"RadiationAtomicNumber":                                        "300A0304",
+	"RadiationChargeState":                                         "300A0306",
+	"ScanMode":                                                     "300A0308",
+	"VirtualSourceAxisDistances":                                   "300A030A",
+	"SnoutSequence":                                                "300A030C",
+	"SnoutPosition":                                                "300A030D",
+	"SnoutID":                                                      "300A030F",
+	"NumberOfRangeShifters":                                        "300A0312",
+	"RangeShifterSequence":                                         "300A0314",
+	"RangeShifterNumber":                                           "300A0316",
+	"RangeShifterID":                                               "300A0318",
+	"RangeShifterType":                                             "300A0320",
+	"RangeShifterDescription":                                      "300A0322",
+	"NumberOfLateralSpreadingDevices":                              "300A0330",
+	"LateralSpreadingDeviceSequence":                               "300A0332",
+	"LateralSpreadingDeviceNumber":                                 "300A0334",
+	"LateralSpreadingDeviceID":                                     "300A0336",
+	"LateralSpreadingDeviceType":                                   "300A0338",
+	"LateralSpreadingDeviceDescription":                            "300A033A",
+	"LateralSpreadingDeviceWaterEquivalentThickness":               "300A033C",
+	"NumberOfRangeModulators":                                      "300A0340",
+	"RangeModulatorSequence":                                       "300A0342",
+	"RangeModulatorNumber":                                         "300A0344",
+	"RangeModulatorID":                                             "300A0346",
+	"RangeModulatorType":                                           "300A0348",
+	"RangeModulatorDescription":                                    "300A034A",
+	"BeamCurrentModulationID":                                      "300A034C",
+	"PatientSupportType":                                           "300A0350",
+	"PatientSupportID":                                             "300A0352",
+	"PatientSupportAccessoryCode":                                  "300A0354",
+	"FixationLightAzimuthalAngle":                                  "300A0356",
+	"FixationLightPolarAngle":                                      "300A0358",
+	"MetersetRate":                                                 "300A035A",
+	"RangeShifterSettingsSequence":                                 "300A0360",
+	"RangeShifterSetting":                                          "300A0362",
+	"IsocenterToRangeShifterDistance":                              "300A0364",
+	"RangeShifterWaterEquivalentThickness":                         "300A0366",
+	"LateralSpreadingDeviceSettingsSequence":                       "300A0370",
+	"LateralSpreadingDeviceSetting":                                "300A0372",
+	"IsocenterToLateralSpreadingDeviceDistance":                    "300A0374",
+	"RangeModulatorSettingsSequence":                               "300A0380",
+	"RangeModulatorGatingStartValue":                               "300A0382",
+	"RangeModulatorGatingStopValue":                                "300A0384",
+	"IsocenterToRangeModulatorDistance":                            "300A038A",
+	"ScanSpotTuneID":                                               "300A0390",
+	"NumberOfScanSpotPositions":                                    "300A0392",
+	"ScanSpotPositionMap":                                          "300A0394",
+	"ScanSpotMetersetWeights":                                      "300A0396",
+	"ScanningSpotSize":                                             "300A0398",
+	"NumberOfPaintings":                                            "300A039A",
+	"IonToleranceTableSequence":                                    "300A03A0",
+	"IonBeamSequence":                                              "300A03A2",
+	"IonBeamLimitingDeviceSequence":                                "300A03A4",
+	"IonBlockSequence":                                             "300A03A6",
+	"IonControlPointSequence":                                      "300A03A8",
+	"IonWedgeSequence":                                             "300A03AA",
+	"IonWedgePositionSequence":                                     "300A03AC",
+	"ReferencedSetupImageSequence":                                 "300A0401",
+	"SetupImageComment":                                            "300A0402",
+	"MotionSynchronizationSequence":                                "300A0410",
+	"ControlPointOrientation":                                      "300A0412",
+	"GeneralAccessorySequence":                                     "300A0420",
+	"GeneralAccessoryID":                                           "300A0421",
+	"GeneralAccessoryDescription":                                  "300A0422",
+	"GeneralAccessoryType":                                         "300A0423",
+	"GeneralAccessoryNumber":                                       "300A0424",
+	"ReferencedRTPlanSequence":                                     "300C0002",
+	"ReferencedBeamSequence":                                       "300C0004",
+	"ReferencedBeamNumber":                                         "300C0006",
+	"ReferencedReferenceImageNumber":                               "300C0007",
+	"StartCumulativeMetersetWeight":                                "300C0008",
+	"EndCumulativeMetersetWeight":                                  "300C0009",
+	"ReferencedBrachyApplicationSetupSequence":                     "300C000A",
+	"ReferencedBrachyApplicationSetupNumber":                       "300C000C",
+	"ReferencedSourceNumber":                                       "300C000E",
+	"ReferencedFractionGroupSequence":                              "300C0020",
+	"ReferencedFractionGroupNumber":                                "300C0022",
+	"ReferencedVerificationImageSequence":                          "300C0040",
+	"ReferencedReferenceImageSequence":                             "300C0042",
+	"ReferencedDoseReferenceSequence":                              "300C0050",
+	"ReferencedDoseReferenceNumber":                                "300C0051",
+	"BrachyReferencedDoseReferenceSequence":                        "300C0055",
+	"ReferencedStructureSetSequence":                               "300C0060",
+	"ReferencedPatientSetupNumber":                                 "300C006A",
+	"ReferencedDoseSequence":                                       "300C0080",
+	"ReferencedToleranceTableNumber":                               "300C00A0",
+	"ReferencedBolusSequence":                                      "300C00B0",
+	"ReferencedWedgeNumber":                                        "300C00C0",
+	"ReferencedCompensatorNumber":                                  "300C00D0",
+	"ReferencedBlockNumber":                                        "300C00E0",
+	"ReferencedControlPointIndex":                                  "300C00F0",
+	"ReferencedControlPointSequence":                               "300C00F2",
+	"ReferencedStartControlPointIndex":                             "300C00F4",
+	"ReferencedStopControlPointIndex":                              "300C00F6",
+	"ReferencedRangeShifterNumber":                                 "300C0100",
+	"ReferencedLateralSpreadingDeviceNumber":                       "300C0102",
+	"ReferencedRangeModulatorNumber":                               "300C0104",
+	"ApprovalStatus":                                               "300E0002",
+	"ReviewDate":                                                   "300E0004",
+	"ReviewTime":                                                   "300E0005",
+	"ReviewerName":                                                 "300E0008",
+	"TextGroupLength":                                              "40000000",
+	"Arbitrary":                                                    "40000010",
+	"TextComments":                                                 "40004000",
+	"ResultsID":                                                    "40080040",
+	"ResultsIDIssuer":                                              "40080042",
+	"ReferencedInterpretationSequence":                             "40080050",
+	"InterpretationRecordedDate":                                   "40080100",
+	"InterpretationRecordedTime":                                   "40080101",
+	"InterpretationRecorder":                                       "40080102",
+	"ReferenceToRecordedSound":                                     "40080103",
+	"InterpretationTranscriptionDate":                              "40080108",
+	"InterpretationTranscriptionTime":                              "40080109",
+	"InterpretationTranscriber":                                    "4008010A",
+	"InterpretationText":                                           "4008010B",
+	"InterpretationAuthor":                                         "4008010C",
+	"InterpretationApproverSequence":                               "40080111",
+	"InterpretationApprovalDate":                                   "40080112",
+	"InterpretationApprovalTime":                                   "40080113",
+	"PhysicianApprovingInterpretation":                             "40080114",
+	"InterpretationDiagnosisDescription":                           "40080115",
+	"InterpretationDiagnosisCodeSequence":                          "40080117",
+	"ResultsDistributionListSequence":                              "40080118",
+	"DistributionName":                                             "40080119",
+	"DistributionAddress":                                          "4008011A",
+	"InterpretationID":                                             "40080200",
+	"InterpretationIDIssuer":                                       "40080202",
+	"InterpretationTypeID":                                         "40080210",
+	"InterpretationStatusID":                                       "40080212",
+	"Impressions":                                                  "40080300",
+	"ResultsComments":                                              "40084000",
+	"MACParametersSequence":                                        "4FFE0001",
+	"CurveDimensions":                                              "50XX0005",
+	"NumberOfPoints":                                               "50XX0010",
+	"TypeOfData":                                                   "50XX0020",
+	"CurveDescription":                                             "50XX0022",
+	"AxisUnits":                                                    "50XX0030",
+	"AxisLabels":                                                   "50XX0040",
+	"DataValueRepresentation":                                      "50XX0103",
+	"MinimumCoordinateValue":                                       "50XX0104",
+	"MaximumCoordinateValue":                                       "50XX0105",
+	"CurveRange":                                                   "50XX0106",
+	"CurveDataDescriptor":                                          "50XX0110",
+	"CoordinateStartValue":                                         "50XX0112",
+	"CoordinateStepValue":                                          "50XX0114",
+	"CurveActivationLayer":                                         "50XX1001",
+	"AudioType":                                                    "50XX2000",
+	"AudioSampleFormat":                                            "50XX2002",
+	"NumberOfChannels":                                             "50XX2004",
+	"NumberOfSamples":                                              "50XX2006",
+	"SampleRate":                                                   "50XX2008",
+	"TotalTime":                                                    "50XX200A",
+	"AudioSampleData":                                              "50XX200C",
+	"AudioComments":                                                "50XX200E",
+	"CurveLabel":                                                   "50XX2500",
+	"CurveReferencedOverlaySequence":                               "50XX2600",
+	"ReferencedOverlayGroup":                                       "50XX2610",
+	"CurveData":                                                    "50XX3000",
+	"SharedFunctionalGroupsSequence":                               "52009229",
+	"PerFrameFunctionalGroupsSequence":                             "52009230",
+	"WaveformSequence":                                             "54000100",
+	"ChannelMinimumValue":                                          "54000110",
+	"ChannelMaximumValue":                                          "54000112",
+	"WaveformBitsAllocated":                                        "54001004",
+	"WaveformSampleInterpretation":                                 "54001006",
+	"WaveformPaddingValue":                                         "5400100A",
+	"WaveformData":                                                 "54001010",
+	"FirstOrderPhaseCorrectionAngle":                               "56000010",
+	"SpectroscopyData":                                             "56000020",
+	"OverlayGroupLength":                                           "60000000",
+	"OverlayRows":                                                  "60XX0010",
+	"OverlayColumns":                                               "60XX0011",
+	"OverlayPlanes":                                                "60XX0012",
+	"NumberOfFramesInOverlay":                                      "60XX0015",
+	"OverlayDescription":                                           "60XX0022",
+	"OverlayType":                                                  "60XX0040",
+	"OverlaySubtype":                                               "60XX0045",
+	"OverlayOrigin":                                                "60XX0050",
+	"ImageFrameOrigin":                                             "60XX0051",
+	"OverlayPlaneOrigin":                                           "60XX0052",
+	"OverlayCompressionCode":                                       "60XX0060",
+	"OverlayCompressionOriginator":                                 "60XX0061",
+	"OverlayCompressionLabel":                                      "60XX0062",
+	"OverlayCompressionDescription":                                "60XX0063",
+	"OverlayCompressionStepPointers":                               "60XX0066",
+	"OverlayRepeatInterval":                                        "60XX0068",
+	"OverlayBitsGrouped":                                           "60XX0069",
+	"OverlayBitsAllocated":                                         "60XX0100",
+	"OverlayBitPosition":                                           "60XX0102",
+	"OverlayFormat":                                                "60XX0110",
+	"OverlayLocation":                                              "60XX0200",
+	"OverlayCodeLabel":                                             "60XX0800",
+	"OverlayNumberOfTables":                                        "60XX0802",
+	"OverlayCodeTableLocation":                                     "60XX0803",
+	"OverlayBitsForCodeWord":                                       "60XX0804",
+	"OverlayActivationLayer":                                       "60XX1001",
+	"OverlayDescriptorGray":                                        "60XX1100",
+	"OverlayDescriptorRed":                                         "60XX1101",
+	"OverlayDescriptorGreen":                                       "60XX1102",
+	"OverlayDescriptorBlue":                                        "60XX1103",
+	"OverlaysGray":                                                 "60XX1200",
+	"OverlaysRed":                                                  "60XX1201",
+	"OverlaysGreen":                                                "60XX1202",
+	"OverlaysBlue":                                                 "60XX1203",
+	"ROIArea":                                                      "60XX1301",
+	"ROIMean":                                                      "60XX1302",
+	"ROIStandardDeviation":                                         "60XX1303",
+	"OverlayLabel":                                                 "60XX1500",
+	"OverlayData":                                                  "60XX3000",
+	"OverlayComments":                                              "60XX4000",
+	"FloatPixelData":                                               "7FE00008",
+	"DoubleFloatPixelData":                                         "7FE00009",
+	"PixelData":                                                    "7FE00010",
+	"PixelDataGroupLength":                                         "7FXX0000",
+	"VariablePixelData":                                            "7FXX0010",
+	"VariableNextDataGroup":                                        "7FXX0011",
+	"VariableCoefficientsSDVN":                                     "7FXX0020",
+	"VariableCoefficientsSDHN":                                     "7FXX0030",
+	"VariableCoefficientsSDDN":                                     "7FXX0040",
+	"DigitalSignaturesSequence":                                    "FFFAFFFA",
+	"DataSetTrailingPadding":                                       "FFFCFFFC",
+	"Item":                                                         "FFFEE000",
+	"ItemDelimitationItem":                                         "FFFEE00D",
+	"SequenceDelimitationItem":                                     "FFFEE0DD",
+}
@@ -0,0 +1,52 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom/uid"
+)
+
+// TransferSyntax describes how the data elements of a dataset are encoded.
+// PS3.5 Section 10
+type TransferSyntax struct {
+	UID       string
+	Explicit  bool
+	ByteOrder binary.ByteOrder
+	Deflated  bool
+}
+
+// Uncompressed transfer syntaxes.
+var (
+	ImplicitVRLittleEndian         = TransferSyntax{UID: uid.ImplicitVRLittleEndian, ByteOrder: binary.LittleEndian}
+	ExplicitVRLittleEndian         = TransferSyntax{UID: uid.ExplicitVRLittleEndian, Explicit: true, ByteOrder: binary.LittleEndian}
+	DeflatedExplicitVRLittleEndian = TransferSyntax{UID: uid.DeflatedExplicitVRLittleEndian, Explicit: true, ByteOrder: binary.LittleEndian, Deflated: true}
+	ExplicitVRBigEndian            = TransferSyntax{UID: uid.ExplicitVRBigEndian, Explicit: true, ByteOrder: binary.BigEndian}
+)
+
+// LookupTransferSyntax returns the TransferSyntax for the given UID u.
+// Trailing padding is ignored.
+// Any UID other than the uncompressed ones is assumed to be an encapsulated
+// syntax, which are all Explicit VR Little Endian.
+func LookupTransferSyntax(u string) TransferSyntax {
+	u = strings.TrimRight(u, "\x00 ")
+	switch u {
+	case ImplicitVRLittleEndian.UID:
+		return ImplicitVRLittleEndian
+	case ExplicitVRLittleEndian.UID:
+		return ExplicitVRLittleEndian
+	case DeflatedExplicitVRLittleEndian.UID:
+		return DeflatedExplicitVRLittleEndian
+	case ExplicitVRBigEndian.UID:
+		return ExplicitVRBigEndian
+	}
+	return TransferSyntax{UID: u, Explicit: true, ByteOrder: binary.LittleEndian}
+}
@@ -0,0 +1,21 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package uid contains the registry of DICOM Unique Identifiers: SOP classes,
+// transfer syntaxes, well-known instances and frames of reference.
+//
+// uid.go is generated from PS3.6 with dictgen, see dicom/dictionary.go.
+package uid
+
+// Entry - UID registry entry.
+type Entry struct {
+	Name    string
+	Keyword string
+	Type    string // e.g. "SOP Class", "Transfer Syntax".
+	Retired bool
+}
@@ -0,0 +1,1295 @@
+// Code generated by dictgen from part06.xml. DO NOT EDIT.
+
+package uid
+
+// UID values by keyword.
+// PS3.6 Table A-1 and Table A-2.
+const (
+	// Verification Verification SOP Class
+	Verification = "1.2.840.10008.1.1"
+	// ImplicitVRLittleEndian Implicit VR Little Endian: Default Transfer Syntax for DICOM
+	ImplicitVRLittleEndian = "1.2.840.10008.1.2"
+	// ExplicitVRLittleEndian Explicit VR Little Endian
+	ExplicitVRLittleEndian = "1.2.840.10008.1.2.1"
+	// EncapsulatedUncompressedExplicitVRLittleEndian Encapsulated Uncompressed Explicit VR Little Endian
+	EncapsulatedUncompressedExplicitVRLittleEndian = "1.2.840.10008.1.2.1.98"
+	// DeflatedExplicitVRLittleEndian Deflated Explicit VR Little Endian
+	DeflatedExplicitVRLittleEndian = "1.2.840.10008.1.2.1.99"
+	// ExplicitVRBigEndian Explicit VR Big Endian
+	ExplicitVRBigEndian = "1.2.840.10008.1.2.2"
+	// JPEGBaseline8Bit JPEG Baseline (Process 1): Default Transfer Syntax for Lossy JPEG 8 Bit Image Compression
+	JPEGBaseline8Bit = "1.2.840.10008.1.2.4.50"
+	// JPEGExtended12Bit JPEG Extended (Process 2 & 4): Default Transfer Syntax for Lossy JPEG 12 Bit Image Compression (Process 4 only)
+	JPEGExtended12Bit = "1.2.840.10008.1.2.4.51"
+	// JPEGExtended35 JPEG Extended (Processes 3 & 5)
+	JPEGExtended35 = "1.2.840.10008.1.2.4.52"
+	// JPEGSpectralSelectionNonHierarchical68 JPEG Spectral Selection, Non-Hierarchical (Processes 6 & 8)
+	JPEGSpectralSelectionNonHierarchical68 = "1.2.840.10008.1.2.4.53"
+	// JPEGSpectralSelectionNonHierarchical79 JPEG Spectral Selection, Non-Hierarchical (Processes 7 & 9)
+	JPEGSpectralSelectionNonHierarchical79 = "1.2.840.10008.1.2.4.54"
+	// JPEGFullProgressionNonHierarchical1012 JPEG Full Progression, Non-Hierarchical (Processes 10 & 12)
+	JPEGFullProgressionNonHierarchical1012 = "1.2.840.10008.1.2.4.55"
+	// JPEGFullProgressionNonHierarchical1113 JPEG Full Progression, Non-Hierarchical (Processes 11 & 13)
+	JPEGFullProgressionNonHierarchical1113 = "1.2.840.10008.1.2.4.56"
+	// JPEGLossless JPEG Lossless, Non-Hierarchical (Process 14)
+	JPEGLossless = "1.2.840.10008.1.2.4.57"
+	// JPEGLosslessNonHierarchical15 JPEG Lossless, Non-Hierarchical (Process 15)
+	JPEGLosslessNonHierarchical15 = "1.2.840.10008.1.2.4.58"
+	// JPEGExtendedHierarchical1618 JPEG Extended, Hierarchical (Processes 16 & 18)
+	JPEGExtendedHierarchical1618 = "1.2.840.10008.1.2.4.59"
+	// JPEGExtendedHierarchical1719 JPEG Extended, Hierarchical (Processes 17 & 19)
+	JPEGExtendedHierarchical1719 = "1.2.840.10008.1.2.4.60"
+	// JPEGSpectralSelectionHierarchical2022 JPEG Spectral Selection, Hierarchical (Processes 20 & 22)
+	JPEGSpectralSelectionHierarchical2022 = "1.2.840.10008.1.2.4.61"
+	// JPEGSpectralSelectionHierarchical2123 JPEG Spectral Selection, Hierarchical (Processes 21 & 23)
+	JPEGSpectralSelectionHierarchical2123 = "1.2.840.10008.1.2.4.62"
+	// JPEGFullProgressionHierarchical2426 JPEG Full Progression, Hierarchical (Processes 24 & 26)
+	JPEGFullProgressionHierarchical2426 = "1.2.840.10008.1.2.4.63"
+	// JPEGFullProgressionHierarchical2527 JPEG Full Progression, Hierarchical (Processes 25 & 27)
+	JPEGFullProgressionHierarchical2527 = "1.2.840.10008.1.2.4.64"
+	// JPEGLosslessHierarchical28 JPEG Lossless, Hierarchical (Process 28)
+	JPEGLosslessHierarchical28 = "1.2.840.10008.1.2.4.65"
+	// JPEGLosslessHierarchical29 JPEG Lossless, Hierarchical (Process 29)
+	JPEGLosslessHierarchical29 = "1.2.840.10008.1.2.4.66"
+	// JPEGLosslessSV1 JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14 [Selection Value 1]): Default Transfer Syntax for Lossless JPEG Image Compression
+	JPEGLosslessSV1 = "1.2.840.10008.1.2.4.70"
+	// JPEGLSLossless JPEG-LS Lossless Image Compression
+	JPEGLSLossless = "1.2.840.10008.1.2.4.80"
+	// JPEGLSNearLossless JPEG-LS Lossy (Near-Lossless) Image Compression
+	JPEGLSNearLossless = "1.2.840.10008.1.2.4.81"
+	// JPEG2000Lossless JPEG 2000 Image Compression (Lossless Only)
+	JPEG2000Lossless = "1.2.840.10008.1.2.4.90"
+	// JPEG2000 JPEG 2000 Image Compression
+	JPEG2000 = "1.2.840.10008.1.2.4.91"
+	// JPEG2000MCLossless JPEG 2000 Part 2 Multi-component Image Compression (Lossless Only)
+	JPEG2000MCLossless = "1.2.840.10008.1.2.4.92"
+	// JPEG2000MC JPEG 2000 Part 2 Multi-component Image Compression
+	JPEG2000MC = "1.2.840.10008.1.2.4.93"
+	// JPIPReferenced JPIP Referenced
+	JPIPReferenced = "1.2.840.10008.1.2.4.94"
+	// JPIPReferencedDeflate JPIP Referenced Deflate
+	JPIPReferencedDeflate = "1.2.840.10008.1.2.4.95"
+	// MPEG2MPML MPEG2 Main Profile / Main Level
+	MPEG2MPML = "1.2.840.10008.1.2.4.100"
+	// MPEG2MPMLF Fragmentable MPEG2 Main Profile / Main Level
+	MPEG2MPMLF = "1.2.840.10008.1.2.4.100.1"
+	// MPEG2MPHL MPEG2 Main Profile / High Level
+	MPEG2MPHL = "1.2.840.10008.1.2.4.101"
+	// MPEG2MPHLF Fragmentable MPEG2 Main Profile / High Level
+	MPEG2MPHLF = "1.2.840.10008.1.2.4.101.1"
+	// MPEG4HP41 MPEG-4 AVC/H.264 High Profile / Level 4.1
+	MPEG4HP41 = "1.2.840.10008.1.2.4.102"
+	// MPEG4HP41F Fragmentable MPEG-4 AVC/H.264 High Profile / Level 4.1
+	MPEG4HP41F = "1.2.840.10008.1.2.4.102.1"
+	// MPEG4HP41BD MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1
+	MPEG4HP41BD = "1.2.840.10008.1.2.4.103"
+	// MPEG4HP41BDF Fragmentable MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1
+	MPEG4HP41BDF = "1.2.840.10008.1.2.4.103.1"
+	// MPEG4HP422D MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video
+	MPEG4HP422D = "1.2.840.10008.1.2.4.104"
+	// MPEG4HP422DF Fragmentable MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video
+	MPEG4HP422DF = "1.2.840.10008.1.2.4.104.1"
+	// MPEG4HP423D MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video
+	MPEG4HP423D = "1.2.840.10008.1.2.4.105"
+	// MPEG4HP423DF Fragmentable MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video
+	MPEG4HP423DF = "1.2.840.10008.1.2.4.105.1"
+	// MPEG4HP42STEREO MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2
+	MPEG4HP42STEREO = "1.2.840.10008.1.2.4.106"
+	// MPEG4HP42STEREOF Fragmentable MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2
+	MPEG4HP42STEREOF = "1.2.840.10008.1.2.4.106.1"
+	// HEVCMP51 HEVC/H.265 Main Profile / Level 5.1
+	HEVCMP51 = "1.2.840.10008.1.2.4.107"
+	// HEVCM10P51 HEVC/H.265 Main 10 Profile / Level 5.1
+	HEVCM10P51 = "1.2.840.10008.1.2.4.108"
+	// JPEGXLLossless JPEG XL Lossless
+	JPEGXLLossless = "1.2.840.10008.1.2.4.110"
+	// JPEGXLJPEGRecompression JPEG XL JPEG Recompression
+	JPEGXLJPEGRecompression = "1.2.840.10008.1.2.4.111"
+	// JPEGXL JPEG XL
+	JPEGXL = "1.2.840.10008.1.2.4.112"
+	// HTJ2KLossless High-Throughput JPEG 2000 Image Compression (Lossless Only)
+	HTJ2KLossless = "1.2.840.10008.1.2.4.201"
+	// HTJ2KLosslessRPCL High-Throughput JPEG 2000 with RPCL Options Image Compression (Lossless Only)
+	HTJ2KLosslessRPCL = "1.2.840.10008.1.2.4.202"
+	// HTJ2K High-Throughput JPEG 2000 Image Compression
+	HTJ2K = "1.2.840.10008.1.2.4.203"
+	// JPIPHTJ2KReferenced JPIP HTJ2K Referenced
+	JPIPHTJ2KReferenced = "1.2.840.10008.1.2.4.204"
+	// JPIPHTJ2KReferencedDeflate JPIP HTJ2K Referenced Deflate
+	JPIPHTJ2KReferencedDeflate = "1.2.840.10008.1.2.4.205"
+	// RLELossless RLE Lossless
+	RLELossless = "1.2.840.10008.1.2.5"
+	// RFC2557MIMEEncapsulation RFC 2557 MIME encapsulation
+	RFC2557MIMEEncapsulation = "1.2.840.10008.1.2.6.1"
+	// XMLEncoding XML Encoding
+	XMLEncoding = "1.2.840.10008.1.2.6.2"
+	// SMPTEST211020UncompressedProgressiveActiveVideo SMPTE ST 2110-20 Uncompressed Progressive Active Video
+	SMPTEST211020UncompressedProgressiveActiveVideo = "1.2.840.10008.1.2.7.1"
+	// SMPTEST211020UncompressedInterlacedActiveVideo SMPTE ST 2110-20 Uncompressed Interlaced Active Video
+	SMPTEST211020UncompressedInterlacedActiveVideo = "1.2.840.10008.1.2.7.2"
+	// SMPTEST211030PCMDigitalAudio SMPTE ST 2110-30 PCM Digital Audio
+	SMPTEST211030PCMDigitalAudio = "1.2.840.10008.1.2.7.3"
+	// MediaStorageDirectoryStorage Media Storage Directory Storage
+	MediaStorageDirectoryStorage = "1.2.840.10008.1.3.10"
+	// HotIronPalette Hot Iron Color Palette SOP Instance
+	HotIronPalette = "1.2.840.10008.1.5.1"
+	// PETPalette PET Color Palette SOP Instance
+	PETPalette = "1.2.840.10008.1.5.2"
+	// HotMetalBluePalette Hot Metal Blue Color Palette SOP Instance
+	HotMetalBluePalette = "1.2.840.10008.1.5.3"
+	// PET20StepPalette PET 20 Step Color Palette SOP Instance
+	PET20StepPalette = "1.2.840.10008.1.5.4"
+	// SpringPalette Spring Color Palette SOP Instance
+	SpringPalette = "1.2.840.10008.1.5.5"
+	// SummerPalette Summer Color Palette SOP Instance
+	SummerPalette = "1.2.840.10008.1.5.6"
+	// FallPalette Fall Color Palette SOP Instance
+	FallPalette = "1.2.840.10008.1.5.7"
+	// WinterPalette Winter Color Palette SOP Instance
+	WinterPalette = "1.2.840.10008.1.5.8"
+	// BasicStudyContentNotification Basic Study Content Notification SOP Class
+	BasicStudyContentNotification = "1.2.840.10008.1.9"
+	// Papyrus3ImplicitVRLittleEndian Papyrus 3 Implicit VR Little Endian
+	Papyrus3ImplicitVRLittleEndian = "1.2.840.10008.1.20"
+	// StorageCommitmentPushModel Storage Commitment Push Model SOP Class
+	StorageCommitmentPushModel = "1.2.840.10008.1.20.1"
+	// StorageCommitmentPushModelInstance Storage Commitment Push Model SOP Instance
+	StorageCommitmentPushModelInstance = "1.2.840.10008.1.20.1.1"
+	// StorageCommitmentPullModel Storage Commitment Pull Model SOP Class
+	StorageCommitmentPullModel = "1.2.840.10008.1.20.2"
+	// StorageCommitmentPullModelInstance Storage Commitment Pull Model SOP Instance
+	StorageCommitmentPullModelInstance = "1.2.840.10008.1.20.2.1"
+	// ProceduralEventLogging Procedural Event Logging SOP Class
+	ProceduralEventLogging = "1.2.840.10008.1.40"
+	// ProceduralEventLoggingInstance Procedural Event Logging SOP Instance
+	ProceduralEventLoggingInstance = "1.2.840.10008.1.40.1"
+	// SubstanceAdministrationLogging Substance Administration Logging SOP Class
+	SubstanceAdministrationLogging = "1.2.840.10008.1.42"
+	// SubstanceAdministrationLoggingInstance Substance Administration Logging SOP Instance
+	SubstanceAdministrationLoggingInstance = "1.2.840.10008.1.42.1"
+	// DCMUID DICOM UID Registry
+	DCMUID = "1.2.840.10008.2.6.1"
+	// DCM DICOM Controlled Terminology
+	DCM = "1.2.840.10008.2.16.4"
+	// DICOMApplicationContext DICOM Application Context Name
+	DICOMApplicationContext = "1.2.840.10008.3.1.1.1"
+	// DetachedPatientManagement Detached Patient Management SOP Class
+	DetachedPatientManagement = "1.2.840.10008.3.1.2.1.1"
+	// DetachedPatientManagementMeta Detached Patient Management Meta SOP Class
+	DetachedPatientManagementMeta = "1.2.840.10008.3.1.2.1.4"
+	// DetachedVisitManagement Detached Visit Management SOP Class
+	DetachedVisitManagement = "1.2.840.10008.3.1.2.2.1"
+	// DetachedStudyManagement Detached Study Management SOP Class
+	DetachedStudyManagement = "1.2.840.10008.3.1.2.3.1"
+	// StudyComponentManagement Study Component Management SOP Class
+	StudyComponentManagement = "1.2.840.10008.3.1.2.3.2"
+	// ModalityPerformedProcedureStep Modality Performed Procedure Step SOP Class
+	ModalityPerformedProcedureStep = "1.2.840.10008.3.1.2.3.3"
+	// ModalityPerformedProcedureStepRetrieve Modality Performed Procedure Step Retrieve SOP Class
+	ModalityPerformedProcedureStepRetrieve = "1.2.840.10008.3.1.2.3.4"
+	// ModalityPerformedProcedureStepNotification Modality Performed Procedure Step Notification SOP Class
+	ModalityPerformedProcedureStepNotification = "1.2.840.10008.3.1.2.3.5"
+	// DetachedResultsManagement Detached Results Management SOP Class
+	DetachedResultsManagement = "1.2.840.10008.3.1.2.5.1"
+	// DetachedResultsManagementMeta Detached Results Management Meta SOP Class
+	DetachedResultsManagementMeta = "1.2.840.10008.3.1.2.5.4"
+	// DetachedStudyManagementMeta Detached Study Management Meta SOP Class
+	DetachedStudyManagementMeta = "1.2.840.10008.3.1.2.5.5"
+	// DetachedInterpretationManagement Detached Interpretation Management SOP Class
+	DetachedInterpretationManagement = "1.2.840.10008.3.1.2.6.1"
+	// Storage Storage Service Class
+	Storage = "1.2.840.10008.4.2"
+	// BasicFilmSession Basic Film Session SOP Class
+	BasicFilmSession = "1.2.840.10008.5.1.1.1"
+	// BasicFilmBox Basic Film Box SOP Class
+	BasicFilmBox = "1.2.840.10008.5.1.1.2"
+	// BasicGrayscaleImageBox Basic Grayscale Image Box SOP Class
+	BasicGrayscaleImageBox = "1.2.840.10008.5.1.1.4"
+	// BasicColorImageBox Basic Color Image Box SOP Class
+	BasicColorImageBox = "1.2.840.10008.5.1.1.4.1"
+	// ReferencedImageBox Referenced Image Box SOP Class
+	ReferencedImageBox = "1.2.840.10008.5.1.1.4.2"
+	// BasicGrayscalePrintManagementMeta Basic Grayscale Print Management Meta SOP Class
+	BasicGrayscalePrintManagementMeta = "1.2.840.10008.5.1.1.9"
+	// ReferencedGrayscalePrintManagementMeta Referenced Grayscale Print Management Meta SOP Class
+	ReferencedGrayscalePrintManagementMeta = "1.2.840.10008.5.1.1.9.1"
+	// PrintJob Print Job SOP Class
+	PrintJob = "1.2.840.10008.5.1.1.14"
+	// BasicAnnotationBox Basic Annotation Box SOP Class
+	BasicAnnotationBox = "1.2.840.10008.5.1.1.15"
+	// Printer Printer SOP Class
+	Printer = "1.2.840.10008.5.1.1.16"
+	// PrinterConfigurationRetrieval Printer Configuration Retrieval SOP Class
+	PrinterConfigurationRetrieval = "1.2.840.10008.5.1.1.16.376"
+	// PrinterInstance Printer SOP Instance
+	PrinterInstance = "1.2.840.10008.5.1.1.17"
+	// PrinterConfigurationRetrievalInstance Printer Configuration Retrieval SOP Instance
+	PrinterConfigurationRetrievalInstance = "1.2.840.10008.5.1.1.17.376"
+	// BasicColorPrintManagementMeta Basic Color Print Management Meta SOP Class
+	BasicColorPrintManagementMeta = "1.2.840.10008.5.1.1.18"
+	// ReferencedColorPrintManagementMeta Referenced Color Print Management Meta SOP Class
+	ReferencedColorPrintManagementMeta = "1.2.840.10008.5.1.1.18.1"
+	// VOILUTBox VOI LUT Box SOP Class
+	VOILUTBox = "1.2.840.10008.5.1.1.22"
+	// PresentationLUT Presentation LUT SOP Class
+	PresentationLUT = "1.2.840.10008.5.1.1.23"
+	// ImageOverlayBox Image Overlay Box SOP Class
+	ImageOverlayBox = "1.2.840.10008.5.1.1.24"
+	// BasicPrintImageOverlayBox Basic Print Image Overlay Box SOP Class
+	BasicPrintImageOverlayBox = "1.2.840.10008.5.1.1.24.1"
+	// PrintQueueInstance Print Queue SOP Instance
+	PrintQueueInstance = "1.2.840.10008.5.1.1.25"
+	// PrintQueueManagement Print Queue Management SOP Class
+	PrintQueueManagement = "1.2.840.10008.5.1.1.26"
+	// StoredPrintStorage Stored Print Storage SOP Class
+	StoredPrintStorage = "1.2.840.10008.5.1.1.27"
+	// HardcopyGrayscaleImageStorage Hardcopy Grayscale Image Storage SOP Class
+	HardcopyGrayscaleImageStorage = "1.2.840.10008.5.1.1.29"
+	// HardcopyColorImageStorage Hardcopy Color Image Storage SOP Class
+	HardcopyColorImageStorage = "1.2.840.10008.5.1.1.30"
+	// PullPrintRequest Pull Print Request SOP Class
+	PullPrintRequest = "1.2.840.10008.5.1.1.31"
+	// PullStoredPrintManagementMeta Pull Stored Print Management Meta SOP Class
+	PullStoredPrintManagementMeta = "1.2.840.10008.5.1.1.32"
+	// MediaCreationManagement Media Creation Management SOP Class UID
+	MediaCreationManagement = "1.2.840.10008.5.1.1.33"
+	// DisplaySystem Display System SOP Class
+	DisplaySystem = "1.2.840.10008.5.1.1.40"
+	// DisplaySystemInstance Display System SOP Instance
+	DisplaySystemInstance = "1.2.840.10008.5.1.1.40.1"
+	// ComputedRadiographyImageStorage Computed Radiography Image Storage
+	ComputedRadiographyImageStorage = "1.2.840.10008.5.1.4.1.1.1"
+	// DigitalXRayImageStorageForPresentation Digital X-Ray Image Storage - For Presentation
+	DigitalXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.1.1"
+	// DigitalXRayImageStorageForProcessing Digital X-Ray Image Storage - For Processing
+	DigitalXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.1.1.1"
+	// DigitalMammographyXRayImageStorageForPresentation Digital Mammography X-Ray Image Storage - For Presentation
+	DigitalMammographyXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.1.2"
+	// DigitalMammographyXRayImageStorageForProcessing Digital Mammography X-Ray Image Storage - For Processing
+	DigitalMammographyXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.1.2.1"
+	// DigitalIntraOralXRayImageStorageForPresentation Digital Intra-Oral X-Ray Image Storage - For Presentation
+	DigitalIntraOralXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.1.3"
+	// DigitalIntraOralXRayImageStorageForProcessing Digital Intra-Oral X-Ray Image Storage - For Processing
+	DigitalIntraOralXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.1.3.1"
+	// CTImageStorage CT Image Storage
+	CTImageStorage = "1.2.840.10008.5.1.4.1.1.2"
+	// EnhancedCTImageStorage Enhanced CT Image Storage
+	EnhancedCTImageStorage = "1.2.840.10008.5.1.4.1.1.2.1"
+	// LegacyConvertedEnhancedCTImageStorage Legacy Converted Enhanced CT Image Storage
+	LegacyConvertedEnhancedCTImageStorage = "1.2.840.10008.5.1.4.1.1.2.2"
+	// UltrasoundMultiFrameImageStorageRetired Ultrasound Multi-frame Image Storage
+	UltrasoundMultiFrameImageStorageRetired = "1.2.840.10008.5.1.4.1.1.3"
+	// UltrasoundMultiFrameImageStorage Ultrasound Multi-frame Image Storage
+	UltrasoundMultiFrameImageStorage = "1.2.840.10008.5.1.4.1.1.3.1"
+	// MRImageStorage MR Image Storage
+	MRImageStorage = "1.2.840.10008.5.1.4.1.1.4"
+	// EnhancedMRImageStorage Enhanced MR Image Storage
+	EnhancedMRImageStorage = "1.2.840.10008.5.1.4.1.1.4.1"
+	// MRSpectroscopyStorage MR Spectroscopy Storage
+	MRSpectroscopyStorage = "1.2.840.10008.5.1.4.1.1.4.2"
+	// EnhancedMRColorImageStorage Enhanced MR Color Image Storage
+	EnhancedMRColorImageStorage = "1.2.840.10008.5.1.4.1.1.4.3"
+	// LegacyConvertedEnhancedMRImageStorage Legacy Converted Enhanced MR Image Storage
+	LegacyConvertedEnhancedMRImageStorage = "1.2.840.10008.5.1.4.1.1.4.4"
+	// NuclearMedicineImageStorageRetired Nuclear Medicine Image Storage
+	NuclearMedicineImageStorageRetired = "1.2.840.10008.5.1.4.1.1.5"
+	// UltrasoundImageStorageRetired Ultrasound Image Storage
+	UltrasoundImageStorageRetired = "1.2.840.10008.5.1.4.1.1.6"
+	// UltrasoundImageStorage Ultrasound Image Storage
+	UltrasoundImageStorage = "1.2.840.10008.5.1.4.1.1.6.1"
+	// EnhancedUSVolumeStorage Enhanced US Volume Storage
+	EnhancedUSVolumeStorage = "1.2.840.10008.5.1.4.1.1.6.2"
+	// PhotoacousticImageStorage Photoacoustic Image Storage
+	PhotoacousticImageStorage = "1.2.840.10008.5.1.4.1.1.6.3"
+	// SecondaryCaptureImageStorage Secondary Capture Image Storage
+	SecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7"
+	// MultiFrameSingleBitSecondaryCaptureImageStorage Multi-frame Single Bit Secondary Capture Image Storage
+	MultiFrameSingleBitSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.1"
+	// MultiFrameGrayscaleByteSecondaryCaptureImageStorage Multi-frame Grayscale Byte Secondary Capture Image Storage
+	MultiFrameGrayscaleByteSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.2"
+	// MultiFrameGrayscaleWordSecondaryCaptureImageStorage Multi-frame Grayscale Word Secondary Capture Image Storage
+	MultiFrameGrayscaleWordSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.3"
+	// MultiFrameTrueColorSecondaryCaptureImageStorage Multi-frame True Color Secondary Capture Image Storage
+	MultiFrameTrueColorSecondaryCaptureImageStorage = "1.2.840.10008.5.1.4.1.1.7.4"
+	// StandaloneOverlayStorage Standalone Overlay Storage
+	StandaloneOverlayStorage = "1.2.840.10008.5.1.4.1.1.8"
+	// StandaloneCurveStorage Standalone Curve Storage
+	StandaloneCurveStorage = "1.2.840.10008.5.1.4.1.1.9"
+	// WaveformStorageTrial Waveform Storage - Trial
+	WaveformStorageTrial = "1.2.840.10008.5.1.4.1.1.9.1"
+	// TwelveLeadECGWaveformStorage 12-lead ECG Waveform Storage
+	TwelveLeadECGWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.1.1"
+	// GeneralECGWaveformStorage General ECG Waveform Storage
+	GeneralECGWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.1.2"
+	// AmbulatoryECGWaveformStorage Ambulatory ECG Waveform Storage
+	AmbulatoryECGWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.1.3"
+	// General32bitECGWaveformStorage General 32-bit ECG Waveform Storage
+	General32bitECGWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.1.4"
+	// HemodynamicWaveformStorage Hemodynamic Waveform Storage
+	HemodynamicWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.2.1"
+	// CardiacElectrophysiologyWaveformStorage Cardiac Electrophysiology Waveform Storage
+	CardiacElectrophysiologyWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.3.1"
+	// BasicVoiceAudioWaveformStorage Basic Voice Audio Waveform Storage
+	BasicVoiceAudioWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.4.1"
+	// GeneralAudioWaveformStorage General Audio Waveform Storage
+	GeneralAudioWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.4.2"
+	// ArterialPulseWaveformStorage Arterial Pulse Waveform Storage
+	ArterialPulseWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.5.1"
+	// RespiratoryWaveformStorage Respiratory Waveform Storage
+	RespiratoryWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.6.1"
+	// MultichannelRespiratoryWaveformStorage Multi-channel Respiratory Waveform Storage
+	MultichannelRespiratoryWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.6.2"
+	// RoutineScalpElectroencephalogramWaveformStorage Routine Scalp Electroencephalogram Waveform Storage
+	RoutineScalpElectroencephalogramWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.7.1"
+	// ElectromyogramWaveformStorage Electromyogram Waveform Storage
+	ElectromyogramWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.7.2"
+	// ElectrooculogramWaveformStorage Electrooculogram Waveform Storage
+	ElectrooculogramWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.7.3"
+	// SleepElectroencephalogramWaveformStorage Sleep Electroencephalogram Waveform Storage
+	SleepElectroencephalogramWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.7.4"
+	// BodyPositionWaveformStorage Body Position Waveform Storage
+	BodyPositionWaveformStorage = "1.2.840.10008.5.1.4.1.1.9.8.1"
+	// StandaloneModalityLUTStorage Standalone Modality LUT Storage
+	StandaloneModalityLUTStorage = "1.2.840.10008.5.1.4.1.1.10"
+	// StandaloneVOILUTStorage Standalone VOI LUT Storage
+	StandaloneVOILUTStorage = "1.2.840.10008.5.1.4.1.1.11"
+	// GrayscaleSoftcopyPresentationStateStorage Grayscale Softcopy Presentation State Storage
+	GrayscaleSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.1"
+	// ColorSoftcopyPresentationStateStorage Color Softcopy Presentation State Storage
+	ColorSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.2"
+	// PseudoColorSoftcopyPresentationStateStorage Pseudo-Color Softcopy Presentation State Storage
+	PseudoColorSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.3"
+	// BlendingSoftcopyPresentationStateStorage Blending Softcopy Presentation State Storage
+	BlendingSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.4"
+	// XAXRFGrayscaleSoftcopyPresentationStateStorage XA/XRF Grayscale Softcopy Presentation State Storage
+	XAXRFGrayscaleSoftcopyPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.5"
+	// GrayscalePlanarMPRVolumetricPresentationStateStorage Grayscale Planar MPR Volumetric Presentation State Storage
+	GrayscalePlanarMPRVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.6"
+	// CompositingPlanarMPRVolumetricPresentationStateStorage Compositing Planar MPR Volumetric Presentation State Storage
+	CompositingPlanarMPRVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.7"
+	// AdvancedBlendingPresentationStateStorage Advanced Blending Presentation State Storage
+	AdvancedBlendingPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.8"
+	// VolumeRenderingVolumetricPresentationStateStorage Volume Rendering Volumetric Presentation State Storage
+	VolumeRenderingVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.9"
+	// SegmentedVolumeRenderingVolumetricPresentationStateStorage Segmented Volume Rendering Volumetric Presentation State Storage
+	SegmentedVolumeRenderingVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.10"
+	// MultipleVolumeRenderingVolumetricPresentationStateStorage Multiple Volume Rendering Volumetric Presentation State Storage
+	MultipleVolumeRenderingVolumetricPresentationStateStorage = "1.2.840.10008.5.1.4.1.1.11.11"
+	// XRayAngiographicImageStorage X-Ray Angiographic Image Storage
+	XRayAngiographicImageStorage = "1.2.840.10008.5.1.4.1.1.12.1"
+	// EnhancedXAImageStorage Enhanced XA Image Storage
+	EnhancedXAImageStorage = "1.2.840.10008.5.1.4.1.1.12.1.1"
+	// XRayRadiofluoroscopicImageStorage X-Ray Radiofluoroscopic Image Storage
+	XRayRadiofluoroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.12.2"
+	// EnhancedXRFImageStorage Enhanced XRF Image Storage
+	EnhancedXRFImageStorage = "1.2.840.10008.5.1.4.1.1.12.2.1"
+	// XRayAngiographicBiPlaneImageStorage X-Ray Angiographic Bi-Plane Image Storage
+	XRayAngiographicBiPlaneImageStorage = "1.2.840.10008.5.1.4.1.1.12.3"
+	// XRay3DAngiographicImageStorage X-Ray 3D Angiographic Image Storage
+	XRay3DAngiographicImageStorage = "1.2.840.10008.5.1.4.1.1.13.1.1"
+	// XRay3DCraniofacialImageStorage X-Ray 3D Craniofacial Image Storage
+	XRay3DCraniofacialImageStorage = "1.2.840.10008.5.1.4.1.1.13.1.2"
+	// BreastTomosynthesisImageStorage Breast Tomosynthesis Image Storage
+	BreastTomosynthesisImageStorage = "1.2.840.10008.5.1.4.1.1.13.1.3"
+	// BreastProjectionXRayImageStorageForPresentation Breast Projection X-Ray Image Storage - For Presentation
+	BreastProjectionXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.13.1.4"
+	// BreastProjectionXRayImageStorageForProcessing Breast Projection X-Ray Image Storage - For Processing
+	BreastProjectionXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.13.1.5"
+	// IntravascularOpticalCoherenceTomographyImageStorageForPresentation Intravascular Optical Coherence Tomography Image Storage - For Presentation
+	IntravascularOpticalCoherenceTomographyImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.14.1"
+	// IntravascularOpticalCoherenceTomographyImageStorageForProcessing Intravascular Optical Coherence Tomography Image Storage - For Processing
+	IntravascularOpticalCoherenceTomographyImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.14.2"
+	// NuclearMedicineImageStorage Nuclear Medicine Image Storage
+	NuclearMedicineImageStorage = "1.2.840.10008.5.1.4.1.1.20"
+	// ParametricMapStorage Parametric Map Storage
+	ParametricMapStorage = "1.2.840.10008.5.1.4.1.1.30"
+	// RawDataStorage Raw Data Storage
+	RawDataStorage = "1.2.840.10008.5.1.4.1.1.66"
+	// SpatialRegistrationStorage Spatial Registration Storage
+	SpatialRegistrationStorage = "1.2.840.10008.5.1.4.1.1.66.1"
+	// SpatialFiducialsStorage Spatial Fiducials Storage
+	SpatialFiducialsStorage = "1.2.840.10008.5.1.4.1.1.66.2"
+	// DeformableSpatialRegistrationStorage Deformable Spatial Registration Storage
+	DeformableSpatialRegistrationStorage = "1.2.840.10008.5.1.4.1.1.66.3"
+	// SegmentationStorage Segmentation Storage
+	SegmentationStorage = "1.2.840.10008.5.1.4.1.1.66.4"
+	// SurfaceSegmentationStorage Surface Segmentation Storage
+	SurfaceSegmentationStorage = "1.2.840.10008.5.1.4.1.1.66.5"
+	// TractographyResultsStorage Tractography Results Storage
+	TractographyResultsStorage = "1.2.840.10008.5.1.4.1.1.66.6"
+	// RealWorldValueMappingStorage Real World Value Mapping Storage
+	RealWorldValueMappingStorage = "1.2.840.10008.5.1.4.1.1.67"
+	// SurfaceScanMeshStorage Surface Scan Mesh Storage
+	SurfaceScanMeshStorage = "1.2.840.10008.5.1.4.1.1.68.1"
+	// SurfaceScanPointCloudStorage Surface Scan Point Cloud Storage
+	SurfaceScanPointCloudStorage = "1.2.840.10008.5.1.4.1.1.68.2"
+	// VLImageStorageTrial VL Image Storage - Trial
+	VLImageStorageTrial = "1.2.840.10008.5.1.4.1.1.77.1"
+	// VLMultiFrameImageStorageTrial VL Multi-frame Image Storage - Trial
+	VLMultiFrameImageStorageTrial = "1.2.840.10008.5.1.4.1.1.77.2"
+	// VLEndoscopicImageStorage VL Endoscopic Image Storage
+	VLEndoscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.1"
+	// VideoEndoscopicImageStorage Video Endoscopic Image Storage
+	VideoEndoscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.1.1"
+	// VLMicroscopicImageStorage VL Microscopic Image Storage
+	VLMicroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.2"
+	// VideoMicroscopicImageStorage Video Microscopic Image Storage
+	VideoMicroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.2.1"
+	// VLSlideCoordinatesMicroscopicImageStorage VL Slide-Coordinates Microscopic Image Storage
+	VLSlideCoordinatesMicroscopicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.3"
+	// VLPhotographicImageStorage VL Photographic Image Storage
+	VLPhotographicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.4"
+	// VideoPhotographicImageStorage Video Photographic Image Storage
+	VideoPhotographicImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.4.1"
+	// OphthalmicPhotography8BitImageStorage Ophthalmic Photography 8 Bit Image Storage
+	OphthalmicPhotography8BitImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.1"
+	// OphthalmicPhotography16BitImageStorage Ophthalmic Photography 16 Bit Image Storage
+	OphthalmicPhotography16BitImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.2"
+	// StereometricRelationshipStorage Stereometric Relationship Storage
+	StereometricRelationshipStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.3"
+	// OphthalmicTomographyImageStorage Ophthalmic Tomography Image Storage
+	OphthalmicTomographyImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.4"
+	// WideFieldOphthalmicPhotographyStereographicProjectionImageStorage Wide Field Ophthalmic Photography Stereographic Projection Image Storage
+	WideFieldOphthalmicPhotographyStereographicProjectionImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.5"
+	// WideFieldOphthalmicPhotography3DCoordinatesImageStorage Wide Field Ophthalmic Photography 3D Coordinates Image Storage
+	WideFieldOphthalmicPhotography3DCoordinatesImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.6"
+	// OphthalmicOpticalCoherenceTomographyEnFaceImageStorage Ophthalmic Optical Coherence Tomography En Face Image Storage
+	OphthalmicOpticalCoherenceTomographyEnFaceImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.7"
+	// OphthalmicOpticalCoherenceTomographyBscanVolumeAnalysisStorage Ophthalmic Optical Coherence Tomography B-scan Volume Analysis Storage
+	OphthalmicOpticalCoherenceTomographyBscanVolumeAnalysisStorage = "1.2.840.10008.5.1.4.1.1.77.1.5.8"
+	// VLWholeSlideMicroscopyImageStorage VL Whole Slide Microscopy Image Storage
+	VLWholeSlideMicroscopyImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.6"
+	// DermoscopicPhotographyImageStorage Dermoscopic Photography Image Storage
+	DermoscopicPhotographyImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.7"
+	// ConfocalMicroscopyImageStorage Confocal Microscopy Image Storage
+	ConfocalMicroscopyImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.8"
+	// ConfocalMicroscopyTiledPyramidalImageStorage Confocal Microscopy Tiled Pyramidal Image Storage
+	ConfocalMicroscopyTiledPyramidalImageStorage = "1.2.840.10008.5.1.4.1.1.77.1.9"
+	// LensometryMeasurementsStorage Lensometry Measurements Storage
+	LensometryMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.1"
+	// AutorefractionMeasurementsStorage Autorefraction Measurements Storage
+	AutorefractionMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.2"
+	// KeratometryMeasurementsStorage Keratometry Measurements Storage
+	KeratometryMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.3"
+	// SubjectiveRefractionMeasurementsStorage Subjective Refraction Measurements Storage
+	SubjectiveRefractionMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.4"
+	// VisualAcuityMeasurementsStorage Visual Acuity Measurements Storage
+	VisualAcuityMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.5"
+	// SpectaclePrescriptionReportStorage Spectacle Prescription Report Storage
+	SpectaclePrescriptionReportStorage = "1.2.840.10008.5.1.4.1.1.78.6"
+	// OphthalmicAxialMeasurementsStorage Ophthalmic Axial Measurements Storage
+	OphthalmicAxialMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.78.7"
+	// IntraocularLensCalculationsStorage Intraocular Lens Calculations Storage
+	IntraocularLensCalculationsStorage = "1.2.840.10008.5.1.4.1.1.78.8"
+	// MacularGridThicknessAndVolumeReportStorage Macular Grid Thickness and Volume Report Storage
+	MacularGridThicknessAndVolumeReportStorage = "1.2.840.10008.5.1.4.1.1.79.1"
+	// OphthalmicVisualFieldStaticPerimetryMeasurementsStorage Ophthalmic Visual Field Static Perimetry Measurements Storage
+	OphthalmicVisualFieldStaticPerimetryMeasurementsStorage = "1.2.840.10008.5.1.4.1.1.80.1"
+	// OphthalmicThicknessMapStorage Ophthalmic Thickness Map Storage
+	OphthalmicThicknessMapStorage = "1.2.840.10008.5.1.4.1.1.81.1"
+	// CornealTopographyMapStorage Corneal Topography Map Storage
+	CornealTopographyMapStorage = "1.2.840.10008.5.1.4.1.1.82.1"
+	// TextSRStorageTrial Text SR Storage - Trial
+	TextSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.1"
+	// AudioSRStorageTrial Audio SR Storage - Trial
+	AudioSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.2"
+	// DetailSRStorageTrial Detail SR Storage - Trial
+	DetailSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.3"
+	// ComprehensiveSRStorageTrial Comprehensive SR Storage - Trial
+	ComprehensiveSRStorageTrial = "1.2.840.10008.5.1.4.1.1.88.4"
+	// BasicTextSRStorage Basic Text SR Storage
+	BasicTextSRStorage = "1.2.840.10008.5.1.4.1.1.88.11"
+	// EnhancedSRStorage Enhanced SR Storage
+	EnhancedSRStorage = "1.2.840.10008.5.1.4.1.1.88.22"
+	// ComprehensiveSRStorage Comprehensive SR Storage
+	ComprehensiveSRStorage = "1.2.840.10008.5.1.4.1.1.88.33"
+	// Comprehensive3DSRStorage Comprehensive 3D SR Storage
+	Comprehensive3DSRStorage = "1.2.840.10008.5.1.4.1.1.88.34"
+	// ExtensibleSRStorage Extensible SR Storage
+	ExtensibleSRStorage = "1.2.840.10008.5.1.4.1.1.88.35"
+	// ProcedureLogStorage Procedure Log Storage
+	ProcedureLogStorage = "1.2.840.10008.5.1.4.1.1.88.40"
+	// MammographyCADSRStorage Mammography CAD SR Storage
+	MammographyCADSRStorage = "1.2.840.10008.5.1.4.1.1.88.50"
+	// KeyObjectSelectionDocumentStorage Key Object Selection Document Storage
+	KeyObjectSelectionDocumentStorage = "1.2.840.10008.5.1.4.1.1.88.59"
+	// ChestCADSRStorage Chest CAD SR Storage
+	ChestCADSRStorage = "1.2.840.10008.5.1.4.1.1.88.65"
+	// XRayRadiationDoseSRStorage X-Ray Radiation Dose SR Storage
+	XRayRadiationDoseSRStorage = "1.2.840.10008.5.1.4.1.1.88.67"
+	// RadiopharmaceuticalRadiationDoseSRStorage Radiopharmaceutical Radiation Dose SR Storage
+	RadiopharmaceuticalRadiationDoseSRStorage = "1.2.840.10008.5.1.4.1.1.88.68"
+	// ColonCADSRStorage Colon CAD SR Storage
+	ColonCADSRStorage = "1.2.840.10008.5.1.4.1.1.88.69"
+	// ImplantationPlanSRStorage Implantation Plan SR Storage
+	ImplantationPlanSRStorage = "1.2.840.10008.5.1.4.1.1.88.70"
+	// AcquisitionContextSRStorage Acquisition Context SR Storage
+	AcquisitionContextSRStorage = "1.2.840.10008.5.1.4.1.1.88.71"
+	// SimplifiedAdultEchoSRStorage Simplified Adult Echo SR Storage
+	SimplifiedAdultEchoSRStorage = "1.2.840.10008.5.1.4.1.1.88.72"
+	// PatientRadiationDoseSRStorage Patient Radiation Dose SR Storage
+	PatientRadiationDoseSRStorage = "1.2.840.10008.5.1.4.1.1.88.73"
+	// PlannedImagingAgentAdministrationSRStorage Planned Imaging Agent Administration SR Storage
+	PlannedImagingAgentAdministrationSRStorage = "1.2.840.10008.5.1.4.1.1.88.74"
+	// PerformedImagingAgentAdministrationSRStorage Performed Imaging Agent Administration SR Storage
+	PerformedImagingAgentAdministrationSRStorage = "1.2.840.10008.5.1.4.1.1.88.75"
+	// EnhancedXRayRadiationDoseSRStorage Enhanced X-Ray Radiation Dose SR Storage
+	EnhancedXRayRadiationDoseSRStorage = "1.2.840.10008.5.1.4.1.1.88.76"
+	// ContentAssessmentResultsStorage Content Assessment Results Storage
+	ContentAssessmentResultsStorage = "1.2.840.10008.5.1.4.1.1.90.1"
+	// MicroscopyBulkSimpleAnnotationsStorage Microscopy Bulk Simple Annotations Storage
+	MicroscopyBulkSimpleAnnotationsStorage = "1.2.840.10008.5.1.4.1.1.91.1"
+	// EncapsulatedPDFStorage Encapsulated PDF Storage
+	EncapsulatedPDFStorage = "1.2.840.10008.5.1.4.1.1.104.1"
+	// EncapsulatedCDAStorage Encapsulated CDA Storage
+	EncapsulatedCDAStorage = "1.2.840.10008.5.1.4.1.1.104.2"
+	// EncapsulatedSTLStorage Encapsulated STL Storage
+	EncapsulatedSTLStorage = "1.2.840.10008.5.1.4.1.1.104.3"
+	// EncapsulatedOBJStorage Encapsulated OBJ Storage
+	EncapsulatedOBJStorage = "1.2.840.10008.5.1.4.1.1.104.4"
+	// EncapsulatedMTLStorage Encapsulated MTL Storage
+	EncapsulatedMTLStorage = "1.2.840.10008.5.1.4.1.1.104.5"
+	// PositronEmissionTomographyImageStorage Positron Emission Tomography Image Storage
+	PositronEmissionTomographyImageStorage = "1.2.840.10008.5.1.4.1.1.128"
+	// LegacyConvertedEnhancedPETImageStorage Legacy Converted Enhanced PET Image Storage
+	LegacyConvertedEnhancedPETImageStorage = "1.2.840.10008.5.1.4.1.1.128.1"
+	// StandalonePETCurveStorage Standalone PET Curve Storage
+	StandalonePETCurveStorage = "1.2.840.10008.5.1.4.1.1.129"
+	// EnhancedPETImageStorage Enhanced PET Image Storage
+	EnhancedPETImageStorage = "1.2.840.10008.5.1.4.1.1.130"
+	// BasicStructuredDisplayStorage Basic Structured Display Storage
+	BasicStructuredDisplayStorage = "1.2.840.10008.5.1.4.1.1.131"
+	// CTDefinedProcedureProtocolStorage CT Defined Procedure Protocol Storage
+	CTDefinedProcedureProtocolStorage = "1.2.840.10008.5.1.4.1.1.200.1"
+	// CTPerformedProcedureProtocolStorage CT Performed Procedure Protocol Storage
+	CTPerformedProcedureProtocolStorage = "1.2.840.10008.5.1.4.1.1.200.2"
+	// ProtocolApprovalStorage Protocol Approval Storage
+	ProtocolApprovalStorage = "1.2.840.10008.5.1.4.1.1.200.3"
+	// ProtocolApprovalInformationModelFind Protocol Approval Information Model - FIND
+	ProtocolApprovalInformationModelFind = "1.2.840.10008.5.1.4.1.1.200.4"
+	// ProtocolApprovalInformationModelMove Protocol Approval Information Model - MOVE
+	ProtocolApprovalInformationModelMove = "1.2.840.10008.5.1.4.1.1.200.5"
+	// ProtocolApprovalInformationModelGet Protocol Approval Information Model - GET
+	ProtocolApprovalInformationModelGet = "1.2.840.10008.5.1.4.1.1.200.6"
+	// XADefinedProcedureProtocolStorage XA Defined Procedure Protocol Storage
+	XADefinedProcedureProtocolStorage = "1.2.840.10008.5.1.4.1.1.200.7"
+	// XAPerformedProcedureProtocolStorage XA Performed Procedure Protocol Storage
+	XAPerformedProcedureProtocolStorage = "1.2.840.10008.5.1.4.1.1.200.8"
+	// InventoryStorage Inventory Storage
+	InventoryStorage = "1.2.840.10008.5.1.4.1.1.201.1"
+	// StorageManagementInstance Storage Management SOP Instance
+	StorageManagementInstance = "1.2.840.10008.5.1.4.1.1.201.1.1"
+	// InventoryFind Inventory - FIND
+	InventoryFind = "1.2.840.10008.5.1.4.1.1.201.2"
+	// InventoryMove Inventory - MOVE
+	InventoryMove = "1.2.840.10008.5.1.4.1.1.201.3"
+	// InventoryGet Inventory - GET
+	InventoryGet = "1.2.840.10008.5.1.4.1.1.201.4"
+	// InventoryCreation Inventory Creation
+	InventoryCreation = "1.2.840.10008.5.1.4.1.1.201.5"
+	// RepositoryQuery Repository Query
+	RepositoryQuery = "1.2.840.10008.5.1.4.1.1.201.6"
+	// RTImageStorage RT Image Storage
+	RTImageStorage = "1.2.840.10008.5.1.4.1.1.481.1"
+	// RTDoseStorage RT Dose Storage
+	RTDoseStorage = "1.2.840.10008.5.1.4.1.1.481.2"
+	// RTStructureSetStorage RT Structure Set Storage
+	RTStructureSetStorage = "1.2.840.10008.5.1.4.1.1.481.3"
+	// RTBeamsTreatmentRecordStorage RT Beams Treatment Record Storage
+	RTBeamsTreatmentRecordStorage = "1.2.840.10008.5.1.4.1.1.481.4"
+	// RTPlanStorage RT Plan Storage
+	RTPlanStorage = "1.2.840.10008.5.1.4.1.1.481.5"
+	// RTBrachyTreatmentRecordStorage RT Brachy Treatment Record Storage
+	RTBrachyTreatmentRecordStorage = "1.2.840.10008.5.1.4.1.1.481.6"
+	// RTTreatmentSummaryRecordStorage RT Treatment Summary Record Storage
+	RTTreatmentSummaryRecordStorage = "1.2.840.10008.5.1.4.1.1.481.7"
+	// RTIonPlanStorage RT Ion Plan Storage
+	RTIonPlanStorage = "1.2.840.10008.5.1.4.1.1.481.8"
+	// RTIonBeamsTreatmentRecordStorage RT Ion Beams Treatment Record Storage
+	RTIonBeamsTreatmentRecordStorage = "1.2.840.10008.5.1.4.1.1.481.9"
+	// RTPhysicianIntentStorage RT Physician Intent Storage
+	RTPhysicianIntentStorage = "1.2.840.10008.5.1.4.1.1.481.10"
+	// RTSegmentAnnotationStorage RT Segment Annotation Storage
+	RTSegmentAnnotationStorage = "1.2.840.10008.5.1.4.1.1.481.11"
+	// RTRadiationSetStorage RT Radiation Set Storage
+	RTRadiationSetStorage = "1.2.840.10008.5.1.4.1.1.481.12"
+	// CArmPhotonElectronRadiationStorage C-Arm Photon-Electron Radiation Storage
+	CArmPhotonElectronRadiationStorage = "1.2.840.10008.5.1.4.1.1.481.13"
+	// TomotherapeuticRadiationStorage Tomotherapeutic Radiation Storage
+	TomotherapeuticRadiationStorage = "1.2.840.10008.5.1.4.1.1.481.14"
+	// RoboticArmRadiationStorage Robotic-Arm Radiation Storage
+	RoboticArmRadiationStorage = "1.2.840.10008.5.1.4.1.1.481.15"
+	// RTRadiationRecordSetStorage RT Radiation Record Set Storage
+	RTRadiationRecordSetStorage = "1.2.840.10008.5.1.4.1.1.481.16"
+	// RTRadiationSalvageRecordStorage RT Radiation Salvage Record Storage
+	RTRadiationSalvageRecordStorage = "1.2.840.10008.5.1.4.1.1.481.17"
+	// TomotherapeuticRadiationRecordStorage Tomotherapeutic Radiation Record Storage
+	TomotherapeuticRadiationRecordStorage = "1.2.840.10008.5.1.4.1.1.481.18"
+	// CArmPhotonElectronRadiationRecordStorage C-Arm Photon-Electron Radiation Record Storage
+	CArmPhotonElectronRadiationRecordStorage = "1.2.840.10008.5.1.4.1.1.481.19"
+	// RoboticRadiationRecordStorage Robotic Radiation Record Storage
+	RoboticRadiationRecordStorage = "1.2.840.10008.5.1.4.1.1.481.20"
+	// RTRadiationSetDeliveryInstructionStorage RT Radiation Set Delivery Instruction Storage
+	RTRadiationSetDeliveryInstructionStorage = "1.2.840.10008.5.1.4.1.1.481.21"
+	// RTTreatmentPreparationStorage RT Treatment Preparation Storage
+	RTTreatmentPreparationStorage = "1.2.840.10008.5.1.4.1.1.481.22"
+	// EnhancedRTImageStorage Enhanced RT Image Storage
+	EnhancedRTImageStorage = "1.2.840.10008.5.1.4.1.1.481.23"
+	// EnhancedContinuousRTImageStorage Enhanced Continuous RT Image Storage
+	EnhancedContinuousRTImageStorage = "1.2.840.10008.5.1.4.1.1.481.24"
+	// RTPatientPositionAcquisitionInstructionStorage RT Patient Position Acquisition Instruction Storage
+	RTPatientPositionAcquisitionInstructionStorage = "1.2.840.10008.5.1.4.1.1.481.25"
+	// DICOSCTImageStorage DICOS CT Image Storage
+	DICOSCTImageStorage = "1.2.840.10008.5.1.4.1.1.501.1"
+	// DICOSDigitalXRayImageStorageForPresentation DICOS Digital X-Ray Image Storage - For Presentation
+	DICOSDigitalXRayImageStorageForPresentation = "1.2.840.10008.5.1.4.1.1.501.2.1"
+	// DICOSDigitalXRayImageStorageForProcessing DICOS Digital X-Ray Image Storage - For Processing
+	DICOSDigitalXRayImageStorageForProcessing = "1.2.840.10008.5.1.4.1.1.501.2.2"
+	// DICOSThreatDetectionReportStorage DICOS Threat Detection Report Storage
+	DICOSThreatDetectionReportStorage = "1.2.840.10008.5.1.4.1.1.501.3"
+	// DICOS2DAITStorage DICOS 2D AIT Storage
+	DICOS2DAITStorage = "1.2.840.10008.5.1.4.1.1.501.4"
+	// DICOS3DAITStorage DICOS 3D AIT Storage
+	DICOS3DAITStorage = "1.2.840.10008.5.1.4.1.1.501.5"
+	// DICOSQuadrupoleResonanceStorage DICOS Quadrupole Resonance (QR) Storage
+	DICOSQuadrupoleResonanceStorage = "1.2.840.10008.5.1.4.1.1.501.6"
+	// EddyCurrentImageStorage Eddy Current Image Storage
+	EddyCurrentImageStorage = "1.2.840.10008.5.1.4.1.1.601.1"
+	// EddyCurrentMultiFrameImageStorage Eddy Current Multi-frame Image Storage
+	EddyCurrentMultiFrameImageStorage = "1.2.840.10008.5.1.4.1.1.601.2"
+	// PatientRootQueryRetrieveInformationModelFind Patient Root Query/Retrieve Information Model - FIND
+	PatientRootQueryRetrieveInformationModelFind = "1.2.840.10008.5.1.4.1.2.1.1"
+	// PatientRootQueryRetrieveInformationModelMove Patient Root Query/Retrieve Information Model - MOVE
+	PatientRootQueryRetrieveInformationModelMove = "1.2.840.10008.5.1.4.1.2.1.2"
+	// PatientRootQueryRetrieveInformationModelGet Patient Root Query/Retrieve Information Model - GET
+	PatientRootQueryRetrieveInformationModelGet = "1.2.840.10008.5.1.4.1.2.1.3"
+	// StudyRootQueryRetrieveInformationModelFind Study Root Query/Retrieve Information Model - FIND
+	StudyRootQueryRetrieveInformationModelFind = "1.2.840.10008.5.1.4.1.2.2.1"
+	// StudyRootQueryRetrieveInformationModelMove Study Root Query/Retrieve Information Model - MOVE
+	StudyRootQueryRetrieveInformationModelMove = "1.2.840.10008.5.1.4.1.2.2.2"
+	// StudyRootQueryRetrieveInformationModelGet Study Root Query/Retrieve Information Model - GET
+	StudyRootQueryRetrieveInformationModelGet = "1.2.840.10008.5.1.4.1.2.2.3"
+	// PatientStudyOnlyQueryRetrieveInformationModelFind Patient/Study Only Query/Retrieve Information Model - FIND
+	PatientStudyOnlyQueryRetrieveInformationModelFind = "1.2.840.10008.5.1.4.1.2.3.1"
+	// PatientStudyOnlyQueryRetrieveInformationModelMove Patient/Study Only Query/Retrieve Information Model - MOVE
+	PatientStudyOnlyQueryRetrieveInformationModelMove = "1.2.840.10008.5.1.4.1.2.3.2"
+	// PatientStudyOnlyQueryRetrieveInformationModelGet Patient/Study Only Query/Retrieve Information Model - GET
+	PatientStudyOnlyQueryRetrieveInformationModelGet = "1.2.840.10008.5.1.4.1.2.3.3"
+	// CompositeInstanceRootRetrieveMove Composite Instance Root Retrieve - MOVE
+	CompositeInstanceRootRetrieveMove = "1.2.840.10008.5.1.4.1.2.4.2"
+	// CompositeInstanceRootRetrieveGet Composite Instance Root Retrieve - GET
+	CompositeInstanceRootRetrieveGet = "1.2.840.10008.5.1.4.1.2.4.3"
+	// CompositeInstanceRetrieveWithoutBulkDataGet Composite Instance Retrieve Without Bulk Data - GET
+	CompositeInstanceRetrieveWithoutBulkDataGet = "1.2.840.10008.5.1.4.1.2.5.3"
+	// DefinedProcedureProtocolInformationModelFind Defined Procedure Protocol Information Model - FIND
+	DefinedProcedureProtocolInformationModelFind = "1.2.840.10008.5.1.4.20.1"
+	// DefinedProcedureProtocolInformationModelMove Defined Procedure Protocol Information Model - MOVE
+	DefinedProcedureProtocolInformationModelMove = "1.2.840.10008.5.1.4.20.2"
+	// DefinedProcedureProtocolInformationModelGet Defined Procedure Protocol Information Model - GET
+	DefinedProcedureProtocolInformationModelGet = "1.2.840.10008.5.1.4.20.3"
+	// ModalityWorklistInformationModelFind Modality Worklist Information Model - FIND
+	ModalityWorklistInformationModelFind = "1.2.840.10008.5.1.4.31"
+	// GeneralPurposeWorklistManagementMeta General Purpose Worklist Management Meta SOP Class
+	GeneralPurposeWorklistManagementMeta = "1.2.840.10008.5.1.4.32"
+	// GeneralPurposeWorklistInformationModelFind General Purpose Worklist Information Model - FIND
+	GeneralPurposeWorklistInformationModelFind = "1.2.840.10008.5.1.4.32.1"
+	// GeneralPurposeScheduledProcedureStep General Purpose Scheduled Procedure Step SOP Class
+	GeneralPurposeScheduledProcedureStep = "1.2.840.10008.5.1.4.32.2"
+	// GeneralPurposePerformedProcedureStep General Purpose Performed Procedure Step SOP Class
+	GeneralPurposePerformedProcedureStep = "1.2.840.10008.5.1.4.32.3"
+	// InstanceAvailabilityNotification Instance Availability Notification SOP Class
+	InstanceAvailabilityNotification = "1.2.840.10008.5.1.4.33"
+	// RTBeamsDeliveryInstructionStorageTrial RT Beams Delivery Instruction Storage - Trial
+	RTBeamsDeliveryInstructionStorageTrial = "1.2.840.10008.5.1.4.34.1"
+	// RTConventionalMachineVerificationTrial RT Conventional Machine Verification - Trial
+	RTConventionalMachineVerificationTrial = "1.2.840.10008.5.1.4.34.2"
+	// RTIonMachineVerificationTrial RT Ion Machine Verification - Trial
+	RTIonMachineVerificationTrial = "1.2.840.10008.5.1.4.34.3"
+	// UnifiedWorklistAndProcedureStepTrial Unified Worklist and Procedure Step Service Class - Trial
+	UnifiedWorklistAndProcedureStepTrial = "1.2.840.10008.5.1.4.34.4"
+	// UnifiedProcedureStepPushTrial Unified Procedure Step - Push SOP Class - Trial
+	UnifiedProcedureStepPushTrial = "1.2.840.10008.5.1.4.34.4.1"
+	// UnifiedProcedureStepWatchTrial Unified Procedure Step - Watch SOP Class - Trial
+	UnifiedProcedureStepWatchTrial = "1.2.840.10008.5.1.4.34.4.2"
+	// UnifiedProcedureStepPullTrial Unified Procedure Step - Pull SOP Class - Trial
+	UnifiedProcedureStepPullTrial = "1.2.840.10008.5.1.4.34.4.3"
+	// UnifiedProcedureStepEventTrial Unified Procedure Step - Event SOP Class - Trial
+	UnifiedProcedureStepEventTrial = "1.2.840.10008.5.1.4.34.4.4"
+	// UPSGlobalSubscriptionInstance UPS Global Subscription SOP Instance
+	UPSGlobalSubscriptionInstance = "1.2.840.10008.5.1.4.34.5"
+	// UPSFilteredGlobalSubscriptionInstance UPS Filtered Global Subscription SOP Instance
+	UPSFilteredGlobalSubscriptionInstance = "1.2.840.10008.5.1.4.34.5.1"
+	// UnifiedWorklistAndProcedureStep Unified Worklist and Procedure Step Service Class
+	UnifiedWorklistAndProcedureStep = "1.2.840.10008.5.1.4.34.6"
+	// UnifiedProcedureStepPush Unified Procedure Step - Push SOP Class
+	UnifiedProcedureStepPush = "1.2.840.10008.5.1.4.34.6.1"
+	// UnifiedProcedureStepWatch Unified Procedure Step - Watch SOP Class
+	UnifiedProcedureStepWatch = "1.2.840.10008.5.1.4.34.6.2"
+	// UnifiedProcedureStepPull Unified Procedure Step - Pull SOP Class
+	UnifiedProcedureStepPull = "1.2.840.10008.5.1.4.34.6.3"
+	// UnifiedProcedureStepEvent Unified Procedure Step - Event SOP Class
+	UnifiedProcedureStepEvent = "1.2.840.10008.5.1.4.34.6.4"
+	// UnifiedProcedureStepQuery Unified Procedure Step - Query SOP Class
+	UnifiedProcedureStepQuery = "1.2.840.10008.5.1.4.34.6.5"
+	// RTBeamsDeliveryInstructionStorage RT Beams Delivery Instruction Storage
+	RTBeamsDeliveryInstructionStorage = "1.2.840.10008.5.1.4.34.7"
+	// RTConventionalMachineVerification RT Conventional Machine Verification
+	RTConventionalMachineVerification = "1.2.840.10008.5.1.4.34.8"
+	// RTIonMachineVerification RT Ion Machine Verification
+	RTIonMachineVerification = "1.2.840.10008.5.1.4.34.9"
+	// RTBrachyApplicationSetupDeliveryInstructionStorage RT Brachy Application Setup Delivery Instruction Storage
+	RTBrachyApplicationSetupDeliveryInstructionStorage = "1.2.840.10008.5.1.4.34.10"
+	// GeneralRelevantPatientInformationQuery General Relevant Patient Information Query
+	GeneralRelevantPatientInformationQuery = "1.2.840.10008.5.1.4.37.1"
+	// BreastImagingRelevantPatientInformationQuery Breast Imaging Relevant Patient Information Query
+	BreastImagingRelevantPatientInformationQuery = "1.2.840.10008.5.1.4.37.2"
+	// CardiacRelevantPatientInformationQuery Cardiac Relevant Patient Information Query
+	CardiacRelevantPatientInformationQuery = "1.2.840.10008.5.1.4.37.3"
+	// HangingProtocolStorage Hanging Protocol Storage
+	HangingProtocolStorage = "1.2.840.10008.5.1.4.38.1"
+	// HangingProtocolInformationModelFind Hanging Protocol Information Model - FIND
+	HangingProtocolInformationModelFind = "1.2.840.10008.5.1.4.38.2"
+	// HangingProtocolInformationModelMove Hanging Protocol Information Model - MOVE
+	HangingProtocolInformationModelMove = "1.2.840.10008.5.1.4.38.3"
+	// HangingProtocolInformationModelGet Hanging Protocol Information Model - GET
+	HangingProtocolInformationModelGet = "1.2.840.10008.5.1.4.38.4"
+	// ColorPaletteStorage Color Palette Storage
+	ColorPaletteStorage = "1.2.840.10008.5.1.4.39.1"
+	// ColorPaletteQueryRetrieveInformationModelFind Color Palette Query/Retrieve Information Model - FIND
+	ColorPaletteQueryRetrieveInformationModelFind = "1.2.840.10008.5.1.4.39.2"
+	// ColorPaletteQueryRetrieveInformationModelMove Color Palette Query/Retrieve Information Model - MOVE
+	ColorPaletteQueryRetrieveInformationModelMove = "1.2.840.10008.5.1.4.39.3"
+	// ColorPaletteQueryRetrieveInformationModelGet Color Palette Query/Retrieve Information Model - GET
+	ColorPaletteQueryRetrieveInformationModelGet = "1.2.840.10008.5.1.4.39.4"
+	// ProductCharacteristicsQuery Product Characteristics Query SOP Class
+	ProductCharacteristicsQuery = "1.2.840.10008.5.1.4.41"
+	// SubstanceApprovalQuery Substance Approval Query SOP Class
+	SubstanceApprovalQuery = "1.2.840.10008.5.1.4.42"
+	// GenericImplantTemplateStorage Generic Implant Template Storage
+	GenericImplantTemplateStorage = "1.2.840.10008.5.1.4.43.1"
+	// GenericImplantTemplateInformationModelFind Generic Implant Template Information Model - FIND
+	GenericImplantTemplateInformationModelFind = "1.2.840.10008.5.1.4.43.2"
+	// GenericImplantTemplateInformationModelMove Generic Implant Template Information Model - MOVE
+	GenericImplantTemplateInformationModelMove = "1.2.840.10008.5.1.4.43.3"
+	// GenericImplantTemplateInformationModelGet Generic Implant Template Information Model - GET
+	GenericImplantTemplateInformationModelGet = "1.2.840.10008.5.1.4.43.4"
+	// ImplantAssemblyTemplateStorage Implant Assembly Template Storage
+	ImplantAssemblyTemplateStorage = "1.2.840.10008.5.1.4.44.1"
+	// ImplantAssemblyTemplateInformationModelFind Implant Assembly Template Information Model - FIND
+	ImplantAssemblyTemplateInformationModelFind = "1.2.840.10008.5.1.4.44.2"
+	// ImplantAssemblyTemplateInformationModelMove Implant Assembly Template Information Model - MOVE
+	ImplantAssemblyTemplateInformationModelMove = "1.2.840.10008.5.1.4.44.3"
+	// ImplantAssemblyTemplateInformationModelGet Implant Assembly Template Information Model - GET
+	ImplantAssemblyTemplateInformationModelGet = "1.2.840.10008.5.1.4.44.4"
+	// ImplantTemplateGroupStorage Implant Template Group Storage
+	ImplantTemplateGroupStorage = "1.2.840.10008.5.1.4.45.1"
+	// ImplantTemplateGroupInformationModelFind Implant Template Group Information Model - FIND
+	ImplantTemplateGroupInformationModelFind = "1.2.840.10008.5.1.4.45.2"
+	// ImplantTemplateGroupInformationModelMove Implant Template Group Information Model - MOVE
+	ImplantTemplateGroupInformationModelMove = "1.2.840.10008.5.1.4.45.3"
+	// ImplantTemplateGroupInformationModelGet Implant Template Group Information Model - GET
+	ImplantTemplateGroupInformationModelGet = "1.2.840.10008.5.1.4.45.4"
+	// NativeDICOMModel Native DICOM Model
+	NativeDICOMModel = "1.2.840.10008.7.1.1"
+	// AbstractMultiDimensionalImageModel Abstract Multi-Dimensional Image Model
+	AbstractMultiDimensionalImageModel = "1.2.840.10008.7.1.2"
+	// DICOMContentMappingResource DICOM Content Mapping Resource
+	DICOMContentMappingResource = "1.2.840.10008.8.1.1"
+	// VideoEndoscopicImageRealTimeCommunication Video Endoscopic Image Real-Time Communication
+	VideoEndoscopicImageRealTimeCommunication = "1.2.840.10008.10.1"
+	// VideoPhotographicImageRealTimeCommunication Video Photographic Image Real-Time Communication
+	VideoPhotographicImageRealTimeCommunication = "1.2.840.10008.10.2"
+	// AudioWaveformRealTimeCommunication Audio Waveform Real-Time Communication
+	AudioWaveformRealTimeCommunication = "1.2.840.10008.10.3"
+	// RenditionSelectionDocumentRealTimeCommunication Rendition Selection Document Real-Time Communication
+	RenditionSelectionDocumentRealTimeCommunication = "1.2.840.10008.10.4"
+	// TalairachBrainAtlas Talairach Brain Atlas Frame of Reference
+	TalairachBrainAtlas = "1.2.840.10008.1.4.1.1"
+	// SPM2T1 SPM2 T1 Frame of Reference
+	SPM2T1 = "1.2.840.10008.1.4.1.2"
+	// SPM2T2 SPM2 T2 Frame of Reference
+	SPM2T2 = "1.2.840.10008.1.4.1.3"
+	// SPM2PD SPM2 PD Frame of Reference
+	SPM2PD = "1.2.840.10008.1.4.1.4"
+	// SPM2EPI SPM2 EPI Frame of Reference
+	SPM2EPI = "1.2.840.10008.1.4.1.5"
+	// SPM2FILT1 SPM2 FIL T1 Frame of Reference
+	SPM2FILT1 = "1.2.840.10008.1.4.1.6"
+	// SPM2PET SPM2 PET Frame of Reference
+	SPM2PET = "1.2.840.10008.1.4.1.7"
+	// SPM2TRANSM SPM2 TRANSM Frame of Reference
+	SPM2TRANSM = "1.2.840.10008.1.4.1.8"
+	// SPM2SPECT SPM2 SPECT Frame of Reference
+	SPM2SPECT = "1.2.840.10008.1.4.1.9"
+	// SPM2GRAY SPM2 GRAY Frame of Reference
+	SPM2GRAY = "1.2.840.10008.1.4.1.10"
+	// SPM2WHITE SPM2 WHITE Frame of Reference
+	SPM2WHITE = "1.2.840.10008.1.4.1.11"
+	// SPM2CSF SPM2 CSF Frame of Reference
+	SPM2CSF = "1.2.840.10008.1.4.1.12"
+	// SPM2BRAINMASK SPM2 BRAINMASK Frame of Reference
+	SPM2BRAINMASK = "1.2.840.10008.1.4.1.13"
+	// SPM2AVG305T1 SPM2 AVG305T1 Frame of Reference
+	SPM2AVG305T1 = "1.2.840.10008.1.4.1.14"
+	// SPM2AVG152T1 SPM2 AVG152T1 Frame of Reference
+	SPM2AVG152T1 = "1.2.840.10008.1.4.1.15"
+	// SPM2AVG152T2 SPM2 AVG152T2 Frame of Reference
+	SPM2AVG152T2 = "1.2.840.10008.1.4.1.16"
+	// SPM2AVG152PD SPM2 AVG152PD Frame of Reference
+	SPM2AVG152PD = "1.2.840.10008.1.4.1.17"
+	// SPM2SINGLESUBJT1 SPM2 SINGLESUBJT1 Frame of Reference
+	SPM2SINGLESUBJT1 = "1.2.840.10008.1.4.1.18"
+	// ICBM452T1 ICBM 452 T1 Frame of Reference
+	ICBM452T1 = "1.2.840.10008.1.4.2.1"
+	// ICBMSingleSubjectMRI ICBM Single Subject MRI Frame of Reference
+	ICBMSingleSubjectMRI = "1.2.840.10008.1.4.2.2"
+	// IEC61217FixedCoordinateSystem IEC 61217 Fixed Coordinate System Frame of Reference
+	IEC61217FixedCoordinateSystem = "1.2.840.10008.1.4.3.1"
+	// StandardRoboticArmCoordinateSystem Standard Robotic-Arm Coordinate System Frame of Reference
+	StandardRoboticArmCoordinateSystem = "1.2.840.10008.1.4.3.2"
+	// IEC61217TableTopCoordinateSystem IEC 61217 Table Top Coordinate System Frame of Reference
+	IEC61217TableTopCoordinateSystem = "1.2.840.10008.1.4.3.3"
+	// SRI24 SRI24 Frame of Reference
+	SRI24 = "1.2.840.10008.1.4.4.1"
+	// Colin27 Colin27 Frame of Reference
+	Colin27 = "1.2.840.10008.1.4.5.1"
+	// LPBA40AIR LPBA40/AIR Frame of Reference
+	LPBA40AIR = "1.2.840.10008.1.4.6.1"
+	// LPBA40FLIRT LPBA40/FLIRT Frame of Reference
+	LPBA40FLIRT = "1.2.840.10008.1.4.6.2"
+	// LPBA40SPM5 LPBA40/SPM5 Frame of Reference
+	LPBA40SPM5 = "1.2.840.10008.1.4.6.3"
+)
+
+// UID - Registry of DICOM Unique Identifiers keyed by UID value.
+var UID = map[string]Entry{
+	Verification:           {Name: "Verification SOP Class", Keyword: "Verification", Type: "SOP Class"},
+	ImplicitVRLittleEndian: {Name: "Implicit VR Little Endian: Default Transfer Syntax for DICOM", Keyword: "ImplicitVRLittleEndian", Type: "Transfer Syntax"},
+	ExplicitVRLittleEndian: {Name: "Explicit VR Little Endian", Keyword: "ExplicitVRLittleEndian", Type: "Transfer Syntax"},
+	EncapsulatedUncompressedExplicitVRLittleEndian: {Name: "Encapsulated Uncompressed Explicit VR Little Endian", Keyword: "EncapsulatedUncompressedExplicitVRLittleEndian", Type: "Transfer Syntax"},
+	DeflatedExplicitVRLittleEndian:                 {Name: "Deflated Explicit VR Little Endian", Keyword: "DeflatedExplicitVRLittleEndian", Type: "Transfer Syntax"},
+	ExplicitVRBigEndian:                            {Name: "Explicit VR Big Endian", Keyword: "ExplicitVRBigEndian", Type: "Transfer Syntax", Retired: true},
+	JPEGBaseline8Bit:                               {Name: "JPEG Baseline (Process 1): Default Transfer Syntax for Lossy JPEG 8 Bit Image Compression", Keyword: "JPEGBaseline8Bit", Type: "Transfer Syntax"},
+	JPEGExtended12Bit:                              {Name: "JPEG Extended (Process 2 & 4): Default Transfer Syntax for Lossy JPEG 12 Bit Image Compression (Process 4 only)", Keyword: "JPEGExtended12Bit", Type: "Transfer Syntax"},
+	JPEGExtended35:                                 {Name: "JPEG Extended (Processes 3 & 5)", Keyword: "JPEGExtended35", Type: "Transfer Syntax", Retired: true},
+	JPEGSpectralSelectionNonHierarchical68:         {Name: "JPEG Spectral Selection, Non-Hierarchical (Processes 6 & 8)", Keyword: "JPEGSpectralSelectionNonHierarchical68", Type: "Transfer Syntax", Retired: true},
+	JPEGSpectralSelectionNonHierarchical79:         {Name: "JPEG Spectral Selection, Non-Hierarchical (Processes 7 & 9)", Keyword: "JPEGSpectralSelectionNonHierarchical79", Type: "Transfer Syntax", Retired: true},
+	JPEGFullProgressionNonHierarchical1012:         {Name: "JPEG Full Progression, Non-Hierarchical (Processes 10 & 12)", Keyword: "JPEGFullProgressionNonHierarchical1012", Type: "Transfer Syntax", Retired: true},
+	JPEGFullProgressionNonHierarchical1113:         {Name: "JPEG Full Progression, Non-Hierarchical (Processes 11 & 13)", Keyword: "JPEGFullProgressionNonHierarchical1113", Type: "Transfer Syntax", Retired: true},
+	JPEGLossless:                                   {Name: "JPEG Lossless, Non-Hierarchical (Process 14)", Keyword: "JPEGLossless", Type: "Transfer Syntax"},
+	JPEGLosslessNonHierarchical15:                  {Name: "JPEG Lossless, Non-Hierarchical (Process 15)", Keyword: "JPEGLosslessNonHierarchical15", Type: "Transfer Syntax", Retired: true},
+	JPEGExtendedHierarchical1618:                   {Name: "JPEG Extended, Hierarchical (Processes 16 & 18)", Keyword: "JPEGExtendedHierarchical1618", Type: "Transfer Syntax", Retired: true},
+	JPEGExtendedHierarchical1719:                   {Name: "JPEG Extended, Hierarchical (Processes 17 & 19)", Keyword: "JPEGExtendedHierarchical1719", Type: "Transfer Syntax", Retired: true},
+	JPEGSpectralSelectionHierarchical2022:          {Name: "JPEG Spectral Selection, Hierarchical (Processes 20 & 22)", Keyword: "JPEGSpectralSelectionHierarchical2022", Type: "Transfer Syntax", Retired: true},
+	JPEGSpectralSelectionHierarchical2123:          {Name: "JPEG Spectral Selection, Hierarchical (Processes 21 & 23)", Keyword: "JPEGSpectralSelectionHierarchical2123", Type: "Transfer Syntax", Retired: true},
+	JPEGFullProgressionHierarchical2426:            {Name: "JPEG Full Progression, Hierarchical (Processes 24 & 26)", Keyword: "JPEGFullProgressionHierarchical2426", Type: "Transfer Syntax", Retired: true},
+	JPEGFullProgressionHierarchical2527:            {Name: "JPEG Full Progression, Hierarchical (Processes 25 & 27)", Keyword: "JPEGFullProgressionHierarchical2527", Type: "Transfer Syntax", Retired: true},
+	JPEGLosslessHierarchical28:                     {Name: "JPEG Lossless, Hierarchical (Process 28)", Keyword: "JPEGLosslessHierarchical28", Type: "Transfer Syntax", Retired: true},
+	JPEGLosslessHierarchical29:                     {Name: "JPEG Lossless, Hierarchical (Process 29)", Keyword: "JPEGLosslessHierarchical29", Type: "Transfer Syntax", Retired: true},
+	JPEGLosslessSV1:                                {Name: "JPEG Lossless, Non-Hierarchical, First-Order Prediction (Process 14 [Selection Value 1]): Default Transfer Syntax for Lossless JPEG Image Compression", Keyword: "JPEGLosslessSV1", Type: "Transfer Syntax"},
+	JPEGLSLossless:                                 {Name: "JPEG-LS Lossless Image Compression", Keyword: "JPEGLSLossless", Type: "Transfer Syntax"},
+	JPEGLSNearLossless:                             {Name: "JPEG-LS Lossy (Near-Lossless) Image Compression", Keyword: "JPEGLSNearLossless", Type: "Transfer Syntax"},
+	JPEG2000Lossless:                               {Name: "JPEG 2000 Image Compression (Lossless Only)", Keyword: "JPEG2000Lossless", Type: "Transfer Syntax"},
+	JPEG2000:                                       {Name: "JPEG 2000 Image Compression", Keyword: "JPEG2000", Type: "Transfer Syntax"},
+	JPEG2000MCLossless:                             {Name: "JPEG 2000 Part 2 Multi-component Image Compression (Lossless Only)", Keyword: "JPEG2000MCLossless", Type: "Transfer Syntax"},
+	JPEG2000MC:                                     {Name: "JPEG 2000 Part 2 Multi-component Image Compression", Keyword: "JPEG2000MC", Type: "Transfer Syntax"},
+	JPIPReferenced:                                 {Name: "JPIP Referenced", Keyword: "JPIPReferenced", Type: "Transfer Syntax"},
+	JPIPReferencedDeflate:                          {Name: "JPIP Referenced Deflate", Keyword: "JPIPReferencedDeflate", Type: "Transfer Syntax"},
+	MPEG2MPML:                                      {Name: "MPEG2 Main Profile / Main Level", Keyword: "MPEG2MPML", Type: "Transfer Syntax"},
+	MPEG2MPMLF:                                     {Name: "Fragmentable MPEG2 Main Profile / Main Level", Keyword: "MPEG2MPMLF", Type: "Transfer Syntax"},
+	MPEG2MPHL:                                      {Name: "MPEG2 Main Profile / High Level", Keyword: "MPEG2MPHL", Type: "Transfer Syntax"},
+	MPEG2MPHLF:                                     {Name: "Fragmentable MPEG2 Main Profile / High Level", Keyword: "MPEG2MPHLF", Type: "Transfer Syntax"},
+	MPEG4HP41:                                      {Name: "MPEG-4 AVC/H.264 High Profile / Level 4.1", Keyword: "MPEG4HP41", Type: "Transfer Syntax"},
+	MPEG4HP41F:                                     {Name: "Fragmentable MPEG-4 AVC/H.264 High Profile / Level 4.1", Keyword: "MPEG4HP41F", Type: "Transfer Syntax"},
+	MPEG4HP41BD:                                    {Name: "MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1", Keyword: "MPEG4HP41BD", Type: "Transfer Syntax"},
+	MPEG4HP41BDF:                                   {Name: "Fragmentable MPEG-4 AVC/H.264 BD-compatible High Profile / Level 4.1", Keyword: "MPEG4HP41BDF", Type: "Transfer Syntax"},
+	MPEG4HP422D:                                    {Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video", Keyword: "MPEG4HP422D", Type: "Transfer Syntax"},
+	MPEG4HP422DF:                                   {Name: "Fragmentable MPEG-4 AVC/H.264 High Profile / Level 4.2 For 2D Video", Keyword: "MPEG4HP422DF", Type: "Transfer Syntax"},
+	MPEG4HP423D:                                    {Name: "MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video", Keyword: "MPEG4HP423D", Type: "Transfer Syntax"},
+	MPEG4HP423DF:                                   {Name: "Fragmentable MPEG-4 AVC/H.264 High Profile / Level 4.2 For 3D Video", Keyword: "MPEG4HP423DF", Type: "Transfer Syntax"},
+	MPEG4HP42STEREO:                                {Name: "MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2", Keyword: "MPEG4HP42STEREO", Type: "Transfer Syntax"},
+	MPEG4HP42STEREOF:                               {Name: "Fragmentable MPEG-4 AVC/H.264 Stereo High Profile / Level 4.2", Keyword: "MPEG4HP42STEREOF", Type: "Transfer Syntax"},
+	HEVCMP51:                                       {Name: "HEVC/H.265 Main Profile / Level 5.1", Keyword: "HEVCMP51", Type: "Transfer Syntax"},
+	HEVCM10P51:                                     {Name: "HEVC/H.265 Main 10 Profile / Level 5.1", Keyword: "HEVCM10P51", Type: "Transfer Syntax"},
+	JPEGXLLossless:                                 {Name: "JPEG XL Lossless", Keyword: "JPEGXLLossless", Type: "Transfer Syntax"},
+	JPEGXLJPEGRecompression:                        {Name: "JPEG XL JPEG Recompression", Keyword: "JPEGXLJPEGRecompression", Type: "Transfer Syntax"},
+	JPEGXL:                                         {Name: "JPEG XL", Keyword: "JPEGXL", Type: "Transfer Syntax"},
+	HTJ2KLossless:                                  {Name: "High-Throughput JPEG 2000 Image Compression (Lossless Only)", Keyword: "HTJ2KLossless", Type: "Transfer Syntax"},
+	HTJ2KLosslessRPCL:                              {Name: "High-Throughput JPEG 2000 with RPCL Options Image Compression (Lossless Only)", Keyword: "HTJ2KLosslessRPCL", Type: "Transfer Syntax"},
+	HTJ2K:                                          {Name: "High-Throughput JPEG 2000 Image Compression", Keyword: "HTJ2K", Type: "Transfer Syntax"},
+	JPIPHTJ2KReferenced:                            {Name: "JPIP HTJ2K Referenced", Keyword: "JPIPHTJ2KReferenced", Type: "Transfer Syntax"},
+	JPIPHTJ2KReferencedDeflate:                     {Name: "JPIP HTJ2K Referenced Deflate", Keyword: "JPIPHTJ2KReferencedDeflate", Type: "Transfer Syntax"},
+	RLELossless:                                    {Name: "RLE Lossless", Keyword: "RLELossless", Type: "Transfer Syntax"},
+	RFC2557MIMEEncapsulation:                       {Name: "RFC 2557 MIME encapsulation", Keyword: "RFC2557MIMEEncapsulation", Type: "Transfer Syntax", Retired: true},
+	XMLEncoding:                                    {Name: "XML Encoding", Keyword: "XMLEncoding", Type: "Transfer Syntax", Retired: true},
+	SMPTEST211020UncompressedProgressiveActiveVideo:            {Name: "SMPTE ST 2110-20 Uncompressed Progressive Active Video", Keyword: "SMPTEST211020UncompressedProgressiveActiveVideo", Type: "Transfer Syntax"},
+	SMPTEST211020UncompressedInterlacedActiveVideo:             {Name: "SMPTE ST 2110-20 Uncompressed Interlaced Active Video", Keyword: "SMPTEST211020UncompressedInterlacedActiveVideo", Type: "Transfer Syntax"},
+	SMPTEST211030PCMDigitalAudio:                               {Name: "SMPTE ST 2110-30 PCM Digital Audio", Keyword: "SMPTEST211030PCMDigitalAudio", Type: "Transfer Syntax"},
+	MediaStorageDirectoryStorage:                               {Name: "Media Storage Directory Storage", Keyword: "MediaStorageDirectoryStorage", Type: "SOP Class"},
+	HotIronPalette:                                             {Name: "Hot Iron Color Palette SOP Instance", Keyword: "HotIronPalette", Type: "Well-known SOP Instance"},
+	PETPalette:                                                 {Name: "PET Color Palette SOP Instance", Keyword: "PETPalette", Type: "Well-known SOP Instance"},
+	HotMetalBluePalette:                                        {Name: "Hot Metal Blue Color Palette SOP Instance", Keyword: "HotMetalBluePalette", Type: "Well-known SOP Instance"},
+	PET20StepPalette:                                           {Name: "PET 20 Step Color Palette SOP Instance", Keyword: "PET20StepPalette", Type: "Well-known SOP Instance"},
+	SpringPalette:                                              {Name: "Spring Color Palette SOP Instance", Keyword: "SpringPalette", Type: "Well-known SOP Instance"},
+	SummerPalette:                                              {Name: "Summer Color Palette SOP Instance", Keyword: "SummerPalette", Type: "Well-known SOP Instance"},
+	FallPalette:                                                {Name: "Fall Color Palette SOP Instance", Keyword: "FallPalette", Type: "Well-known SOP Instance"},
+	WinterPalette:                                              {Name: "Winter Color Palette SOP Instance", Keyword: "WinterPalette", Type: "Well-known SOP Instance"},
+	BasicStudyContentNotification:                              {Name: "Basic Study Content Notification SOP Class", Keyword: "BasicStudyContentNotification", Type: "SOP Class", Retired: true},
+	Papyrus3ImplicitVRLittleEndian:                             {Name: "Papyrus 3 Implicit VR Little Endian", Keyword: "Papyrus3ImplicitVRLittleEndian", Type: "Transfer Syntax", Retired: true},
+	StorageCommitmentPushModel:                                 {Name: "Storage Commitment Push Model SOP Class", Keyword: "StorageCommitmentPushModel", Type: "SOP Class"},
+	StorageCommitmentPushModelInstance:                         {Name: "Storage Commitment Push Model SOP Instance", Keyword: "StorageCommitmentPushModelInstance", Type: "Well-known SOP Instance"},
+	StorageCommitmentPullModel:                                 {Name: "Storage Commitment Pull Model SOP Class", Keyword: "StorageCommitmentPullModel", Type: "SOP Class", Retired: true},
+	StorageCommitmentPullModelInstance:                         {Name: "Storage Commitment Pull Model SOP Instance", Keyword: "StorageCommitmentPullModelInstance", Type: "Well-known SOP Instance", Retired: true},
+	ProceduralEventLogging:                                     {Name: "Procedural Event Logging SOP Class", Keyword: "ProceduralEventLogging", Type: "SOP Class"},
+	ProceduralEventLoggingInstance:                             {Name: "Procedural Event Logging SOP Instance", Keyword: "ProceduralEventLoggingInstance", Type: "Well-known SOP Instance"},
+	SubstanceAdministrationLogging:                             {Name: "Substance Administration Logging SOP Class", Keyword: "SubstanceAdministrationLogging", Type: "SOP Class"},
+	SubstanceAdministrationLoggingInstance:                     {Name: "Substance Administration Logging SOP Instance", Keyword: "SubstanceAdministrationLoggingInstance", Type: "Well-known SOP Instance"},
+	DCMUID:                                                     {Name: "DICOM UID Registry", Keyword: "DCMUID", Type: "DICOM UIDs as a Coding Scheme"},
+	DCM:                                                        {Name: "DICOM Controlled Terminology", Keyword: "DCM", Type: "Coding Scheme"},
+	DICOMApplicationContext:                                    {Name: "DICOM Application Context Name", Keyword: "DICOMApplicationContext", Type: "Application Context Name"},
+	DetachedPatientManagement:                                  {Name: "Detached Patient Management SOP Class", Keyword: "DetachedPatientManagement", Type: "SOP Class", Retired: true},
+	DetachedPatientManagementMeta:                              {Name: "Detached Patient Management Meta SOP Class", Keyword: "DetachedPatientManagementMeta", Type: "Meta SOP Class", Retired: true},
+	DetachedVisitManagement:                                    {Name: "Detached Visit Management SOP Class", Keyword: "DetachedVisitManagement", Type: "SOP Class", Retired: true},
+	DetachedStudyManagement:                                    {Name: "Detached Study Management SOP Class", Keyword: "DetachedStudyManagement", Type: "SOP Class", Retired: true},
+	StudyComponentManagement:                                   {Name: "Study Component Management SOP Class", Keyword: "StudyComponentManagement", Type: "SOP Class", Retired: true},
+	ModalityPerformedProcedureStep:                             {Name: "Modality Performed Procedure Step SOP Class", Keyword: "ModalityPerformedProcedureStep", Type: "SOP Class"},
+	ModalityPerformedProcedureStepRetrieve:                     {Name: "Modality Performed Procedure Step Retrieve SOP Class", Keyword: "ModalityPerformedProcedureStepRetrieve", Type: "SOP Class"},
+	ModalityPerformedProcedureStepNotification:                 {Name: "Modality Performed Procedure Step Notification SOP Class", Keyword: "ModalityPerformedProcedureStepNotification", Type: "SOP Class"},
+	DetachedResultsManagement:                                  {Name: "Detached Results Management SOP Class", Keyword: "DetachedResultsManagement", Type: "SOP Class", Retired: true},
+	DetachedResultsManagementMeta:                              {Name: "Detached Results Management Meta SOP Class", Keyword: "DetachedResultsManagementMeta", Type: "Meta SOP Class", Retired: true},
+	DetachedStudyManagementMeta:                                {Name: "Detached Study Management Meta SOP Class", Keyword: "DetachedStudyManagementMeta", Type: "Meta SOP Class", Retired: true},
+	DetachedInterpretationManagement:                           {Name: "Detached Interpretation Management SOP Class", Keyword: "DetachedInterpretationManagement", Type: "SOP Class", Retired: true},
+	Storage:                                                    {Name: "Storage Service Class", Keyword: "Storage", Type: "Service Class"},
+	BasicFilmSession:                                           {Name: "Basic Film Session SOP Class", Keyword: "BasicFilmSession", Type: "SOP Class"},
+	BasicFilmBox:                                               {Name: "Basic Film Box SOP Class", Keyword: "BasicFilmBox", Type: "SOP Class"},
+	BasicGrayscaleImageBox:                                     {Name: "Basic Grayscale Image Box SOP Class", Keyword: "BasicGrayscaleImageBox", Type: "SOP Class"},
+	BasicColorImageBox:                                         {Name: "Basic Color Image Box SOP Class", Keyword: "BasicColorImageBox", Type: "SOP Class"},
+	ReferencedImageBox:                                         {Name: "Referenced Image Box SOP Class", Keyword: "ReferencedImageBox", Type: "SOP Class", Retired: true},
+	BasicGrayscalePrintManagementMeta:                          {Name: "Basic Grayscale Print Management Meta SOP Class", Keyword: "BasicGrayscalePrintManagementMeta", Type: "Meta SOP Class"},
+	ReferencedGrayscalePrintManagementMeta:                     {Name: "Referenced Grayscale Print Management Meta SOP Class", Keyword: "ReferencedGrayscalePrintManagementMeta", Type: "Meta SOP Class", Retired: true},
+	PrintJob:                                                   {Name: "Print Job SOP Class", Keyword: "PrintJob", Type: "SOP Class"},
+	BasicAnnotationBox:                                         {Name: "Basic Annotation Box SOP Class", Keyword: "BasicAnnotationBox", Type: "SOP Class"},
+	Printer:                                                    {Name: "Printer SOP Class", Keyword: "Printer", Type: "SOP Class"},
+	PrinterConfigurationRetrieval:                              {Name: "Printer Configuration Retrieval SOP Class", Keyword: "PrinterConfigurationRetrieval", Type: "SOP Class"},
+	PrinterInstance:                                            {Name: "Printer SOP Instance", Keyword: "PrinterInstance", Type: "Well-known Printer SOP Instance"},
+	PrinterConfigurationRetrievalInstance:                      {Name: "Printer Configuration Retrieval SOP Instance", Keyword: "PrinterConfigurationRetrievalInstance", Type: "Well-known Printer SOP Instance"},
+	BasicColorPrintManagementMeta:                              {Name: "Basic Color Print Management Meta SOP Class", Keyword: "BasicColorPrintManagementMeta", Type: "Meta SOP Class"},
+	ReferencedColorPrintManagementMeta:                         {Name: "Referenced Color Print Management Meta SOP Class", Keyword: "ReferencedColorPrintManagementMeta", Type: "Meta SOP Class", Retired: true},
+	VOILUTBox:                                                  {Name: "VOI LUT Box SOP Class", Keyword: "VOILUTBox", Type: "SOP Class"},
+	PresentationLUT:                                            {Name: "Presentation LUT SOP Class", Keyword: "PresentationLUT", Type: "SOP Class"},
+	ImageOverlayBox:                                            {Name: "Image Overlay Box SOP Class", Keyword: "ImageOverlayBox", Type: "SOP Class", Retired: true},
+	BasicPrintImageOverlayBox:                                  {Name: "Basic Print Image Overlay Box SOP Class", Keyword: "BasicPrintImageOverlayBox", Type: "SOP Class", Retired: true},
+	PrintQueueInstance:                                         {Name: "Print Queue SOP Instance", Keyword: "PrintQueueInstance", Type: "Well-known Print Queue SOP Instance", Retired: true},
+	PrintQueueManagement:                                       {Name: "Print Queue Management SOP Class", Keyword: "PrintQueueManagement", Type: "SOP Class", Retired: true},
+	StoredPrintStorage:                                         {Name: "Stored Print Storage SOP Class", Keyword: "StoredPrintStorage", Type: "SOP Class", Retired: true},
+	HardcopyGrayscaleImageStorage:                              {Name: "Hardcopy Grayscale Image Storage SOP Class", Keyword: "HardcopyGrayscaleImageStorage", Type: "SOP Class", Retired: true},
+	HardcopyColorImageStorage:                                  {Name: "Hardcopy Color Image Storage SOP Class", Keyword: "HardcopyColorImageStorage", Type: "SOP Class", Retired: true},
+	PullPrintRequest:                                           {Name: "Pull Print Request SOP Class", Keyword: "PullPrintRequest", Type: "SOP Class", Retired: true},
+	PullStoredPrintManagementMeta:                              {Name: "Pull Stored Print Management Meta SOP Class", Keyword: "PullStoredPrintManagementMeta", Type: "Meta SOP Class", Retired: true},
+	MediaCreationManagement:                                    {Name: "Media Creation Management SOP Class UID", Keyword: "MediaCreationManagement", Type: "SOP Class"},
+	DisplaySystem:                                              {Name: "Display System SOP Class", Keyword: "DisplaySystem", Type: "SOP Class"},
+	DisplaySystemInstance:                                      {Name: "Display System SOP Instance", Keyword: "DisplaySystemInstance", Type: "Well-known SOP Instance"},
+	ComputedRadiographyImageStorage:                            {Name: "Computed Radiography Image Storage", Keyword: "ComputedRadiographyImageStorage", Type: "SOP Class"},
+	DigitalXRayImageStorageForPresentation:                     {Name: "Digital X-Ray Image Storage - For Presentation", Keyword: "DigitalXRayImageStorageForPresentation", Type: "SOP Class"},
+	DigitalXRayImageStorageForProcessing:                       {Name: "Digital X-Ray Image Storage - For Processing", Keyword: "DigitalXRayImageStorageForProcessing", Type: "SOP Class"},
+	DigitalMammographyXRayImageStorageForPresentation:          {Name: "Digital Mammography X-Ray Image Storage - For Presentation", Keyword: "DigitalMammographyXRayImageStorageForPresentation", Type: "SOP Class"},
+	DigitalMammographyXRayImageStorageForProcessing:            {Name: "Digital Mammography X-Ray Image Storage - For Processing", Keyword: "DigitalMammographyXRayImageStorageForProcessing", Type: "SOP Class"},
+	DigitalIntraOralXRayImageStorageForPresentation:            {Name: "Digital Intra-Oral X-Ray Image Storage - For Presentation", Keyword: "DigitalIntraOralXRayImageStorageForPresentation", Type: "SOP Class"},
+	DigitalIntraOralXRayImageStorageForProcessing:              {Name: "Digital Intra-Oral X-Ray Image Storage - For Processing", Keyword: "DigitalIntraOralXRayImageStorageForProcessing", Type: "SOP Class"},
+	CTImageStorage:                                             {Name: "CT Image Storage", Keyword: "CTImageStorage", Type: "SOP Class"},
+	EnhancedCTImageStorage:                                     {Name: "Enhanced CT Image Storage", Keyword: "EnhancedCTImageStorage", Type: "SOP Class"},
+	LegacyConvertedEnhancedCTImageStorage:                      {Name: "Legacy Converted Enhanced CT Image Storage", Keyword: "LegacyConvertedEnhancedCTImageStorage", Type: "SOP Class"},
+	UltrasoundMultiFrameImageStorageRetired:                    {Name: "Ultrasound Multi-frame Image Storage", Keyword: "UltrasoundMultiFrameImageStorageRetired", Type: "SOP Class", Retired: true},
+	UltrasoundMultiFrameImageStorage:                           {Name: "Ultrasound Multi-frame Image Storage", Keyword: "UltrasoundMultiFrameImageStorage", Type: "SOP Class"},
+	MRImageStorage:                                             {Name: "MR Image Storage", Keyword: "MRImageStorage", Type: "SOP Class"},
+	EnhancedMRImageStorage:                                     {Name: "Enhanced MR Image Storage", Keyword: "EnhancedMRImageStorage", Type: "SOP Class"},
+	MRSpectroscopyStorage:                                      {Name: "MR Spectroscopy Storage", Keyword: "MRSpectroscopyStorage", Type: "SOP Class"},
+	EnhancedMRColorImageStorage:                                {Name: "Enhanced MR Color Image Storage", Keyword: "EnhancedMRColorImageStorage", Type: "SOP Class"},
+	LegacyConvertedEnhancedMRImageStorage:                      {Name: "Legacy Converted Enhanced MR Image Storage", Keyword: "LegacyConvertedEnhancedMRImageStorage", Type: "SOP Class"},
+	NuclearMedicineImageStorageRetired:                         {Name: "Nuclear Medicine Image Storage", Keyword: "NuclearMedicineImageStorageRetired", Type: "SOP Class", Retired: true},
+	UltrasoundImageStorageRetired:                              {Name: "Ultrasound Image Storage", Keyword: "UltrasoundImageStorageRetired", Type: "SOP Class", Retired: true},
+	UltrasoundImageStorage:                                     {Name: "Ultrasound Image Storage", Keyword: "UltrasoundImageStorage", Type: "SOP Class"},
+	EnhancedUSVolumeStorage:                                    {Name: "Enhanced US Volume Storage", Keyword: "EnhancedUSVolumeStorage", Type: "SOP Class"},
+	PhotoacousticImageStorage:                                  {Name: "Photoacoustic Image Storage", Keyword: "PhotoacousticImageStorage", Type: "SOP Class"},
+	SecondaryCaptureImageStorage:                               {Name: "Secondary Capture Image Storage", Keyword: "SecondaryCaptureImageStorage", Type: "SOP Class"},
+	MultiFrameSingleBitSecondaryCaptureImageStorage:            {Name: "Multi-frame Single Bit Secondary Capture Image Storage", Keyword: "MultiFrameSingleBitSecondaryCaptureImageStorage", Type: "SOP Class"},
+	MultiFrameGrayscaleByteSecondaryCaptureImageStorage:        {Name: "Multi-frame Grayscale Byte Secondary Capture Image Storage", Keyword: "MultiFrameGrayscaleByteSecondaryCaptureImageStorage", Type: "SOP Class"},
+	MultiFrameGrayscaleWordSecondaryCaptureImageStorage:        {Name: "Multi-frame Grayscale Word Secondary Capture Image Storage", Keyword: "MultiFrameGrayscaleWordSecondaryCaptureImageStorage", Type: "SOP Class"},
+	MultiFrameTrueColorSecondaryCaptureImageStorage:            {Name: "Multi-frame True Color Secondary Capture Image Storage", Keyword: "MultiFrameTrueColorSecondaryCaptureImageStorage", Type: "SOP Class"},
+	StandaloneOverlayStorage:                                   {Name: "Standalone Overlay Storage", Keyword: "StandaloneOverlayStorage", Type: "SOP Class", Retired: true},
+	StandaloneCurveStorage:                                     {Name: "Standalone Curve Storage", Keyword: "StandaloneCurveStorage", Type: "SOP Class", Retired: true},
+	WaveformStorageTrial:                                       {Name: "Waveform Storage - Trial", Keyword: "WaveformStorageTrial", Type: "SOP Class", Retired: true},
+	TwelveLeadECGWaveformStorage:                               {Name: "12-lead ECG Waveform Storage", Keyword: "TwelveLeadECGWaveformStorage", Type: "SOP Class"},
+	GeneralECGWaveformStorage:                                  {Name: "General ECG Waveform Storage", Keyword: "GeneralECGWaveformStorage", Type: "SOP Class"},
+	AmbulatoryECGWaveformStorage:                               {Name: "Ambulatory ECG Waveform Storage", Keyword: "AmbulatoryECGWaveformStorage", Type: "SOP Class"},
+	General32bitECGWaveformStorage:                             {Name: "General 32-bit ECG Waveform Storage", Keyword: "General32bitECGWaveformStorage", Type: "SOP Class"},
+	HemodynamicWaveformStorage:                                 {Name: "Hemodynamic Waveform Storage", Keyword: "HemodynamicWaveformStorage", Type: "SOP Class"},
+	CardiacElectrophysiologyWaveformStorage:                    {Name: "Cardiac Electrophysiology Waveform Storage", Keyword: "CardiacElectrophysiologyWaveformStorage", Type: "SOP Class"},
+	BasicVoiceAudioWaveformStorage:                             {Name: "Basic Voice Audio Waveform Storage", Keyword: "BasicVoiceAudioWaveformStorage", Type: "SOP Class"},
+	GeneralAudioWaveformStorage:                                {Name: "General Audio Waveform Storage", Keyword: "GeneralAudioWaveformStorage", Type: "SOP Class"},
+	ArterialPulseWaveformStorage:                               {Name: "Arterial Pulse Waveform Storage", Keyword: "ArterialPulseWaveformStorage", Type: "SOP Class"},
+	RespiratoryWaveformStorage:                                 {Name: "Respiratory Waveform Storage", Keyword: "RespiratoryWaveformStorage", Type: "SOP Class"},
+	MultichannelRespiratoryWaveformStorage:                     {Name: "Multi-channel Respiratory Waveform Storage", Keyword: "MultichannelRespiratoryWaveformStorage", Type: "SOP Class"},
+	RoutineScalpElectroencephalogramWaveformStorage:            {Name: "Routine Scalp Electroencephalogram Waveform Storage", Keyword: "RoutineScalpElectroencephalogramWaveformStorage", Type: "SOP Class"},
+	ElectromyogramWaveformStorage:                              {Name: "Electromyogram Waveform Storage", Keyword: "ElectromyogramWaveformStorage", Type: "SOP Class"},
+	ElectrooculogramWaveformStorage:                            {Name: "Electrooculogram Waveform Storage", Keyword: "ElectrooculogramWaveformStorage", Type: "SOP Class"},
+	SleepElectroencephalogramWaveformStorage:                   {Name: "Sleep Electroencephalogram Waveform Storage", Keyword: "SleepElectroencephalogramWaveformStorage", Type: "SOP Class"},
+	BodyPositionWaveformStorage:                                {Name: "Body Position Waveform Storage", Keyword: "BodyPositionWaveformStorage", Type: "SOP Class"},
+	StandaloneModalityLUTStorage:                               {Name: "Standalone Modality LUT Storage", Keyword: "StandaloneModalityLUTStorage", Type: "SOP Class", Retired: true},
+	StandaloneVOILUTStorage:                                    {Name: "Standalone VOI LUT Storage", Keyword: "StandaloneVOILUTStorage", Type: "SOP Class", Retired: true},
+	GrayscaleSoftcopyPresentationStateStorage:                  {Name: "Grayscale Softcopy Presentation State Storage", Keyword: "GrayscaleSoftcopyPresentationStateStorage", Type: "SOP Class"},
+	ColorSoftcopyPresentationStateStorage:                      {Name: "Color Softcopy Presentation State Storage", Keyword: "ColorSoftcopyPresentationStateStorage", Type: "SOP Class"},
+	PseudoColorSoftcopyPresentationStateStorage:                {Name: "Pseudo-Color Softcopy Presentation State Storage", Keyword: "PseudoColorSoftcopyPresentationStateStorage", Type: "SOP Class"},
+	BlendingSoftcopyPresentationStateStorage:                   {Name: "Blending Softcopy Presentation State Storage", Keyword: "BlendingSoftcopyPresentationStateStorage", Type: "SOP Class"},
+	XAXRFGrayscaleSoftcopyPresentationStateStorage:             {Name: "XA/XRF Grayscale Softcopy Presentation State Storage", Keyword: "XAXRFGrayscaleSoftcopyPresentationStateStorage", Type: "SOP Class"},
+	GrayscalePlanarMPRVolumetricPresentationStateStorage:       {Name: "Grayscale Planar MPR Volumetric Presentation State Storage", Keyword: "GrayscalePlanarMPRVolumetricPresentationStateStorage", Type: "SOP Class"},
+	CompositingPlanarMPRVolumetricPresentationStateStorage:     {Name: "Compositing Planar MPR Volumetric Presentation State Storage", Keyword: "CompositingPlanarMPRVolumetricPresentationStateStorage", Type: "SOP Class"},
+	AdvancedBlendingPresentationStateStorage:                   {Name: "Advanced Blending Presentation State Storage", Keyword: "AdvancedBlendingPresentationStateStorage", Type: "SOP Class"},
+	VolumeRenderingVolumetricPresentationStateStorage:          {Name: "Volume Rendering Volumetric Presentation State Storage", Keyword: "VolumeRenderingVolumetricPresentationStateStorage", Type: "SOP Class"},
+	SegmentedVolumeRenderingVolumetricPresentationStateStorage: {Name: "Segmented Volume Rendering Volumetric Presentation State Storage", Keyword: "SegmentedVolumeRenderingVolumetricPresentationStateStorage", Type: "SOP Class"},
+	MultipleVolumeRenderingVolumetricPresentationStateStorage:  {Name: "Multiple Volume Rendering Volumetric Presentation State Storage", Keyword: "MultipleVolumeRenderingVolumetricPresentationStateStorage", Type: "SOP Class"},
+	XRayAngiographicImageStorage:                               {Name: "X-Ray Angiographic Image Storage", Keyword: "XRayAngiographicImageStorage", Type: "SOP Class"},
+	EnhancedXAImageStorage:                                     {Name: "Enhanced XA Image Storage", Keyword: "EnhancedXAImageStorage", Type: "SOP Class"},
+	XRayRadiofluoroscopicImageStorage:                          {Name: "X-Ray Radiofluoroscopic Image Storage", Keyword: "XRayRadiofluoroscopicImageStorage", Type: "SOP Class"},
+	EnhancedXRFImageStorage:                                    {Name: "Enhanced XRF Image Storage", Keyword: "EnhancedXRFImageStorage", Type: "SOP Class"},
+	XRayAngiographicBiPlaneImageStorage:                        {Name: "X-Ray Angiographic Bi-Plane Image Storage", Keyword: "XRayAngiographicBiPlaneImageStorage", Type: "SOP Class", Retired: true},
+	XRay3DAngiographicImageStorage:                             {Name: "X-Ray 3D Angiographic Image Storage", Keyword: "XRay3DAngiographicImageStorage", Type: "SOP Class"},
+	XRay3DCraniofacialImageStorage:                             {Name: "X-Ray 3D Craniofacial Image Storage", Keyword: "XRay3DCraniofacialImageStorage", Type: "SOP Class"},
+	BreastTomosynthesisImageStorage:                            {Name: "Breast Tomosynthesis Image Storage", Keyword: "BreastTomosynthesisImageStorage", Type: "SOP Class"},
+	BreastProjectionXRayImageStorageForPresentation:            {Name: "Breast Projection X-Ray Image Storage - For Presentation", Keyword: "BreastProjectionXRayImageStorageForPresentation", Type: "SOP Class"},
+	BreastProjectionXRayImageStorageForProcessing:              {Name: "Breast Projection X-Ray Image Storage - For Processing", Keyword: "BreastProjectionXRayImageStorageForProcessing", Type: "SOP Class"},
+	IntravascularOpticalCoherenceTomographyImageStorageForPresentation: {Name: "Intravascular Optical Coherence Tomography Image Storage - For Presentation", Keyword: "IntravascularOpticalCoherenceTomographyImageStorageForPresentation", Type: "SOP Class"},
+	IntravascularOpticalCoherenceTomographyImageStorageForProcessing:   {Name: "Intravascular Optical Coherence Tomography Image Storage - For Processing", Keyword: "IntravascularOpticalCoherenceTomographyImageStorageForProcessing", Type: "SOP Class"},
+	NuclearMedicineImageStorage:                                        {Name: "Nuclear Medicine Image Storage", Keyword: "NuclearMedicineImageStorage", Type: "SOP Class"},
+	ParametricMapStorage:                                               {Name: "Parametric Map Storage", Keyword: "ParametricMapStorage", Type: "SOP Class"},
+	RawDataStorage:                                                     {Name: "Raw Data Storage", Keyword: "RawDataStorage", Type: "SOP Class"},
+	SpatialRegistrationStorage:                                         {Name: "Spatial Registration Storage", Keyword: "SpatialRegistrationStorage", Type: "SOP Class"},
+	SpatialFiducialsStorage:                                            {Name: "Spatial Fiducials Storage", Keyword: "SpatialFiducialsStorage", Type: "SOP Class"},
+	DeformableSpatialRegistrationStorage:                               {Name: "Deformable Spatial Registration Storage", Keyword: "DeformableSpatialRegistrationStorage", Type: "SOP Class"},
+	SegmentationStorage:                                                {Name: "Segmentation Storage", Keyword: "SegmentationStorage", Type: "SOP Class"},
+	SurfaceSegmentationStorage:                                         {Name: "Surface Segmentation Storage", Keyword: "SurfaceSegmentationStorage", Type: "SOP Class"},
+	TractographyResultsStorage:                                         {Name: "Tractography Results Storage", Keyword: "TractographyResultsStorage", Type: "SOP Class"},
+	RealWorldValueMappingStorage:                                       {Name: "Real World Value Mapping Storage", Keyword: "RealWorldValueMappingStorage", Type: "SOP Class"},
+	SurfaceScanMeshStorage:                                             {Name: "Surface Scan Mesh Storage", Keyword: "SurfaceScanMeshStorage", Type: "SOP Class"},
+	SurfaceScanPointCloudStorage:                                       {Name: "Surface Scan Point Cloud Storage", Keyword: "SurfaceScanPointCloudStorage", Type: "SOP Class"},
+	VLImageStorageTrial:                                                {Name: "VL Image Storage - Trial", Keyword: "VLImageStorageTrial", Type: "SOP Class", Retired: true},
+	VLMultiFrameImageStorageTrial:                                      {Name: "VL Multi-frame Image Storage - Trial", Keyword: "VLMultiFrameImageStorageTrial", Type: "SOP Class", Retired: true},
+	VLEndoscopicImageStorage:                                           {Name: "VL Endoscopic Image Storage", Keyword: "VLEndoscopicImageStorage", Type: "SOP Class"},
+	VideoEndoscopicImageStorage:                                        {Name: "Video Endoscopic Image Storage", Keyword: "VideoEndoscopicImageStorage", Type: "SOP Class"},
+	VLMicroscopicImageStorage:                                          {Name: "VL Microscopic Image Storage", Keyword: "VLMicroscopicImageStorage", Type: "SOP Class"},
+	VideoMicroscopicImageStorage:                                       {Name: "Video Microscopic Image Storage", Keyword: "VideoMicroscopicImageStorage", Type: "SOP Class"},
+	VLSlideCoordinatesMicroscopicImageStorage:                          {Name: "VL Slide-Coordinates Microscopic Image Storage", Keyword: "VLSlideCoordinatesMicroscopicImageStorage", Type: "SOP Class"},
+	VLPhotographicImageStorage:                                         {Name: "VL Photographic Image Storage", Keyword: "VLPhotographicImageStorage", Type: "SOP Class"},
+	VideoPhotographicImageStorage:                                      {Name: "Video Photographic Image Storage", Keyword: "VideoPhotographicImageStorage", Type: "SOP Class"},
+	OphthalmicPhotography8BitImageStorage:                              {Name: "Ophthalmic Photography 8 Bit Image Storage", Keyword: "OphthalmicPhotography8BitImageStorage", Type: "SOP Class"},
+	OphthalmicPhotography16BitImageStorage:                             {Name: "Ophthalmic Photography 16 Bit Image Storage", Keyword: "OphthalmicPhotography16BitImageStorage", Type: "SOP Class"},
+	StereometricRelationshipStorage:                                    {Name: "Stereometric Relationship Storage", Keyword: "StereometricRelationshipStorage", Type: "SOP Class"},
+	OphthalmicTomographyImageStorage:                                   {Name: "Ophthalmic Tomography Image Storage", Keyword: "OphthalmicTomographyImageStorage", Type: "SOP Class"},
+	WideFieldOphthalmicPhotographyStereographicProjectionImageStorage:  {Name: "Wide Field Ophthalmic Photography Stereographic Projection Image Storage", Keyword: "WideFieldOphthalmicPhotographyStereographicProjectionImageStorage", Type: "SOP Class"},
+	WideFieldOphthalmicPhotography3DCoordinatesImageStorage:            {Name: "Wide Field Ophthalmic Photography 3D Coordinates Image Storage", Keyword: "WideFieldOphthalmicPhotography3DCoordinatesImageStorage", Type: "SOP Class"},
+	OphthalmicOpticalCoherenceTomographyEnFaceImageStorage:             {Name: "Ophthalmic Optical Coherence Tomography En Face Image Storage", Keyword: "OphthalmicOpticalCoherenceTomographyEnFaceImageStorage", Type: "SOP Class"},
+	OphthalmicOpticalCoherenceTomographyBscanVolumeAnalysisStorage:     {Name: "Ophthalmic Optical Coherence Tomography B-scan Volume Analysis Storage", Keyword: "OphthalmicOpticalCoherenceTomographyBscanVolumeAnalysisStorage", Type: "SOP Class"},
+	VLWholeSlideMicroscopyImageStorage:                                 {Name: "VL Whole Slide Microscopy Image Storage", Keyword: "VLWholeSlideMicroscopyImageStorage", Type: "SOP Class"},
+	DermoscopicPhotographyImageStorage:                                 {Name: "Dermoscopic Photography Image Storage", Keyword: "DermoscopicPhotographyImageStorage", Type: "SOP Class"},
+	ConfocalMicroscopyImageStorage:                                     {Name: "Confocal Microscopy Image Storage", Keyword: "ConfocalMicroscopyImageStorage", Type: "SOP Class"},
+	ConfocalMicroscopyTiledPyramidalImageStorage:                       {Name: "Confocal Microscopy Tiled Pyramidal Image Storage", Keyword: "ConfocalMicroscopyTiledPyramidalImageStorage", Type: "SOP Class"},
+	LensometryMeasurementsStorage:                                      {Name: "Lensometry Measurements Storage", Keyword: "LensometryMeasurementsStorage", Type: "SOP Class"},
+	AutorefractionMeasurementsStorage:                                  {Name: "Autorefraction Measurements Storage", Keyword: "AutorefractionMeasurementsStorage", Type: "SOP Class"},
+	KeratometryMeasurementsStorage:                                     {Name: "Keratometry Measurements Storage", Keyword: "KeratometryMeasurementsStorage", Type: "SOP Class"},
+	SubjectiveRefractionMeasurementsStorage:                            {Name: "Subjective Refraction Measurements Storage", Keyword: "SubjectiveRefractionMeasurementsStorage", Type: "SOP Class"},
+	VisualAcuityMeasurementsStorage:                                    {Name: "Visual Acuity Measurements Storage", Keyword: "VisualAcuityMeasurementsStorage", Type: "SOP Class"},
+	SpectaclePrescriptionReportStorage:                                 {Name: "Spectacle Prescription Report Storage", Keyword: "SpectaclePrescriptionReportStorage", Type: "SOP Class"},
+	OphthalmicAxialMeasurementsStorage:                                 {Name: "Ophthalmic Axial Measurements Storage", Keyword: "OphthalmicAxialMeasurementsStorage", Type: "SOP Class"},
+	IntraocularLensCalculationsStorage:                                 {Name: "Intraocular Lens Calculations Storage", Keyword: "IntraocularLensCalculationsStorage", Type: "SOP Class"},
+	MacularGridThicknessAndVolumeReportStorage:                         {Name: "Macular Grid Thickness and Volume Report Storage", Keyword: "MacularGridThicknessAndVolumeReportStorage", Type: "SOP Class"},
+	OphthalmicVisualFieldStaticPerimetryMeasurementsStorage:            {Name: "Ophthalmic Visual Field Static Perimetry Measurements Storage", Keyword: "OphthalmicVisualFieldStaticPerimetryMeasurementsStorage", Type: "SOP Class"},
+	OphthalmicThicknessMapStorage:                                      {Name: "Ophthalmic Thickness Map Storage", Keyword: "OphthalmicThicknessMapStorage", Type: "SOP Class"},
+	CornealTopographyMapStorage:                                        {Name: "Corneal Topography Map Storage", Keyword: "CornealTopographyMapStorage", Type: "SOP Class"},
+	TextSRStorageTrial:                                                 {Name: "Text SR Storage - Trial", Keyword: "TextSRStorageTrial", Type: "SOP Class", Retired: true},
+	AudioSRStorageTrial:                                                {Name: "Audio SR Storage - Trial", Keyword: "AudioSRStorageTrial", Type: "SOP Class", Retired: true},
+	DetailSRStorageTrial:                                               {Name: "Detail SR Storage - Trial", Keyword: "DetailSRStorageTrial", Type: "SOP Class", Retired: true},
+	ComprehensiveSRStorageTrial:                                        {Name: "Comprehensive SR Storage - Trial", Keyword: "ComprehensiveSRStorageTrial", Type: "SOP Class", Retired: true},
+	BasicTextSRStorage:                                                 {Name: "Basic Text SR Storage", Keyword: "BasicTextSRStorage", Type: "SOP Class"},
+	EnhancedSRStorage:                                                  {Name: "Enhanced SR Storage", Keyword: "EnhancedSRStorage", Type: "SOP Class"},
+	ComprehensiveSRStorage:                                             {Name: "Comprehensive SR Storage", Keyword: "ComprehensiveSRStorage", Type: "SOP Class"},
+	Comprehensive3DSRStorage:                                           {Name: "Comprehensive 3D SR Storage", Keyword: "Comprehensive3DSRStorage", Type: "SOP Class"},
+	ExtensibleSRStorage:                                                {Name: "Extensible SR Storage", Keyword: "ExtensibleSRStorage", Type: "SOP Class"},
+	ProcedureLogStorage:                                                {Name: "Procedure Log Storage", Keyword: "ProcedureLogStorage", Type: "SOP Class"},
+	MammographyCADSRStorage:                                            {Name: "Mammography CAD SR Storage", Keyword: "MammographyCADSRStorage", Type: "SOP Class"},
+	KeyObjectSelectionDocumentStorage:                                  {Name: "Key Object Selection Document Storage", Keyword: "KeyObjectSelectionDocumentStorage", Type: "SOP Class"},
+	ChestCADSRStorage:                                                  {Name: "Chest CAD SR Storage", Keyword: "ChestCADSRStorage", Type: "SOP Class"},
+	XRayRadiationDoseSRStorage:                                         {Name: "X-Ray Radiation Dose SR Storage", Keyword: "XRayRadiationDoseSRStorage", Type: "SOP Class"},
+	RadiopharmaceuticalRadiationDoseSRStorage:                          {Name: "Radiopharmaceutical Radiation Dose SR Storage", Keyword: "RadiopharmaceuticalRadiationDoseSRStorage", Type: "SOP Class"},
+	ColonCADSRStorage:                                                  {Name: "Colon CAD SR Storage", Keyword: "ColonCADSRStorage", Type: "SOP Class"},
+	ImplantationPlanSRStorage:                                          {Name: "Implantation Plan SR Storage", Keyword: "ImplantationPlanSRStorage", Type: "SOP Class"},
+	AcquisitionContextSRStorage:                                        {Name: "Acquisition Context SR Storage", Keyword: "AcquisitionContextSRStorage", Type: "SOP Class"},
+	SimplifiedAdultEchoSRStorage:                                       {Name: "Simplified Adult Echo SR Storage", Keyword: "SimplifiedAdultEchoSRStorage", Type: "SOP Class"},
+	PatientRadiationDoseSRStorage:                                      {Name: "Patient Radiation Dose SR Storage", Keyword: "PatientRadiationDoseSRStorage", Type: "SOP Class"},
+	PlannedImagingAgentAdministrationSRStorage:                         {Name: "Planned Imaging Agent Administration SR Storage", Keyword: "PlannedImagingAgentAdministrationSRStorage", Type: "SOP Class"},
+	PerformedImagingAgentAdministrationSRStorage:                       {Name: "Performed Imaging Agent Administration SR Storage", Keyword: "PerformedImagingAgentAdministrationSRStorage", Type: "SOP Class"},
+	EnhancedXRayRadiationDoseSRStorage:                                 {Name: "Enhanced X-Ray Radiation Dose SR Storage", Keyword: "EnhancedXRayRadiationDoseSRStorage", Type: "SOP Class"},
+	ContentAssessmentResultsStorage:                                    {Name: "Content Assessment Results Storage", Keyword: "ContentAssessmentResultsStorage", Type: "SOP Class"},
+	MicroscopyBulkSimpleAnnotationsStorage:                             {Name: "Microscopy Bulk Simple Annotations Storage", Keyword: "MicroscopyBulkSimpleAnnotationsStorage", Type: "SOP Class"},
+	EncapsulatedPDFStorage:                                             {Name: "Encapsulated PDF Storage", Keyword: "EncapsulatedPDFStorage", Type: "SOP Class"},
+	EncapsulatedCDAStorage:                                             {Name: "Encapsulated CDA Storage", Keyword: "EncapsulatedCDAStorage", Type: "SOP Class"},
+	EncapsulatedSTLStorage:                                             {Name: "Encapsulated STL Storage", Keyword: "EncapsulatedSTLStorage", Type: "SOP Class"},
+	EncapsulatedOBJStorage:                                             {Name: "Encapsulated OBJ Storage", Keyword: "EncapsulatedOBJStorage", Type: "SOP Class"},
+	EncapsulatedMTLStorage:                                             {Name: "Encapsulated MTL Storage", Keyword: "EncapsulatedMTLStorage", Type: "SOP Class"},
+	PositronEmissionTomographyImageStorage:                             {Name: "Positron Emission Tomography Image Storage", Keyword: "PositronEmissionTomographyImageStorage", Type: "SOP Class"},
+	LegacyConvertedEnhancedPETImageStorage:                             {Name: "Legacy Converted Enhanced PET Image Storage", Keyword: "LegacyConvertedEnhancedPETImageStorage", Type: "SOP Class"},
+	StandalonePETCurveStorage:                                          {Name: "Standalone PET Curve Storage", Keyword: "StandalonePETCurveStorage", Type: "SOP Class", Retired: true},
+	EnhancedPETImageStorage:                                            {Name: "Enhanced PET Image Storage", Keyword: "EnhancedPETImageStorage", Type: "SOP Class"},
+	BasicStructuredDisplayStorage:                                      {Name: "Basic Structured Display Storage", Keyword: "BasicStructuredDisplayStorage", Type: "SOP Class"},
+	CTDefinedProcedureProtocolStorage:                                  {Name: "CT Defined Procedure Protocol Storage", Keyword: "CTDefinedProcedureProtocolStorage", Type: "SOP Class"},
+	CTPerformedProcedureProtocolStorage:                                {Name: "CT Performed Procedure Protocol Storage", Keyword: "CTPerformedProcedureProtocolStorage", Type: "SOP Class"},
+	ProtocolApprovalStorage:                                            {Name: "Protocol Approval Storage", Keyword: "ProtocolApprovalStorage", Type: "SOP Class"},
+	ProtocolApprovalInformationModelFind:                               {Name: "Protocol Approval Information Model - FIND", Keyword: "ProtocolApprovalInformationModelFind", Type: "SOP Class"},
+	ProtocolApprovalInformationModelMove:                               {Name: "Protocol Approval Information Model - MOVE", Keyword: "ProtocolApprovalInformationModelMove", Type: "SOP Class"},
+	ProtocolApprovalInformationModelGet:                                {Name: "Protocol Approval Information Model - GET", Keyword: "ProtocolApprovalInformationModelGet", Type: "SOP Class"},
+	XADefinedProcedureProtocolStorage:                                  {Name: "XA Defined Procedure Protocol Storage", Keyword: "XADefinedProcedureProtocolStorage", Type: "SOP Class"},
+	XAPerformedProcedureProtocolStorage:                                {Name: "XA Performed Procedure Protocol Storage", Keyword: "XAPerformedProcedureProtocolStorage", Type: "SOP Class"},
+	InventoryStorage:                                                   {Name: "Inventory Storage", Keyword: "InventoryStorage", Type: "SOP Class"},
+	StorageManagementInstance:                                          {Name: "Storage Management SOP Instance", Keyword: "StorageManagementInstance", Type: "Well-known SOP Instance"},
+	InventoryFind:                                                      {Name: "Inventory - FIND", Keyword: "InventoryFind", Type: "SOP Class"},
+	InventoryMove:                                                      {Name: "Inventory - MOVE", Keyword: "InventoryMove", Type: "SOP Class"},
+	InventoryGet:                                                       {Name: "Inventory - GET", Keyword: "InventoryGet", Type: "SOP Class"},
+	InventoryCreation:                                                  {Name: "Inventory Creation", Keyword: "InventoryCreation", Type: "SOP Class"},
+	RepositoryQuery:                                                    {Name: "Repository Query", Keyword: "RepositoryQuery", Type: "SOP Class"},
+	RTImageStorage:                                                     {Name: "RT Image Storage", Keyword: "RTImageStorage", Type: "SOP Class"},
+	RTDoseStorage:                                                      {Name: "RT Dose Storage", Keyword: "RTDoseStorage", Type: "SOP Class"},
+	RTStructureSetStorage:                                              {Name: "RT Structure Set Storage", Keyword: "RTStructureSetStorage", Type: "SOP Class"},
+	RTBeamsTreatmentRecordStorage:                                      {Name: "RT Beams Treatment Record Storage", Keyword: "RTBeamsTreatmentRecordStorage", Type: "SOP Class"},
+	RTPlanStorage:                                                      {Name: "RT Plan Storage", Keyword: "RTPlanStorage", Type: "SOP Class"},
+	RTBrachyTreatmentRecordStorage:                                     {Name: "RT Brachy Treatment Record Storage", Keyword: "RTBrachyTreatmentRecordStorage", Type: "SOP Class"},
+	RTTreatmentSummaryRecordStorage:                                    {Name: "RT Treatment Summary Record Storage", Keyword: "RTTreatmentSummaryRecordStorage", Type: "SOP Class"},
+	RTIonPlanStorage:                                                   {Name: "RT Ion Plan Storage", Keyword: "RTIonPlanStorage", Type: "SOP Class"},
+	RTIonBeamsTreatmentRecordStorage:                                   {Name: "RT Ion Beams Treatment Record Storage", Keyword: "RTIonBeamsTreatmentRecordStorage", Type: "SOP Class"},
+	RTPhysicianIntentStorage:                                           {Name: "RT Physician Intent Storage", Keyword: "RTPhysicianIntentStorage", Type: "SOP Class"},
+	RTSegmentAnnotationStorage:                                         {Name: "RT Segment Annotation Storage", Keyword: "RTSegmentAnnotationStorage", Type: "SOP Class"},
+	RTRadiationSetStorage:                                              {Name: "RT Radiation Set Storage", Keyword: "RTRadiationSetStorage", Type: "SOP Class"},
+	CArmPhotonElectronRadiationStorage:                                 {Name: "C-Arm Photon-Electron Radiation Storage", Keyword: "CArmPhotonElectronRadiationStorage", Type: "SOP Class"},
+	TomotherapeuticRadiationStorage:                                    {Name: "Tomotherapeutic Radiation Storage", Keyword: "TomotherapeuticRadiationStorage", Type: "SOP Class"},
+	RoboticArmRadiationStorage:                                         {Name: "Robotic-Arm Radiation Storage", Keyword: "RoboticArmRadiationStorage", Type: "SOP Class"},
+	RTRadiationRecordSetStorage:                                        {Name: "RT Radiation Record Set Storage", Keyword: "RTRadiationRecordSetStorage", Type: "SOP Class"},
+	RTRadiationSalvageRecordStorage:                                    {Name: "RT Radiation Salvage Record Storage", Keyword: "RTRadiationSalvageRecordStorage", Type: "SOP Class"},
+	TomotherapeuticRadiationRecordStorage:                              {Name: "Tomotherapeutic Radiation Record Storage", Keyword: "TomotherapeuticRadiationRecordStorage", Type: "SOP Class"},
+	CArmPhotonElectronRadiationRecordStorage:                           {Name: "C-Arm Photon-Electron Radiation Record Storage", Keyword: "CArmPhotonElectronRadiationRecordStorage", Type: "SOP Class"},
+	RoboticRadiationRecordStorage:                                      {Name: "Robotic Radiation Record Storage", Keyword: "RoboticRadiationRecordStorage", Type: "SOP Class"},
+	RTRadiationSetDeliveryInstructionStorage:                           {Name: "RT Radiation Set Delivery Instruction Storage", Keyword: "RTRadiationSetDeliveryInstructionStorage", Type: "SOP Class"},
+	RTTreatmentPreparationStorage:                                      {Name: "RT Treatment Preparation Storage", Keyword: "RTTreatmentPreparationStorage", Type: "SOP Class"},
+	EnhancedRTImageStorage:                                             {Name: "Enhanced RT Image Storage", Keyword: "EnhancedRTImageStorage", Type: "SOP Class"},
+	EnhancedContinuousRTImageStorage:                                   {Name: "Enhanced Continuous RT Image Storage", Keyword: "EnhancedContinuousRTImageStorage", Type: "SOP Class"},
+	RTPatientPositionAcquisitionInstructionStorage:                     {Name: "RT Patient Position Acquisition Instruction Storage", Keyword: "RTPatientPositionAcquisitionInstructionStorage", Type: "SOP Class"},
+	DICOSCTImageStorage:                                                {Name: "DICOS CT Image Storage", Keyword: "DICOSCTImageStorage", Type: "SOP Class"},
+	DICOSDigitalXRayImageStorageForPresentation:                        {Name: "DICOS Digital X-Ray Image Storage - For Presentation", Keyword: "DICOSDigitalXRayImageStorageForPresentation", Type: "SOP Class"},
+	DICOSDigitalXRayImageStorageForProcessing:                          {Name: "DICOS Digital X-Ray Image Storage - For Processing", Keyword: "DICOSDigitalXRayImageStorageForProcessing", Type: "SOP Class"},
+	DICOSThreatDetectionReportStorage:                                  {Name: "DICOS Threat Detection Report Storage", Keyword: "DICOSThreatDetectionReportStorage", Type: "SOP Class"},
+	DICOS2DAITStorage:                                                  {Name: "DICOS 2D AIT Storage", Keyword: "DICOS2DAITStorage", Type: "SOP Class"},
+	DICOS3DAITStorage:                                                  {Name: "DICOS 3D AIT Storage", Keyword: "DICOS3DAITStorage", Type: "SOP Class"},
+	DICOSQuadrupoleResonanceStorage:                                    {Name: "DICOS Quadrupole Resonance (QR) Storage", Keyword: "DICOSQuadrupoleResonanceStorage", Type: "SOP Class"},
+	EddyCurrentImageStorage:                                            {Name: "Eddy Current Image Storage", Keyword: "EddyCurrentImageStorage", Type: "SOP Class"},
+	EddyCurrentMultiFrameImageStorage:                                  {Name: "Eddy Current Multi-frame Image Storage", Keyword: "EddyCurrentMultiFrameImageStorage", Type: "SOP Class"},
+	PatientRootQueryRetrieveInformationModelFind:                       {Name: "Patient Root Query/Retrieve Information Model - FIND", Keyword: "PatientRootQueryRetrieveInformationModelFind", Type: "SOP Class"},
+	PatientRootQueryRetrieveInformationModelMove:                       {Name: "Patient Root Query/Retrieve Information Model - MOVE", Keyword: "PatientRootQueryRetrieveInformationModelMove", Type: "SOP Class"},
+	PatientRootQueryRetrieveInformationModelGet:                        {Name: "Patient Root Query/Retrieve Information Model - GET", Keyword: "PatientRootQueryRetrieveInformationModelGet", Type: "SOP Class"},
+	StudyRootQueryRetrieveInformationModelFind:                         {Name: "Study Root Query/Retrieve Information Model - FIND", Keyword: "StudyRootQueryRetrieveInformationModelFind", Type: "SOP Class"},
+	StudyRootQueryRetrieveInformationModelMove:                         {Name: "Study Root Query/Retrieve Information Model - MOVE", Keyword: "StudyRootQueryRetrieveInformationModelMove", Type: "SOP Class"},
+	StudyRootQueryRetrieveInformationModelGet:                          {Name: "Study Root Query/Retrieve Information Model - GET", Keyword: "StudyRootQueryRetrieveInformationModelGet", Type: "SOP Class"},
+	PatientStudyOnlyQueryRetrieveInformationModelFind:                  {Name: "Patient/Study Only Query/Retrieve Information Model - FIND", Keyword: "PatientStudyOnlyQueryRetrieveInformationModelFind", Type: "SOP Class", Retired: true},
+	PatientStudyOnlyQueryRetrieveInformationModelMove:                  {Name: "Patient/Study Only Query/Retrieve Information Model - MOVE", Keyword: "PatientStudyOnlyQueryRetrieveInformationModelMove", Type: "SOP Class", Retired: true},
+	PatientStudyOnlyQueryRetrieveInformationModelGet:                   {Name: "Patient/Study Only Query/Retrieve Information Model - GET", Keyword: "PatientStudyOnlyQueryRetrieveInformationModelGet", Type: "SOP Class", Retired: true},
+	CompositeInstanceRootRetrieveMove:                                  {Name: "Composite Instance Root Retrieve - MOVE", Keyword: "CompositeInstanceRootRetrieveMove", Type: "SOP Class"},
+	CompositeInstanceRootRetrieveGet:                                   {Name: "Composite Instance Root Retrieve - GET", Keyword: "CompositeInstanceRootRetrieveGet", Type: "SOP Class"},
+	CompositeInstanceRetrieveWithoutBulkDataGet:                        {Name: "Composite Instance Retrieve Without Bulk Data - GET", Keyword: "CompositeInstanceRetrieveWithoutBulkDataGet", Type: "SOP Class"},
+	DefinedProcedureProtocolInformationModelFind:                       {Name: "Defined Procedure Protocol Information Model - FIND", Keyword: "DefinedProcedureProtocolInformationModelFind", Type: "SOP Class"},
+	DefinedProcedureProtocolInformationModelMove:                       {Name: "Defined Procedure Protocol Information Model - MOVE", Keyword: "DefinedProcedureProtocolInformationModelMove", Type: "SOP Class"},
+	DefinedProcedureProtocolInformationModelGet:                        {Name: "Defined Procedure Protocol Information Model - GET", Keyword: "DefinedProcedureProtocolInformationModelGet", Type: "SOP Class"},
+	ModalityWorklistInformationModelFind:                               {Name: "Modality Worklist Information Model - FIND", Keyword: "ModalityWorklistInformationModelFind", Type: "SOP Class"},
+	GeneralPurposeWorklistManagementMeta:                               {Name: "General Purpose Worklist Management Meta SOP Class", Keyword: "GeneralPurposeWorklistManagementMeta", Type: "Meta SOP Class", Retired: true},
+	GeneralPurposeWorklistInformationModelFind:                         {Name: "General Purpose Worklist Information Model - FIND", Keyword: "GeneralPurposeWorklistInformationModelFind", Type: "SOP Class", Retired: true},
+	GeneralPurposeScheduledProcedureStep:                               {Name: "General Purpose Scheduled Procedure Step SOP Class", Keyword: "GeneralPurposeScheduledProcedureStep", Type: "SOP Class", Retired: true},
+	GeneralPurposePerformedProcedureStep:                               {Name: "General Purpose Performed Procedure Step SOP Class", Keyword: "GeneralPurposePerformedProcedureStep", Type: "SOP Class", Retired: true},
+	InstanceAvailabilityNotification:                                   {Name: "Instance Availability Notification SOP Class", Keyword: "InstanceAvailabilityNotification", Type: "SOP Class"},
+	RTBeamsDeliveryInstructionStorageTrial:                             {Name: "RT Beams Delivery Instruction Storage - Trial", Keyword: "RTBeamsDeliveryInstructionStorageTrial", Type: "SOP Class", Retired: true},
+	RTConventionalMachineVerificationTrial:                             {Name: "RT Conventional Machine Verification - Trial", Keyword: "RTConventionalMachineVerificationTrial", Type: "SOP Class", Retired: true},
+	RTIonMachineVerificationTrial:                                      {Name: "RT Ion Machine Verification - Trial", Keyword: "RTIonMachineVerificationTrial", Type: "SOP Class", Retired: true},
+	UnifiedWorklistAndProcedureStepTrial:                               {Name: "Unified Worklist and Procedure Step Service Class - Trial", Keyword: "UnifiedWorklistAndProcedureStepTrial", Type: "Service Class", Retired: true},
+	UnifiedProcedureStepPushTrial:                                      {Name: "Unified Procedure Step - Push SOP Class - Trial", Keyword: "UnifiedProcedureStepPushTrial", Type: "SOP Class", Retired: true},
+	UnifiedProcedureStepWatchTrial:                                     {Name: "Unified Procedure Step - Watch SOP Class - Trial", Keyword: "UnifiedProcedureStepWatchTrial", Type: "SOP Class", Retired: true},
+	UnifiedProcedureStepPullTrial:                                      {Name: "Unified Procedure Step - Pull SOP Class - Trial", Keyword: "UnifiedProcedureStepPullTrial", Type: "SOP Class", Retired: true},
+	UnifiedProcedureStepEventTrial:                                     {Name: "Unified Procedure Step - Event SOP Class - Trial", Keyword: "UnifiedProcedureStepEventTrial", Type: "SOP Class", Retired: true},
+	UPSGlobalSubscriptionInstance:                                      {Name: "UPS Global Subscription SOP Instance", Keyword: "UPSGlobalSubscriptionInstance", Type: "Well-known SOP Instance"},
+	UPSFilteredGlobalSubscriptionInstance:                              {Name: "UPS Filtered Global Subscription SOP Instance", Keyword: "UPSFilteredGlobalSubscriptionInstance", Type: "Well-known SOP Instance"},
+	UnifiedWorklistAndProcedureStep:                                    {Name: "Unified Worklist and Procedure Step Service Class", Keyword: "UnifiedWorklistAndProcedureStep", Type: "Service Class"},
+	UnifiedProcedureStepPush:                                           {Name: "Unified Procedure Step - Push SOP Class", Keyword: "UnifiedProcedureStepPush", Type: "SOP Class"},
+	UnifiedProcedureStepWatch:                                          {Name: "Unified Procedure Step - Watch SOP Class", Keyword: "UnifiedProcedureStepWatch", Type: "SOP Class"},
+	UnifiedProcedureStepPull:                                           {Name: "Unified Procedure Step - Pull SOP Class", Keyword: "UnifiedProcedureStepPull", Type: "SOP Class"},
+	UnifiedProcedureStepEvent:                                          {Name: "Unified Procedure Step - Event SOP Class", Keyword: "UnifiedProcedureStepEvent", Type: "SOP Class"},
+	UnifiedProcedureStepQuery:                                          {Name: "Unified Procedure Step - Query SOP Class", Keyword: "UnifiedProcedureStepQuery", Type: "SOP Class"},
+	RTBeamsDeliveryInstructionStorage:                                  {Name: "RT Beams Delivery Instruction Storage", Keyword: "RTBeamsDeliveryInstructionStorage", Type: "SOP Class"},
+	RTConventionalMachineVerification:                                  {Name: "RT Conventional Machine Verification", Keyword: "RTConventionalMachineVerification", Type: "SOP Class"},
+	RTIonMachineVerification:                                           {Name: "RT Ion Machine Verification", Keyword: "RTIonMachineVerification", Type: "SOP Class"},
+	RTBrachyApplicationSetupDeliveryInstructionStorage:                 {Name: "RT Brachy Application Setup Delivery Instruction Storage", Keyword: "RTBrachyApplicationSetupDeliveryInstructionStorage", Type: "SOP Class"},
+	GeneralRelevantPatientInformationQuery:                             {Name: "General Relevant Patient Information Query", Keyword: "GeneralRelevantPatientInformationQuery", Type: "SOP Class"},
+	BreastImagingRelevantPatientInformationQuery:                       {Name: "Breast Imaging Relevant Patient Information Query", Keyword: "BreastImagingRelevantPatientInformationQuery", Type: "SOP Class"},
+	CardiacRelevantPatientInformationQuery:                             {Name: "Cardiac Relevant Patient Information Query", Keyword: "CardiacRelevantPatientInformationQuery", Type: "SOP Class"},
+	HangingProtocolStorage:                                             {Name: "Hanging Protocol Storage", Keyword: "HangingProtocolStorage", Type: "SOP Class"},
+	HangingProtocolInformationModelFind:                                {Name: "Hanging Protocol Information Model - FIND", Keyword: "HangingProtocolInformationModelFind", Type: "SOP Class"},
+	HangingProtocolInformationModelMove:                                {Name: "Hanging Protocol Information Model - MOVE", Keyword: "HangingProtocolInformationModelMove", Type: "SOP Class"},
+	HangingProtocolInformationModelGet:                                 {Name: "Hanging Protocol Information Model - GET", Keyword: "HangingProtocolInformationModelGet", Type: "SOP Class"},
+	ColorPaletteStorage:                                                {Name: "Color Palette Storage", Keyword: "ColorPaletteStorage", Type: "SOP Class"},
+	ColorPaletteQueryRetrieveInformationModelFind:                      {Name: "Color Palette Query/Retrieve Information Model - FIND", Keyword: "ColorPaletteQueryRetrieveInformationModelFind", Type: "SOP Class"},
+	ColorPaletteQueryRetrieveInformationModelMove:                      {Name: "Color Palette Query/Retrieve Information Model - MOVE", Keyword: "ColorPaletteQueryRetrieveInformationModelMove", Type: "SOP Class"},
+	ColorPaletteQueryRetrieveInformationModelGet:                       {Name: "Color Palette Query/Retrieve Information Model - GET", Keyword: "ColorPaletteQueryRetrieveInformationModelGet", Type: "SOP Class"},
+	ProductCharacteristicsQuery:                                        {Name: "Product Characteristics Query SOP Class", Keyword: "ProductCharacteristicsQuery", Type: "SOP Class"},
+	SubstanceApprovalQuery:                                             {Name: "Substance Approval Query SOP Class", Keyword: "SubstanceApprovalQuery", Type: "SOP Class"},
+	GenericImplantTemplateStorage:                                      {Name: "Generic Implant Template Storage", Keyword: "GenericImplantTemplateStorage", Type: "SOP Class"},
+	GenericImplantTemplateInformationModelFind:                         {Name: "Generic Implant Template Information Model - FIND", Keyword: "GenericImplantTemplateInformationModelFind", Type: "SOP Class"},
+	GenericImplantTemplateInformationModelMove:                         {Name: "Generic Implant Template Information Model - MOVE", Keyword: "GenericImplantTemplateInformationModelMove", Type: "SOP Class"},
+	GenericImplantTemplateInformationModelGet:                          {Name: "Generic Implant Template Information Model - GET", Keyword: "GenericImplantTemplateInformationModelGet", Type: "SOP Class"},
+	ImplantAssemblyTemplateStorage:                                     {Name: "Implant Assembly Template Storage", Keyword: "ImplantAssemblyTemplateStorage", Type: "SOP Class"},
+	ImplantAssemblyTemplateInformationModelFind:                        {Name: "Implant Assembly Template Information Model - FIND", Keyword: "ImplantAssemblyTemplateInformationModelFind", Type: "SOP Class"},
+	ImplantAssemblyTemplateInformationModelMove:                        {Name: "Implant Assembly Template Information Model - MOVE", Keyword: "ImplantAssemblyTemplateInformationModelMove", Type: "SOP Class"},
+	ImplantAssemblyTemplateInformationModelGet:                         {Name: "Implant Assembly Template Information Model - GET", Keyword: "ImplantAssemblyTemplateInformationModelGet", Type: "SOP Class"},
+	ImplantTemplateGroupStorage:                                        {Name: "Implant Template Group Storage", Keyword: "ImplantTemplateGroupStorage", Type: "SOP Class"},
+	ImplantTemplateGroupInformationModelFind:                           {Name: "Implant Template Group Information Model - FIND", Keyword: "ImplantTemplateGroupInformationModelFind", Type: "SOP Class"},
+	ImplantTemplateGroupInformationModelMove:                           {Name: "Implant Template Group Information Model - MOVE", Keyword: "ImplantTemplateGroupInformationModelMove", Type: "SOP Class"},
+	ImplantTemplateGroupInformationModelGet:                            {Name: "Implant Template Group Information Model - GET", Keyword: "ImplantTemplateGroupInformationModelGet", Type: "SOP Class"},
+	NativeDICOMModel:                                                   {Name: "Native DICOM Model", Keyword: "NativeDICOMModel", Type: "Application Hosting Model"},
+	AbstractMultiDimensionalImageModel:                                 {Name: "Abstract Multi-Dimensional Image Model", Keyword: "AbstractMultiDimensionalImageModel", Type: "Application Hosting Model"},
+	DICOMContentMappingResource:                                        {Name: "DICOM Content Mapping Resource", Keyword: "DICOMContentMappingResource", Type: "Mapping Resource"},
+	VideoEndoscopicImageRealTimeCommunication:                          {Name: "Video Endoscopic Image Real-Time Communication", Keyword: "VideoEndoscopicImageRealTimeCommunication", Type: "SOP Class"},
+	VideoPhotographicImageRealTimeCommunication:                        {Name: "Video Photographic Image Real-Time Communication", Keyword: "VideoPhotographicImageRealTimeCommunication", Type: "SOP Class"},
+	AudioWaveformRealTimeCommunication:                                 {Name: "Audio Waveform Real-Time Communication", Keyword: "AudioWaveformRealTimeCommunication", Type: "SOP Class"},
+	RenditionSelectionDocumentRealTimeCommunication:                    {Name: "Rendition Selection Document Real-Time Communication", Keyword: "RenditionSelectionDocumentRealTimeCommunication", Type: "SOP Class"},
+	"1.2.840.10008.15.0.3.1":                                           {Name: "dicomDeviceName", Keyword: "dicomDeviceName", Type: "LDAP OID"},
+	"1.2.840.10008.15.0.3.2":                                           {Name: "dicomDescription", Keyword: "dicomDescription", Type: "LDAP OID"},
+	TalairachBrainAtlas:                                                {Name: "Talairach Brain Atlas Frame of Reference", Keyword: "TalairachBrainAtlas", Type: "Well-known frame of reference"},
+	SPM2T1:                                                             {Name: "SPM2 T1 Frame of Reference", Keyword: "SPM2T1", Type: "Well-known frame of reference"},
+	SPM2T2:                                                             {Name: "SPM2 T2 Frame of Reference", Keyword: "SPM2T2", Type: "Well-known frame of reference"},
+	SPM2PD:                                                             {Name: "SPM2 PD Frame of Reference", Keyword: "SPM2PD", Type: "Well-known frame of reference"},
+	SPM2EPI:                                                            {Name: "SPM2 EPI Frame of Reference", Keyword: "SPM2EPI", Type: "Well-known frame of reference"},
+	SPM2FILT1:                                                          {Name: "SPM2 FIL T1 Frame of Reference", Keyword: "SPM2FILT1", Type: "Well-known frame of reference"},
+	SPM2PET:                                                            {Name: "SPM2 PET Frame of Reference", Keyword: "SPM2PET", Type: "Well-known frame of reference"},
+	SPM2TRANSM:                                                         {Name: "SPM2 TRANSM Frame of Reference", Keyword: "SPM2TRANSM", Type: "Well-known frame of reference"},
+	SPM2SPECT:                                                          {Name: "SPM2 SPECT Frame of Reference", Keyword: "SPM2SPECT", Type: "Well-known frame of reference"},
+	SPM2GRAY:                                                           {Name: "SPM2 GRAY Frame of Reference", Keyword: "SPM2GRAY", Type: "Well-known frame of reference"},
+	SPM2WHITE:                                                          {Name: "SPM2 WHITE Frame of Reference", Keyword: "SPM2WHITE", Type: "Well-known frame of reference"},
+	SPM2CSF:                                                            {Name: "SPM2 CSF Frame of Reference", Keyword: "SPM2CSF", Type: "Well-known frame of reference"},
+	SPM2BRAINMASK:                                                      {Name: "SPM2 BRAINMASK Frame of Reference", Keyword: "SPM2BRAINMASK", Type: "Well-known frame of reference"},
+	SPM2AVG305T1:                                                       {Name: "SPM2 AVG305T1 Frame of Reference", Keyword: "SPM2AVG305T1", Type: "Well-known frame of reference"},
+	SPM2AVG152T1:                                                       {Name: "SPM2 AVG152T1 Frame of Reference", Keyword: "SPM2AVG152T1", Type: "Well-known frame of reference"},
+	SPM2AVG152T2:                                                       {Name: "SPM2 AVG152T2 Frame of Reference", Keyword: "SPM2AVG152T2", Type: "Well-known frame of reference"},
+	SPM2AVG152PD:                                                       {Name: "SPM2 AVG152PD Frame of Reference", Keyword: "SPM2AVG152PD", Type: "Well-known frame of reference"},
+	SPM2SINGLESUBJT1:                                                   {Name: "SPM2 SINGLESUBJT1 Frame of Reference", Keyword: "SPM2SINGLESUBJT1", Type: "Well-known frame of reference"},
+	ICBM452T1:                                                          {Name: "ICBM 452 T1 Frame of Reference", Keyword: "ICBM452T1", Type: "Well-known frame of reference"},
+	ICBMSingleSubjectMRI:                                               {Name: "ICBM Single Subject MRI Frame of Reference", Keyword: "ICBMSingleSubjectMRI", Type: "Well-known frame of reference"},
+	IEC61217FixedCoordinateSystem:                                      {Name: "IEC 61217 Fixed Coordinate System Frame of Reference", Keyword: "IEC61217FixedCoordinateSystem", Type: "Well-known frame of reference"},
+	StandardRoboticArmCoordinateSystem:                                 {Name: "Standard Robotic-Arm Coordinate System Frame of Reference", Keyword: "StandardRoboticArmCoordinateSystem", Type: "Well-known frame of reference"},
+	IEC61217TableTopCoordinateSystem:                                   {Name: "IEC 61217 Table Top Coordinate System Frame of Reference", Keyword: "IEC61217TableTopCoordinateSystem", Type: "Well-known frame of reference"},
+	SRI24:                                                              {Name: "SRI24 Frame of Reference", Keyword: "SRI24", Type: "Well-known frame of reference"},
+	Colin27:                                                            {Name: "Colin27 Frame of Reference", Keyword: "Colin27", Type: "Well-known frame of reference"},
+	LPBA40AIR:                                                          {Name: "LPBA40/AIR Frame of Reference", Keyword: "LPBA40AIR", Type: "Well-known frame of reference"},
+	LPBA40FLIRT:                                                        {Name: "LPBA40/FLIRT Frame of Reference", Keyword: "LPBA40FLIRT", Type: "Well-known frame of reference"},
+	LPBA40SPM5:                                                         {Name: "LPBA40/SPM5 Frame of Reference", Keyword: "LPBA40SPM5", Type: "Well-known frame of reference"},
+}
@@ -0,0 +1,365 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom/tag"
+)
+
+// binaryWidth - Size in bytes of a single value of the binary VRs.
+var binaryWidth = map[string]int{
+	"AT": 4,
+	"FD": 8, "FL": 4,
+	"OB": 1, "OD": 8, "OF": 4, "OL": 4, "OV": 8, "OW": 2,
+	"SL": 4, "SS": 2, "SV": 8,
+	"UL": 4, "UN": 1, "US": 2, "UV": 8,
+}
+
+// singleValued - String VRs that never hold more than one value, a
+// backslash is part of the text.
+// PS3.5 Section 6.4
+var singleValued = map[string]bool{"LT": true, "ST": true, "UR": true, "UT": true}
+
+// leadingSpaces - String VRs where leading spaces are not significant.
+// PS3.5 Table 6.2-1
+var leadingSpaces = map[string]bool{
+	"AE": true, "CS": true, "DS": true, "DT": true, "IS": true, "LO": true,
+	"SH": true, "TM": true,
+}
+
+// Values returns the raw bytes of each of the element values.
+// String values are split on the backslash delimiter and binary values by
+// the size of their VR.
+// Sequences and empty elements have no values.
+func (e *Element) Values() [][]byte {
+	if len(e.Value) == 0 || e.VR == "SQ" {
+		return nil
+	}
+	if w, ok := binaryWidth[e.VR]; ok {
+		values := make([][]byte, 0, len(e.Value)/w)
+		for n := 0; n+w <= len(e.Value); n += w {
+			values = append(values, e.Value[n:n+w])
+		}
+		return values
+	}
+	if singleValued[e.VR] {
+		return [][]byte{e.Value}
+	}
+	return bytes.Split(e.Value, []byte{'\\'})
+}
+
+// Strings returns the values of an element with a string VR without their
+// padding, decoded to UTF-8 with the CharacterSet of the element.
+func (e *Element) Strings() ([]string, error) {
+	if _, ok := binaryWidth[e.VR]; ok || e.VR == "SQ" {
+		return nil, fmt.Errorf("%s: VR %s is not a string", e.Tag, e.VR)
+	}
+	strs := e.textValues()
+	for i, s := range strs {
+		s = strings.TrimRight(s, " \x00")
+		if leadingSpaces[e.VR] {
+			s = strings.TrimLeft(s, " ")
+		}
+		strs[i] = s
+	}
+	return strs, e.checkVM(len(strs))
+}
+
+// textValues splits the decoded value of a string element.
+// The whole value is decoded first, a backslash byte can be part of a
+// multi-byte character.
+func (e *Element) textValues() []string {
+	if e.CharacterSet == nil || !textVRs[e.VR] {
+		values := e.Values()
+		strs := make([]string, len(values))
+		for i, v := range values {
+			strs[i] = string(v)
+		}
+		return strs
+	}
+	s := e.CharacterSet.Decode(e.Value, e.VR)
+	if len(s) == 0 {
+		return []string{}
+	}
+	if singleValued[e.VR] {
+		return []string{s}
+	}
+	return strings.Split(s, "\\")
+}
+
+// SetStrings replaces the values of an element with a string VR, encoded
+// with the CharacterSet of the element and padded to an even length.
+func (e *Element) SetStrings(values []string) error {
+	if _, ok := binaryWidth[e.VR]; ok || e.VR == "SQ" {
+		return fmt.Errorf("%s: VR %s is not a string", e.Tag, e.VR)
+	}
+	s := strings.Join(values, "\\")
+	b := []byte(s)
+	if textVRs[e.VR] {
+		var err error
+		b, err = e.CharacterSet.Encode(s, e.VR)
+		if err != nil {
+			return fmt.Errorf("%s: %s", e.Tag, err)
+		}
+	}
+	if len(b)%2 == 1 {
+		b = append(b, padding(e.VR))
+	}
+	e.Value = b
+	e.Len = uint32(len(b))
+	e.Lazy = false
+	return nil
+}
+
+// Ints returns the values of an IS, SS, US, SL, UL, SV or UV element and
+// the words of OW, OL and OV elements.
+// UV and OV values above math.MaxInt64 wrap around.
+func (e *Element) Ints() ([]int64, error) {
+	if e.VR == "IS" {
+		// A VM mismatch is returned with the values, as for binary VRs.
+		strs, vmErr := e.Strings()
+		ints := make([]int64, len(strs))
+		for i, s := range strs {
+			var err error
+			ints[i], err = strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid IS value '%s'", e.Tag, s)
+			}
+		}
+		return ints, vmErr
+	}
+	bo := e.ByteOrder()
+	values := e.Values()
+	ints := make([]int64, len(values))
+	for i, v := range values {
+		switch e.VR {
+		case "SS":
+			ints[i] = int64(int16(bo.Uint16(v)))
+		case "US", "OW":
+			ints[i] = int64(bo.Uint16(v))
+		case "SL":
+			ints[i] = int64(int32(bo.Uint32(v)))
+		case "UL", "OL":
+			ints[i] = int64(bo.Uint32(v))
+		case "SV", "UV", "OV":
+			ints[i] = int64(bo.Uint64(v))
+		default:
+			return nil, fmt.Errorf("%s: VR %s is not an integer", e.Tag, e.VR)
+		}
+	}
+	return ints, e.checkVM(len(ints))
+}
+
+// Floats returns the values of a DS, FL or FD element and the words of OF
+// and OD elements.
+func (e *Element) Floats() ([]float64, error) {
+	if e.VR == "DS" {
+		// A VM mismatch is returned with the values, as for binary VRs.
+		strs, vmErr := e.Strings()
+		floats := make([]float64, len(strs))
+		for i, s := range strs {
+			var err error
+			floats[i], err = strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid DS value '%s'", e.Tag, s)
+			}
+		}
+		return floats, vmErr
+	}
+	bo := e.ByteOrder()
+	values := e.Values()
+	floats := make([]float64, len(values))
+	for i, v := range values {
+		switch e.VR {
+		case "FL", "OF":
+			floats[i] = float64(math.Float32frombits(bo.Uint32(v)))
+		case "FD", "OD":
+			floats[i] = math.Float64frombits(bo.Uint64(v))
+		default:
+			return nil, fmt.Errorf("%s: VR %s is not a floating point number", e.Tag, e.VR)
+		}
+	}
+	return floats, e.checkVM(len(floats))
+}
+
+// Tags returns the values of an AT element.
+func (e *Element) Tags() ([]Tag, error) {
+	if e.VR != "AT" {
+		return nil, fmt.Errorf("%s: VR %s is not an attribute tag", e.Tag, e.VR)
+	}
+	bo := e.ByteOrder()
+	values := e.Values()
+	tags := make([]Tag, len(values))
+	for i, v := range values {
+		tags[i] = Tag{Group: bo.Uint16(v[0:2]), Element: bo.Uint16(v[2:4])}
+	}
+	return tags, e.checkVM(len(tags))
+}
+
+// ValueString returns the element values decoded according to their VR and
+// separated by a backslash.
+// OB and UN values are shown as decimal bytes.
+func (e *Element) ValueString() string {
+	values := []string{}
+	switch e.VR {
+	case "SQ":
+	case "AT":
+		tags, _ := e.Tags()
+		for _, t := range tags {
+			values = append(values, t.String())
+		}
+	case "FL", "OF":
+		floats, _ := e.Floats()
+		for _, f := range floats {
+			values = append(values, strconv.FormatFloat(f, 'g', -1, 32))
+		}
+	case "FD", "OD":
+		floats, _ := e.Floats()
+		for _, f := range floats {
+			values = append(values, strconv.FormatFloat(f, 'g', -1, 64))
+		}
+	case "UV", "OV":
+		ints, _ := e.Ints()
+		for _, i := range ints {
+			values = append(values, strconv.FormatUint(uint64(i), 10))
+		}
+	case "SS", "US", "SL", "UL", "SV", "OW", "OL":
+		ints, _ := e.Ints()
+		for _, i := range ints {
+			values = append(values, strconv.FormatInt(i, 10))
+		}
+	case "OB", "UN":
+		for _, b := range e.Value {
+			values = append(values, strconv.Itoa(int(b)))
+		}
+	default:
+		values, _ = e.Strings()
+	}
+	return strings.Join(values, "\\")
+}
+
+// SetValueString replaces the values of an element with s, backslash
+// separated values in the form returned by ValueString.
+// Binary values are encoded in the byte order of the element.
+func (e *Element) SetValueString(s string) error {
+	w, ok := binaryWidth[e.VR]
+	if !ok {
+		if singleValued[e.VR] {
+			return e.SetStrings([]string{s})
+		}
+		return e.SetStrings(strings.Split(s, "\\"))
+	}
+	values := []string{}
+	if s != "" {
+		values = strings.Split(s, "\\")
+	}
+	bo := e.ByteOrder()
+	b := make([]byte, len(values)*w)
+	for i, v := range values {
+		var err error
+		var n uint64
+		x := b[i*w : (i+1)*w]
+		switch e.VR {
+		case "AT":
+			t, err := ParseTag(v)
+			if err != nil {
+				return fmt.Errorf("%s: invalid %s value '%s'", e.Tag, e.VR, v)
+			}
+			bo.PutUint16(x[0:2], t.Group)
+			bo.PutUint16(x[2:4], t.Element)
+			continue
+		case "FL", "OF":
+			var f float64
+			f, err = strconv.ParseFloat(v, 32)
+			n = uint64(math.Float32bits(float32(f)))
+		case "FD", "OD":
+			var f float64
+			f, err = strconv.ParseFloat(v, 64)
+			n = math.Float64bits(f)
+		case "SS", "SL", "SV":
+			var i int64
+			i, err = strconv.ParseInt(v, 10, w*8)
+			n = uint64(i)
+		default:
+			n, err = strconv.ParseUint(v, 10, w*8)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: invalid %s value '%s'", e.Tag, e.VR, v)
+		}
+		switch w {
+		case 1:
+			x[0] = byte(n)
+		case 2:
+			bo.PutUint16(x, uint16(n))
+		case 4:
+			bo.PutUint32(x, uint32(n))
+		case 8:
+			bo.PutUint64(x, n)
+		}
+	}
+	e.Value = b
+	e.Len = uint32(len(b))
+	e.Lazy = false
+	e.Fragments, e.OffsetTable = nil, nil
+	return nil
+}
+
+// checkVM validates the number of values against the VM of the element in
+// the data dictionary. Empty values and elements missing from the dictionary
+// are not checked.
+func (e *Element) checkVM(n int) error {
+	if n == 0 {
+		return nil
+	}
+	var entry tag.Entry
+	if e.Tag.IsPrivate() {
+		entry, _ = tag.LookupPrivate(e.Creator, e.Tag.Group, e.Tag.Element)
+	} else {
+		entry, _, _ = tag.Lookup(e.Tag.Group, e.Tag.Element)
+	}
+	if entry.VM == "" || matchVM(entry.VM, n) {
+		return nil
+	}
+	return fmt.Errorf("%s: %d values don't match VM %s", e.Tag, n, entry.VM)
+}
+
+// matchVM reports whether n values are allowed by vm, written as in PS3.6:
+// "1", "1-3", "1-n" or "2-2n".
+func matchVM(vm string, n int) bool {
+	parts := strings.SplitN(vm, "-", 2)
+	min, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return true
+	}
+	if len(parts) == 1 {
+		return n == min
+	}
+	if n < min {
+		return false
+	}
+	max := parts[1]
+	if strings.HasSuffix(max, "n") {
+		step, err := strconv.Atoi(strings.TrimSuffix(max, "n"))
+		if err != nil {
+			step = 1
+		}
+		return n%step == 0
+	}
+	m, err := strconv.Atoi(max)
+	if err != nil {
+		return true
+	}
+	return n <= m
+}
@@ -0,0 +1,190 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func TestStrings(t *testing.T) {
+	e := &Element{Tag: Tag{0x0008, 0x0008}, VR: "CS", Value: []byte("ORIGINAL\\ PRIMARY\\AXIAL ")}
+	strs, err := e.Strings()
+	if err != nil || !reflect.DeepEqual(strs, []string{"ORIGINAL", "PRIMARY", "AXIAL"}) {
+		t.Errorf("Fail: %q, %v", strs, err)
+	}
+	e = &Element{Tag: Tag{0x0008, 0x0016}, VR: "UI", Value: []byte("1.2.840.10008.5.1.4.1.1.2\x00")}
+	strs, err = e.Strings()
+	if err != nil || !reflect.DeepEqual(strs, []string{"1.2.840.10008.5.1.4.1.1.2"}) {
+		t.Errorf("Fail: %q, %v", strs, err)
+	}
+	// Backslash is not a delimiter in text VRs.
+	e = &Element{Tag: Tag{0x0020, 0x4000}, VR: "LT", Value: []byte(" C:\\images ")}
+	strs, err = e.Strings()
+	if err != nil || !reflect.DeepEqual(strs, []string{" C:\\images"}) {
+		t.Errorf("Fail: %q, %v", strs, err)
+	}
+	// Patient ID has VM 1.
+	e = &Element{Tag: Tag{0x0010, 0x0020}, VR: "LO", Value: []byte("123\\456 ")}
+	strs, err = e.Strings()
+	if err == nil || err.Error() != "(0010,0020): 2 values don't match VM 1" {
+		t.Errorf("Fail: %q, %v", strs, err)
+	}
+	e = &Element{Tag: Tag{0x0028, 0x0010}, VR: "US", Value: []byte{0, 2}}
+	_, err = e.Strings()
+	if err == nil {
+		t.Errorf("Fail: US read as string")
+	}
+}
+
+func TestInts(t *testing.T) {
+	cases := []struct {
+		e        *Element
+		expected []int64
+	}{
+		{&Element{Tag: Tag{0x0020, 0x0013}, VR: "IS", Value: []byte(" 12 ")}, []int64{12}},
+		{&Element{Tag: Tag{0x0028, 0x0010}, VR: "US", Value: []byte{0, 2}}, []int64{512}},
+		{&Element{Tag: Tag{0x0028, 0x0010}, VR: "US", Value: []byte{2, 0}, byteOrder: binary.BigEndian}, []int64{512}},
+		{&Element{Tag: Tag{0x0028, 0x0106}, VR: "SS", Value: []byte{0x00, 0xFC}}, []int64{-1024}},
+		{&Element{Tag: Tag{0x0018, 0x6020}, VR: "SL", Value: []byte{0xFF, 0xFF, 0xFF, 0xFF}}, []int64{-1}},
+		{&Element{Tag: Tag{0x0028, 0x1101}, VR: "US", Value: []byte{0, 1, 0, 0, 16, 0}}, []int64{256, 0, 16}},
+	}
+	for _, c := range cases {
+		ints, err := c.e.Ints()
+		if err != nil || !reflect.DeepEqual(ints, c.expected) {
+			t.Errorf("Fail: %v, %v", ints, err)
+		}
+	}
+	// The values are returned with a VM mismatch.
+	e := &Element{Tag: Tag{0x0028, 0x1101}, VR: "US", Value: []byte{0, 1, 0, 0}}
+	ints, err := e.Ints()
+	if err == nil || err.Error() != "(0028,1101): 2 values don't match VM 3" || !reflect.DeepEqual(ints, []int64{256, 0}) {
+		t.Errorf("Fail: %v, %v", ints, err)
+	}
+	e = &Element{Tag: Tag{0x0020, 0x0013}, VR: "IS", Value: []byte("1\\2 ")}
+	ints, err = e.Ints()
+	if err == nil || err.Error() != "(0020,0013): 2 values don't match VM 1" || !reflect.DeepEqual(ints, []int64{1, 2}) {
+		t.Errorf("Fail: %v, %v", ints, err)
+	}
+}
+
+func TestFloats(t *testing.T) {
+	cases := []struct {
+		e        *Element
+		expected []float64
+	}{
+		{&Element{Tag: Tag{0x0028, 0x0030}, VR: "DS", Value: []byte("0.5\\0.25")}, []float64{0.5, 0.25}},
+		{&Element{Tag: Tag{0x0018, 0x9087}, VR: "FD", Value: []byte{0, 0, 0, 0, 0, 0, 0xF0, 0x3F}}, []float64{1}},
+		{&Element{Tag: Tag{0x0018, 0x9089}, VR: "FD", Value: []byte{0, 0, 0, 0, 0, 0, 0xE0, 0x3F, 0, 0, 0, 0, 0, 0, 0xF0, 0xBF, 0, 0, 0, 0, 0, 0, 0, 0}}, []float64{0.5, -1, 0}},
+		{&Element{Tag: Tag{0x0010, 0x9431}, VR: "FL", Value: []byte{0, 0, 0x20, 0x41}}, []float64{10}},
+	}
+	for _, c := range cases {
+		floats, err := c.e.Floats()
+		if err != nil || !reflect.DeepEqual(floats, c.expected) {
+			t.Errorf("Fail: %v, %v", floats, err)
+		}
+	}
+	// Pixel Spacing has VM 2.
+	e := &Element{Tag: Tag{0x0028, 0x0030}, VR: "DS", Value: []byte("0.5 ")}
+	floats, err := e.Floats()
+	if err == nil || err.Error() != "(0028,0030): 1 values don't match VM 2" || !reflect.DeepEqual(floats, []float64{0.5}) {
+		t.Errorf("Fail: %v, %v", floats, err)
+	}
+}
+
+func TestTags(t *testing.T) {
+	e := &Element{Tag: Tag{0x0028, 0x0009}, VR: "AT", Value: []byte{0x54, 0x00, 0x10, 0x00, 0x54, 0x00, 0x20, 0x00}}
+	tags, err := e.Tags()
+	if err != nil || !reflect.DeepEqual(tags, []Tag{{0x0054, 0x0010}, {0x0054, 0x0020}}) {
+		t.Errorf("Fail: %v, %v", tags, err)
+	}
+}
+
+func TestMatchVM(t *testing.T) {
+	cases := []struct {
+		vm       string
+		n        int
+		expected bool
+	}{
+		{"1", 1, true},
+		{"1", 2, false},
+		{"1-3", 3, true},
+		{"1-3", 4, false},
+		{"1-n", 20, true},
+		{"2-n", 1, false},
+		{"2-2n", 4, true},
+		{"2-2n", 3, false},
+		{"3-3n", 6, true},
+	}
+	for _, c := range cases {
+		if matchVM(c.vm, c.n) != c.expected {
+			t.Errorf("Fail: %s %d", c.vm, c.n)
+		}
+	}
+}
+
+func TestValueString(t *testing.T) {
+	cases := []struct {
+		e        *Element
+		expected string
+	}{
+		{&Element{Tag: Tag{0x0008, 0x0008}, VR: "CS", Value: []byte("ORIGINAL\\PRIMARY ")}, "ORIGINAL\\PRIMARY"},
+		{&Element{Tag: Tag{0x0028, 0x0106}, VR: "SS", Value: []byte{0x00, 0xFC}}, "-1024"},
+		{&Element{Tag: Tag{0x0028, 0x0106}, VR: "SS", Value: []byte{0xFC, 0x00}, byteOrder: binary.BigEndian}, "-1024"},
+		{&Element{Tag: Tag{0x0018, 0x6020}, VR: "SL", Value: []byte{0xFE, 0xFF, 0xFF, 0xFF}}, "-2"},
+		{&Element{Tag: Tag{0x0028, 0x1101}, VR: "US", Value: []byte{0, 1, 0, 0, 16, 0}}, "256\\0\\16"},
+		{&Element{Tag: Tag{0x0010, 0x9431}, VR: "FL", Value: []byte{0xCD, 0xCC, 0xCC, 0x3D}}, "0.1"},
+		{&Element{Tag: Tag{0x0018, 0x9089}, VR: "FD", Value: []byte{0, 0, 0, 0, 0, 0, 0xE0, 0x3F, 0, 0, 0, 0, 0, 0, 0xF0, 0xBF, 0, 0, 0, 0, 0, 0, 0, 0}}, "0.5\\-1\\0"},
+		{&Element{Tag: Tag{0x0028, 0x0009}, VR: "AT", Value: []byte{0x54, 0x00, 0x10, 0x00}}, "(0054,0010)"},
+		{&Element{Tag: Tag{0x0028, 0x0009}, VR: "AT", Value: []byte{0x00, 0x54, 0x00, 0x10}, byteOrder: binary.BigEndian}, "(0054,0010)"},
+		{&Element{Tag: Tag{0x0064, 0x0009}, VR: "OF", Value: []byte{0, 0, 0xC0, 0x3F, 0, 0, 0x80, 0xBF}}, "1.5\\-1"},
+		{&Element{Tag: Tag{0x0066, 0x0040}, VR: "OL", Value: []byte{1, 0, 0, 0, 0, 0, 1, 0}}, "1\\65536"},
+		{&Element{Tag: Tag{0x0018, 0x1086}, VR: "UV", Value: []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}}, "18446744073709551615"},
+	}
+	for _, c := range cases {
+		s := c.e.ValueString()
+		if s != c.expected {
+			t.Errorf("Fail: %s %s: %q", c.e.Tag, c.e.VR, s)
+		}
+	}
+}
+
+func TestSetValueString(t *testing.T) {
+	cases := []struct {
+		e        *Element
+		s        string
+		expected []byte
+	}{
+		{&Element{Tag: Tag{0x0010, 0x0020}, VR: "LO"}, "123", []byte("123 ")},
+		{&Element{Tag: Tag{0x0028, 0x0010}, VR: "US"}, "512", []byte{0, 2}},
+		{&Element{Tag: Tag{0x0028, 0x0010}, VR: "US", byteOrder: binary.BigEndian}, "512", []byte{2, 0}},
+		{&Element{Tag: Tag{0x0028, 0x0106}, VR: "SS"}, "-1024", []byte{0x00, 0xFC}},
+		{&Element{Tag: Tag{0x0028, 0x1101}, VR: "US"}, "256\\0\\16", []byte{0, 1, 0, 0, 16, 0}},
+		{&Element{Tag: Tag{0x0018, 0x9089}, VR: "FD"}, "1", []byte{0, 0, 0, 0, 0, 0, 0xF0, 0x3F}},
+		{&Element{Tag: Tag{0x0020, 0x9165}, VR: "AT"}, "(0028,0010)", []byte{0x28, 0, 0x10, 0}},
+		{&Element{Tag: Tag{0x0028, 0x0010}, VR: "US"}, "", []byte{}},
+	}
+	for _, c := range cases {
+		err := c.e.SetValueString(c.s)
+		if err != nil || !reflect.DeepEqual(c.e.Value, c.expected) {
+			t.Errorf("Fail: %s %v, %v", c.s, c.e.Value, err)
+		}
+	}
+	e := &Element{Tag: Tag{0x0028, 0x0010}, VR: "US"}
+	err := e.SetValueString("70000")
+	if err == nil || err.Error() != "(0028,0010): invalid US value '70000'" {
+		t.Errorf("Fail: %v", err)
+	}
+	e = &Element{Tag: Tag{0x0020, 0x5000}, VR: "AT", Value: []byte{0x28, 0, 0x10, 0}}
+	err = e.SetValueString("(0028,0010)\\garbage")
+	if err == nil || err.Error() != "(0020,5000): invalid AT value 'garbage'" || !reflect.DeepEqual(e.Value, []byte{0x28, 0, 0x10, 0}) {
+		t.Errorf("Fail: %v, %v", err, e.Value)
+	}
+}
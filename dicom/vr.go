@@ -0,0 +1,30 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+// vrs lists the Value Representations from PS3.5 Table 6.2-1.
+var vrs = map[string]bool{
+	"AE": true, "AS": true, "AT": true, "CS": true, "DA": true, "DS": true,
+	"DT": true, "FD": true, "FL": true, "IS": true, "LO": true, "LT": true,
+	"OB": true, "OD": true, "OF": true, "OL": true, "OV": true, "OW": true,
+	"PN": true, "SH": true, "SL": true, "SQ": true, "SS": true, "ST": true,
+	"SV": true, "TM": true, "UC": true, "UI": true, "UL": true, "UN": true,
+	"UR": true, "US": true, "UT": true, "UV": true,
+}
+
+// hasLongLength reports whether the VR is encoded with two reserved bytes
+// and a 32 bit length in explicit VR transfer syntaxes.
+// PS3.5 Section 7.1.2
+func hasLongLength(vr string) bool {
+	switch vr {
+	case "OB", "OD", "OF", "OL", "OV", "OW", "SQ", "SV", "UC", "UN", "UR", "UT", "UV":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,309 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ImplementationClassUID identifies go-dicom in the File Meta Information and
+// in association negotiation.
+const ImplementationClassUID = "1.2.40.0.13.1.1"
+
+// ImplementationVersion is the Implementation Version Name of go-dicom.
+const ImplementationVersion = "go-dicom-0.1.0"
+
+// File Meta Information elements generated by Write.
+var (
+	FileMetaInformationGroupLengthTag = Tag{0x0002, 0x0000}
+	FileMetaInformationVersionTag     = Tag{0x0002, 0x0001}
+	MediaStorageSOPClassUIDTag        = Tag{0x0002, 0x0002}
+	MediaStorageSOPInstanceUIDTag     = Tag{0x0002, 0x0003}
+	ImplementationClassUIDTag         = Tag{0x0002, 0x0012}
+	ImplementationVersionNameTag      = Tag{0x0002, 0x0013}
+	SOPClassUIDTag                    = Tag{0x0008, 0x0016}
+	SOPInstanceUIDTag                 = Tag{0x0008, 0x0018}
+)
+
+// WriteOptions - Controls how Write encodes a dataset.
+type WriteOptions struct {
+	// TransferSyntax of the written dataset. The zero value keeps the
+	// transfer syntax of the dataset, see Dataset.TransferSyntax.
+	TransferSyntax TransferSyntax
+}
+
+// Write encodes ds as a DICOM Part 10 file: the File Preamble, the "DICM"
+// prefix, the File Meta Information and the rest of the dataset in the
+// chosen transfer syntax.
+//
+// The File Meta Information is generated from the one in ds, the SOP Class
+// and Instance UIDs of the dataset and the go-dicom implementation UID and
+// version. Group length elements other than (0002,0000) are dropped,
+// sequences and items are written with undefined length and odd values are
+// padded to an even length.
+// PS3.10 Section 7.1
+func Write(w io.Writer, ds *Dataset, opts WriteOptions) error {
+	ts := opts.TransferSyntax
+	if ts.UID == "" {
+		ts = ds.TransferSyntax()
+	}
+	body := &Dataset{}
+	for _, e := range ds.Elements {
+		if e.Tag.Group != 0x0002 {
+			body.Elements = append(body.Elements, e)
+		}
+	}
+	meta, err := fileMetaInformation(ds, ts)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	bw.Write(make([]byte, preambleLen))
+	bw.WriteString("DICM")
+	enc := &encoder{w: bw, ts: ExplicitVRLittleEndian}
+	if err := enc.writeDataset(meta); err != nil {
+		return err
+	}
+	if err := WriteDataset(bw, body, ts); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// WriteDataset encodes the elements of ds in the transfer syntax ts, without
+// File Preamble or File Meta Information, as sent in P-DATA-TF PDUs.
+// With Deflated Explicit VR Little Endian the encoded dataset is compressed
+// with raw deflate.
+// PS3.5 Section A.5
+func WriteDataset(w io.Writer, ds *Dataset, ts TransferSyntax) error {
+	var fw *flate.Writer
+	if ts.Deflated {
+		var err error
+		fw, err = flate.NewWriter(w, flate.DefaultCompression)
+		if err != nil {
+			return err
+		}
+		w = fw
+	}
+	enc := &encoder{w: bufio.NewWriter(w), ts: ts}
+	if err := enc.writeDataset(ds); err != nil {
+		return err
+	}
+	if err := enc.w.Flush(); err != nil {
+		return err
+	}
+	if fw != nil {
+		return fw.Close()
+	}
+	return nil
+}
+
+// fileMetaInformation returns the File Meta Information for ds written in
+// the given transfer syntax, with its group length.
+func fileMetaInformation(ds *Dataset, ts TransferSyntax) (*Dataset, error) {
+	meta := &Dataset{}
+	for _, e := range ds.Elements {
+		switch e.Tag {
+		case FileMetaInformationGroupLengthTag, FileMetaInformationVersionTag, TransferSyntaxUIDTag,
+			ImplementationClassUIDTag, ImplementationVersionNameTag:
+		default:
+			if e.Tag.Group == 0x0002 {
+				meta.Elements = append(meta.Elements, e)
+			}
+		}
+	}
+	// The SOP Class and Instance UIDs of the dataset take precedence over the
+	// ones in the File Meta Information.
+	for _, uids := range [][2]Tag{{MediaStorageSOPClassUIDTag, SOPClassUIDTag}, {MediaStorageSOPInstanceUIDTag, SOPInstanceUIDTag}} {
+		if e, ok := ds.FindElement(uids[1]); ok {
+			meta.set(&Element{Tag: uids[0], VR: "UI", Value: e.Value})
+		}
+		if _, ok := meta.FindElement(uids[0]); !ok {
+			return nil, fmt.Errorf("missing %s, can't fill %s", uids[1], uids[0])
+		}
+	}
+	meta.set(&Element{Tag: FileMetaInformationVersionTag, VR: "OB", Value: []byte{0x00, 0x01}})
+	meta.set(&Element{Tag: TransferSyntaxUIDTag, VR: "UI", Value: []byte(ts.UID)})
+	meta.set(&Element{Tag: ImplementationClassUIDTag, VR: "UI", Value: []byte(ImplementationClassUID)})
+	meta.set(&Element{Tag: ImplementationVersionNameTag, VR: "SH", Value: []byte(ImplementationVersion)})
+	var length uint32
+	for _, e := range meta.Elements {
+		l := 8 + len(e.Value) + len(e.Value)%2
+		if hasLongLength(e.VR) {
+			l += 4
+		}
+		length += uint32(l)
+	}
+	value := make([]byte, 4)
+	binary.LittleEndian.PutUint32(value, length)
+	meta.set(&Element{Tag: FileMetaInformationGroupLengthTag, VR: "UL", Value: value})
+	return meta, nil
+}
+
+// set replaces the element with the tag of e, or adds it keeping the
+// elements sorted.
+func (ds *Dataset) set(e *Element) {
+	for i, f := range ds.Elements {
+		if f.Tag == e.Tag {
+			ds.Elements[i] = e
+			return
+		}
+	}
+	ds.Elements = append(ds.Elements, e)
+	sort.SliceStable(ds.Elements, func(i, j int) bool { return ds.Elements[i].Tag.less(ds.Elements[j].Tag) })
+}
+
+// encoder writes data elements in a transfer syntax.
+// Write errors are kept by the bufio.Writer and returned by Flush.
+type encoder struct {
+	w  *bufio.Writer
+	ts TransferSyntax
+}
+
+// writeDataset writes the elements of ds sorted by tag, leaving out group
+// lengths other than the File Meta Information one.
+func (enc *encoder) writeDataset(ds *Dataset) error {
+	elements := []*Element{}
+	for _, e := range ds.Elements {
+		if e.Tag.Element != 0x0000 || e.Tag == FileMetaInformationGroupLengthTag {
+			elements = append(elements, e)
+		}
+	}
+	sort.SliceStable(elements, func(i, j int) bool { return elements[i].Tag.less(elements[j].Tag) })
+	for _, e := range elements {
+		if err := enc.writeElement(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (enc *encoder) writeElement(e *Element) error {
+	if err := e.ReadValue(); err != nil {
+		return err
+	}
+	if enc.ts.Explicit && !vrs[e.VR] {
+		return fmt.Errorf("%s: unknown VR '%s'", e.Tag, e.VR)
+	}
+	switch {
+	case e.VR == "SQ":
+		enc.writeHeader(e.Tag, e.VR, UndefinedLength)
+		for _, item := range e.Items {
+			enc.writeItemHeader(ItemTag, UndefinedLength)
+			if err := enc.writeDataset(item); err != nil {
+				return err
+			}
+			enc.writeItemHeader(ItemDelimitationItemTag, 0)
+		}
+		enc.writeItemHeader(SequenceDelimitationItemTag, 0)
+	case e.Fragments != nil || e.Len == UndefinedLength:
+		if isNative(enc.ts) {
+			return fmt.Errorf("%s: encapsulated pixel data can't be written in %s", e.Tag, enc.ts.UID)
+		}
+		enc.writeHeader(e.Tag, e.VR, UndefinedLength)
+		enc.writeItemHeader(ItemTag, uint32(4*len(e.OffsetTable)))
+		for _, o := range e.OffsetTable {
+			binary.Write(enc.w, enc.ts.ByteOrder, o)
+		}
+		for _, f := range e.Fragments {
+			enc.writeItemHeader(ItemTag, uint32(len(f)+len(f)%2))
+			enc.w.Write(f)
+			if len(f)%2 == 1 {
+				enc.w.WriteByte(0)
+			}
+		}
+		enc.writeItemHeader(SequenceDelimitationItemTag, 0)
+	default:
+		if e.Tag == PixelDataTag && !isNative(enc.ts) {
+			return fmt.Errorf("%s: native pixel data can't be written in %s", e.Tag, enc.ts.UID)
+		}
+		value := swapValue(e, enc.ts.ByteOrder)
+		if len(value)%2 == 1 {
+			value = append(value[:len(value):len(value)], padding(e.VR))
+		}
+		if enc.ts.Explicit && !hasLongLength(e.VR) && len(value) > 0xFFFF {
+			return fmt.Errorf("%s: value length %d too long for VR %s", e.Tag, len(value), e.VR)
+		}
+		enc.writeHeader(e.Tag, e.VR, uint32(len(value)))
+		enc.w.Write(value)
+	}
+	return nil
+}
+
+// writeHeader writes the tag, VR and length of an element.
+func (enc *encoder) writeHeader(t Tag, vr string, l uint32) {
+	bo := enc.ts.ByteOrder
+	b := make([]byte, 4, 12)
+	bo.PutUint16(b[0:2], t.Group)
+	bo.PutUint16(b[2:4], t.Element)
+	switch {
+	case !enc.ts.Explicit:
+		b = append(b, 0, 0, 0, 0)
+		bo.PutUint32(b[4:], l)
+	case hasLongLength(vr):
+		b = append(b, vr[0], vr[1], 0, 0, 0, 0, 0, 0)
+		bo.PutUint32(b[8:], l)
+	default:
+		b = append(b, vr[0], vr[1], 0, 0)
+		bo.PutUint16(b[6:], uint16(l))
+	}
+	enc.w.Write(b)
+}
+
+// writeItemHeader writes the tag and length of an item or delimiter.
+func (enc *encoder) writeItemHeader(t Tag, l uint32) {
+	bo := enc.ts.ByteOrder
+	b := make([]byte, 8)
+	bo.PutUint16(b[0:2], t.Group)
+	bo.PutUint16(b[2:4], t.Element)
+	bo.PutUint32(b[4:], l)
+	enc.w.Write(b)
+}
+
+// isNative reports whether ts is one of the uncompressed transfer syntaxes.
+func isNative(ts TransferSyntax) bool {
+	switch ts.UID {
+	case ImplicitVRLittleEndian.UID, ExplicitVRLittleEndian.UID, DeflatedExplicitVRLittleEndian.UID, ExplicitVRBigEndian.UID:
+		return true
+	}
+	return false
+}
+
+// padding returns the byte used to pad values of the VR to an even length.
+// PS3.5 Section 6.2
+func padding(vr string) byte {
+	if _, ok := binaryWidth[vr]; ok || vr == "UI" {
+		return 0x00
+	}
+	return ' '
+}
+
+// swapValue returns the value of e in the byte order bo.
+func swapValue(e *Element, bo binary.ByteOrder) []byte {
+	w := binaryWidth[e.VR]
+	if e.ByteOrder() == bo || w < 2 {
+		return e.Value
+	}
+	// Attribute tags are a pair of 16 bit values.
+	if e.VR == "AT" {
+		w = 2
+	}
+	value := make([]byte, len(e.Value))
+	for n := 0; n+w <= len(value); n += w {
+		for i := 0; i < w; i++ {
+			value[n+i] = e.Value[n+w-1-i]
+		}
+	}
+	return value
+}
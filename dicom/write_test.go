@@ -0,0 +1,128 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	sq := implicitLE(Tag{0x0008, 0x1140}, nil)
+	binary.LittleEndian.PutUint32(sq[4:], UndefinedLength)
+	sq = append(sq, header(ItemTag, UndefinedLength)...)
+	sq = append(sq, implicitLE(Tag{0x0008, 0x1155}, []byte("1.2.3\x00"))...)
+	sq = append(sq, header(ItemDelimitationItemTag, 0)...)
+	sq = append(sq, header(SequenceDelimitationItemTag, 0)...)
+	b := part10(
+		explicitLE(TransferSyntaxUIDTag, "UI", []byte("1.2.840.10008.1.2\x00")),
+		implicitLE(Tag{0x0008, 0x0000}, []byte{0, 0, 0, 0}),
+		implicitLE(SOPClassUIDTag, []byte("1.2.840.10008.5.1.4.1.1.2\x00")),
+		implicitLE(SOPInstanceUIDTag, []byte("1.2.3.4")),
+		sq,
+		implicitLE(Tag{0x0010, 0x0010}, []byte("DOE^JOHN")),
+		implicitLE(Tag{0x0028, 0x0010}, []byte{0x00, 0x02}),
+	)
+	// The odd length SOP Instance UID is padded on write.
+	ds, err := NewDecoder(bytes.NewReader(b), Options{Lenient: true}).Decode()
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	var out bytes.Buffer
+	if err := Write(&out, ds, WriteOptions{TransferSyntax: ExplicitVRBigEndian}); err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	d := NewDecoder(bytes.NewReader(out.Bytes()), Options{})
+	got, err := d.Decode()
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	if d.TransferSyntax() != ExplicitVRBigEndian {
+		t.Errorf("Fail: %v", d.TransferSyntax())
+	}
+	strs := func(t Tag) []string {
+		e, _ := got.FindElement(t)
+		s, _ := e.Strings()
+		return s
+	}
+	if s := strs(MediaStorageSOPInstanceUIDTag); !reflect.DeepEqual(s, []string{"1.2.3.4"}) {
+		t.Errorf("Fail: %v", s)
+	}
+	if s := strs(ImplementationClassUIDTag); !reflect.DeepEqual(s, []string{ImplementationClassUID}) {
+		t.Errorf("Fail: %v", s)
+	}
+	if _, ok := got.FindElement(Tag{0x0008, 0x0000}); ok {
+		t.Errorf("Fail: group length written")
+	}
+	gl, _ := got.FindElement(FileMetaInformationGroupLengthTag)
+	var metaEnd int64
+	for _, e := range got.Elements {
+		if e.Tag.Group == 0x0002 {
+			metaEnd = e.ValueOffset + int64(len(e.Value))
+		}
+	}
+	if l, _ := gl.Ints(); l[0] != metaEnd-(gl.ValueOffset+4) {
+		t.Errorf("Fail: group length %d, meta ends at %d", l[0], metaEnd)
+	}
+	if e, _ := got.FindElement(SOPInstanceUIDTag); !reflect.DeepEqual(e.Value, []byte("1.2.3.4\x00")) {
+		t.Errorf("Fail: %q", e.Value)
+	}
+	if e, _ := got.FindElement(Tag{0x0028, 0x0010}); e.VR != "US" || !reflect.DeepEqual(e.Value, []byte{0x02, 0x00}) {
+		t.Errorf("Fail: %v %v", e.VR, e.Value)
+	}
+	e, _ := got.FindElement(Tag{0x0008, 0x1140})
+	if len(e.Items) != 1 || !reflect.DeepEqual(e.Items[0].Elements[0].Value, []byte("1.2.3\x00")) {
+		t.Errorf("Fail: %v", e.Items)
+	}
+}
+
+func TestWriteDeflated(t *testing.T) {
+	ds := &Dataset{Elements: []*Element{
+		{Tag: SOPClassUIDTag, VR: "UI", Value: []byte("1.2.840.10008.5.1.4.1.1.7\x00")},
+		{Tag: SOPInstanceUIDTag, VR: "UI", Value: []byte("1.2.3.4\x00")},
+		{Tag: Tag{0x0010, 0x0010}, VR: "PN", Value: []byte("DOE^JOHN")},
+	}}
+	var out bytes.Buffer
+	if err := Write(&out, ds, WriteOptions{TransferSyntax: DeflatedExplicitVRLittleEndian}); err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	d := NewDecoder(bytes.NewReader(out.Bytes()), Options{})
+	got, err := d.Decode()
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	if d.TransferSyntax() != DeflatedExplicitVRLittleEndian {
+		t.Errorf("Fail: %v", d.TransferSyntax())
+	}
+	if e, ok := got.FindElement(Tag{0x0010, 0x0010}); !ok || !reflect.DeepEqual(e.Value, []byte("DOE^JOHN")) {
+		t.Errorf("Fail: %v", e)
+	}
+}
+
+func TestWriteDataset(t *testing.T) {
+	ds := &Dataset{Elements: []*Element{
+		{Tag: Tag{0x0008, 0x0052}, VR: "CS", Value: []byte("STUDY ")},
+		{Tag: Tag{0x0010, 0x0010}, VR: "PN", Value: []byte("DOE*")},
+	}}
+	for _, ts := range []TransferSyntax{ImplicitVRLittleEndian, DeflatedExplicitVRLittleEndian} {
+		var out bytes.Buffer
+		if err := WriteDataset(&out, ds, ts); err != nil {
+			t.Fatalf("Fail: %s", err)
+		}
+		got, err := NewDatasetDecoder(bytes.NewReader(out.Bytes()), ts, Options{}).Decode()
+		if err != nil {
+			t.Fatalf("Fail: %s", err)
+		}
+		if len(got.Elements) != 2 || !reflect.DeepEqual(got.Elements[1].Value, []byte("DOE*")) {
+			t.Errorf("Fail: %v", got.Elements)
+		}
+	}
+}
@@ -0,0 +1,322 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/davidgamba/go-dicom/dicom/tag"
+)
+
+// xmlDataset - NativeDicomModel root element or sequence Item.
+// PS3.19 Section A.1
+type xmlDataset struct {
+	XMLName    xml.Name
+	Space      string         `xml:"xml:space,attr,omitempty"`
+	Number     int            `xml:"number,attr,omitempty"`
+	Attributes []xmlAttribute `xml:"DicomAttribute"`
+}
+
+// xmlAttribute - DicomAttribute element.
+// DataFragment holds encapsulated pixel data as written by dcm4che, the
+// Basic Offset Table followed by the fragments.
+type xmlAttribute struct {
+	Tag            string          `xml:"tag,attr"`
+	VR             string          `xml:"vr,attr"`
+	Keyword        string          `xml:"keyword,attr,omitempty"`
+	PrivateCreator string          `xml:"privateCreator,attr,omitempty"`
+	Values         []xmlValue      `xml:"Value"`
+	PersonNames    []xmlPersonName `xml:"PersonName"`
+	Items          []xmlDataset    `xml:"Item"`
+	BulkData       *xmlBulkData    `xml:"BulkData"`
+	InlineBinary   *string         `xml:"InlineBinary"`
+	DataFragments  []xmlFragment   `xml:"DataFragment"`
+}
+
+type xmlValue struct {
+	Number int    `xml:"number,attr"`
+	Value  string `xml:",chardata"`
+}
+
+type xmlPersonName struct {
+	Number      int            `xml:"number,attr"`
+	Alphabetic  *xmlComponents `xml:"Alphabetic"`
+	Ideographic *xmlComponents `xml:"Ideographic"`
+	Phonetic    *xmlComponents `xml:"Phonetic"`
+}
+
+type xmlComponents struct {
+	FamilyName string `xml:",omitempty"`
+	GivenName  string `xml:",omitempty"`
+	MiddleName string `xml:",omitempty"`
+	NamePrefix string `xml:",omitempty"`
+	NameSuffix string `xml:",omitempty"`
+}
+
+type xmlBulkData struct {
+	URI string `xml:"uri,attr"`
+}
+
+type xmlFragment struct {
+	Number       int    `xml:"number,attr"`
+	InlineBinary string `xml:"InlineBinary"`
+}
+
+// XMLOptions - Controls how EncodeXML writes binary values.
+type XMLOptions struct {
+	// BulkDataURI returns the URI written instead of the value of an OB, OD,
+	// OF, OL, OV, OW or UN element, or "" to write the value as InlineBinary.
+	BulkDataURI func(e *Element) string
+}
+
+// EncodeXML writes ds in the Native DICOM Model, indented.
+// Group lengths are left out and elements keep their BulkDataURI when their
+// value wasn't read.
+// PS3.19 Section A.1
+func EncodeXML(w io.Writer, ds *Dataset, opts XMLOptions) error {
+	root, err := opts.dataset(ds)
+	if err != nil {
+		return err
+	}
+	root.XMLName = xml.Name{Local: "NativeDicomModel"}
+	root.Space = "preserve"
+	io.WriteString(w, xml.Header)
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(root); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// DecodeXML reads a dataset in the Native DICOM Model, like the output of
+// dcm4che's dcm2xml.
+// Text values are encoded with the Specific Character Set of the dataset.
+// PS3.19 Section A.1
+func DecodeXML(r io.Reader) (*Dataset, error) {
+	var root xmlDataset
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, err
+	}
+	if root.XMLName.Local != "NativeDicomModel" {
+		return nil, fmt.Errorf("expected NativeDicomModel, found %s", root.XMLName.Local)
+	}
+	return root.dataset(nil)
+}
+
+func (opts XMLOptions) dataset(ds *Dataset) (xmlDataset, error) {
+	x := xmlDataset{}
+	for _, e := range ds.Elements {
+		if e.Tag.Element == 0x0000 {
+			continue
+		}
+		a, err := opts.attribute(e)
+		if err != nil {
+			return x, err
+		}
+		x.Attributes = append(x.Attributes, a)
+	}
+	sort.SliceStable(x.Attributes, func(i, j int) bool { return x.Attributes[i].Tag < x.Attributes[j].Tag })
+	return x, nil
+}
+
+func (opts XMLOptions) attribute(e *Element) (xmlAttribute, error) {
+	a := xmlAttribute{Tag: fmt.Sprintf("%04X%04X", e.Tag.Group, e.Tag.Element), VR: e.VR}
+	if e.Tag.IsPrivate() {
+		a.PrivateCreator = e.Creator
+	} else if entry, _, ok := tag.Lookup(e.Tag.Group, e.Tag.Element); ok {
+		a.Keyword = entry.Keyword
+	}
+	if e.BulkDataURI != "" && (e.Lazy || len(e.Value) == 0) {
+		a.BulkData = &xmlBulkData{e.BulkDataURI}
+		return a, nil
+	}
+	if jsonBinaryVRs[e.VR] && opts.BulkDataURI != nil {
+		if uri := opts.BulkDataURI(e); uri != "" {
+			a.BulkData = &xmlBulkData{uri}
+			return a, nil
+		}
+	}
+	if err := e.ReadValue(); err != nil {
+		return a, err
+	}
+	switch {
+	case e.VR == "SQ":
+		for i, item := range e.Items {
+			x, err := opts.dataset(item)
+			if err != nil {
+				return a, err
+			}
+			x.XMLName = xml.Name{Local: "Item"}
+			x.Number = i + 1
+			a.Items = append(a.Items, x)
+		}
+	case e.Fragments != nil:
+		table := make([]byte, 4*len(e.OffsetTable))
+		for i, o := range e.OffsetTable {
+			binary.LittleEndian.PutUint32(table[4*i:], o)
+		}
+		a.DataFragments = []xmlFragment{{1, base64.StdEncoding.EncodeToString(table)}}
+		for i, f := range e.Fragments {
+			a.DataFragments = append(a.DataFragments, xmlFragment{i + 2, base64.StdEncoding.EncodeToString(f)})
+		}
+	case len(e.Value) == 0:
+	case jsonBinaryVRs[e.VR]:
+		s := base64.StdEncoding.EncodeToString(swapValue(e, binary.LittleEndian))
+		a.InlineBinary = &s
+	case e.VR == "PN":
+		names, _ := e.PersonNames()
+		for i, pn := range names {
+			x := xmlPersonName{Number: i + 1}
+			x.Alphabetic, x.Ideographic, x.Phonetic = xmlName(pn.Alphabetic), xmlName(pn.Ideographic), xmlName(pn.Phonetic)
+			a.PersonNames = append(a.PersonNames, x)
+		}
+	default:
+		for i, s := range modelValues(e) {
+			a.Values = append(a.Values, xmlValue{i + 1, s})
+		}
+	}
+	return a, nil
+}
+
+// xmlName returns the components of a name group, nil when it is empty.
+func xmlName(c PersonNameComponents) *xmlComponents {
+	if c == (PersonNameComponents{}) {
+		return nil
+	}
+	x := xmlComponents(c)
+	return &x
+}
+
+// dataset returns the dataset of a NativeDicomModel or Item element whose
+// text values are encoded with cs, unless it has its own Specific Character
+// Set.
+func (x xmlDataset) dataset(cs *CharacterSet) (*Dataset, error) {
+	for _, a := range x.Attributes {
+		if a.Tag == fmt.Sprintf("%04X%04X", SpecificCharacterSetTag.Group, SpecificCharacterSetTag.Element) {
+			var err error
+			cs, err = NewCharacterSet(a.values())
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	ds := &Dataset{}
+	for _, a := range x.Attributes {
+		e, err := a.element(cs)
+		if err != nil {
+			return nil, err
+		}
+		ds.Elements = append(ds.Elements, e)
+	}
+	sort.SliceStable(ds.Elements, func(i, j int) bool { return ds.Elements[i].Tag.less(ds.Elements[j].Tag) })
+	for _, e := range ds.Elements {
+		if e.Creator == "" {
+			e.Creator = ds.creator(e.Tag)
+		}
+	}
+	return ds, nil
+}
+
+// values returns the Value or PersonName elements of the attribute in the
+// order of their number, missing numbers are empty values and values
+// without number follow the last one.
+func (a xmlAttribute) values() []string {
+	strs := []string{}
+	set := func(n int, s string) {
+		if n <= 0 {
+			n = len(strs) + 1
+		}
+		for len(strs) < n {
+			strs = append(strs, "")
+		}
+		strs[n-1] = s
+	}
+	for _, v := range a.Values {
+		set(v.Number, v.Value)
+	}
+	for _, pn := range a.PersonNames {
+		groups := []string{}
+		for _, c := range []*xmlComponents{pn.Alphabetic, pn.Ideographic, pn.Phonetic} {
+			if c == nil {
+				groups = append(groups, "")
+				continue
+			}
+			groups = append(groups, PersonNameComponents(*c).String())
+		}
+		set(pn.Number, strings.TrimRight(strings.Join(groups, "="), "="))
+	}
+	return strs
+}
+
+func (a xmlAttribute) element(cs *CharacterSet) (*Element, error) {
+	t, err := ParseTag(a.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if !vrs[a.VR] {
+		return nil, fmt.Errorf("%s: unknown VR '%s'", t, a.VR)
+	}
+	e := &Element{Tag: t, VR: a.VR, Creator: a.PrivateCreator}
+	if textVRs[e.VR] {
+		e.CharacterSet = cs
+	}
+	switch {
+	case a.BulkData != nil:
+		e.BulkDataURI = a.BulkData.URI
+	case a.DataFragments != nil:
+		e.Len = UndefinedLength
+		e.Fragments = [][]byte{}
+		sort.SliceStable(a.DataFragments, func(i, j int) bool { return a.DataFragments[i].Number < a.DataFragments[j].Number })
+		for i, f := range a.DataFragments {
+			b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(f.InlineBinary))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", t, err)
+			}
+			if i > 0 {
+				e.Fragments = append(e.Fragments, b)
+				continue
+			}
+			for n := 0; n+4 <= len(b); n += 4 {
+				e.OffsetTable = append(e.OffsetTable, binary.LittleEndian.Uint32(b[n:]))
+			}
+		}
+	case a.InlineBinary != nil:
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(*a.InlineBinary))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", t, err)
+		}
+		e.Value = b
+		e.Len = uint32(len(b))
+	case e.VR == "SQ":
+		e.Len = UndefinedLength
+		sort.SliceStable(a.Items, func(i, j int) bool { return a.Items[i].Number < a.Items[j].Number })
+		for _, x := range a.Items {
+			item, err := x.dataset(cs)
+			if err != nil {
+				return nil, err
+			}
+			e.Items = append(e.Items, item)
+		}
+	default:
+		if strs := a.values(); len(strs) > 0 {
+			if err := e.SetValueString(strings.Join(strs, "\\")); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return e, nil
+}
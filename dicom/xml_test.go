@@ -0,0 +1,118 @@
+// This file is part of go-dicom.
+//
+// Copyright (C) 2016  David Gamba Rios
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dicom
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncodeXML(t *testing.T) {
+	ds := &Dataset{Elements: []*Element{
+		{Tag: Tag{0x0008, 0x0005}, VR: "CS", Value: []byte("\\ISO 2022 IR 87 ")},
+		{Tag: Tag{0x0008, 0x0060}, VR: "CS", Value: []byte("MR")},
+		{Tag: Tag{0x0010, 0x0010}, VR: "PN", Value: []byte("Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B")},
+		{Tag: Tag{0x0028, 0x0010}, VR: "US", Value: []byte{0, 2}},
+		{Tag: Tag{0x0029, 0x0010}, VR: "LO", Value: []byte("SIEMENS CSA HEADER")},
+		{Tag: Tag{0x0029, 0x1008}, VR: "CS", Value: []byte("IMAGE NUM 4 "), Creator: "SIEMENS CSA HEADER"},
+		{Tag: Tag{0x0040, 0x0275}, VR: "SQ", Items: []*Dataset{
+			{Elements: []*Element{{Tag: Tag{0x0040, 0x0009}, VR: "SH", Value: []byte("SPS1")}}},
+		}},
+		{Tag: Tag{0x7FE0, 0x0010}, VR: "OB", Value: []byte{0, 1}},
+	}}
+	cs, _ := NewCharacterSet([]string{"", "ISO 2022 IR 87"})
+	ds.Elements[2].CharacterSet = cs
+	var buf bytes.Buffer
+	err := EncodeXML(&buf, ds, XMLOptions{})
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<NativeDicomModel xml:space="preserve">
+  <DicomAttribute tag="00080005" vr="CS" keyword="SpecificCharacterSet">
+    <Value number="1"></Value>
+    <Value number="2">ISO 2022 IR 87</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00080060" vr="CS" keyword="Modality">
+    <Value number="1">MR</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00100010" vr="PN" keyword="PatientName">
+    <PersonName number="1">
+      <Alphabetic>
+        <FamilyName>Yamada</FamilyName>
+        <GivenName>Tarou</GivenName>
+      </Alphabetic>
+      <Ideographic>
+        <FamilyName>山田</FamilyName>
+        <GivenName>太郎</GivenName>
+      </Ideographic>
+    </PersonName>
+  </DicomAttribute>
+  <DicomAttribute tag="00280010" vr="US" keyword="Rows">
+    <Value number="1">512</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00290010" vr="LO">
+    <Value number="1">SIEMENS CSA HEADER</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00291008" vr="CS" privateCreator="SIEMENS CSA HEADER">
+    <Value number="1">IMAGE NUM 4</Value>
+  </DicomAttribute>
+  <DicomAttribute tag="00400275" vr="SQ" keyword="RequestAttributesSequence">
+    <Item number="1">
+      <DicomAttribute tag="00400009" vr="SH" keyword="ScheduledProcedureStepID">
+        <Value number="1">SPS1</Value>
+      </DicomAttribute>
+    </Item>
+  </DicomAttribute>
+  <DicomAttribute tag="7FE00010" vr="OB" keyword="PixelData">
+    <InlineBinary>AAE=</InlineBinary>
+  </DicomAttribute>
+</NativeDicomModel>
+`
+	if err != nil || buf.String() != expected {
+		t.Errorf("Fail: %s, %v", buf.String(), err)
+	}
+	decoded, err := DecodeXML(&buf)
+	if err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	for i, e := range decoded.Elements {
+		if e.Tag != ds.Elements[i].Tag || e.ValueString() != ds.Elements[i].ValueString() || e.Creator != ds.Elements[i].Creator {
+			t.Errorf("Fail: %s %s %s", e.Tag, e.ValueString(), e.Creator)
+		}
+	}
+}
+
+func TestDecodeXML(t *testing.T) {
+	s := `<?xml version="1.0" encoding="UTF-8"?>
+<NativeDicomModel xml:space="preserve">
+<DicomAttribute keyword="SpecificCharacterSet" tag="00080005" vr="CS"><Value number="1">ISO_IR 100</Value></DicomAttribute>
+<DicomAttribute keyword="ImageType" tag="00080008" vr="CS"><Value number="1">ORIGINAL</Value><Value number="3">AXIAL</Value></DicomAttribute>
+<DicomAttribute keyword="PatientName" tag="00100010" vr="PN"><PersonName number="1"><Alphabetic><FamilyName>Buc</FamilyName><GivenName>Jérôme</GivenName></Alphabetic></PersonName></DicomAttribute>
+<DicomAttribute keyword="PixelData" tag="7FE00010" vr="OB"><BulkData uri="file:///tmp/1.dcm?offset=10&amp;length=4"/></DicomAttribute>
+</NativeDicomModel>`
+	ds, err := DecodeXML(strings.NewReader(s))
+	if err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	values := [][]byte{}
+	for _, e := range ds.Elements {
+		values = append(values, e.Value)
+	}
+	expected := [][]byte{[]byte("ISO_IR 100"), []byte("ORIGINAL\\\\AXIAL "), []byte("Buc^J\xe9r\xf4me"), nil}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Fail: %q", values)
+	}
+	if ds.Elements[3].BulkDataURI != "file:///tmp/1.dcm?offset=10&length=4" {
+		t.Errorf("Fail: %s", ds.Elements[3].BulkDataURI)
+	}
+	_, err = DecodeXML(strings.NewReader("<DicomAttribute/>"))
+	if err == nil || err.Error() != "expected NativeDicomModel, found DicomAttribute" {
+		t.Errorf("Fail: %v", err)
+	}
+}
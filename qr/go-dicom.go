@@ -13,9 +13,9 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"github.com/davidgamba/go-dicom/dicom"
+	"github.com/davidgamba/go-dicom/dicom/uid"
 	"github.com/davidgamba/go-dicom/qr/pdu"
-	"github.com/davidgamba/go-dicom/qr/sopclass"
-	"github.com/davidgamba/go-dicom/qr/syntax/ts"
 	"github.com/davidgamba/go-getoptions" // As getoptions
 	"log"
 	"net"
@@ -25,13 +25,13 @@ import (
 )
 
 // AppContextName = "1.2.840.10008.3.1.1.1"
-const AppContextName = "1.2.840.10008.3.1.1.1"
+const AppContextName = uid.DICOMApplicationContext
 
 // ImplementationClassUID = "1.2.40.0.13.1.1"
-const ImplementationClassUID = "1.2.40.0.13.1.1"
+const ImplementationClassUID = dicom.ImplementationClassUID
 
 // ImplementationVersion = "go-dicom-0.1.0"
-const ImplementationVersion = "go-dicom-0.1.0"
+const ImplementationVersion = dicom.ImplementationVersion
 
 type dicomqr struct {
 	CalledAE  [16]byte
@@ -124,7 +124,7 @@ func PressContextItem(items ...[]byte) []byte {
 
 // AbstractSyntaxItem returns a byte slice with abstract syntax item.
 func AbstractSyntaxItem() []byte {
-	return stringItem([]byte{0x30}, sopclass.VerificationSOPClass, "AbstractSyntaxItem")
+	return stringItem([]byte{0x30}, uid.Verification, "AbstractSyntaxItem")
 }
 
 // TrasnferSyntaxItem returns a byte slice with transfer syntax item.
@@ -319,10 +319,10 @@ func main() {
 	qr.ARAdd(pdu.AppContext(AppContextName))
 
 	qr.ARAdd(PressContextItem(
-		pdu.AbstractSyntax(sopclass.PatientRootQRIMFind),
-		TrasnferSyntaxItem(ts.ImplicitVRLittleEndian),
-		TrasnferSyntaxItem(ts.ExplicitVRLittleEndian),
-		TrasnferSyntaxItem(ts.ExplicitVRBigEndian),
+		pdu.AbstractSyntax(uid.PatientRootQueryRetrieveInformationModelFind),
+		TrasnferSyntaxItem(uid.ImplicitVRLittleEndian),
+		TrasnferSyntaxItem(uid.ExplicitVRLittleEndian),
+		TrasnferSyntaxItem(uid.ExplicitVRBigEndian),
 	))
 	qr.ARAdd(UserInfoItem(
 		MaximunLenghtItem(32768),
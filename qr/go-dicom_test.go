@@ -9,8 +9,11 @@
 package main
 
 import (
-	"github.com/davidgamba/go-dicom/pdu"
-	"github.com/davidgamba/go-dicom/syntax/ts"
+	"bytes"
+	"encoding/binary"
+	"github.com/davidgamba/go-dicom/dicom"
+	"github.com/davidgamba/go-dicom/dicom/uid"
+	"github.com/davidgamba/go-dicom/qr/pdu"
 	"reflect"
 	"testing"
 )
@@ -48,9 +51,47 @@ func TestPutIntToByteSize(t *testing.T) {
 }
 
 func TestTS(t *testing.T) {
-	b := TrasnferSyntaxItem(ts.ImplicitVRLittleEndian)
+	b := TrasnferSyntaxItem(uid.ImplicitVRLittleEndian)
 	ts := []byte{0x40, 0, 0, 0x11, 0x31, 0x2e, 0x32, 0x2e, 0x38, 0x34, 0x30, 0x2e, 0x31, 0x30, 0x30, 0x30, 0x38, 0x2e, 0x31, 0x2e, 0x32}
 	if !reflect.DeepEqual(b, ts) {
 		t.Errorf("Fail: %x", b)
 	}
 }
+
+func TestDeflatedDataTF(t *testing.T) {
+	ds := &dicom.Dataset{Elements: []*dicom.Element{
+		{Tag: dicom.Tag{Group: 0x0008, Element: 0x0052}, VR: "CS", Value: []byte("PATIENT ")},
+		{Tag: dicom.Tag{Group: 0x0010, Element: 0x0010}, VR: "PN", Value: []byte("DOE*")},
+	}}
+	var b bytes.Buffer
+	if err := dicom.WriteDataset(&b, ds, dicom.DeflatedExplicitVRLittleEndian); err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	pdus := pdu.DataTF(1, b.Bytes(), 8)
+	var data []byte
+	for i, p := range pdus {
+		// PDU header, PDV item length, presentation context and message
+		// control header.
+		content := p[6+4+2:]
+		data = append(data, content...)
+		if l := binary.BigEndian.Uint32(p[2:6]); int(l) != len(p)-6 {
+			t.Errorf("Fail: PDU %d length %d", i, l)
+		}
+		if l := binary.BigEndian.Uint32(p[6:10]); int(l) != 2+len(content) {
+			t.Errorf("Fail: PDU %d PDV item length %d", i, l)
+		}
+		if p[10] != 1 {
+			t.Errorf("Fail: PDU %d presentation context %d", i, p[10])
+		}
+		if last := p[6+4+1] == 0x2; last != (i == len(pdus)-1) {
+			t.Errorf("Fail: PDU %d last fragment flag %v", i, last)
+		}
+	}
+	got, err := dicom.NewDatasetDecoder(bytes.NewReader(data), dicom.DeflatedExplicitVRLittleEndian, dicom.Options{}).Decode()
+	if err != nil {
+		t.Fatalf("Fail: %s", err)
+	}
+	if len(got.Elements) != 2 || !reflect.DeepEqual(got.Elements[1].Value, []byte("DOE*")) {
+		t.Errorf("Fail: %v", got.Elements)
+	}
+}
@@ -34,7 +34,6 @@ type PDATATFPDU struct {
 type PDVItem struct {
 	Lenght        [4]byte
 	PresContextID byte // Odd Integers between 1 and 255
-	Context       byte // Abstract syntax ID to use
 	Flag          byte // Message Control Header
 	// 0 - Message Data set Information
 	// 1 - Message Command Information
@@ -106,15 +105,16 @@ func (e *AReleaseRequest) Len() {
 func (e *PDATATFPDU) Len() {
 	var l int
 	for _, c := range e.Content {
-		l += c.Len() + 5
+		l += c.Len() + 4
 	}
 	PutIntToByteSize4(&e.PDULenght, uint32(l))
 }
 
-// Len get the len of PDVItem
+// Len get the len of PDVItem, the presentation context ID and message
+// control header followed by the fragment.
+// PS3.8 Section 9.3.5.1
 func (e *PDVItem) Len() int {
-	l := len(e.Content)
-	l++
+	l := len(e.Content) + 2
 	PutIntToByteSize4(&e.Lenght, uint32(l))
 	return l
 }
@@ -174,7 +174,6 @@ func (e *PDVItem) ToBytes() []byte {
 	b := []byte{}
 	b = append(b, e.Lenght[:]...)
 	b = append(b, e.PresContextID)
-	b = append(b, e.Context)
 	b = append(b, e.Flag)
 	b = append(b, e.Content[:]...)
 	return b
@@ -215,7 +214,6 @@ func AbstractSyntax(name string) []byte {
 func CFindRQ(sopclass, level string) []byte {
 	pdu1 := PDVItem{
 		PresContextID: 0x1,
-		Context:       0x1,
 		Flag:          0x3,
 		Content:       []byte{},
 	}
@@ -227,3 +225,30 @@ func CFindRQ(sopclass, level string) []byte {
 	e.PDU.Content = append(e.PDU.Content, pdu1)
 	return e.PDU.ToBytes()
 }
+
+// DataTF returns the P-DATA-TF PDUs carrying an encoded dataset, split in
+// PDV items of at most maxLength bytes. The last one is flagged as the last
+// fragment.
+// qr doesn't send P-DATA yet, datasets are not exchanged over the
+// association.
+func DataTF(presContextID byte, data []byte, maxLength int) [][]byte {
+	pdus := [][]byte{}
+	for len(data) > 0 || len(pdus) == 0 {
+		n := len(data)
+		if n > maxLength {
+			n = maxLength
+		}
+		item := PDVItem{
+			PresContextID: presContextID,
+			Content:       data[:n],
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			item.Flag = 0x2
+		}
+		e := PDATATFPDU{PDUType: 0x4}
+		e.Content = append(e.Content, item)
+		pdus = append(pdus, e.ToBytes())
+	}
+	return pdus
+}
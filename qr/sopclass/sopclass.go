@@ -1,38 +0,0 @@
-// This file is part of go-dicom.
-//
-// Copyright (C) 2016  David Gamba Rios
-//
-// This Source Code Form is subject to the terms of the Mozilla Public
-// License, v. 2.0. If a copy of the MPL was not distributed with this
-// file, You can obtain one at http://mozilla.org/MPL/2.0/.
-
-// Package sopclass contains constants for DICOM SOP classes
-package sopclass
-
-// VerificationSOPClass Verification SOP Class
-// 1.2.840.10008.1.1
-const VerificationSOPClass = "1.2.840.10008.1.1"
-
-// PatientRootQRIMFind Patient Root Query/Retrieve Information Model – FIND
-// 1.2.840.10008.5.1.4.1.2.1.1
-const PatientRootQRIMFind = "1.2.840.10008.5.1.4.1.2.1.1"
-
-// PatientRootQRIMMove Patient Root Query/Retrieve Information Model – MOVE
-// 1.2.840.10008.5.1.4.1.2.1.2
-const PatientRootQRIMMove = "1.2.840.10008.5.1.4.1.2.1.2"
-
-// PatientRootQRIMGet Patient Root Query/Retrieve Information Model – GET
-// 1.2.840.10008.5.1.4.1.2.1.3
-const PatientRootQRIMGet = "1.2.840.10008.5.1.4.1.2.1.3"
-
-// StudyRootQRIMFind Study Root Query/Retrieve Information Model – FIND
-// 1.2.840.10008.5.1.4.1.2.2.1
-const StudyRootQRIMFind = "1.2.840.10008.5.1.4.1.2.2.1"
-
-// StudyRootQRIMMove Study Root Query/Retrieve Information Model – MOVE
-// 1.2.840.10008.5.1.4.1.2.2.2
-const StudyRootQRIMMove = "1.2.840.10008.5.1.4.1.2.2.2"
-
-// StudyRootQRIMGet Study Root Query/Retrieve Information Model – GET
-// 1.2.840.10008.5.1.4.1.2.2.3
-const StudyRootQRIMGet = "1.2.840.10008.5.1.4.1.2.2.3"
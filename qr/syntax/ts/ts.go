@@ -1,19 +0,0 @@
-// This file is part of go-dicom.
-//
-// Copyright (C) 2016  David Gamba Rios
-//
-// This Source Code Form is subject to the terms of the Mozilla Public
-// License, v. 2.0. If a copy of the MPL was not distributed with this
-// file, You can obtain one at http://mozilla.org/MPL/2.0/.
-
-// Package ts contains DICOM transfer syntax constants
-package ts
-
-// ImplicitVRLittleEndian = "1.2.840.10008.1.2"
-const ImplicitVRLittleEndian = "1.2.840.10008.1.2"
-
-// ExplicitVRLittleEndian = "1.2.840.10008.1.2.1"
-const ExplicitVRLittleEndian = "1.2.840.10008.1.2.1"
-
-// ExplicitVRBigEndian = "1.2.840.10008.1.2.2"
-const ExplicitVRBigEndian = "1.2.840.10008.1.2.2"
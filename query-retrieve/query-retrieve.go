@@ -6,6 +6,8 @@ package main
 
 import (
 	"fmt"
+	"github.com/davidgamba/go-dicom/dicom"
+	dicomtag "github.com/davidgamba/go-dicom/dicom/tag"
 	"github.com/davidgamba/go-dicom/qr/tag"
 	"github.com/davidgamba/go-getoptions" // as getoptions
 	"gopkg.in/xmlpath.v2"
@@ -14,6 +16,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var debug bool
@@ -80,6 +83,68 @@ func debugln(a ...interface{}) (n int, err error) {
 	return 0, nil
 }
 
+// personName returns the first PN value of the attribute with the given
+// keyword in a dcm4che XML dataset, with all its component groups.
+func personName(n *xmlpath.Node, keyword string) string {
+	pn := dicom.PersonName{}
+	groups := map[string]*dicom.PersonNameComponents{
+		"Alphabetic":  &pn.Alphabetic,
+		"Ideographic": &pn.Ideographic,
+		"Phonetic":    &pn.Phonetic,
+	}
+	for g, c := range groups {
+		components := map[string]*string{
+			"FamilyName": &c.FamilyName,
+			"GivenName":  &c.GivenName,
+			"MiddleName": &c.MiddleName,
+			"NamePrefix": &c.NamePrefix,
+			"NameSuffix": &c.NameSuffix,
+		}
+		for name, v := range components {
+			path := xmlpath.MustCompile(fmt.Sprintf("DicomAttribute[@keyword='%s']/PersonName[@number='1']/%s/%s", keyword, g, name))
+			*v, _ = path.String(n)
+		}
+	}
+	return pn.String()
+}
+
+// patientNameKey returns the PatientName matching key for the PN value pn.
+// Only the alphabetic component group is sent, archives don't agree on how
+// to match the ideographic and phonetic ones.
+func patientNameKey(pn string) string {
+	name := dicom.ParsePersonName(pn)
+	if name.Alphabetic.String() == "" {
+		return "PatientName=" + name.String()
+	}
+	return "PatientName=" + name.Alphabetic.String()
+}
+
+// matchingKeys validates the date and time range matching keys of the
+// "keyword=value" queries and returns them normalized, other keys are passed
+// as is.
+func matchingKeys(query []string) ([]string, error) {
+	keys := []string{}
+	for _, q := range query {
+		kv := strings.SplitN(q, "=", 2)
+		t, err := dicom.ParseTag(kv[0])
+		if len(kv) != 2 || kv[1] == "" || err != nil {
+			keys = append(keys, q)
+			continue
+		}
+		entry, _, _ := dicomtag.Lookup(t.Group, t.Element)
+		switch entry.VR {
+		case "DA", "TM", "DT":
+			r, err := dicom.ParseDateTimeRange(entry.VR, kv[1], time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", kv[0], err)
+			}
+			q = kv[0] + "=" + r.String()
+		}
+		keys = append(keys, q)
+	}
+	return keys, nil
+}
+
 func patientLevelFind(bin, pacs, bind, dir string, query ...string) ([]tag.PatientLevel, error) {
 	var pl []tag.PatientLevel
 	err := os.Remove(dir + string(os.PathSeparator) + "001.dcm")
@@ -115,14 +180,13 @@ func patientLevelFind(bin, pacs, bind, dir string, query ...string) ([]tag.Patie
 		return pl, err
 	}
 	path := xmlpath.MustCompile("/NativeDicomModel")
-	pnPath := xmlpath.MustCompile("DicomAttribute[@keyword='PatientName']/PersonName[@number='1']/Alphabetic/FamilyName")
 	pIDPath := xmlpath.MustCompile("DicomAttribute[@keyword='PatientID']/Value")
 	pNSPath := xmlpath.MustCompile("DicomAttribute[@keyword='NumberOfPatientRelatedStudies']/Value")
 	pNSerPath := xmlpath.MustCompile("DicomAttribute[@keyword='NumberOfPatientRelatedSeries']/Value")
 	pNInsPath := xmlpath.MustCompile("DicomAttribute[@keyword='NumberOfPatientRelatedInstances']/Value")
 	iter := path.Iter(root)
 	for iter.Next() {
-		pn, _ := pnPath.String(iter.Node())
+		pn := personName(iter.Node(), "PatientName")
 		pID, _ := pIDPath.String(iter.Node())
 		pNS, _ := pNSPath.String(iter.Node())
 		pNSer, _ := pNSerPath.String(iter.Node())
@@ -186,8 +250,6 @@ func studyLevelFind(bin, pacs, bind, dir string, studyRoot bool, query ...string
 	smodPath := xmlpath.MustCompile("DicomAttribute[@keyword='ModalitiesInStudy']/Value")
 	sNSerPath := xmlpath.MustCompile("DicomAttribute[@keyword='NumberOfStudyRelatedSeries']/Value")
 	sNInsPath := xmlpath.MustCompile("DicomAttribute[@keyword='NumberOfStudyRelatedInstances']/Value")
-	rnPath := xmlpath.MustCompile("DicomAttribute[@keyword='ReferringPhysicianName']/PersonName[@number='1']/Alphabetic/FamilyName")
-	pnPath := xmlpath.MustCompile("DicomAttribute[@keyword='PatientName']/PersonName[@number='1']/Alphabetic/FamilyName")
 	pIDPath := xmlpath.MustCompile("DicomAttribute[@keyword='PatientID']/Value")
 	iter := path.Iter(root)
 	for iter.Next() {
@@ -196,8 +258,8 @@ func studyLevelFind(bin, pacs, bind, dir string, studyRoot bool, query ...string
 		smod, _ := smodPath.String(iter.Node())
 		sNSer, _ := sNSerPath.String(iter.Node())
 		sNIns, _ := sNInsPath.String(iter.Node())
-		rn, _ := rnPath.String(iter.Node())
-		pn, _ := pnPath.String(iter.Node())
+		rn := personName(iter.Node(), "ReferringPhysicianName")
+		pn := personName(iter.Node(), "PatientName")
 		pID, _ := pIDPath.String(iter.Node())
 		csl := tag.StudyLevel{StudyInstanceUID: suid,
 			AccessionNumber:          san,
@@ -226,7 +288,7 @@ func seriesList(bin, pacs, bind, dir, patient, studyUID string) ([]tag.SeriesLev
 	command = append(command, bin+string(os.PathSeparator)+"bin"+string(os.PathSeparator)+"findscu")
 	command = append(command, "-c", pacs)
 	command = append(command, "-b", bind)
-	command = append(command, "-m", "PatientName="+patient)
+	command = append(command, "-m", patientNameKey(patient))
 	command = append(command, "-m", "StudyInstanceUID="+studyUID)
 	command = append(command, "-r", "SeriesInstanceUID")
 	command = append(command, "-r", "00200011") // SeriesNumber
@@ -281,7 +343,7 @@ func sopList(bin, pacs, bind, dir, patient, studyUID, seriesUID string) ([]tag.I
 	command = append(command, bin+string(os.PathSeparator)+"bin"+string(os.PathSeparator)+"findscu")
 	command = append(command, "-c", pacs)
 	command = append(command, "-b", bind)
-	command = append(command, "-m", "PatientName="+patient)
+	command = append(command, "-m", patientNameKey(patient))
 	command = append(command, "-m", "StudyInstanceUID="+studyUID)
 	command = append(command, "-m", "SeriesInstanceUID="+seriesUID)
 	command = append(command, "-r", "SOPInstanceUID")
@@ -325,7 +387,7 @@ func getSeries(bin, pacs, bind, dir, patient, studyUID, seriesUID string) error
 	command = append(command, bin+string(os.PathSeparator)+"bin"+string(os.PathSeparator)+"getscu")
 	command = append(command, "-c", pacs)
 	command = append(command, "-b", bind)
-	command = append(command, "-m", "PatientName="+patient)
+	command = append(command, "-m", patientNameKey(patient))
 	command = append(command, "-m", "StudyInstanceUID="+studyUID)
 	command = append(command, "-m", "SeriesInstanceUID="+seriesUID)
 	command = append(command, "-L", "SERIES")
@@ -352,7 +414,7 @@ func getInstance(bin, pacs, bind, dir, patient, studyUID, seriesUID, sopUID stri
 	command = append(command, bin+string(os.PathSeparator)+"bin"+string(os.PathSeparator)+"getscu")
 	command = append(command, "-c", pacs)
 	command = append(command, "-b", bind)
-	command = append(command, "-m", "PatientName="+patient)
+	command = append(command, "-m", patientNameKey(patient))
 	command = append(command, "-m", "StudyInstanceUID="+studyUID)
 	command = append(command, "-m", "SeriesInstanceUID="+seriesUID)
 	command = append(command, "-m", "SOPInstanceUID="+sopUID)
@@ -387,7 +449,7 @@ func printPatientSOPList(bin, pacs, bind, dir string, level int, get bool, query
 		fmt.Printf("  NumberOfPatientRelatedSeries: %s,\n", p.NumberOfRelatedSeries)
 		fmt.Printf("  NumberOfPatientRelatedInstances: %s,\n", p.NumberOfRelatedInstances)
 		if level >= 1 { // study
-			printStudySOPList(bin, pacs, bind, dir, level, get, p, "PatientName="+p.PatientName)
+			printStudySOPList(bin, pacs, bind, dir, level, get, p, patientNameKey(p.PatientName))
 		}
 		fmt.Printf("}\n")
 	}
@@ -527,7 +589,7 @@ func main() {
 			os.Exit(1)
 		}
 		for _, p := range pl {
-			err := printPatientSOPList(lib, pacs, bind, dir, level, false, "PatientName="+p.PatientName)
+			err := printPatientSOPList(lib, pacs, bind, dir, level, false, patientNameKey(p.PatientName))
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "[ERROR] printPatientSOPList: %s\n", err)
 				os.Exit(1)
@@ -540,7 +602,7 @@ func main() {
 			os.Exit(1)
 		}
 		patient := remaining[1]
-		err := printPatientSOPList(lib, pacs, bind, dir, level, false, "PatientName="+patient)
+		err := printPatientSOPList(lib, pacs, bind, dir, level, false, patientNameKey(patient))
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "[ERROR] printPatientSOPList: %s\n", err)
 			os.Exit(1)
@@ -551,8 +613,12 @@ func main() {
 			synopsis()
 			os.Exit(1)
 		}
-		query := remaining[1:]
-		err := printPatientSOPList(lib, pacs, bind, dir, level, false, query...)
+		query, err := matchingKeys(remaining[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[ERROR] query: %s\n", err)
+			os.Exit(1)
+		}
+		err = printPatientSOPList(lib, pacs, bind, dir, level, false, query...)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "[ERROR] printPatientSOPList: %s\n", err)
 			os.Exit(1)
@@ -563,8 +629,12 @@ func main() {
 			synopsis()
 			os.Exit(1)
 		}
-		query := remaining[1:]
-		err := printStudySOPList(lib, pacs, bind, dir, level, false, tag.PatientLevel{}, query...)
+		query, err := matchingKeys(remaining[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[ERROR] query: %s\n", err)
+			os.Exit(1)
+		}
+		err = printStudySOPList(lib, pacs, bind, dir, level, false, tag.PatientLevel{}, query...)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "[ERROR] printStudySOPList: %s\n", err)
 			os.Exit(1)
@@ -575,8 +645,12 @@ func main() {
 			synopsis()
 			os.Exit(1)
 		}
-		query := remaining[1:]
-		err := printStudySOPList(lib, pacs, bind, dir, 2, true, tag.PatientLevel{}, query...)
+		query, err := matchingKeys(remaining[1:])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[ERROR] query: %s\n", err)
+			os.Exit(1)
+		}
+		err = printStudySOPList(lib, pacs, bind, dir, 2, true, tag.PatientLevel{}, query...)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "[ERROR] printStudySOPList: %s\n", err)
 			os.Exit(1)
@@ -588,7 +662,7 @@ func main() {
 			os.Exit(1)
 		}
 		for _, p := range pl {
-			err := printPatientSOPList(lib, pacs, bind, dir, 2, true, "PatientName="+p.PatientName)
+			err := printPatientSOPList(lib, pacs, bind, dir, 2, true, patientNameKey(p.PatientName))
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "[ERROR] printPatientSOPList: %s\n", err)
 				os.Exit(1)
@@ -601,7 +675,7 @@ func main() {
 			os.Exit(1)
 		}
 		patient := remaining[1]
-		err := printPatientSOPList(lib, pacs, bind, dir, 2, true, "PatientName="+patient)
+		err := printPatientSOPList(lib, pacs, bind, dir, 2, true, patientNameKey(patient))
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "[ERROR] printPatientSOPList: %s\n", err)
 			os.Exit(1)